@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	pb "rpcGoDatatype/proto"
+	"rpcGoDatatype/usage"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// callInfo collects what the interceptors of a call account: the sizes of
+// the data converted, which conversions report since URL, path and object
+// inputs and output URL results are not part of the messages, and the
+// client once authenticated. The outermost interceptor attaches it.
+type callInfo struct {
+	mu       sync.Mutex
+	client   string
+	in, out  int64
+	reported bool
+}
+
+type callInfoKey struct{}
+
+// withCallInfo returns ctx carrying the call's info, creating it unless an
+// enclosing interceptor did.
+func withCallInfo(ctx context.Context) (context.Context, *callInfo) {
+	if c, ok := ctx.Value(callInfoKey{}).(*callInfo); ok {
+		return ctx, c
+	}
+	c := &callInfo{}
+	return context.WithValue(ctx, callInfoKey{}, c), c
+}
+
+// reportConverted adds the sizes of a conversion's input and output to the
+// call converting it.
+func reportConverted(ctx context.Context, in, out int64) {
+	if c, ok := ctx.Value(callInfoKey{}).(*callInfo); ok {
+		c.mu.Lock()
+		c.in += in
+		c.out += out
+		c.reported = true
+		c.mu.Unlock()
+	}
+}
+
+// setClient notes the identity of the authenticated caller.
+func (c *callInfo) setClient(client string) {
+	c.mu.Lock()
+	c.client = client
+	c.mu.Unlock()
+}
+
+// identity returns the caller noted by setClient, else the identity known
+// from ctx, as for calls rejected before authentication.
+func (c *callInfo) identity(ctx context.Context) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.client != "" {
+		return c.client
+	}
+	return clientIdentity(ctx)
+}
+
+// sizes returns the reported conversion sizes, else the message sizes in
+// and out.
+func (c *callInfo) sizes(in, out int64) (int64, int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.reported {
+		return c.in, c.out
+	}
+	return in, out
+}
+
+// messageSize returns the encoded size of a message, or 0.
+func messageSize(m interface{}) int64 {
+	if msg, ok := m.(proto.Message); ok {
+		return int64(proto.Size(msg))
+	}
+	return 0
+}
+
+// usageInterceptor enforces monthly quotas and accounts each call's input
+// and output sizes to the calling client. Health checks are not accounted.
+func (s *server) usageInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if info.FullMethod == healthpb.Health_Check_FullMethodName {
+		return handler(ctx, req)
+	}
+	ctx, call := withCallInfo(ctx)
+	client := clientIdentity(ctx)
+	call.setClient(client)
+	if err := s.checkQuota(client); err != nil {
+		return nil, err
+	}
+	resp, err := handler(ctx, req)
+	in, out := call.sizes(messageSize(req), messageSize(resp))
+	if err != nil {
+		out = 0
+	}
+	s.usage.Add(client, in, out)
+	return resp, err
+}
+
+// usageStreamInterceptor enforces quotas on streaming calls and accounts
+// their messages, which carry the data, once the stream ends.
+func (s *server) usageStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if info.FullMethod == healthpb.Health_Watch_FullMethodName {
+		return handler(srv, ss)
+	}
+	_, call := withCallInfo(ss.Context())
+	client := clientIdentity(ss.Context())
+	call.setClient(client)
+	if err := s.checkQuota(client); err != nil {
+		return err
+	}
+	counted := &countingStream{ServerStream: ss}
+	err := handler(srv, counted)
+	s.usage.Add(client, counted.in, counted.out)
+	return err
+}
+
+func (s *server) checkQuota(client string) error {
+	if err := s.usage.Check(client); err != nil {
+		if errors.Is(err, usage.ErrQuotaExceeded) {
+			return status.Errorf(codes.ResourceExhausted, "%v for %s", err, client)
+		}
+		return err
+	}
+	return nil
+}
+
+func clientUsage(r usage.Record) *pb.ClientUsage {
+	return &pb.ClientUsage{
+		Client:        r.Client,
+		Period:        r.Period,
+		Requests:      r.Requests,
+		BytesIn:       r.BytesIn,
+		BytesOut:      r.BytesOut,
+		Rejected:      r.Rejected,
+		QuotaBytes:    r.Quota.Bytes,
+		QuotaRequests: r.Quota.Requests,
+	}
+}
+
+// GetUsage reports this month's usage of the caller. Admins may ask for
+// another client or for all clients.
+func (s *server) GetUsage(ctx context.Context, req *pb.UsageRequest) (*pb.UsageResponse, error) {
+	if (req.All || req.Client != "") && !s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "admin token required")
+	}
+	resp := &pb.UsageResponse{}
+	if req.All {
+		for _, r := range s.usage.List() {
+			resp.Usage = append(resp.Usage, clientUsage(r))
+		}
+		return resp, nil
+	}
+	client := req.Client
+	if client == "" {
+		client = clientIdentity(ctx)
+	}
+	resp.Usage = append(resp.Usage, clientUsage(s.usage.Get(client)))
+	return resp, nil
+}
@@ -0,0 +1,420 @@
+// Package alerting evaluates converted rows against threshold rules and
+// emits alert events to subscribers and webhooks.
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"rpcGoDatatype/timeparse"
+)
+
+// Comparison operators accepted in Rule.Operator.
+const (
+	Above        = ">"
+	AboveOrEqual = ">="
+	Below        = "<"
+	BelowOrEqual = "<="
+)
+
+// Alert states.
+const (
+	StateFiring   = "firing"
+	StateResolved = "resolved"
+)
+
+const (
+	webhookTimeout = 30 * time.Second
+	// subscriberBuffer is the number of events a slow subscriber may fall
+	// behind before events are dropped for it.
+	subscriberBuffer = 64
+)
+
+var (
+	ErrNotFound = errors.New("alert rule not found")
+	ErrExists   = errors.New("alert rule already exists")
+	ErrInvalid  = errors.New("invalid alert rule")
+)
+
+// Rule fires when Parameter compares to Threshold by Operator in every row
+// of a station for at least Duration, and resolves at the first row that
+// no longer does.
+type Rule struct {
+	ID        string  `json:"id"`
+	Parameter string  `json:"parameter"`
+	Operator  string  `json:"operator"`
+	Threshold float64 `json:"threshold"`
+	// Station limits the rule to one station. Empty matches every station.
+	Station string `json:"station,omitempty"`
+	// StationColumn names the column holding the station of a row. Without
+	// it, or when the row has no value, the station is the client that
+	// submitted the data.
+	StationColumn string `json:"station_column,omitempty"`
+	// TimeColumn holds the time of a row. Without it, or when the value is
+	// not a time, rows are timed on arrival.
+	TimeColumn string `json:"time_column,omitempty"`
+	// Duration is how long the condition must hold, e.g. "15m". Empty
+	// fires at the first row.
+	Duration string `json:"duration,omitempty"`
+	// Webhook receives the rule's events as JSON POST requests.
+	Webhook   string    `json:"webhook,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	duration time.Duration
+}
+
+// Event is a change in the state of a rule for a station.
+type Event struct {
+	RuleID    string  `json:"rule_id"`
+	Station   string  `json:"station"`
+	Parameter string  `json:"parameter"`
+	State     string  `json:"state"`
+	Operator  string  `json:"operator"`
+	Threshold float64 `json:"threshold"`
+	// Value is the value of the row that changed the state.
+	Value float64 `json:"value"`
+	// Since is when the condition started to hold.
+	Since time.Time `json:"since"`
+	Time  time.Time `json:"time"`
+}
+
+func (r *Rule) validate() error {
+	switch {
+	case r.Parameter == "":
+		return fmt.Errorf("parameter is required")
+	case math.IsNaN(r.Threshold) || math.IsInf(r.Threshold, 0):
+		return fmt.Errorf("threshold must be finite")
+	}
+	switch r.Operator {
+	case Above, AboveOrEqual, Below, BelowOrEqual:
+	default:
+		return fmt.Errorf("unsupported operator %q", r.Operator)
+	}
+	r.duration = 0
+	if r.Duration != "" {
+		d, err := time.ParseDuration(r.Duration)
+		if err != nil || d < 0 {
+			return fmt.Errorf("invalid duration %q", r.Duration)
+		}
+		r.duration = d
+	}
+	if r.Webhook != "" {
+		u, err := url.Parse(r.Webhook)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid webhook %q", r.Webhook)
+		}
+	}
+	return nil
+}
+
+func (r *Rule) holds(v float64) bool {
+	switch r.Operator {
+	case Above:
+		return v > r.Threshold
+	case AboveOrEqual:
+		return v >= r.Threshold
+	case Below:
+		return v < r.Threshold
+	}
+	return v <= r.Threshold
+}
+
+// condition tracks a rule whose condition holds for a station.
+type condition struct {
+	since  time.Time
+	firing bool
+}
+
+type conditionKey struct {
+	rule, station string
+}
+
+// Service keeps the rules, in memory and, when a path is set, in a JSON
+// file, and the state of their conditions, in memory only.
+type Service struct {
+	path   string
+	client *http.Client
+
+	mu          sync.Mutex
+	rules       map[string]*Rule
+	conditions  map[conditionKey]*condition
+	subscribers map[chan Event]struct{}
+}
+
+// Open loads the rules from path. An empty path keeps rules in memory only.
+func Open(path string) (*Service, error) {
+	s := &Service{
+		path:        path,
+		client:      &http.Client{Timeout: webhookTimeout},
+		rules:       make(map[string]*Rule),
+		conditions:  make(map[conditionKey]*condition),
+		subscribers: make(map[chan Event]struct{}),
+	}
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading alert rules: %v", err)
+	}
+	var rules []*Rule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("error parsing alert rules: %v", err)
+	}
+	for _, r := range rules {
+		if err := r.validate(); err != nil {
+			return nil, fmt.Errorf("alert rule %s: %v", r.ID, err)
+		}
+		s.rules[r.ID] = r
+	}
+	return s, nil
+}
+
+// Create adds a rule, with a random ID unless it has one.
+func (s *Service) Create(r Rule) (Rule, error) {
+	if err := r.validate(); err != nil {
+		return Rule{}, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	if r.ID == "" {
+		b := make([]byte, 8)
+		if _, err := rand.Read(b); err != nil {
+			return Rule{}, fmt.Errorf("error generating rule id: %v", err)
+		}
+		r.ID = hex.EncodeToString(b)
+	}
+	r.CreatedAt = time.Now().UTC()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rules[r.ID]; ok {
+		return Rule{}, fmt.Errorf("rule %s: %w", r.ID, ErrExists)
+	}
+	s.rules[r.ID] = &r
+	if err := s.save(); err != nil {
+		delete(s.rules, r.ID)
+		return Rule{}, err
+	}
+	return r, nil
+}
+
+// List returns the rules ordered by ID.
+func (s *Service) List() []Rule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := make([]Rule, 0, len(s.rules))
+	for _, r := range s.rules {
+		list = append(list, *r)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list
+}
+
+// Delete removes a rule and forgets its conditions.
+func (s *Service) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.rules[id]
+	if !ok {
+		return fmt.Errorf("rule %s: %w", id, ErrNotFound)
+	}
+	delete(s.rules, id)
+	if err := s.save(); err != nil {
+		s.rules[id] = r
+		return err
+	}
+	for key := range s.conditions {
+		if key.rule == id {
+			delete(s.conditions, key)
+		}
+	}
+	return nil
+}
+
+// Active reports whether there are rules to evaluate.
+func (s *Service) Active() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.rules) > 0
+}
+
+// Subscribe returns a channel receiving every event from now on, and a
+// function to stop the subscription. Events are dropped for subscribers
+// that fall too far behind.
+func (s *Service) Subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, subscriberBuffer)
+	s.mu.Lock()
+	s.subscribers[ch] = struct{}{}
+	s.mu.Unlock()
+	return ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.subscribers[ch]; ok {
+			delete(s.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// Evaluate checks a row submitted by client against the rules.
+func (s *Service) Evaluate(client string, row map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var now time.Time
+	for _, r := range s.rules {
+		v, ok := number(row[r.Parameter])
+		if !ok {
+			continue
+		}
+		station := client
+		if r.StationColumn != "" {
+			if sv := row[r.StationColumn]; sv != nil {
+				if text := strings.TrimSpace(fmt.Sprint(sv)); text != "" {
+					station = text
+				}
+			}
+		}
+		if r.Station != "" && r.Station != station {
+			continue
+		}
+		t, ok := rowTime(row[r.TimeColumn])
+		if !ok {
+			if now.IsZero() {
+				now = time.Now().UTC()
+			}
+			t = now
+		}
+
+		key := conditionKey{rule: r.ID, station: station}
+		c := s.conditions[key]
+		if !r.holds(v) {
+			if c != nil {
+				delete(s.conditions, key)
+				if c.firing {
+					s.emit(r, Event{Station: station, State: StateResolved, Value: v, Since: c.since, Time: t})
+				}
+			}
+			continue
+		}
+		if c == nil {
+			c = &condition{since: t}
+			s.conditions[key] = c
+		}
+		if !c.firing && t.Sub(c.since) >= r.duration {
+			c.firing = true
+			s.emit(r, Event{Station: station, State: StateFiring, Value: v, Since: c.since, Time: t})
+		}
+	}
+}
+
+// emit sends an event to the subscribers and the rule's webhook. s.mu is
+// held.
+func (s *Service) emit(r *Rule, ev Event) {
+	ev.RuleID, ev.Parameter, ev.Operator, ev.Threshold = r.ID, r.Parameter, r.Operator, r.Threshold
+	slog.Info("alert", "rule", ev.RuleID, "station", ev.Station, "state", ev.State, "value", ev.Value)
+	for ch := range s.subscribers {
+		select {
+		case ch <- ev:
+		default:
+			slog.Warn("alert subscriber is too slow, dropping event", "rule", ev.RuleID)
+		}
+	}
+	if r.Webhook != "" {
+		go s.post(r.Webhook, ev)
+	}
+}
+
+func (s *Service) post(webhook string, ev Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+	body, _ := json.Marshal(ev)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		slog.ErrorContext(ctx, "invalid alert webhook", "rule", ev.RuleID, "error", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		slog.ErrorContext(ctx, "alert webhook failed", "rule", ev.RuleID, "error", err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		slog.ErrorContext(ctx, "alert webhook rejected event", "rule", ev.RuleID, "status", resp.Status)
+	}
+}
+
+// number reads a numeric row value.
+func number(v interface{}) (float64, bool) {
+	var (
+		f   float64
+		err error
+	)
+	switch x := v.(type) {
+	case json.Number:
+		f, err = x.Float64()
+	case string:
+		f, err = strconv.ParseFloat(strings.TrimSpace(x), 64)
+	case float64:
+		f = x
+	default:
+		return 0, false
+	}
+	return f, err == nil && !math.IsNaN(f) && !math.IsInf(f, 0)
+}
+
+// rowTime reads a textual time or an epoch time in seconds.
+func rowTime(v interface{}) (time.Time, bool) {
+	switch x := v.(type) {
+	case string:
+		if t, err := timeparse.Parse(strings.TrimSpace(x)); err == nil {
+			return t.UTC(), true
+		}
+	case json.Number:
+		if t, err := timeparse.ParseEpoch(x.String()); err == nil {
+			return t.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// save writes the rules to the file. s.mu is held.
+func (s *Service) save() error {
+	if s.path == "" {
+		return nil
+	}
+	rules := make([]*Rule, 0, len(s.rules))
+	for _, r := range s.rules {
+		rules = append(rules, r)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	data, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding alert rules: %v", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("error writing alert rules: %v", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("error writing alert rules: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"rpcGoDatatype/alerting"
+	"rpcGoDatatype/csvconverter"
+	pb "rpcGoDatatype/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// alertingServer serves the Alerting service. Rules are managed with the
+// admin token; any authenticated client may list them and watch alerts.
+type alertingServer struct {
+	pb.UnimplementedAlertingServer
+	s *server
+}
+
+func alertingError(err error) error {
+	switch {
+	case errors.Is(err, alerting.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, alerting.ErrExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, alerting.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func (a alertingServer) CreateAlertRule(ctx context.Context, req *pb.CreateAlertRuleRequest) (*pb.AlertRule, error) {
+	if !a.s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "admin token required")
+	}
+	m := req.Rule
+	if m == nil {
+		return nil, badRequest("rule is required", "rule")
+	}
+	rule, err := a.s.alerts.Create(alerting.Rule{
+		ID:            m.Id,
+		Parameter:     m.Parameter,
+		Operator:      m.Operator,
+		Threshold:     m.Threshold,
+		Station:       m.Station,
+		StationColumn: m.StationColumn,
+		TimeColumn:    m.TimeColumn,
+		Duration:      m.Duration,
+		Webhook:       m.Webhook,
+	})
+	if err != nil {
+		return nil, alertingError(err)
+	}
+	return alertRuleProto(rule), nil
+}
+
+func (a alertingServer) ListAlertRules(ctx context.Context, req *pb.ListAlertRulesRequest) (*pb.ListAlertRulesResponse, error) {
+	resp := &pb.ListAlertRulesResponse{}
+	for _, r := range a.s.alerts.List() {
+		resp.Rules = append(resp.Rules, alertRuleProto(r))
+	}
+	return resp, nil
+}
+
+func (a alertingServer) DeleteAlertRule(ctx context.Context, req *pb.DeleteAlertRuleRequest) (*pb.DeleteAlertRuleResponse, error) {
+	if !a.s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "admin token required")
+	}
+	if err := a.s.alerts.Delete(req.Id); err != nil {
+		return nil, alertingError(err)
+	}
+	return &pb.DeleteAlertRuleResponse{}, nil
+}
+
+// WatchAlerts streams the alert events raised from now on, optionally only
+// those of one rule or station.
+func (a alertingServer) WatchAlerts(req *pb.WatchAlertsRequest, stream pb.Alerting_WatchAlertsServer) error {
+	events, stop := a.s.alerts.Subscribe()
+	defer stop()
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if req.RuleId != "" && ev.RuleID != req.RuleId || req.Station != "" && ev.Station != req.Station {
+				continue
+			}
+			if err := stream.Send(&pb.AlertEvent{
+				RuleId:    ev.RuleID,
+				Station:   ev.Station,
+				Parameter: ev.Parameter,
+				State:     ev.State,
+				Operator:  ev.Operator,
+				Threshold: ev.Threshold,
+				Value:     ev.Value,
+				Since:     formatTime(ev.Since),
+				Time:      formatTime(ev.Time),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func alertRuleProto(r alerting.Rule) *pb.AlertRule {
+	return &pb.AlertRule{
+		Id:            r.ID,
+		Parameter:     r.Parameter,
+		Operator:      r.Operator,
+		Threshold:     r.Threshold,
+		Station:       r.Station,
+		StationColumn: r.StationColumn,
+		TimeColumn:    r.TimeColumn,
+		Duration:      r.Duration,
+		Webhook:       r.Webhook,
+		CreatedAt:     formatTime(r.CreatedAt),
+	}
+}
+
+// alertsActive reports whether there are alert rules to evaluate.
+func (s *server) alertsActive() bool {
+	return s.alerts != nil && s.alerts.Active()
+}
+
+// alertOptions makes a conversion evaluate the rows it outputs against the
+// alert rules, with the caller as the default station.
+func (s *server) alertOptions(ctx context.Context, opts csvconverter.Options) csvconverter.Options {
+	if !s.alertsActive() {
+		return opts
+	}
+	client := clientIdentity(ctx)
+	opts.Observe = func(row map[string]interface{}) {
+		s.alerts.Evaluate(client, row)
+	}
+	return opts
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"log/slog"
+	"path"
+	"time"
+
+	"rpcGoDatatype/audit"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var deterministic = proto.MarshalOptions{Deterministic: true}
+
+// auditInterceptor writes an audit record for each conversion call, with
+// hashes of the request and of the result.
+func (s *server) auditInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !conversionMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	ctx, call := withCallInfo(ctx)
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	rec := audit.Record{
+		Time:     start,
+		Finished: time.Now(),
+		Client:   call.identity(ctx),
+		Method:   path.Base(info.FullMethod),
+	}
+	if r, ok := req.(interface{ GetFrom() string }); ok {
+		rec.From = r.GetFrom()
+	}
+	if r, ok := req.(interface{ GetTo() string }); ok {
+		rec.To = r.GetTo()
+	}
+	if m, ok := req.(proto.Message); ok {
+		rec.RequestHash = messageHash(m)
+	}
+	if m, ok := resp.(proto.Message); ok && err == nil {
+		rec.ResultHash = messageHash(m)
+	}
+	s.writeAudit(ctx, rec, err)
+	return resp, err
+}
+
+// auditStreamInterceptor audits streaming conversions once their stream ends.
+// The hashes cover the messages received and sent, in order.
+func (s *server) auditStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !conversionMethods[info.FullMethod] {
+		return handler(srv, ss)
+	}
+	ctx, call := withCallInfo(ss.Context())
+	start := time.Now()
+	hs := &hashingStream{ServerStream: &callStream{ServerStream: ss, ctx: ctx}, in: sha256.New(), out: sha256.New()}
+	err := handler(srv, hs)
+	rec := audit.Record{
+		Time:        start,
+		Finished:    time.Now(),
+		Client:      call.identity(ctx),
+		Method:      path.Base(info.FullMethod),
+		From:        hs.from,
+		To:          hs.to,
+		RequestHash: hex.EncodeToString(hs.in.Sum(nil)),
+	}
+	if err == nil {
+		rec.ResultHash = hex.EncodeToString(hs.out.Sum(nil))
+	}
+	s.writeAudit(ctx, rec, err)
+	return err
+}
+
+// messageHash returns the SHA-256 of a message's deterministic encoding.
+func messageHash(m proto.Message) string {
+	data, err := deterministic.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// hashingStream hashes a stream's messages, each prefixed with its length.
+type hashingStream struct {
+	grpc.ServerStream
+	from, to string
+	in, out  hash.Hash
+}
+
+func (h *hashingStream) RecvMsg(m interface{}) error {
+	if err := h.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		writeMessage(h.in, msg)
+		if from, to, ok := streamFormats(m); ok && h.from == "" {
+			h.from, h.to = from, to
+		}
+	}
+	return nil
+}
+
+func (h *hashingStream) SendMsg(m interface{}) error {
+	if msg, ok := m.(proto.Message); ok {
+		writeMessage(h.out, msg)
+	}
+	return h.ServerStream.SendMsg(m)
+}
+
+func writeMessage(h hash.Hash, m proto.Message) {
+	data, _ := deterministic.Marshal(m)
+	var n [8]byte
+	binary.BigEndian.PutUint64(n[:], uint64(len(data)))
+	h.Write(n[:])
+	h.Write(data)
+}
+
+// writeAudit writes a record with the outcome of err. Failing to write
+// does not fail the call; failures are logged and counted.
+func (s *server) writeAudit(ctx context.Context, rec audit.Record, err error) {
+	rec.Code = status.Code(err).String()
+	if err := s.audit.Write(rec); err != nil {
+		slog.ErrorContext(ctx, "error writing audit record", "method", rec.Method, "error", err)
+	}
+}
@@ -0,0 +1,213 @@
+// Package audit writes tamper-evident audit records as JSON lines. Each
+// record carries the hash of the record before it and its own hash over
+// both, so editing, removing or reordering records breaks the chain. With
+// a key the hashes are HMACs, which only holders of the key can forge.
+package audit
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Record is one audited call.
+type Record struct {
+	Seq      uint64    `json:"seq"`
+	Time     time.Time `json:"time"`
+	Finished time.Time `json:"finished"`
+	Client   string    `json:"client"`
+	Method   string    `json:"method"`
+	From     string    `json:"from,omitempty"`
+	To       string    `json:"to,omitempty"`
+	// RequestHash and ResultHash are SHA-256 digests of the request and
+	// of the result, in their deterministic protobuf encoding.
+	RequestHash string `json:"request_hash"`
+	ResultHash  string `json:"result_hash,omitempty"`
+	// Code is the gRPC status code name, "OK" on success.
+	Code string `json:"code"`
+	Prev string `json:"prev"`
+	Hash string `json:"hash"`
+}
+
+// Logger appends records to a sink.
+type Logger struct {
+	key []byte
+
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+	seq    uint64
+	prev   string
+
+	written atomic.Int64
+	failed  atomic.Int64
+}
+
+// Open opens the sink: "stdout", "stderr" or the path of a file records
+// are appended to. The chain continues from the last record of an
+// existing file.
+func Open(sink string, key []byte) (*Logger, error) {
+	l := &Logger{key: key}
+	switch sink {
+	case "stdout":
+		l.w = os.Stdout
+		return l, nil
+	case "stderr":
+		l.w = os.Stderr
+		return l, nil
+	}
+	f, err := os.OpenFile(sink, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening audit log: %v", err)
+	}
+	last, err := lastRecord(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("error reading audit log %s: %v", sink, err)
+	}
+	if last != nil {
+		l.seq, l.prev = last.Seq, last.Hash
+	}
+	l.w, l.closer = f, f
+	return l, nil
+}
+
+// lastRecord returns the last record in r, if any.
+func lastRecord(r io.Reader) (*Record, error) {
+	var last []byte
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	for sc.Scan() {
+		if len(sc.Bytes()) > 0 {
+			last = append(last[:0], sc.Bytes()...)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if last == nil {
+		return nil, nil
+	}
+	rec := &Record{}
+	if err := json.Unmarshal(last, rec); err != nil {
+		return nil, fmt.Errorf("last record is invalid: %v", err)
+	}
+	return rec, nil
+}
+
+// Write chains and appends a record. Seq, Prev and Hash are set by Write.
+func (l *Logger) Write(rec Record) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	rec.Seq = l.seq + 1
+	rec.Prev = l.prev
+	rec.Time = rec.Time.UTC()
+	rec.Finished = rec.Finished.UTC()
+	hash, err := Hash(rec, l.key)
+	if err != nil {
+		l.failed.Add(1)
+		return err
+	}
+	rec.Hash = hash
+	line, err := json.Marshal(rec)
+	if err != nil {
+		l.failed.Add(1)
+		return fmt.Errorf("error encoding audit record: %v", err)
+	}
+	if _, err := l.w.Write(append(line, '\n')); err != nil {
+		l.failed.Add(1)
+		return fmt.Errorf("error writing audit record: %v", err)
+	}
+	l.seq, l.prev = rec.Seq, rec.Hash
+	l.written.Add(1)
+	return nil
+}
+
+// Close flushes and closes a file sink.
+func (l *Logger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closer == nil {
+		return nil
+	}
+	if f, ok := l.closer.(*os.File); ok {
+		f.Sync()
+	}
+	return l.closer.Close()
+}
+
+// Hash returns the hash of a record, computed over its JSON encoding
+// without the hash itself.
+func Hash(rec Record, key []byte) (string, error) {
+	rec.Hash = ""
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return "", fmt.Errorf("error encoding audit record: %v", err)
+	}
+	if key == nil {
+		sum := sha256.Sum256(data)
+		return hex.EncodeToString(sum[:]), nil
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// ErrBroken reports a record that does not fit the chain.
+var ErrBroken = errors.New("audit chain is broken")
+
+// Verify checks the chain of the records in r and returns how many it
+// read. The first record may continue an earlier, rotated log.
+func Verify(r io.Reader, key []byte) (int, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	var n int
+	var prev *Record
+	for sc.Scan() {
+		if len(sc.Bytes()) == 0 {
+			continue
+		}
+		n++
+		rec := Record{}
+		if err := json.Unmarshal(sc.Bytes(), &rec); err != nil {
+			return n, fmt.Errorf("record %d is invalid: %v", n, err)
+		}
+		hash, err := Hash(rec, key)
+		if err != nil {
+			return n, err
+		}
+		if !hmac.Equal([]byte(hash), []byte(rec.Hash)) {
+			return n, fmt.Errorf("%w: record %d (seq %d) does not match its hash", ErrBroken, n, rec.Seq)
+		}
+		if prev != nil && (rec.Prev != prev.Hash || rec.Seq != prev.Seq+1) {
+			return n, fmt.Errorf("%w: record %d (seq %d) does not follow seq %d", ErrBroken, n, rec.Seq, prev.Seq)
+		}
+		prev = &rec
+	}
+	return n, sc.Err()
+}
+
+// Stats counts the records a Logger wrote.
+type Stats struct {
+	Written int64 `json:"written"`
+	Failed  int64 `json:"failed"`
+}
+
+// Stats returns the current counts.
+func (l *Logger) Stats() Stats {
+	return Stats{Written: l.written.Load(), Failed: l.failed.Load()}
+}
+
+// Vars returns the current counts in a form suitable for expvar.
+func (l *Logger) Vars() interface{} {
+	return l.Stats()
+}
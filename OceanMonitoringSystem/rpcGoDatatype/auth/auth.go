@@ -0,0 +1,172 @@
+// Package auth authenticates gRPC calls with API keys or bearer tokens
+// taken from request metadata.
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ErrInvalidCredentials is returned by validators rejecting a credential.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// Principal is the authenticated caller.
+type Principal struct {
+	Subject string
+	Scopes  []string
+}
+
+// HasScope reports whether the principal was granted scope.
+func (p *Principal) HasScope(scope string) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKeyHeader is the metadata key carrying an API key.
+const APIKeyHeader = "x-api-key"
+
+// Credential is what a client presented: an API key from x-api-key or a
+// bearer token from the authorization header.
+type Credential struct {
+	APIKey string
+	Bearer string
+}
+
+// Validator checks a credential. Validators that do not handle the kind of
+// credential given return ErrInvalidCredentials.
+type Validator interface {
+	Validate(ctx context.Context, cred Credential) (*Principal, error)
+}
+
+// ValidatorFunc adapts a function to Validator.
+type ValidatorFunc func(ctx context.Context, cred Credential) (*Principal, error)
+
+func (f ValidatorFunc) Validate(ctx context.Context, cred Credential) (*Principal, error) {
+	return f(ctx, cred)
+}
+
+type principalKey struct{}
+
+// FromContext returns the principal stored by the interceptor.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// Authenticator accepts a call if any of its validators accepts the
+// caller's credential.
+type Authenticator struct {
+	validators []Validator
+	// public methods are called without credentials.
+	public map[string]bool
+	// scopes maps methods to the scope a principal needs to call them.
+	scopes map[string]string
+}
+
+// New creates an Authenticator. Calls to the public methods, given as full
+// gRPC method names, need no credentials.
+func New(validators []Validator, public ...string) *Authenticator {
+	a := &Authenticator{validators: validators, public: make(map[string]bool)}
+	for _, m := range public {
+		a.public[m] = true
+	}
+	return a
+}
+
+// RequireScopes makes calls to each method, a full gRPC method name, need
+// a principal granted the mapped scope. Once set, calls to methods that are
+// neither public nor mapped are refused.
+func (a *Authenticator) RequireScopes(scopes map[string]string) {
+	a.scopes = scopes
+}
+
+// authorize checks that p may call method.
+func (a *Authenticator) authorize(p *Principal, method string) error {
+	if a.scopes == nil {
+		return nil
+	}
+	scope, ok := a.scopes[method]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "method %s is not available", method)
+	}
+	if !p.HasScope(scope) {
+		return status.Errorf(codes.PermissionDenied, "scope %q required", scope)
+	}
+	return nil
+}
+
+func (a *Authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if a.public[method] {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	var cred Credential
+	if v := md.Get(APIKeyHeader); len(v) > 0 {
+		cred.APIKey = v[0]
+	}
+	if v := md.Get("authorization"); len(v) > 0 {
+		scheme, token, ok := strings.Cut(v[0], " ")
+		if !ok || !strings.EqualFold(scheme, "bearer") {
+			return nil, status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+		}
+		cred.Bearer = strings.TrimSpace(token)
+	}
+	if cred.APIKey == "" && cred.Bearer == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	for _, v := range a.validators {
+		p, err := v.Validate(ctx, cred)
+		if errors.Is(err, ErrInvalidCredentials) {
+			continue
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+		}
+		if err := a.authorize(p, method); err != nil {
+			return nil, err
+		}
+		return context.WithValue(ctx, principalKey{}, p), nil
+	}
+	return nil, status.Error(codes.Unauthenticated, ErrInvalidCredentials.Error())
+}
+
+// UnaryInterceptor authenticates unary calls.
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor authenticates streaming calls.
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &principalStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type principalStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *principalStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// keys maps the API keys accepted by the test validator to their scopes.
+var keys = map[string][]string{
+	"reader": {"read"},
+	"admin":  {"read", "admin"},
+}
+
+var validator = ValidatorFunc(func(ctx context.Context, cred Credential) (*Principal, error) {
+	scopes, ok := keys[cred.APIKey]
+	if !ok {
+		return nil, ErrInvalidCredentials
+	}
+	return &Principal{Subject: cred.APIKey, Scopes: scopes}, nil
+})
+
+func TestUnaryInterceptor(t *testing.T) {
+	a := New([]Validator{validator}, "/svc/Public")
+	a.RequireScopes(map[string]string{
+		"/svc/Read":  "read",
+		"/svc/Admin": "admin",
+	})
+	intercept := a.UnaryInterceptor()
+
+	tests := []struct {
+		method string
+		key    string
+		want   codes.Code
+	}{
+		{method: "/svc/Public", want: codes.OK},
+		{method: "/svc/Read", want: codes.Unauthenticated},
+		{method: "/svc/Read", key: "unknown", want: codes.Unauthenticated},
+		{method: "/svc/Read", key: "reader", want: codes.OK},
+		{method: "/svc/Admin", key: "reader", want: codes.PermissionDenied},
+		{method: "/svc/Admin", key: "admin", want: codes.OK},
+		{method: "/svc/Unscoped", key: "admin", want: codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.key != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(APIKeyHeader, tt.key))
+		}
+		var subject string
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			if p, ok := FromContext(ctx); ok {
+				subject = p.Subject
+			}
+			return nil, nil
+		}
+		_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+		if got := status.Code(err); got != tt.want {
+			t.Errorf("%s with key %q: got %v, want %s", tt.method, tt.key, err, tt.want)
+		}
+		if err == nil && subject != tt.key {
+			t.Errorf("%s with key %q: principal %q in the handler", tt.method, tt.key, subject)
+		}
+	}
+}
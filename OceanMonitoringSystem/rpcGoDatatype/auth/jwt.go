@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWTConfig configures bearer token validation. Exactly one of Secret
+// (HMAC) or PublicKeyFile (PEM RSA, ECDSA or Ed25519 key) must be set.
+type JWTConfig struct {
+	Secret        string
+	PublicKeyFile string
+	Issuer        string
+	Audience      string
+}
+
+type jwtValidator struct {
+	key    interface{}
+	parser *jwt.Parser
+}
+
+// NewJWT creates a validator for signed JWT bearer tokens. The subject
+// claim becomes the principal; scopes come from the space-separated
+// "scope" claim.
+func NewJWT(cfg JWTConfig) (Validator, error) {
+	v := &jwtValidator{}
+	opts := []jwt.ParserOption{jwt.WithExpirationRequired()}
+	switch {
+	case cfg.Secret != "" && cfg.PublicKeyFile != "":
+		return nil, fmt.Errorf("JWT secret and public key are mutually exclusive")
+	case cfg.Secret != "":
+		v.key = []byte(cfg.Secret)
+		opts = append(opts, jwt.WithValidMethods([]string{"HS256", "HS384", "HS512"}))
+	case cfg.PublicKeyFile != "":
+		pem, err := os.ReadFile(cfg.PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading JWT public key: %v", err)
+		}
+		key, methods, err := parsePublicKey(pem)
+		if err != nil {
+			return nil, err
+		}
+		v.key = key
+		opts = append(opts, jwt.WithValidMethods(methods))
+	default:
+		return nil, fmt.Errorf("JWT validation needs a secret or a public key")
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	v.parser = jwt.NewParser(opts...)
+	return v, nil
+}
+
+func parsePublicKey(pem []byte) (crypto.PublicKey, []string, error) {
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(pem); err == nil {
+		return key, []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}, nil
+	}
+	if key, err := jwt.ParseECPublicKeyFromPEM(pem); err == nil {
+		return key, []string{"ES256", "ES384", "ES512"}, nil
+	}
+	if key, err := jwt.ParseEdPublicKeyFromPEM(pem); err == nil {
+		return key, []string{"EdDSA"}, nil
+	}
+	return nil, nil, fmt.Errorf("unsupported JWT public key")
+}
+
+type claims struct {
+	jwt.RegisteredClaims
+	Scope string `json:"scope,omitempty"`
+}
+
+func (v *jwtValidator) Validate(_ context.Context, cred Credential) (*Principal, error) {
+	if cred.Bearer == "" {
+		return nil, ErrInvalidCredentials
+	}
+	var c claims
+	if _, err := v.parser.ParseWithClaims(cred.Bearer, &c, func(*jwt.Token) (interface{}, error) {
+		return v.key, nil
+	}); err != nil {
+		return nil, fmt.Errorf("invalid bearer token: %v", err)
+	}
+	if c.Subject == "" {
+		return nil, fmt.Errorf("invalid bearer token: missing subject")
+	}
+	return &Principal{Subject: c.Subject, Scopes: strings.Fields(c.Scope)}, nil
+}
@@ -0,0 +1,90 @@
+// Package cache stores conversion results keyed by a hash of the request,
+// either in memory or in Redis.
+package cache
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sync"
+)
+
+// Cache stores opaque values by key.
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte) error
+}
+
+// Key hashes the parts of a request into a cache key. Parts are length
+// prefixed, so different splits of the same bytes give different keys.
+func Key(parts ...[]byte) string {
+	h := sha256.New()
+	var n [8]byte
+	for _, p := range parts {
+		binary.BigEndian.PutUint64(n[:], uint64(len(p)))
+		h.Write(n[:])
+		h.Write(p)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// LRU is an in-memory cache holding at most maxBytes of values, evicting
+// the least recently used entries first.
+type LRU struct {
+	maxBytes int64
+
+	mu    sync.Mutex
+	size  int64
+	order *list.List
+	items map[string]*list.Element
+}
+
+type lruEntry struct {
+	key   string
+	value []byte
+}
+
+// NewLRU creates an LRU cache bounded to maxBytes.
+func NewLRU(maxBytes int64) *LRU {
+	return &LRU{maxBytes: maxBytes, order: list.New(), items: make(map[string]*list.Element)}
+}
+
+func (c *LRU) Get(_ context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false, nil
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*lruEntry).value, true, nil
+}
+
+// Set stores value under key. Values larger than the whole cache are not
+// stored.
+func (c *LRU) Set(_ context.Context, key string, value []byte) error {
+	size := int64(len(value))
+	if size > c.maxBytes {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+	c.items[key] = c.order.PushFront(&lruEntry{key: key, value: value})
+	c.size += size
+	for c.size > c.maxBytes {
+		c.remove(c.order.Back())
+	}
+	return nil
+}
+
+// remove drops an entry. Callers must hold c.mu.
+func (c *LRU) remove(el *list.Element) {
+	e := c.order.Remove(el).(*lruEntry)
+	delete(c.items, e.key)
+	c.size -= int64(len(e.value))
+}
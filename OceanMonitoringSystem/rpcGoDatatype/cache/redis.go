@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const keyPrefix = "oms:convert:"
+
+// Redis is a cache shared by every server instance using the same Redis.
+type Redis struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// NewRedis connects to the Redis server at url, e.g.
+// "redis://:password@localhost:6379/0". Entries expire after ttl, or never
+// when ttl is zero.
+func NewRedis(url string, ttl time.Duration) (*Redis, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Redis URL: %v", err)
+	}
+	return &Redis{client: redis.NewClient(opts), ttl: ttl}, nil
+}
+
+func (c *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := c.client.Get(ctx, keyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("error reading cache: %v", err)
+	}
+	return value, true, nil
+}
+
+func (c *Redis) Set(ctx context.Context, key string, value []byte) error {
+	if err := c.client.Set(ctx, keyPrefix+key, value, c.ttl).Err(); err != nil {
+		return fmt.Errorf("error writing cache: %v", err)
+	}
+	return nil
+}
+
+// Ping checks that Redis answers.
+func (c *Redis) Ping(ctx context.Context) error {
+	if err := c.client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("redis is unreachable: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Command auditverify checks the hash chain of an audit log written with
+// AUDIT_SINK, reading the log from a file or standard input:
+//
+//	go run ./cmd/auditverify -key "$AUDIT_KEY" audit.log
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"rpcGoDatatype/audit"
+)
+
+func main() {
+	key := flag.String("key", "", "HMAC key the log was written with")
+	flag.Parse()
+
+	var r io.Reader = os.Stdin
+	if path := flag.Arg(0); path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		r = f
+	}
+	var k []byte
+	if *key != "" {
+		k = []byte(*key)
+	}
+	n, err := audit.Verify(r, k)
+	if err != nil {
+		log.Fatalf("verification failed after %d records: %v", n, err)
+	}
+	fmt.Printf("%d records verified\n", n)
+}
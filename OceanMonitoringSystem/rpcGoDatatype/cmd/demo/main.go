@@ -0,0 +1,234 @@
+// Command demo runs the ocean monitoring pipeline end to end against local
+// services: it generates synthetic buoy readings, publishes them over MQTT,
+// collects them back, converts them with the DataParser service, applies
+// range QC, stores the result, raises alerts and exports a CSV file.
+//
+// Start the dependencies with `docker compose up mqtt rpc-go-datatype`
+// and run `go run ./cmd/demo`.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "rpcGoDatatype/proto"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const csvHeader = "timestamp,buoy_id,water_temp,wave_height,wind_speed"
+
+// qcRange is the accepted range of a parameter; values outside are flagged.
+type qcRange struct {
+	min, max float64
+}
+
+var qcRanges = map[string]qcRange{
+	"water_temp":  {min: -2, max: 40},
+	"wave_height": {min: 0, max: 20},
+	"wind_speed":  {min: 0, max: 75},
+}
+
+func main() {
+	parserAddr := flag.String("parser", "localhost:50051", "DataParser gRPC address")
+	broker := flag.String("mqtt", "tcp://localhost:1883", "MQTT broker URL")
+	buoys := flag.Int("buoys", 3, "number of synthetic buoys")
+	samples := flag.Int("samples", 12, "readings per buoy")
+	waveAlert := flag.Float64("wave-alert", 6, "wave height (m) that raises an alert")
+	outDir := flag.String("out", "demo-output", "directory for stored and exported data")
+	flag.Parse()
+
+	lines := generateReadings(*buoys, *samples, time.Now().UTC().Truncate(10*time.Minute))
+	log.Printf("generated %d synthetic readings for %d buoys", len(lines), *buoys)
+
+	received, err := roundTripMQTT(*broker, lines)
+	if err != nil {
+		log.Fatalf("mqtt ingest failed: %v", err)
+	}
+	log.Printf("ingested %d readings over MQTT", len(received))
+
+	conn, err := grpc.NewClient(*parserAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to connect to parser: %v", err)
+	}
+	defer conn.Close()
+	parser := pb.NewDataParserClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	converted, err := parser.Parse(ctx, &pb.ParseRequest{
+		From: "csv",
+		To:   "json",
+		Data: csvHeader + "\n" + strings.Join(received, "\n") + "\n",
+		Options: &pb.ConvertOptions{
+			StringColumns:       []string{"buoy_id"},
+			NormalizeTimestamps: true,
+		},
+	})
+	if err != nil {
+		log.Fatalf("conversion failed: %v", err)
+	}
+
+	var readings []map[string]interface{}
+	if err := json.Unmarshal([]byte(converted.Result), &readings); err != nil {
+		log.Fatalf("invalid parser output: %v", err)
+	}
+	sort.Slice(readings, func(i, j int) bool {
+		return fmt.Sprint(readings[i]["timestamp"]) < fmt.Sprint(readings[j]["timestamp"])
+	})
+	log.Printf("converted %d readings to JSON", len(readings))
+
+	flagged := applyQC(readings)
+	log.Printf("quality control flagged %d readings", flagged)
+
+	if err := os.MkdirAll(*outDir, 0o755); err != nil {
+		log.Fatalf("failed to create output directory: %v", err)
+	}
+	stored, err := json.MarshalIndent(readings, "", "  ")
+	if err != nil {
+		log.Fatalf("failed to encode readings: %v", err)
+	}
+	storePath := filepath.Join(*outDir, "readings.json")
+	if err := os.WriteFile(storePath, stored, 0o644); err != nil {
+		log.Fatalf("failed to store readings: %v", err)
+	}
+	log.Printf("stored readings in %s", storePath)
+
+	for _, r := range readings {
+		if h, ok := r["wave_height"].(float64); ok && h >= *waveAlert && r["qc_flag"] == "pass" {
+			log.Printf("ALERT buoy %v: wave height %.1f m at %v", r["buoy_id"], h, r["timestamp"])
+		}
+	}
+
+	exported, err := parser.Parse(ctx, &pb.ParseRequest{From: "json", To: "csv", Data: string(stored)})
+	if err != nil {
+		log.Fatalf("export failed: %v", err)
+	}
+	exportPath := filepath.Join(*outDir, "readings.csv")
+	if err := os.WriteFile(exportPath, []byte(exported.Result), 0o644); err != nil {
+		log.Fatalf("failed to write export: %v", err)
+	}
+	log.Printf("exported readings to %s", exportPath)
+}
+
+// generateReadings produces CSV lines for a set of buoys sampling every ten
+// minutes. A storm, a sensor spike and a dropped value are mixed in so QC and
+// alerting have something to find.
+func generateReadings(buoys, samples int, end time.Time) []string {
+	rng := rand.New(rand.NewSource(42))
+	var lines []string
+	for b := 1; b <= buoys; b++ {
+		id := fmt.Sprintf("wavy%d", b)
+		for i := 0; i < samples; i++ {
+			ts := end.Add(-time.Duration(samples-1-i) * 10 * time.Minute)
+			temp := 16 + 2*math.Sin(float64(i)/4) + rng.Float64()*0.2
+			wave := 1.5 + rng.Float64()
+			wind := 8 + rng.Float64()*4
+			if b == 1 && i >= samples-3 {
+				wave += 5
+				wind += 15
+			}
+			tempField := fmt.Sprintf("%.2f", temp)
+			switch {
+			case b == 2 && i == samples/2:
+				tempField = "85.00"
+			case b == buoys && i == 1:
+				tempField = ""
+			}
+			lines = append(lines, fmt.Sprintf("%s,%s,%s,%.2f,%.1f", ts.Format(time.RFC3339), id, tempField, wave, wind))
+		}
+	}
+	return lines
+}
+
+// roundTripMQTT publishes every line on the buoy's topic and returns the
+// lines received back through a wildcard subscription.
+func roundTripMQTT(broker string, lines []string) ([]string, error) {
+	opts := mqtt.NewClientOptions().
+		AddBroker(broker).
+		SetClientID(fmt.Sprintf("ocean-demo-%d", os.Getpid())).
+		SetConnectTimeout(10 * time.Second)
+	client := mqtt.NewClient(opts)
+	if token := client.Connect(); token.Wait() && token.Error() != nil {
+		return nil, token.Error()
+	}
+	defer client.Disconnect(250)
+
+	var (
+		mu       sync.Mutex
+		received []string
+		done     = make(chan struct{})
+	)
+	handler := func(_ mqtt.Client, msg mqtt.Message) {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, string(msg.Payload()))
+		if len(received) == len(lines) {
+			close(done)
+		}
+	}
+	if token := client.Subscribe("ocean/buoys/+", 1, handler); token.Wait() && token.Error() != nil {
+		return nil, token.Error()
+	}
+
+	for _, line := range lines {
+		id := strings.Split(line, ",")[1]
+		token := client.Publish("ocean/buoys/"+id, 1, false, line)
+		if token.Wait() && token.Error() != nil {
+			return nil, token.Error()
+		}
+	}
+
+	var timedOut bool
+	select {
+	case <-done:
+	case <-time.After(15 * time.Second):
+		timedOut = true
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if timedOut {
+		return nil, fmt.Errorf("timed out waiting for readings (%d of %d received)", len(received), len(lines))
+	}
+	return append([]string(nil), received...), nil
+}
+
+// applyQC adds a qc_flag to every reading and returns how many failed.
+func applyQC(readings []map[string]interface{}) int {
+	failed := 0
+	for _, r := range readings {
+		flag := "pass"
+		for param, rng := range qcRanges {
+			v, ok := r[param].(float64)
+			switch {
+			case !ok:
+				flag = "missing"
+			case v < rng.min || v > rng.max:
+				flag = "fail"
+			}
+			if flag == "fail" {
+				break
+			}
+		}
+		if flag != "pass" {
+			failed++
+		}
+		r["qc_flag"] = flag
+	}
+	return failed
+}
@@ -0,0 +1,96 @@
+// Package compression registers the gRPC message compressors offered by the
+// server. Clients choose one per call with the grpc-encoding header; the
+// server answers in the same encoding.
+package compression
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+// Zstd is the name registered for the zstd compressor.
+const Zstd = "zstd"
+
+// Register sets the gzip compression level, -1 being the gzip default, and
+// registers zstd when enabled. It must be called before the server starts.
+func Register(gzipLevel int, enableZstd bool) error {
+	if err := gzip.SetLevel(gzipLevel); err != nil {
+		return fmt.Errorf("error configuring gzip: %v", err)
+	}
+	if enableZstd {
+		encoding.RegisterCompressor(newZstd())
+	}
+	return nil
+}
+
+type zstdCompressor struct {
+	encoders sync.Pool
+	decoders sync.Pool
+}
+
+func newZstd() *zstdCompressor {
+	c := &zstdCompressor{}
+	c.encoders.New = func() any {
+		// Messages are compressed one at a time; extra goroutines per
+		// encoder only add overhead.
+		enc, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			panic(err)
+		}
+		return &zstdWriter{Encoder: enc, pool: &c.encoders}
+	}
+	return c
+}
+
+func (c *zstdCompressor) Name() string { return Zstd }
+
+func (c *zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	z := c.encoders.Get().(*zstdWriter)
+	z.Reset(w)
+	return z, nil
+}
+
+func (c *zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	z, ok := c.decoders.Get().(*zstdReader)
+	if !ok {
+		dec, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return &zstdReader{Decoder: dec, pool: &c.decoders}, nil
+	}
+	if err := z.Reset(r); err != nil {
+		c.decoders.Put(z)
+		return nil, err
+	}
+	return z, nil
+}
+
+type zstdWriter struct {
+	*zstd.Encoder
+	pool *sync.Pool
+}
+
+func (z *zstdWriter) Close() error {
+	defer z.pool.Put(z)
+	return z.Encoder.Close()
+}
+
+type zstdReader struct {
+	*zstd.Decoder
+	pool *sync.Pool
+}
+
+// Read returns the decoder to the pool once the message is fully read.
+func (z *zstdReader) Read(p []byte) (int, error) {
+	n, err := z.Decoder.Read(p)
+	if err == io.EOF {
+		z.pool.Put(z)
+	}
+	return n, err
+}
@@ -0,0 +1,706 @@
+// Package config loads the server settings from a YAML or TOML file,
+// environment variables and command-line flags, in increasing order of
+// precedence.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"rpcGoDatatype/csvconverter"
+	"rpcGoDatatype/fetch"
+	"rpcGoDatatype/ratelimit"
+	"rpcGoDatatype/usage"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Duration is a time.Duration written as a string such as "30s" in
+// configuration files.
+type Duration time.Duration
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	v, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+type Log struct {
+	Format string `yaml:"format" toml:"format"`
+	Level  string `yaml:"level" toml:"level"`
+}
+
+type TLS struct {
+	Cert     string `yaml:"cert" toml:"cert"`
+	Key      string `yaml:"key" toml:"key"`
+	ClientCA string `yaml:"client_ca" toml:"client_ca"`
+}
+
+type JWT struct {
+	Secret    string `yaml:"secret" toml:"secret"`
+	PublicKey string `yaml:"public_key" toml:"public_key"`
+	Issuer    string `yaml:"issuer" toml:"issuer"`
+	Audience  string `yaml:"audience" toml:"audience"`
+}
+
+type Auth struct {
+	Required   bool   `yaml:"required" toml:"required"`
+	AdminToken string `yaml:"admin_token" toml:"admin_token"`
+	JWT        JWT    `yaml:"jwt" toml:"jwt"`
+}
+
+type Limits struct {
+	// RateLimit is "rate[:burst]" calls per second per client.
+	RateLimit        string `yaml:"rate_limit" toml:"rate_limit"`
+	RateLimitClients string `yaml:"rate_limit_clients" toml:"rate_limit_clients"`
+	QuotaBytes       int64  `yaml:"quota_monthly_bytes" toml:"quota_monthly_bytes"`
+	QuotaRequests    int64  `yaml:"quota_monthly_requests" toml:"quota_monthly_requests"`
+	QuotaClients     string `yaml:"quota_clients" toml:"quota_clients"`
+	// RegistrationRateLimit is "rate[:burst]" station registrations per
+	// second per client, on top of RateLimit; empty means no limit.
+	RegistrationRateLimit string `yaml:"registration_rate_limit" toml:"registration_rate_limit"`
+	// MaxInputBytes, MaxRows and MaxColumns bound a single conversion
+	// input; zero means no limit.
+	MaxInputBytes int64 `yaml:"max_input_bytes" toml:"max_input_bytes"`
+	MaxRows       int   `yaml:"max_rows" toml:"max_rows"`
+	MaxColumns    int   `yaml:"max_columns" toml:"max_columns"`
+	// MaxConcurrent conversions run at once, zero meaning no limit; up to
+	// MaxQueued more wait at most QueueTimeout for a free worker.
+	MaxConcurrent int      `yaml:"max_concurrent" toml:"max_concurrent"`
+	MaxQueued     int      `yaml:"max_queued" toml:"max_queued"`
+	QueueTimeout  Duration `yaml:"queue_timeout" toml:"queue_timeout"`
+	// ConversionWorkers convert the rows of one CSV input in parallel;
+	// zero uses one per CPU.
+	ConversionWorkers int `yaml:"conversion_workers" toml:"conversion_workers"`
+}
+
+// Output holds the output defaults for requests that do not choose: JSON
+// is indented by JSONIndent spaces unless CompactJSON is set.
+type Output struct {
+	CompactJSON bool `yaml:"compact_json" toml:"compact_json"`
+	JSONIndent  int  `yaml:"json_indent" toml:"json_indent"`
+}
+
+type Cache struct {
+	MaxBytes int64    `yaml:"max_bytes" toml:"max_bytes"`
+	RedisURL string   `yaml:"redis_url" toml:"redis_url"`
+	TTL      Duration `yaml:"ttl" toml:"ttl"`
+}
+
+type S3 struct {
+	Endpoint  string `yaml:"endpoint" toml:"endpoint"`
+	Region    string `yaml:"region" toml:"region"`
+	AccessKey string `yaml:"access_key" toml:"access_key"`
+	SecretKey string `yaml:"secret_key" toml:"secret_key"`
+	Insecure  bool   `yaml:"insecure" toml:"insecure"`
+	// CacheDir keeps downloaded objects, keyed by ETag, so repeated
+	// conversions read them memory-mapped from the page cache.
+	CacheDir string `yaml:"cache_dir" toml:"cache_dir"`
+}
+
+type Influx struct {
+	URL   string `yaml:"url" toml:"url"`
+	Token string `yaml:"token" toml:"token"`
+	Org   string `yaml:"org" toml:"org"`
+}
+
+type Fetch struct {
+	AllowedHosts []string `yaml:"allowed_hosts" toml:"allowed_hosts"`
+	MaxBytes     int64    `yaml:"max_bytes" toml:"max_bytes"`
+	Timeout      Duration `yaml:"timeout" toml:"timeout"`
+}
+
+type Storage struct {
+	DataRoot          string `yaml:"data_root" toml:"data_root"`
+	RegistrationStore string `yaml:"registration_store" toml:"registration_store"`
+	StationRegistry   string `yaml:"station_registry" toml:"station_registry"`
+	AlertRules        string `yaml:"alert_rules" toml:"alert_rules"`
+	UsageStore        string `yaml:"usage_store" toml:"usage_store"`
+	S3                S3     `yaml:"s3" toml:"s3"`
+	Influx            Influx `yaml:"influx" toml:"influx"`
+	// PostgresURL is the connection string for pg:// output.
+	PostgresURL string `yaml:"postgres_url" toml:"postgres_url"`
+	// HistoryDB is an SQLite database recording every conversion and
+	// persisting asynchronous jobs. Conversions older than
+	// HistoryRetention are deleted; zero keeps them.
+	HistoryDB        string   `yaml:"history_db" toml:"history_db"`
+	HistoryRetention Duration `yaml:"history_retention" toml:"history_retention"`
+	Fetch            Fetch    `yaml:"fetch" toml:"fetch"`
+}
+
+// Jobs configures asynchronous jobs. They are queued in process and stored
+// in Dir or the history database, or, with RedisURL, shared in Redis by
+// every instance using it.
+type Jobs struct {
+	Dir            string   `yaml:"dir" toml:"dir"`
+	RedisURL       string   `yaml:"redis_url" toml:"redis_url"`
+	Workers        int      `yaml:"workers" toml:"workers"`
+	IdempotencyTTL Duration `yaml:"idempotency_ttl" toml:"idempotency_ttl"`
+	// Retention is how long finished jobs and their results are kept.
+	Retention Duration `yaml:"retention" toml:"retention"`
+}
+
+// Kafka configures the streaming stage that converts the messages of Topic
+// and produces the records to OutputTopic. It runs when Brokers is set.
+type Kafka struct {
+	Brokers         []string `yaml:"brokers" toml:"brokers"`
+	Topic           string   `yaml:"topic" toml:"topic"`
+	GroupID         string   `yaml:"group_id" toml:"group_id"`
+	OutputTopic     string   `yaml:"output_topic" toml:"output_topic"`
+	DeadLetterTopic string   `yaml:"dead_letter_topic" toml:"dead_letter_topic"`
+	// Serialization of the records: json or avro.
+	Serialization string `yaml:"serialization" toml:"serialization"`
+	// From is the input format and Options the ConvertOptions as
+	// ProtoJSON.
+	From    string `yaml:"from" toml:"from"`
+	Options string `yaml:"options" toml:"options"`
+}
+
+// Spill moves the rows held for sorting, deduplication and unpivoting to
+// temporary files in Dir once they take about Threshold bytes of memory.
+// Zero keeps them in memory.
+type Spill struct {
+	Threshold int64  `yaml:"threshold" toml:"threshold"`
+	Dir       string `yaml:"dir" toml:"dir"`
+}
+
+// Audit writes a tamper-evident record of every conversion to Sink:
+// "stdout", "stderr" or a file path. With Key, records are chained with
+// HMACs instead of plain hashes.
+type Audit struct {
+	Sink string `yaml:"sink" toml:"sink"`
+	Key  string `yaml:"key" toml:"key"`
+}
+
+// NATS configures request/reply conversions on RequestSubject and the
+// conversion of raw messages on Subject into JetStream records. It runs
+// when URL is set.
+type NATS struct {
+	URL            string `yaml:"url" toml:"url"`
+	CredsFile      string `yaml:"creds_file" toml:"creds_file"`
+	QueueGroup     string `yaml:"queue_group" toml:"queue_group"`
+	Workers        int    `yaml:"workers" toml:"workers"`
+	RequestSubject string `yaml:"request_subject" toml:"request_subject"`
+	Subject        string `yaml:"subject" toml:"subject"`
+	// RecordsSubject receives the records, suffixed with the value of
+	// SubjectColumn when set; Stream is created to store them.
+	RecordsSubject string `yaml:"records_subject" toml:"records_subject"`
+	SubjectColumn  string `yaml:"subject_column" toml:"subject_column"`
+	Stream         string `yaml:"stream" toml:"stream"`
+	// From is the input format of raw messages and Options the
+	// ConvertOptions as ProtoJSON.
+	From    string `yaml:"from" toml:"from"`
+	Options string `yaml:"options" toml:"options"`
+}
+
+// GRPC holds transport settings. Zero durations keep the gRPC defaults.
+type GRPC struct {
+	MaxRecvMsgSize int `yaml:"max_recv_msg_size" toml:"max_recv_msg_size"`
+	MaxSendMsgSize int `yaml:"max_send_msg_size" toml:"max_send_msg_size"`
+	// KeepaliveTime and KeepaliveTimeout control server pings on idle
+	// connections.
+	KeepaliveTime    Duration `yaml:"keepalive_time" toml:"keepalive_time"`
+	KeepaliveTimeout Duration `yaml:"keepalive_timeout" toml:"keepalive_timeout"`
+	// KeepaliveMinTime is the shortest client ping interval allowed;
+	// clients pinging more often are disconnected.
+	KeepaliveMinTime        Duration `yaml:"keepalive_min_time" toml:"keepalive_min_time"`
+	KeepalivePermitNoStream bool     `yaml:"keepalive_permit_without_stream" toml:"keepalive_permit_without_stream"`
+	MaxConnectionIdle       Duration `yaml:"max_connection_idle" toml:"max_connection_idle"`
+	MaxConnectionAge        Duration `yaml:"max_connection_age" toml:"max_connection_age"`
+	MaxConnectionAgeGrace   Duration `yaml:"max_connection_age_grace" toml:"max_connection_age_grace"`
+	// GzipLevel is the gzip level for responses to gzip clients, -1 being
+	// the gzip default.
+	GzipLevel int  `yaml:"gzip_level" toml:"gzip_level"`
+	Zstd      bool `yaml:"zstd" toml:"zstd"`
+}
+
+// Config holds every server setting.
+type Config struct {
+	Listen string `yaml:"listen" toml:"listen"`
+	// UnixSocket is a socket path served in addition to, or with an empty
+	// Listen instead of, the TCP address. UnixSocketMode is its octal
+	// permission.
+	UnixSocket     string `yaml:"unix_socket" toml:"unix_socket"`
+	UnixSocketMode string `yaml:"unix_socket_mode" toml:"unix_socket_mode"`
+	MetricsAddr    string `yaml:"metrics_addr" toml:"metrics_addr"`
+	// GatewayAddr serves the REST/JSON gateway and ConnectAddr the Connect
+	// protocol.
+	GatewayAddr string `yaml:"gateway_addr" toml:"gateway_addr"`
+	ConnectAddr string `yaml:"connect_addr" toml:"connect_addr"`
+	// AdminAddr serves pprof and expvar; it must be a loopback address.
+	AdminAddr      string   `yaml:"admin_addr" toml:"admin_addr"`
+	HealthInterval Duration `yaml:"health_interval" toml:"health_interval"`
+	ShutdownGrace  Duration `yaml:"shutdown_grace" toml:"shutdown_grace"`
+	HooksConfig    string   `yaml:"hooks_config" toml:"hooks_config"`
+	PluginsConfig  string   `yaml:"plugins_config" toml:"plugins_config"`
+	RangesConfig   string   `yaml:"ranges_config" toml:"ranges_config"`
+
+	GRPC    GRPC    `yaml:"grpc" toml:"grpc"`
+	Log     Log     `yaml:"log" toml:"log"`
+	TLS     TLS     `yaml:"tls" toml:"tls"`
+	Auth    Auth    `yaml:"auth" toml:"auth"`
+	Limits  Limits  `yaml:"limits" toml:"limits"`
+	Output  Output  `yaml:"output" toml:"output"`
+	Spill   Spill   `yaml:"spill" toml:"spill"`
+	Cache   Cache   `yaml:"cache" toml:"cache"`
+	Storage Storage `yaml:"storage" toml:"storage"`
+	Jobs    Jobs    `yaml:"jobs" toml:"jobs"`
+	Kafka   Kafka   `yaml:"kafka" toml:"kafka"`
+	NATS    NATS    `yaml:"nats" toml:"nats"`
+	Audit   Audit   `yaml:"audit" toml:"audit"`
+}
+
+// Default returns the settings used when nothing else is configured.
+func Default() *Config {
+	return &Config{
+		Listen:         ":50051",
+		UnixSocketMode: "0660",
+		GRPC: GRPC{
+			MaxRecvMsgSize:   64 << 20,
+			MaxSendMsgSize:   64 << 20,
+			KeepaliveMinTime: Duration(5 * time.Minute),
+			GzipLevel:        -1,
+			Zstd:             true,
+		},
+		HealthInterval: Duration(10 * time.Second),
+		ShutdownGrace:  Duration(30 * time.Second),
+		Limits: Limits{
+			MaxInputBytes: 256 << 20,
+			MaxColumns:    10000,
+			MaxConcurrent: runtime.NumCPU(),
+			MaxQueued:     64,
+			QueueTimeout:  Duration(30 * time.Second),
+			// Registration is open to unauthenticated clients.
+			RegistrationRateLimit: "0.1:5",
+		},
+		Output: Output{JSONIndent: csvconverter.DefaultJSONIndent},
+		Cache:  Cache{TTL: Duration(24 * time.Hour)},
+		Storage: Storage{
+			Fetch: Fetch{MaxBytes: fetch.DefaultMaxBytes, Timeout: Duration(fetch.DefaultTimeout)},
+		},
+		Jobs:  Jobs{Workers: 2, IdempotencyTTL: Duration(time.Hour), Retention: Duration(24 * time.Hour)},
+		Kafka: Kafka{GroupID: "rpc-go-datatype", Serialization: "json", From: "csv"},
+		NATS:  NATS{QueueGroup: "rpc-go-datatype", Workers: 4, From: "csv"},
+	}
+}
+
+// setting binds one configuration value to its environment variable and
+// flag. The flag name is the variable name in lower case with dashes.
+type setting struct {
+	env   string
+	usage string
+	ptr   interface{}
+}
+
+func (c *Config) settings() []setting {
+	return []setting{
+		{"LISTEN_ADDR", "gRPC TCP listen address, empty to only serve the unix socket", &c.Listen},
+		{"UNIX_SOCKET", "gRPC unix socket path", &c.UnixSocket},
+		{"UNIX_SOCKET_MODE", "unix socket permissions in octal", &c.UnixSocketMode},
+		{"METRICS_ADDR", "HTTP address serving /debug/vars", &c.MetricsAddr},
+		{"GATEWAY_ADDR", "HTTP address serving the REST/JSON gateway", &c.GatewayAddr},
+		{"CONNECT_ADDR", "HTTP address serving the Connect, gRPC-Web and gRPC protocols", &c.ConnectAddr},
+		{"ADMIN_ADDR", "loopback HTTP address serving /debug/pprof and /debug/vars", &c.AdminAddr},
+		{"HEALTH_INTERVAL", "interval between readiness checks", &c.HealthInterval},
+		{"SHUTDOWN_GRACE", "time allowed for in-flight calls on shutdown", &c.ShutdownGrace},
+		{"HOOKS_CONFIG", "runbook hooks file", &c.HooksConfig},
+		{"PLUGINS_CONFIG", "external converters file", &c.PluginsConfig},
+		{"RANGES_CONFIG", "acceptable value ranges by sensor type file", &c.RangesConfig},
+		{"GRPC_MAX_RECV_MSG_SIZE", "largest request message in bytes", &c.GRPC.MaxRecvMsgSize},
+		{"GRPC_MAX_SEND_MSG_SIZE", "largest response message in bytes", &c.GRPC.MaxSendMsgSize},
+		{"GRPC_KEEPALIVE_TIME", "ping clients after this long without activity", &c.GRPC.KeepaliveTime},
+		{"GRPC_KEEPALIVE_TIMEOUT", "close connections whose ping is unanswered this long", &c.GRPC.KeepaliveTimeout},
+		{"GRPC_KEEPALIVE_MIN_TIME", "shortest client ping interval allowed", &c.GRPC.KeepaliveMinTime},
+		{"GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM", "allow client pings without active calls", &c.GRPC.KeepalivePermitNoStream},
+		{"GRPC_MAX_CONNECTION_IDLE", "close connections idle this long", &c.GRPC.MaxConnectionIdle},
+		{"GRPC_MAX_CONNECTION_AGE", "close connections older than this", &c.GRPC.MaxConnectionAge},
+		{"GRPC_MAX_CONNECTION_AGE_GRACE", "time given to calls on aged connections", &c.GRPC.MaxConnectionAgeGrace},
+		{"GRPC_GZIP_LEVEL", "gzip level for compressed responses (-1 to 9)", &c.GRPC.GzipLevel},
+		{"GRPC_ZSTD", "offer zstd compression", &c.GRPC.Zstd},
+		{"LOG_FORMAT", "log format: text or json", &c.Log.Format},
+		{"LOG_LEVEL", "log level: debug, info, warn or error", &c.Log.Level},
+		{"TLS_CERT", "server certificate (PEM)", &c.TLS.Cert},
+		{"TLS_KEY", "server private key (PEM)", &c.TLS.Key},
+		{"TLS_CLIENT_CA", "CA bundle required to sign client certificates", &c.TLS.ClientCA},
+		{"AUTH_REQUIRED", "require an API key or bearer token", &c.Auth.Required},
+		{"ADMIN_TOKEN", "token for administrative calls", &c.Auth.AdminToken},
+		{"JWT_SECRET", "HMAC secret for bearer tokens", &c.Auth.JWT.Secret},
+		{"JWT_PUBLIC_KEY", "public key file for bearer tokens", &c.Auth.JWT.PublicKey},
+		{"JWT_ISSUER", "required bearer token issuer", &c.Auth.JWT.Issuer},
+		{"JWT_AUDIENCE", "required bearer token audience", &c.Auth.JWT.Audience},
+		{"RATE_LIMIT", "per-client rate as rate[:burst] calls per second", &c.Limits.RateLimit},
+		{"RATE_LIMIT_CLIENTS", "per-client rate overrides, client=rate[:burst],...", &c.Limits.RateLimitClients},
+		{"REGISTRATION_RATE_LIMIT", "per-client rate of station registrations as rate[:burst] calls per second", &c.Limits.RegistrationRateLimit},
+		{"QUOTA_MONTHLY_BYTES", "monthly input bytes per client", &c.Limits.QuotaBytes},
+		{"QUOTA_MONTHLY_REQUESTS", "monthly requests per client", &c.Limits.QuotaRequests},
+		{"QUOTA_CLIENTS", "per-client quotas, client=bytes:requests,...", &c.Limits.QuotaClients},
+		{"MAX_INPUT_BYTES", "largest conversion input in bytes, 0 for no limit", &c.Limits.MaxInputBytes},
+		{"MAX_ROWS", "most rows in a conversion input, 0 for no limit", &c.Limits.MaxRows},
+		{"MAX_COLUMNS", "most columns in a conversion input row, 0 for no limit", &c.Limits.MaxColumns},
+		{"MAX_CONCURRENT_CONVERSIONS", "conversions running at once, 0 for no limit", &c.Limits.MaxConcurrent},
+		{"MAX_QUEUED_CONVERSIONS", "conversions waiting for a worker before new ones are rejected", &c.Limits.MaxQueued},
+		{"CONVERSION_QUEUE_TIMEOUT", "longest wait for a conversion worker, 0 to wait for the call deadline", &c.Limits.QueueTimeout},
+		{"CONVERSION_WORKERS", "goroutines converting the rows of one CSV input, 0 for one per CPU", &c.Limits.ConversionWorkers},
+		{"JSON_COMPACT", "write compact JSON unless a request asks for indentation", &c.Output.CompactJSON},
+		{"JSON_INDENT", "spaces per level of indented JSON", &c.Output.JSONIndent},
+		{"SPILL_THRESHOLD", "bytes of rows held for sorting before they spill to disk, 0 to keep them in memory", &c.Spill.Threshold},
+		{"SPILL_DIR", "directory for spill files, the system temporary directory when empty", &c.Spill.Dir},
+		{"CACHE_MAX_BYTES", "in-memory result cache size", &c.Cache.MaxBytes},
+		{"CACHE_REDIS_URL", "Redis URL for the result cache", &c.Cache.RedisURL},
+		{"CACHE_TTL", "Redis result cache entry lifetime", &c.Cache.TTL},
+		{"DATA_ROOT", "directory ParseRequest paths are read from", &c.Storage.DataRoot},
+		{"REGISTRATION_STORE", "station registrations file", &c.Storage.RegistrationStore},
+		{"STATION_REGISTRY", "station registry file", &c.Storage.StationRegistry},
+		{"ALERT_RULES", "alert rules file", &c.Storage.AlertRules},
+		{"USAGE_STORE", "usage accounting file", &c.Storage.UsageStore},
+		{"S3_ENDPOINT", "object storage endpoint", &c.Storage.S3.Endpoint},
+		{"S3_REGION", "object storage region", &c.Storage.S3.Region},
+		{"S3_ACCESS_KEY", "object storage access key", &c.Storage.S3.AccessKey},
+		{"S3_SECRET_KEY", "object storage secret key", &c.Storage.S3.SecretKey},
+		{"S3_INSECURE", "connect to object storage over plain HTTP", &c.Storage.S3.Insecure},
+		{"S3_CACHE_DIR", "directory keeping downloaded objects to read them memory-mapped, empty to read them into memory", &c.Storage.S3.CacheDir},
+		{"INFLUX_URL", "InfluxDB base URL for influx:// output", &c.Storage.Influx.URL},
+		{"INFLUX_TOKEN", "InfluxDB API token", &c.Storage.Influx.Token},
+		{"INFLUX_ORG", "InfluxDB organization", &c.Storage.Influx.Org},
+		{"POSTGRES_URL", "PostgreSQL connection string for pg:// output", &c.Storage.PostgresURL},
+		{"HISTORY_DB", "SQLite database recording conversions and jobs", &c.Storage.HistoryDB},
+		{"HISTORY_RETENTION", "how long conversions are kept in the history database", &c.Storage.HistoryRetention},
+		{"FETCH_ALLOWED_HOSTS", "hosts input URLs may name, comma separated", &c.Storage.Fetch.AllowedHosts},
+		{"FETCH_MAX_BYTES", "largest input read from a URL or file", &c.Storage.Fetch.MaxBytes},
+		{"FETCH_TIMEOUT", "timeout for reading input URLs", &c.Storage.Fetch.Timeout},
+		{"JOBS_DIR", "directory persisting asynchronous jobs", &c.Jobs.Dir},
+		{"JOBS_REDIS_URL", "Redis URL sharing asynchronous jobs between instances", &c.Jobs.RedisURL},
+		{"JOB_WORKERS", "number of asynchronous job workers", &c.Jobs.Workers},
+		{"IDEMPOTENCY_TTL", "how long idempotency keys are remembered", &c.Jobs.IdempotencyTTL},
+		{"JOB_RETENTION", "how long finished jobs and their results are kept", &c.Jobs.Retention},
+		{"KAFKA_BROKERS", "Kafka brokers for the streaming stage, comma separated", &c.Kafka.Brokers},
+		{"KAFKA_TOPIC", "Kafka topic of raw messages", &c.Kafka.Topic},
+		{"KAFKA_GROUP_ID", "Kafka consumer group", &c.Kafka.GroupID},
+		{"KAFKA_OUTPUT_TOPIC", "Kafka topic receiving converted records", &c.Kafka.OutputTopic},
+		{"KAFKA_DEAD_LETTER_TOPIC", "Kafka topic receiving messages that fail to convert", &c.Kafka.DeadLetterTopic},
+		{"KAFKA_SERIALIZATION", "record serialization: json or avro", &c.Kafka.Serialization},
+		{"KAFKA_FROM", "input format of Kafka messages", &c.Kafka.From},
+		{"KAFKA_OPTIONS", "conversion options for Kafka messages as ProtoJSON", &c.Kafka.Options},
+		{"NATS_URL", "NATS server URL", &c.NATS.URL},
+		{"NATS_CREDS_FILE", "NATS credentials file", &c.NATS.CredsFile},
+		{"NATS_QUEUE_GROUP", "NATS queue group shared by replicas", &c.NATS.QueueGroup},
+		{"NATS_WORKERS", "NATS requests handled at once", &c.NATS.Workers},
+		{"NATS_REQUEST_SUBJECT", "NATS subject receiving ParseRequests", &c.NATS.RequestSubject},
+		{"NATS_SUBJECT", "NATS subject receiving raw input", &c.NATS.Subject},
+		{"NATS_RECORDS_SUBJECT", "JetStream subject receiving converted records", &c.NATS.RecordsSubject},
+		{"NATS_SUBJECT_COLUMN", "column whose value is appended to the records subject", &c.NATS.SubjectColumn},
+		{"NATS_STREAM", "JetStream stream to create for the records subject", &c.NATS.Stream},
+		{"NATS_FROM", "input format of raw NATS messages", &c.NATS.From},
+		{"NATS_OPTIONS", "conversion options for raw NATS messages as ProtoJSON", &c.NATS.Options},
+		{"AUDIT_SINK", "audit log: stdout, stderr or a file path", &c.Audit.Sink},
+		{"AUDIT_KEY", "HMAC key chaining audit records", &c.Audit.Key},
+	}
+}
+
+func flagName(env string) string {
+	return strings.ToLower(strings.ReplaceAll(env, "_", "-"))
+}
+
+// set parses s into the setting's value.
+func (st setting) set(s string) error {
+	var err error
+	switch p := st.ptr.(type) {
+	case *string:
+		*p = s
+	case *bool:
+		switch strings.ToLower(s) {
+		case "true", "1", "yes":
+			*p = true
+		case "false", "0", "no", "":
+			*p = false
+		default:
+			err = fmt.Errorf("invalid boolean %q", s)
+		}
+	case *int:
+		_, err = fmt.Sscan(s, p)
+	case *int64:
+		_, err = fmt.Sscan(s, p)
+	case *Duration:
+		err = p.UnmarshalText([]byte(s))
+	case *[]string:
+		*p = nil
+		for _, v := range strings.Split(s, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				*p = append(*p, v)
+			}
+		}
+	default:
+		panic(fmt.Sprintf("config: unsupported setting type %T", p))
+	}
+	return err
+}
+
+// flagValue adapts a setting to flag.Value. Values are recorded while
+// parsing and applied after the file and environment, which they override.
+type flagValue struct {
+	setting
+	pending *[]func() error
+}
+
+func (f flagValue) String() string {
+	return ""
+}
+
+func (f flagValue) Set(s string) error {
+	*f.pending = append(*f.pending, func() error {
+		if err := f.set(s); err != nil {
+			return fmt.Errorf("invalid -%s: %v", flagName(f.env), err)
+		}
+		return nil
+	})
+	return nil
+}
+
+func (f flagValue) IsBoolFlag() bool {
+	_, ok := f.ptr.(*bool)
+	return ok
+}
+
+// Options are the flags that control loading rather than settings.
+type Options struct {
+	// PrintConfig asks to print the effective configuration and exit.
+	PrintConfig bool
+}
+
+// Load builds the configuration from the defaults, the file named by
+// -config or CONFIG_FILE, the environment and the command-line flags, and
+// validates it.
+func Load(name string, args []string) (*Config, Options, error) {
+	cfg := Default()
+	var opts Options
+	var pending []func() error
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	file := fs.String("config", os.Getenv("CONFIG_FILE"), "configuration file (YAML or TOML)")
+	fs.BoolVar(&opts.PrintConfig, "print-config", false, "print the effective configuration and exit")
+	settings := cfg.settings()
+	for _, st := range settings {
+		fs.Var(flagValue{st, &pending}, flagName(st.env), st.usage+" (env "+st.env+")")
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, opts, err
+	}
+
+	if *file != "" {
+		if err := cfg.readFile(*file); err != nil {
+			return nil, opts, err
+		}
+	}
+	for _, st := range settings {
+		if v, ok := os.LookupEnv(st.env); ok {
+			if err := st.set(v); err != nil {
+				return nil, opts, fmt.Errorf("invalid %s: %v", st.env, err)
+			}
+		}
+	}
+	for _, apply := range pending {
+		if err := apply(); err != nil {
+			return nil, opts, err
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, opts, err
+	}
+	return cfg, opts, nil
+}
+
+// readFile applies a YAML or TOML file, chosen by its extension.
+func (c *Config) readFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading config: %v", err)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".toml":
+		md, err := toml.Decode(string(data), c)
+		if err != nil {
+			return fmt.Errorf("error parsing config: %v", err)
+		}
+		if keys := md.Undecoded(); len(keys) > 0 {
+			return fmt.Errorf("error parsing config: unknown setting %s", keys[0])
+		}
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(strings.NewReader(string(data)))
+		dec.KnownFields(true)
+		if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("error parsing config: %v", err)
+		}
+	default:
+		return fmt.Errorf("unsupported config file %s: use .yaml, .yml or .toml", path)
+	}
+	return nil
+}
+
+// Validate checks the settings for consistency.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+	check(c.Listen != "" || c.UnixSocket != "", "a listen address or unix socket is required")
+	if _, err := c.SocketMode(); err != nil {
+		check(false, "invalid unix socket mode %q", c.UnixSocketMode)
+	}
+	switch strings.ToLower(c.Log.Format) {
+	case "", "text", "json":
+	default:
+		check(false, "invalid log format %q", c.Log.Format)
+	}
+	if c.Log.Level != "" {
+		var lvl slog.Level
+		check(lvl.UnmarshalText([]byte(c.Log.Level)) == nil, "invalid log level %q", c.Log.Level)
+	}
+	check(c.GRPC.MaxRecvMsgSize > 0 && c.GRPC.MaxSendMsgSize > 0, "gRPC message sizes must be positive")
+	for _, d := range []Duration{c.GRPC.KeepaliveTime, c.GRPC.KeepaliveTimeout, c.GRPC.KeepaliveMinTime,
+		c.GRPC.MaxConnectionIdle, c.GRPC.MaxConnectionAge, c.GRPC.MaxConnectionAgeGrace} {
+		check(d >= 0, "gRPC keepalive and connection durations must not be negative")
+	}
+	check(c.GRPC.GzipLevel >= -1 && c.GRPC.GzipLevel <= 9, "gzip level must be between -1 and 9")
+	check(c.AdminAddr == "" || isLoopback(c.AdminAddr), "admin address %q is not a loopback address", c.AdminAddr)
+	check(c.HealthInterval > 0, "health interval must be positive")
+	check(c.ShutdownGrace >= 0, "shutdown grace must not be negative")
+	check((c.TLS.Cert == "") == (c.TLS.Key == ""), "TLS certificate and key must be set together")
+	check(c.TLS.ClientCA == "" || c.TLS.Cert != "", "a client CA needs a TLS certificate")
+	check(c.Auth.JWT.Secret == "" || c.Auth.JWT.PublicKey == "", "JWT secret and public key are mutually exclusive")
+	if c.Limits.RateLimit != "" {
+		_, err := ratelimit.ParseLimit(c.Limits.RateLimit)
+		check(err == nil, "invalid rate limit: %v", err)
+	}
+	if c.Limits.RegistrationRateLimit != "" {
+		_, err := ratelimit.ParseLimit(c.Limits.RegistrationRateLimit)
+		check(err == nil, "invalid registration rate limit: %v", err)
+	}
+	_, err := ratelimit.ParseOverrides(c.Limits.RateLimitClients)
+	check(err == nil, "invalid rate limit clients: %v", err)
+	check(c.Limits.QuotaBytes >= 0 && c.Limits.QuotaRequests >= 0, "quotas must not be negative")
+	check(c.Limits.MaxInputBytes >= 0 && c.Limits.MaxRows >= 0 && c.Limits.MaxColumns >= 0, "input limits must not be negative")
+	check(c.Limits.MaxConcurrent >= 0 && c.Limits.MaxQueued >= 0 && c.Limits.QueueTimeout >= 0, "conversion pool settings must not be negative")
+	check(c.Limits.ConversionWorkers >= 0, "conversion workers must not be negative")
+	_, err = usage.ParseQuotas(c.Limits.QuotaClients)
+	check(err == nil, "invalid quota clients: %v", err)
+	check(c.Output.JSONIndent > 0 && c.Output.JSONIndent <= csvconverter.MaxJSONIndent, "JSON indent must be between 1 and %d", csvconverter.MaxJSONIndent)
+	check(c.Spill.Threshold >= 0, "spill threshold must not be negative")
+	check(c.Cache.MaxBytes >= 0, "cache size must not be negative")
+	check(c.Cache.TTL >= 0, "cache TTL must not be negative")
+	check(c.Storage.Influx.URL == "" || c.Storage.Influx.Org != "", "InfluxDB needs an organization")
+	check(c.Storage.Fetch.MaxBytes > 0, "fetch max bytes must be positive")
+	check(c.Storage.Fetch.Timeout >= 0, "fetch timeout must not be negative")
+	check(c.Storage.HistoryRetention >= 0, "history retention must not be negative")
+	check(c.Jobs.Dir == "" || c.Storage.HistoryDB == "", "jobs directory and history database are mutually exclusive: jobs are stored in the history database")
+	check(c.Audit.Key == "" || c.Audit.Sink != "", "an audit key needs an audit sink")
+	check(c.Jobs.Dir == "" || c.Jobs.RedisURL == "", "jobs directory and Redis URL are mutually exclusive")
+	check(c.Jobs.Workers > 0, "job workers must be positive")
+	check(c.Jobs.IdempotencyTTL > 0, "idempotency TTL must be positive")
+	check(c.Jobs.Retention > 0, "job retention must be positive")
+	if len(c.Kafka.Brokers) > 0 {
+		check(c.Kafka.Topic != "" && c.Kafka.OutputTopic != "", "Kafka needs a topic and an output topic")
+		check(c.Kafka.GroupID != "", "Kafka needs a consumer group")
+		switch strings.ToLower(c.Kafka.Serialization) {
+		case "json", "avro":
+		default:
+			check(false, "invalid Kafka serialization %q", c.Kafka.Serialization)
+		}
+	}
+	if c.NATS.URL != "" {
+		check(c.NATS.RequestSubject != "" || c.NATS.Subject != "", "NATS needs a request subject or a subject")
+		check(c.NATS.Subject == "" || c.NATS.RecordsSubject != "", "NATS needs a records subject for raw input")
+		check(c.NATS.Workers > 0, "NATS workers must be positive")
+	}
+	return errors.Join(errs...)
+}
+
+// secret hides a credential in printed configuration.
+func secret(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "<redacted>"
+}
+
+// Print writes the configuration as YAML with credentials redacted.
+func (c *Config) Print(w io.Writer) error {
+	out := *c
+	out.Auth.AdminToken = secret(out.Auth.AdminToken)
+	out.Auth.JWT.Secret = secret(out.Auth.JWT.Secret)
+	out.Storage.S3.SecretKey = secret(out.Storage.S3.SecretKey)
+	out.Storage.Influx.Token = secret(out.Storage.Influx.Token)
+	out.Cache.RedisURL = redactURL(out.Cache.RedisURL)
+	out.Jobs.RedisURL = redactURL(out.Jobs.RedisURL)
+	out.Audit.Key = secret(out.Audit.Key)
+	out.Storage.PostgresURL = redactDSN(out.Storage.PostgresURL)
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&out); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// redactURL hides the password in a URL.
+func redactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.User == nil {
+		return s
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), "redacted")
+	}
+	return u.String()
+}
+
+// redactDSN hides the password of a URL, or the whole of a key=value
+// connection string.
+func redactDSN(s string) string {
+	if strings.Contains(s, "://") {
+		return redactURL(s)
+	}
+	return secret(s)
+}
+
+// SocketMode returns UnixSocketMode as file permissions.
+func (c *Config) SocketMode() (os.FileMode, error) {
+	mode, err := strconv.ParseUint(c.UnixSocketMode, 8, 32)
+	if err != nil || mode > 0o777 {
+		return 0, fmt.Errorf("invalid mode %q", c.UnixSocketMode)
+	}
+	return os.FileMode(mode), nil
+}
+
+// isLoopback reports whether addr, a host:port, only listens on the local
+// machine.
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
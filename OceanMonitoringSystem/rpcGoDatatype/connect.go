@@ -0,0 +1,299 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"rpcGoDatatype/config"
+	pb "rpcGoDatatype/proto"
+	"rpcGoDatatype/proto/protoconnect"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// connectService serves DataParser over the Connect protocol by forwarding
+// each call to the gRPC server, see internal.
+type connectService struct {
+	client pb.DataParserClient
+}
+
+// connectHandler serves the Connect, gRPC-Web and gRPC protocols over
+// HTTP/1.1 and unencrypted HTTP/2.
+func connectHandler(conn *grpc.ClientConn, limits config.GRPC) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(protoconnect.NewDataParserHandler(
+		connectService{client: pb.NewDataParserClient(conn)},
+		connect.WithReadMaxBytes(limits.MaxRecvMsgSize),
+		connect.WithSendMaxBytes(limits.MaxSendMsgSize),
+	))
+	mux.Handle(protoconnect.NewStationRegistryHandler(
+		registryConnectService{client: pb.NewStationRegistryClient(conn)},
+		connect.WithReadMaxBytes(limits.MaxRecvMsgSize),
+		connect.WithSendMaxBytes(limits.MaxSendMsgSize),
+	))
+	mux.Handle(protoconnect.NewAlertingHandler(
+		alertingConnectService{client: pb.NewAlertingClient(conn)},
+		connect.WithReadMaxBytes(limits.MaxRecvMsgSize),
+		connect.WithSendMaxBytes(limits.MaxSendMsgSize),
+	))
+	return mux
+}
+
+// forward makes a gRPC call for a Connect request, passing the forwarded
+// headers on as metadata and the response metadata back as headers.
+func forward[Req, Resp any](ctx context.Context, req *connect.Request[Req], call func(context.Context, *Req, ...grpc.CallOption) (*Resp, error)) (*connect.Response[Resp], error) {
+	var header, trailer metadata.MD
+	msg, err := call(metadata.NewOutgoingContext(ctx, forwardedMetadata(req.Header(), req.Peer().Addr)), req.Msg, grpc.Header(&header), grpc.Trailer(&trailer))
+	if err != nil {
+		cerr := connectError(err)
+		copyMetadata(cerr.Meta(), header)
+		return nil, cerr
+	}
+	resp := connect.NewResponse(msg)
+	copyMetadata(resp.Header(), header)
+	copyMetadata(resp.Trailer(), trailer)
+	return resp, nil
+}
+
+// connectError converts a gRPC status, keeping its code and details.
+func connectError(err error) *connect.Error {
+	st := status.Convert(err)
+	cerr := connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	for _, d := range st.Proto().GetDetails() {
+		if detail, err := connect.NewErrorDetail(d); err == nil {
+			cerr.AddDetail(detail)
+		}
+	}
+	return cerr
+}
+
+// copyMetadata copies application metadata, leaving out the gRPC
+// protocol's own keys.
+func copyMetadata(h http.Header, md metadata.MD) {
+	for key, values := range md {
+		if key == "content-type" || strings.HasPrefix(key, "grpc-") {
+			continue
+		}
+		for _, v := range values {
+			h.Add(key, v)
+		}
+	}
+}
+
+func (c connectService) Parse(ctx context.Context, req *connect.Request[pb.ParseRequest]) (*connect.Response[pb.ParseResponse], error) {
+	return forward(ctx, req, c.client.Parse)
+}
+
+func (c connectService) GetCompatibilityMatrix(ctx context.Context, req *connect.Request[pb.CompatibilityMatrixRequest]) (*connect.Response[pb.CompatibilityMatrixResponse], error) {
+	return forward(ctx, req, c.client.GetCompatibilityMatrix)
+}
+
+func (c connectService) RegisterStation(ctx context.Context, req *connect.Request[pb.RegisterStationRequest]) (*connect.Response[pb.RegisterStationResponse], error) {
+	return forward(ctx, req, c.client.RegisterStation)
+}
+
+func (c connectService) ApproveStation(ctx context.Context, req *connect.Request[pb.ApproveStationRequest]) (*connect.Response[pb.ApproveStationResponse], error) {
+	return forward(ctx, req, c.client.ApproveStation)
+}
+
+func (c connectService) GetRegistrationStatus(ctx context.Context, req *connect.Request[pb.RegistrationStatusRequest]) (*connect.Response[pb.RegistrationStatusResponse], error) {
+	return forward(ctx, req, c.client.GetRegistrationStatus)
+}
+
+func (c connectService) Merge(ctx context.Context, req *connect.Request[pb.MergeRequest]) (*connect.Response[pb.ParseResponse], error) {
+	return forward(ctx, req, c.client.Merge)
+}
+
+func (c connectService) Split(ctx context.Context, req *connect.Request[pb.SplitRequest]) (*connect.Response[pb.SplitResponse], error) {
+	return forward(ctx, req, c.client.Split)
+}
+
+func (c connectService) InferSchema(ctx context.Context, req *connect.Request[pb.InferSchemaRequest]) (*connect.Response[pb.InferSchemaResponse], error) {
+	return forward(ctx, req, c.client.InferSchema)
+}
+
+func (c connectService) DescribeData(ctx context.Context, req *connect.Request[pb.DescribeDataRequest]) (*connect.Response[pb.DescribeDataResponse], error) {
+	return forward(ctx, req, c.client.DescribeData)
+}
+
+func (c connectService) Validate(ctx context.Context, req *connect.Request[pb.ValidateRequest]) (*connect.Response[pb.ValidateResponse], error) {
+	return forward(ctx, req, c.client.Validate)
+}
+
+func (c connectService) Pipeline(ctx context.Context, req *connect.Request[pb.PipelineRequest]) (*connect.Response[pb.ParseResponse], error) {
+	return forward(ctx, req, c.client.Pipeline)
+}
+
+func (c connectService) QualityControl(ctx context.Context, req *connect.Request[pb.QualityControlRequest]) (*connect.Response[pb.ParseResponse], error) {
+	return forward(ctx, req, c.client.QualityControl)
+}
+
+func (c connectService) DetectAnomalies(ctx context.Context, req *connect.Request[pb.DetectAnomaliesRequest]) (*connect.Response[pb.ParseResponse], error) {
+	return forward(ctx, req, c.client.DetectAnomalies)
+}
+
+func (c connectService) DetectGaps(ctx context.Context, req *connect.Request[pb.DetectGapsRequest]) (*connect.Response[pb.DetectGapsResponse], error) {
+	return forward(ctx, req, c.client.DetectGaps)
+}
+
+func (c connectService) Correlate(ctx context.Context, req *connect.Request[pb.CorrelateRequest]) (*connect.Response[pb.CorrelateResponse], error) {
+	return forward(ctx, req, c.client.Correlate)
+}
+
+func (c connectService) Aggregate(ctx context.Context, req *connect.Request[pb.AggregateRequest]) (*connect.Response[pb.ParseResponse], error) {
+	return forward(ctx, req, c.client.Aggregate)
+}
+
+func (c connectService) ParseBatch(ctx context.Context, req *connect.Request[pb.ParseBatchRequest]) (*connect.Response[pb.ParseBatchResponse], error) {
+	return forward(ctx, req, c.client.ParseBatch)
+}
+
+func (c connectService) SubmitJob(ctx context.Context, req *connect.Request[pb.ParseRequest]) (*connect.Response[pb.JobStatus], error) {
+	return forward(ctx, req, c.client.SubmitJob)
+}
+
+func (c connectService) GetJobStatus(ctx context.Context, req *connect.Request[pb.JobRequest]) (*connect.Response[pb.JobStatus], error) {
+	return forward(ctx, req, c.client.GetJobStatus)
+}
+
+func (c connectService) GetJobResult(ctx context.Context, req *connect.Request[pb.JobRequest]) (*connect.Response[pb.ParseResponse], error) {
+	return forward(ctx, req, c.client.GetJobResult)
+}
+
+func (c connectService) CancelJob(ctx context.Context, req *connect.Request[pb.JobRequest]) (*connect.Response[pb.JobStatus], error) {
+	return forward(ctx, req, c.client.CancelJob)
+}
+
+func (c connectService) GetUsage(ctx context.Context, req *connect.Request[pb.UsageRequest]) (*connect.Response[pb.UsageResponse], error) {
+	return forward(ctx, req, c.client.GetUsage)
+}
+
+func (c connectService) ListJobs(ctx context.Context, req *connect.Request[pb.ListJobsRequest]) (*connect.Response[pb.ListJobsResponse], error) {
+	return forward(ctx, req, c.client.ListJobs)
+}
+
+func (c connectService) GetHistory(ctx context.Context, req *connect.Request[pb.HistoryRequest]) (*connect.Response[pb.HistoryResponse], error) {
+	return forward(ctx, req, c.client.GetHistory)
+}
+
+func (c connectService) ParseLive(ctx context.Context, bidi *connect.BidiStream[pb.LiveRequest, pb.LiveResponse]) error {
+	ctx, cancel := context.WithCancel(metadata.NewOutgoingContext(ctx, forwardedMetadata(bidi.RequestHeader(), bidi.Peer().Addr)))
+	defer cancel()
+	stream, err := c.client.ParseLive(ctx)
+	if err != nil {
+		return connectError(err)
+	}
+	go func() {
+		for {
+			msg, err := bidi.Receive()
+			if err != nil {
+				stream.CloseSend()
+				return
+			}
+			if err := stream.Send(msg); err != nil {
+				return
+			}
+		}
+	}()
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return connectError(err)
+		}
+		if err := bidi.Send(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// registryConnectService serves StationRegistry over the Connect protocol,
+// see connectService.
+type registryConnectService struct {
+	client pb.StationRegistryClient
+}
+
+func (c registryConnectService) CreatePlatform(ctx context.Context, req *connect.Request[pb.CreatePlatformRequest]) (*connect.Response[pb.Platform], error) {
+	return forward(ctx, req, c.client.CreatePlatform)
+}
+
+func (c registryConnectService) GetPlatform(ctx context.Context, req *connect.Request[pb.GetPlatformRequest]) (*connect.Response[pb.Platform], error) {
+	return forward(ctx, req, c.client.GetPlatform)
+}
+
+func (c registryConnectService) ListPlatforms(ctx context.Context, req *connect.Request[pb.ListPlatformsRequest]) (*connect.Response[pb.ListPlatformsResponse], error) {
+	return forward(ctx, req, c.client.ListPlatforms)
+}
+
+func (c registryConnectService) UpdatePlatform(ctx context.Context, req *connect.Request[pb.UpdatePlatformRequest]) (*connect.Response[pb.Platform], error) {
+	return forward(ctx, req, c.client.UpdatePlatform)
+}
+
+func (c registryConnectService) DeletePlatform(ctx context.Context, req *connect.Request[pb.DeletePlatformRequest]) (*connect.Response[pb.DeletePlatformResponse], error) {
+	return forward(ctx, req, c.client.DeletePlatform)
+}
+
+func (c registryConnectService) PutSensor(ctx context.Context, req *connect.Request[pb.PutSensorRequest]) (*connect.Response[pb.Platform], error) {
+	return forward(ctx, req, c.client.PutSensor)
+}
+
+func (c registryConnectService) DeleteSensor(ctx context.Context, req *connect.Request[pb.DeleteSensorRequest]) (*connect.Response[pb.Platform], error) {
+	return forward(ctx, req, c.client.DeleteSensor)
+}
+
+func (c registryConnectService) AddCalibration(ctx context.Context, req *connect.Request[pb.AddCalibrationRequest]) (*connect.Response[pb.Platform], error) {
+	return forward(ctx, req, c.client.AddCalibration)
+}
+
+func (c registryConnectService) PutDeployment(ctx context.Context, req *connect.Request[pb.PutDeploymentRequest]) (*connect.Response[pb.Platform], error) {
+	return forward(ctx, req, c.client.PutDeployment)
+}
+
+func (c registryConnectService) DeleteDeployment(ctx context.Context, req *connect.Request[pb.DeleteDeploymentRequest]) (*connect.Response[pb.Platform], error) {
+	return forward(ctx, req, c.client.DeleteDeployment)
+}
+
+// alertingConnectService serves Alerting over the Connect protocol, see
+// connectService.
+type alertingConnectService struct {
+	client pb.AlertingClient
+}
+
+func (c alertingConnectService) CreateAlertRule(ctx context.Context, req *connect.Request[pb.CreateAlertRuleRequest]) (*connect.Response[pb.AlertRule], error) {
+	return forward(ctx, req, c.client.CreateAlertRule)
+}
+
+func (c alertingConnectService) ListAlertRules(ctx context.Context, req *connect.Request[pb.ListAlertRulesRequest]) (*connect.Response[pb.ListAlertRulesResponse], error) {
+	return forward(ctx, req, c.client.ListAlertRules)
+}
+
+func (c alertingConnectService) DeleteAlertRule(ctx context.Context, req *connect.Request[pb.DeleteAlertRuleRequest]) (*connect.Response[pb.DeleteAlertRuleResponse], error) {
+	return forward(ctx, req, c.client.DeleteAlertRule)
+}
+
+func (c alertingConnectService) WatchAlerts(ctx context.Context, req *connect.Request[pb.WatchAlertsRequest], out *connect.ServerStream[pb.AlertEvent]) error {
+	stream, err := c.client.WatchAlerts(metadata.NewOutgoingContext(ctx, forwardedMetadata(req.Header(), req.Peer().Addr)), req.Msg)
+	if err != nil {
+		return connectError(err)
+	}
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return connectError(err)
+		}
+		if err := out.Send(msg); err != nil {
+			return err
+		}
+	}
+}
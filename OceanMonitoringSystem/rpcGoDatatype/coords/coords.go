@@ -0,0 +1,189 @@
+// Package coords parses and formats latitudes and longitudes written as
+// decimal degrees, degrees, minutes and seconds, or NMEA 0183 ddmm.mmmm,
+// and tests whether they fall in a region.
+package coords
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Coordinate formats.
+const (
+	// Decimal is signed decimal degrees, e.g. -9.1406.
+	Decimal = "decimal"
+	// DMS is degrees, minutes and seconds, e.g. 38°42'15.3"N or
+	// 38 42 15.3 N. Minutes and seconds may be left out.
+	DMS = "dms"
+	// NMEA is degrees and decimal minutes as in NMEA 0183 sentences, e.g.
+	// 3842.2550N or 00908.4360,W.
+	NMEA = "nmea"
+)
+
+// Axis is a latitude or a longitude.
+type Axis int
+
+const (
+	Latitude Axis = iota
+	Longitude
+)
+
+func (a Axis) String() string {
+	if a == Latitude {
+		return "latitude"
+	}
+	return "longitude"
+}
+
+// limit is the largest absolute value of a coordinate on the axis.
+func (a Axis) limit() float64 {
+	if a == Latitude {
+		return 90
+	}
+	return 180
+}
+
+// hemispheres returns the positive and negative hemisphere letters.
+func (a Axis) hemispheres() (byte, byte) {
+	if a == Latitude {
+		return 'N', 'S'
+	}
+	return 'E', 'W'
+}
+
+// ValidFormat reports whether format is a known format. The empty format
+// is valid for Parse only.
+func ValidFormat(format string) bool {
+	switch format {
+	case Decimal, DMS, NMEA:
+		return true
+	}
+	return false
+}
+
+// Parse reads a coordinate in format, or in decimal or DMS form, whichever
+// it looks like, for an empty format. A hemisphere letter may follow or
+// precede the value instead of a sign; a sign and a letter together, or a
+// letter of the other axis, are errors, as are values out of range.
+func Parse(s string, axis Axis, format string) (float64, error) {
+	body := strings.TrimSpace(s)
+	if body == "" {
+		return 0, fmt.Errorf("empty %s", axis)
+	}
+	hemi := byte(0)
+	if c := body[len(body)-1]; isLetter(c) {
+		hemi, body = c, strings.TrimRight(body[:len(body)-1], " ,")
+	} else if c := body[0]; isLetter(c) {
+		hemi, body = c, strings.TrimLeft(body[1:], " ,")
+	}
+	negative := false
+	if body != "" && (body[0] == '-' || body[0] == '+') {
+		if hemi != 0 {
+			return 0, fmt.Errorf("%s %q has both a sign and a hemisphere", axis, s)
+		}
+		negative, body = body[0] == '-', body[1:]
+	}
+	if hemi != 0 {
+		pos, neg := axis.hemispheres()
+		switch hemi &^ ('a' - 'A') {
+		case pos:
+		case neg:
+			negative = true
+		default:
+			return 0, fmt.Errorf("%s %q has an invalid hemisphere %q", axis, s, hemi)
+		}
+	}
+
+	if format == "" {
+		format = Decimal
+		if strings.ContainsAny(body, "°º'\":′″ ") {
+			format = DMS
+		}
+	}
+	var v float64
+	var err error
+	switch format {
+	case Decimal:
+		v, err = strconv.ParseFloat(body, 64)
+	case DMS:
+		v, err = parseDMS(body)
+	case NMEA:
+		v, err = parseNMEA(body)
+	default:
+		return 0, fmt.Errorf("unsupported coordinate format %q", format)
+	}
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("%s %q is not in %s form", axis, s, format)
+	}
+	if v > axis.limit() {
+		return 0, fmt.Errorf("%s %q is outside [-%g, %g]", axis, s, axis.limit(), axis.limit())
+	}
+	if negative {
+		v = -v
+	}
+	return v, nil
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// parseDMS reads unsigned degrees, minutes and seconds separated by
+// symbols, colons or spaces.
+func parseDMS(s string) (float64, error) {
+	parts := strings.FieldsFunc(s, func(r rune) bool {
+		return strings.ContainsRune("°º'\":′″ ", r)
+	})
+	if len(parts) == 0 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid DMS value")
+	}
+	v := 0.0
+	for i, part := range parts {
+		f, err := strconv.ParseFloat(part, 64)
+		if err != nil || f < 0 || i > 0 && f >= 60 || i < len(parts)-1 && f != math.Trunc(f) {
+			return 0, fmt.Errorf("invalid DMS value")
+		}
+		v += f / math.Pow(60, float64(i))
+	}
+	return v, nil
+}
+
+// parseNMEA reads unsigned degrees and decimal minutes run together.
+func parseNMEA(s string) (float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("invalid NMEA value")
+	}
+	degrees := math.Floor(f / 100)
+	minutes := f - degrees*100
+	if minutes >= 60 {
+		return 0, fmt.Errorf("invalid NMEA value")
+	}
+	return degrees + minutes/60, nil
+}
+
+// Format writes a coordinate in decimal degrees, DMS with seconds to two
+// decimals, or NMEA with minutes to four decimals and a hemisphere letter.
+func Format(v float64, axis Axis, format string) string {
+	pos, neg := axis.hemispheres()
+	hemi := pos
+	if v < 0 {
+		hemi = neg
+	}
+	abs := math.Abs(v)
+	switch format {
+	case DMS:
+		hundredths := int64(math.Round(abs * 360000))
+		return fmt.Sprintf("%d°%02d'%05.2f\"%c", hundredths/360000, hundredths/6000%60, float64(hundredths%6000)/100, hemi)
+	case NMEA:
+		ticks := int64(math.Round(abs * 600000))
+		width := 2
+		if axis == Longitude {
+			width = 3
+		}
+		return fmt.Sprintf("%0*d%07.4f%c", width, ticks/600000, float64(ticks%600000)/10000, hemi)
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,167 @@
+package coords
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Region is an area that points in decimal degrees may fall in.
+type Region interface {
+	Contains(lat, lon float64) bool
+}
+
+// BoundingBox is the area between two latitudes and two longitudes, edges
+// included. A MinLongitude above MaxLongitude wraps across the
+// antimeridian.
+type BoundingBox struct {
+	MinLatitude, MinLongitude float64
+	MaxLatitude, MaxLongitude float64
+}
+
+// Validate checks that the box is within range and not upside down.
+func (b BoundingBox) Validate() error {
+	switch {
+	case b.MinLatitude < -90 || b.MaxLatitude > 90:
+		return fmt.Errorf("bounding box latitudes must be within [-90, 90]")
+	case b.MinLongitude < -180 || b.MaxLongitude > 180 || b.MinLongitude > 180 || b.MaxLongitude < -180:
+		return fmt.Errorf("bounding box longitudes must be within [-180, 180]")
+	case b.MinLatitude > b.MaxLatitude:
+		return fmt.Errorf("bounding box minimum latitude is above its maximum")
+	}
+	return nil
+}
+
+func (b BoundingBox) Contains(lat, lon float64) bool {
+	if lat < b.MinLatitude || lat > b.MaxLatitude {
+		return false
+	}
+	if b.MinLongitude <= b.MaxLongitude {
+		return lon >= b.MinLongitude && lon <= b.MaxLongitude
+	}
+	return lon >= b.MinLongitude || lon <= b.MaxLongitude
+}
+
+// Polygons is a set of polygons, each an outer ring followed by its holes,
+// with the positions of a ring as [longitude, latitude] pairs as in
+// GeoJSON.
+type Polygons [][][][2]float64
+
+// Contains reports whether the point is inside any of the polygons, on the
+// even-odd rule so that holes are excluded. Points exactly on an edge may
+// fall either way.
+func (p Polygons) Contains(lat, lon float64) bool {
+	for _, polygon := range p {
+		inside := false
+		for _, ring := range polygon {
+			for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+				xi, yi := ring[i][0], ring[i][1]
+				xj, yj := ring[j][0], ring[j][1]
+				if (yi > lat) != (yj > lat) && lon < (xj-xi)*(lat-yi)/(yj-yi)+xi {
+					inside = !inside
+				}
+			}
+		}
+		if inside {
+			return true
+		}
+	}
+	return false
+}
+
+// geoJSON holds the members of the GeoJSON objects ParseGeoJSON reads.
+type geoJSON struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+	Geometry    *geoJSON        `json:"geometry"`
+	Features    []geoJSON       `json:"features"`
+}
+
+// ParseGeoJSON reads a GeoJSON Polygon or MultiPolygon, or a Feature or
+// FeatureCollection of them.
+func ParseGeoJSON(data string) (Polygons, error) {
+	var g geoJSON
+	if err := json.Unmarshal([]byte(data), &g); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON: %v", err)
+	}
+	p, err := g.polygons()
+	if err != nil {
+		return nil, err
+	}
+	if len(p) == 0 {
+		return nil, fmt.Errorf("GeoJSON has no polygons")
+	}
+	return p, nil
+}
+
+func (g *geoJSON) polygons() (Polygons, error) {
+	switch g.Type {
+	case "Polygon":
+		var polygon [][][2]float64
+		if err := json.Unmarshal(g.Coordinates, &polygon); err != nil {
+			return nil, fmt.Errorf("invalid GeoJSON polygon: %v", err)
+		}
+		return checkPolygons(Polygons{polygon})
+	case "MultiPolygon":
+		var p Polygons
+		if err := json.Unmarshal(g.Coordinates, &p); err != nil {
+			return nil, fmt.Errorf("invalid GeoJSON multipolygon: %v", err)
+		}
+		return checkPolygons(p)
+	case "Feature":
+		if g.Geometry == nil {
+			return nil, fmt.Errorf("GeoJSON feature has no geometry")
+		}
+		return g.Geometry.polygons()
+	case "FeatureCollection":
+		var all Polygons
+		for i := range g.Features {
+			p, err := g.Features[i].polygons()
+			if err != nil {
+				return nil, err
+			}
+			all = append(all, p...)
+		}
+		return all, nil
+	}
+	return nil, fmt.Errorf("unsupported GeoJSON type %q", g.Type)
+}
+
+// checkPolygons checks that every ring is closed and has at least four
+// positions within range.
+func checkPolygons(p Polygons) (Polygons, error) {
+	for _, polygon := range p {
+		if len(polygon) == 0 {
+			return nil, fmt.Errorf("GeoJSON polygon has no rings")
+		}
+		for _, ring := range polygon {
+			if len(ring) < 4 || ring[0] != ring[len(ring)-1] {
+				return nil, fmt.Errorf("GeoJSON polygon rings must be closed and have at least four positions")
+			}
+			for _, pos := range ring {
+				if pos[0] < -180 || pos[0] > 180 || pos[1] < -90 || pos[1] > 90 {
+					return nil, fmt.Errorf("GeoJSON position %v is out of range", pos)
+				}
+			}
+		}
+	}
+	return p, nil
+}
+
+// earthRadius is the mean radius of the Earth in metres.
+const earthRadius = 6371008.8
+
+// Point is a position in decimal degrees.
+type Point struct {
+	Latitude, Longitude float64
+}
+
+// Distance returns the great-circle distance between two points in metres,
+// by the haversine formula on a spherical Earth.
+func Distance(a, b Point) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dlat := lat2 - lat1
+	dlon := (b.Longitude - a.Longitude) * math.Pi / 180
+	h := math.Sin(dlat/2)*math.Sin(dlat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dlon/2)*math.Sin(dlon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(min(h, 1)))
+}
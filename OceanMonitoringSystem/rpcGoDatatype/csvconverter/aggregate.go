@@ -0,0 +1,265 @@
+package csvconverter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Aggregate functions accepted in Aggregation.Functions.
+const (
+	AggregateMean  = "mean"
+	AggregateMin   = "min"
+	AggregateMax   = "max"
+	AggregateCount = "count"
+)
+
+// defaultAggregateFunctions are computed when Aggregation.Functions is
+// empty.
+var defaultAggregateFunctions = []string{AggregateMean, AggregateMin, AggregateMax, AggregateCount}
+
+// Aggregation rolls rows up into time windows. Each output row holds the
+// window start in TimeColumn, in UTC RFC 3339, the station and a column
+// named <column>_<function> per column and function, e.g. temp_mean.
+// Windows are aligned to whole multiples of their length, so hourly
+// windows start on the hour and daily ones at midnight UTC. Rows come out
+// ordered by window, then by station in order of appearance.
+type Aggregation struct {
+	TimeColumn string
+	// StationColumn aggregates each station separately. Without it all
+	// rows are aggregated together.
+	StationColumn string
+	// Window is the window length as a Go duration such as "10m", "1h" or
+	// "24h".
+	Window  string
+	Columns []string
+	// Functions defaults to mean, min, max and count. count is the number
+	// of numeric values; the others are null for windows without any.
+	Functions []string
+}
+
+// AggregateContext converts data like Convert, replacing its rows with
+// their aggregates. It is a pipeline of one aggregation step.
+func AggregateContext(ctx context.Context, from, to, data string, a Aggregation, opts Options) (*Result, error) {
+	return PipelineContext(ctx, from, to, data, []Step{{Type: StepAggregate, Aggregation: &a}}, opts)
+}
+
+// aggregateStep is a compiled Aggregation.
+type aggregateStep struct {
+	a      Aggregation
+	window time.Duration
+	out    []string
+}
+
+func newAggregateStep(a Aggregation) (*aggregateStep, error) {
+	if a.TimeColumn == "" || len(a.Columns) == 0 {
+		return nil, fmt.Errorf("aggregation needs a time column and at least one column")
+	}
+	window, err := time.ParseDuration(a.Window)
+	if err != nil || window <= 0 {
+		return nil, fmt.Errorf("invalid aggregation window: %q", a.Window)
+	}
+	if len(a.Functions) == 0 {
+		a.Functions = defaultAggregateFunctions
+	}
+	for i, fn := range a.Functions {
+		switch fn {
+		case AggregateMean, AggregateMin, AggregateMax, AggregateCount:
+		default:
+			return nil, fmt.Errorf("unsupported aggregate function %q", fn)
+		}
+		if hasColumn(a.Functions[:i], fn) {
+			return nil, fmt.Errorf("aggregate function %s is listed twice", fn)
+		}
+	}
+	s := &aggregateStep{a: a, window: window, out: []string{a.TimeColumn}}
+	if a.StationColumn != "" {
+		s.out = append(s.out, a.StationColumn)
+	}
+	for i, column := range a.Columns {
+		if column == a.TimeColumn || column == a.StationColumn || hasColumn(a.Columns[:i], column) {
+			return nil, fmt.Errorf("column %s cannot be aggregated twice or as the time or station", column)
+		}
+		for _, fn := range a.Functions {
+			s.out = append(s.out, column+"_"+fn)
+		}
+	}
+	return s, nil
+}
+
+func (s *aggregateStep) columns(in []string) ([]string, error) {
+	named := append([]string{s.a.TimeColumn}, s.a.Columns...)
+	if s.a.StationColumn != "" {
+		named = append(named, s.a.StationColumn)
+	}
+	if err := checkColumns(named, in); err != nil {
+		return nil, fmt.Errorf("aggregation: %v", err)
+	}
+	return s.out, nil
+}
+
+func (s *aggregateStep) reader(src rowReader, columns []string, opts Options, result *Result) rowReader {
+	r := &aggregateReader{src: src, step: s, opts: opts, result: result}
+	if columns != nil {
+		r.columns = s.out
+	}
+	return r
+}
+
+// aggregateReader reads every row of src, keeping only the running
+// aggregates of each window, and returns the aggregates.
+type aggregateReader struct {
+	src     rowReader
+	step    *aggregateStep
+	opts    Options
+	result  *Result
+	columns []string
+	rows    []*object
+	read    bool
+}
+
+func (r *aggregateReader) Columns() []string {
+	return r.columns
+}
+
+// Next returns the next aggregate row, or io.EOF.
+func (r *aggregateReader) Next() (*object, error) {
+	if !r.read {
+		if err := r.readAll(); err != nil {
+			return nil, err
+		}
+		r.read = true
+	}
+	if len(r.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := r.rows[0]
+	r.rows[0] = nil
+	r.rows = r.rows[1:]
+	return row, nil
+}
+
+// aggregateBucket holds the running aggregates of one station and window.
+type aggregateBucket struct {
+	start   time.Time
+	station int
+	value   interface{}
+	sums    []float64
+	mins    []float64
+	maxs    []float64
+	counts  []int
+}
+
+func (r *aggregateReader) readAll() error {
+	a := r.step.a
+	type key struct {
+		station string
+		start   int64
+	}
+	buckets := make(map[key]*aggregateBucket)
+	stations := make(map[string]int)
+	var all []*aggregateBucket
+	untimed := 0
+	for {
+		row, err := r.src.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		v, _ := row.get(a.TimeColumn)
+		t, ok := timeValue(v, r.opts)
+		if !ok {
+			untimed++
+			continue
+		}
+		var station interface{}
+		name := ""
+		if a.StationColumn != "" {
+			if station, _ = row.get(a.StationColumn); station != nil {
+				name = fmt.Sprint(station)
+			}
+		}
+		start := t.UTC().Truncate(r.step.window)
+		k := key{name, start.UnixNano()}
+		b, ok := buckets[k]
+		if !ok {
+			n, ok := stations[name]
+			if !ok {
+				n = len(stations)
+				stations[name] = n
+			}
+			c := len(a.Columns)
+			b = &aggregateBucket{start: start, station: n, value: station, sums: make([]float64, c), mins: make([]float64, c), maxs: make([]float64, c), counts: make([]int, c)}
+			buckets[k] = b
+			all = append(all, b)
+		}
+		for i, column := range a.Columns {
+			v, _ := row.get(column)
+			f, _, number := qcNumber(v)
+			if !number {
+				continue
+			}
+			if b.counts[i] == 0 || f < b.mins[i] {
+				b.mins[i] = f
+			}
+			if b.counts[i] == 0 || f > b.maxs[i] {
+				b.maxs[i] = f
+			}
+			b.sums[i] += f
+			b.counts[i]++
+		}
+	}
+	if untimed > 0 {
+		r.result.Warnings = append(r.result.Warnings, fmt.Sprintf("aggregation: %d rows without a valid %s were ignored", untimed, a.TimeColumn))
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		if !all[i].start.Equal(all[j].start) {
+			return all[i].start.Before(all[j].start)
+		}
+		return all[i].station < all[j].station
+	})
+	r.rows = make([]*object, len(all))
+	for n, b := range all {
+		row := newObjectSize(len(r.step.out))
+		row.line = n + 1
+		row.set(a.TimeColumn, b.start.Format(time.RFC3339Nano))
+		if a.StationColumn != "" {
+			row.set(a.StationColumn, b.value)
+		}
+		for i, column := range a.Columns {
+			for _, fn := range a.Functions {
+				var value interface{}
+				switch {
+				case fn == AggregateCount:
+					value = json.Number(strconv.Itoa(b.counts[i]))
+				case b.counts[i] == 0:
+				case fn == AggregateMean:
+					value = finiteNumber(b.sums[i] / float64(b.counts[i]))
+				case fn == AggregateMin:
+					value = finiteNumber(b.mins[i])
+				case fn == AggregateMax:
+					value = finiteNumber(b.maxs[i])
+				}
+				row.set(column+"_"+fn, value)
+			}
+		}
+		r.rows[n] = row
+	}
+	return nil
+}
+
+// finiteNumber returns f as a number, or nil when it is not finite.
+func finiteNumber(f float64) interface{} {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil
+	}
+	return floatNumber(f)
+}
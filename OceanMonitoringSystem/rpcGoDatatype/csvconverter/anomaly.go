@@ -0,0 +1,320 @@
+package csvconverter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+)
+
+// Anomaly detection methods accepted in AnomalyDetection.Method.
+const (
+	// AnomalyZScore scores a value by its distance from the mean, in
+	// standard deviations.
+	AnomalyZScore = "zscore"
+	// AnomalyMAD scores a value by its distance from the median, in median
+	// absolute deviations scaled to match a normal standard deviation. A
+	// value off a median with no deviation scores infinity.
+	AnomalyMAD = "mad"
+)
+
+// Default thresholds of the anomaly detection methods, and the flag column
+// suffix.
+const (
+	DefaultZScoreThreshold = 3
+	DefaultMADThreshold    = 3.5
+	DefaultAnomalySuffix   = "_anomaly"
+)
+
+// minAnomalyValues is the fewest values a score is computed from.
+const minAnomalyValues = 3
+
+// madScale turns a median absolute deviation into an estimate of the
+// standard deviation of normally distributed values.
+const madScale = 1.4826
+
+// AnomalyDetection configures spike detection on numeric columns. Each
+// column gets a boolean flag column, true for anomalies and null for
+// values that are missing or have too few neighbours to score.
+type AnomalyDetection struct {
+	Columns []string
+	// Method is AnomalyZScore (the default) or AnomalyMAD.
+	Method string
+	// Window is the number of rows, centred on a value, it is scored
+	// against. Zero scores it against the whole series.
+	Window int
+	// Threshold is the score above which a value is an anomaly. Zero uses
+	// the method's default.
+	Threshold float64
+	// StationColumn splits the rows into one series per station. Without
+	// it all rows form one series.
+	StationColumn string
+	// FlagSuffix names the flag columns, DefaultAnomalySuffix when empty.
+	FlagSuffix string
+}
+
+// Anomaly is a value flagged by anomaly detection. Index is the 0-based
+// position of its row among the rows the detection saw, and Row the row as
+// in RowError.
+type Anomaly struct {
+	Index   int
+	Row     int
+	Station string
+	Column  string
+	Value   float64
+	Score   float64
+}
+
+// DetectAnomaliesContext converts data like Convert, appending the flag
+// columns of d and listing the anomalies in Result.Anomalies. It is a
+// pipeline of one anomaly detection step.
+func DetectAnomaliesContext(ctx context.Context, from, to, data string, d AnomalyDetection, opts Options) (*Result, error) {
+	return PipelineContext(ctx, from, to, data, []Step{{Type: StepDetectAnomalies, Anomalies: &d}}, opts)
+}
+
+// anomalyStep is a compiled AnomalyDetection.
+type anomalyStep struct {
+	d     AnomalyDetection
+	flags []string
+}
+
+func newAnomalyStep(d AnomalyDetection) (*anomalyStep, error) {
+	if len(d.Columns) == 0 {
+		return nil, fmt.Errorf("anomaly detection needs at least one column")
+	}
+	switch d.Method {
+	case "":
+		d.Method = AnomalyZScore
+	case AnomalyZScore, AnomalyMAD:
+	default:
+		return nil, fmt.Errorf("unsupported anomaly detection method %q", d.Method)
+	}
+	if d.Window < 0 || d.Threshold < 0 {
+		return nil, fmt.Errorf("anomaly detection window and threshold must not be negative")
+	}
+	if d.Threshold == 0 {
+		d.Threshold = DefaultZScoreThreshold
+		if d.Method == AnomalyMAD {
+			d.Threshold = DefaultMADThreshold
+		}
+	}
+	if d.FlagSuffix == "" {
+		d.FlagSuffix = DefaultAnomalySuffix
+	}
+	s := &anomalyStep{d: d}
+	for i, column := range d.Columns {
+		for _, other := range d.Columns[:i] {
+			if column == other {
+				return nil, fmt.Errorf("column %s is listed twice", column)
+			}
+		}
+		s.flags = append(s.flags, column+d.FlagSuffix)
+	}
+	return s, nil
+}
+
+func (s *anomalyStep) columns(in []string) ([]string, error) {
+	named := s.d.Columns
+	if s.d.StationColumn != "" {
+		named = append(named[:len(named):len(named)], s.d.StationColumn)
+	}
+	if err := checkColumns(named, in); err != nil {
+		return nil, fmt.Errorf("anomaly detection: %v", err)
+	}
+	out := append([]string(nil), in...)
+	for _, f := range s.flags {
+		if checkColumns([]string{f}, in) == nil {
+			return nil, fmt.Errorf("anomaly detection: flag column %q already exists", f)
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+func (s *anomalyStep) reader(src rowReader, columns []string, opts Options, result *Result) rowReader {
+	r := &anomalyReader{src: src, step: s, result: result}
+	if columns != nil {
+		r.columns, _ = s.columns(columns)
+	}
+	return r
+}
+
+// anomalyReader flags the rows of src. Scores need the values after each
+// one, so every row is read before the first is returned.
+type anomalyReader struct {
+	src     rowReader
+	step    *anomalyStep
+	result  *Result
+	columns []string
+	rows    []*object
+	read    bool
+}
+
+func (r *anomalyReader) Columns() []string {
+	return r.columns
+}
+
+// Next returns the next row with its flags, or io.EOF.
+func (r *anomalyReader) Next() (*object, error) {
+	if !r.read {
+		if err := r.readAll(); err != nil {
+			return nil, err
+		}
+		r.read = true
+	}
+	if len(r.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := r.rows[0]
+	r.rows[0] = nil
+	r.rows = r.rows[1:]
+	return row, nil
+}
+
+// readAll reads and flags every row, and records the anomalies found in
+// input order.
+func (r *anomalyReader) readAll() error {
+	d := r.step.d
+	series := make(map[string][]int)
+	var stations []string
+	for {
+		row, err := r.src.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		name := ""
+		if d.StationColumn != "" {
+			if v, _ := row.get(d.StationColumn); v != nil {
+				name = fmt.Sprint(v)
+			}
+		}
+		if _, ok := series[name]; !ok {
+			stations = append(stations, name)
+		}
+		series[name] = append(series[name], len(r.rows))
+		r.rows = append(r.rows, row)
+	}
+
+	var found []Anomaly
+	values := make([]float64, 0, len(r.rows))
+	valid := make([]bool, 0, len(r.rows))
+	for c, column := range d.Columns {
+		flag := r.step.flags[c]
+		for _, name := range stations {
+			indices := series[name]
+			values, valid = values[:0], valid[:0]
+			for _, i := range indices {
+				v, _ := r.rows[i].get(column)
+				f, _, number := qcNumber(v)
+				values = append(values, f)
+				valid = append(valid, number)
+			}
+			scores := anomalyScores(values, valid, d.Method, d.Window)
+			for j, i := range indices {
+				row := r.rows[i]
+				score := scores[j]
+				if math.IsNaN(score) {
+					row.set(flag, nil)
+					continue
+				}
+				anomalous := score > d.Threshold
+				row.set(flag, anomalous)
+				if anomalous {
+					found = append(found, Anomaly{Index: i, Row: row.line, Station: name, Column: column, Value: values[j], Score: score})
+				}
+			}
+		}
+	}
+	sort.SliceStable(found, func(a, b int) bool { return found[a].Index < found[b].Index })
+	r.result.Anomalies = append(r.result.Anomalies, found...)
+	return nil
+}
+
+// anomalyScores scores each valid value of a series against the valid
+// values within window/2 positions of it, or the whole series for a zero
+// window. Invalid values and values with too few neighbours score NaN.
+func anomalyScores(values []float64, valid []bool, method string, window int) []float64 {
+	scores := make([]float64, len(values))
+	var whole []float64
+	if window == 0 {
+		for i, f := range values {
+			if valid[i] {
+				whole = append(whole, f)
+			}
+		}
+	}
+	center, spread := 0.0, 0.0
+	if window == 0 && len(whole) >= minAnomalyValues {
+		center, spread = anomalyBaseline(whole, method)
+	}
+	near := make([]float64, 0, window+1)
+	for i, f := range values {
+		scores[i] = math.NaN()
+		if !valid[i] {
+			continue
+		}
+		if window == 0 {
+			if len(whole) >= minAnomalyValues {
+				scores[i] = anomalyScore(f, center, spread)
+			}
+			continue
+		}
+		near = near[:0]
+		for j := max(0, i-window/2); j <= min(len(values)-1, i+window/2); j++ {
+			if valid[j] {
+				near = append(near, values[j])
+			}
+		}
+		if len(near) >= minAnomalyValues {
+			c, s := anomalyBaseline(near, method)
+			scores[i] = anomalyScore(f, c, s)
+		}
+	}
+	return scores
+}
+
+// anomalyBaseline returns the centre and spread of values: their mean and
+// standard deviation, or median and scaled median absolute deviation. It
+// reorders values.
+func anomalyBaseline(values []float64, method string) (center, spread float64) {
+	if method == AnomalyMAD {
+		center = median(values)
+		for i, f := range values {
+			values[i] = math.Abs(f - center)
+		}
+		return center, madScale * median(values)
+	}
+	for _, f := range values {
+		center += f
+	}
+	center /= float64(len(values))
+	for _, f := range values {
+		spread += (f - center) * (f - center)
+	}
+	return center, math.Sqrt(spread / float64(len(values)))
+}
+
+func anomalyScore(f, center, spread float64) float64 {
+	d := math.Abs(f - center)
+	if spread == 0 {
+		if d == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	return d / spread
+}
+
+// median returns the median of values, which it sorts.
+func median(values []float64) float64 {
+	sort.Float64s(values)
+	n := len(values)
+	if n%2 == 1 {
+		return values[n/2]
+	}
+	return (values[n/2-1] + values[n/2]) / 2
+}
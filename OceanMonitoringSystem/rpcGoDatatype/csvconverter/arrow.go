@@ -0,0 +1,432 @@
+package csvconverter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"rpcGoDatatype/expr"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+// arrowBatchSize is the number of rows in each record batch written to
+// Arrow and Parquet output, and read at a time from Parquet input.
+const arrowBatchSize = 4096
+
+// binaryFormats are the formats whose data is not text. Their input is
+// never transcoded and their output is returned in Result.Encoded.
+var binaryFormats = map[string]bool{
+	"arrow":   true,
+	"parquet": true,
+}
+
+func isBinaryFormat(format string) bool {
+	return binaryFormats[strings.ToLower(format)]
+}
+
+func newArrowReader(r io.Reader, opts Options, result *Result) (rowReader, error) {
+	records, err := ipc.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Arrow stream: %v", err)
+	}
+	return newRecordRows(records, "Arrow stream", opts, result)
+}
+
+func newArrowWriter(w io.Writer, opts Options) rowWriter {
+	return &recordRowWriter{opts: opts, open: func(schema *arrow.Schema) (recordSink, error) {
+		return arrowSink{ipc.NewWriter(w, ipc.WithSchema(schema))}, nil
+	}}
+}
+
+// arrowSink writes record batches as an Arrow IPC stream.
+type arrowSink struct {
+	w *ipc.Writer
+}
+
+func (s arrowSink) write(rec arrow.Record) error { return s.w.Write(rec) }
+
+func (s arrowSink) close() error { return s.w.Close() }
+
+// recordRows reads the rows of Arrow record batches, applying renames,
+// computed columns and the row filter like the CSV reader. Columns are
+// the schema's fields.
+type recordRows struct {
+	records  array.RecordReader
+	source   string
+	opts     Options
+	result   *Result
+	headers  []string
+	columns  []string
+	repeated map[string]bool
+	computed []computedColumn
+	filter   *expr.Expr
+
+	rec arrow.Record
+	i   int
+	n   int
+}
+
+func newRecordRows(records array.RecordReader, source string, opts Options, result *Result) (*recordRows, error) {
+	fields := records.Schema().Fields()
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = f.Name
+	}
+	c := &recordRows{records: records, source: source, opts: opts, result: result}
+	var err error
+	if c.headers, c.repeated, err = resolveHeaders(names, opts.DuplicateHeaders); err != nil {
+		return nil, err
+	}
+	if c.headers, err = renameHeaders(c.headers, opts.Rename); err != nil {
+		return nil, err
+	}
+	if c.computed, err = compileComputed(opts.ComputedColumns); err != nil {
+		return nil, err
+	}
+	if c.columns, err = computedHeaders(c.computed, c.headers); err != nil {
+		return nil, err
+	}
+	if c.filter, err = compileFilter(opts.Filter); err != nil {
+		return nil, err
+	}
+	if err := checkInputColumns(c.columns, c.filter, opts); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *recordRows) Columns() []string {
+	return c.columns
+}
+
+// Next returns the next row that converts and passes the filter, or io.EOF.
+func (c *recordRows) Next() (*object, error) {
+	for {
+		if c.rec == nil || int64(c.i) == c.rec.NumRows() {
+			// The reader owns each record until the next call to Next.
+			if !c.records.Next() {
+				if err := c.records.Err(); err != nil && err != io.EOF {
+					return nil, fmt.Errorf("error reading %s: %v", c.source, err)
+				}
+				return nil, io.EOF
+			}
+			c.rec, c.i = c.records.Record(), 0
+			continue
+		}
+		c.n++
+		c.result.Stats.RowsRead++
+		row, rowErr := c.row(c.rec, c.i)
+		c.i++
+		if rowErr == nil {
+			rowErr = compute(c.computed, row)
+		}
+		if rowErr == nil {
+			ok, err := keep(c.filter, row)
+			if err == nil && ok {
+				return row, nil
+			}
+			if err != nil {
+				rowErr = &RowError{Row: row.line, Reason: err.Error()}
+			}
+		}
+		if rowErr != nil {
+			if err := c.result.rowError(rowErr, c.opts); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// row builds the i-th row of rec. Rows are numbered from 1 across batches.
+func (c *recordRows) row(rec arrow.Record, i int) (*object, *RowError) {
+	item := newObjectSize(len(c.columns))
+	item.line = c.n
+	for j, col := range rec.Columns() {
+		value, err := arrowValue(col, i, c.opts)
+		if err != nil {
+			return nil, &RowError{Row: c.n, Column: c.headers[j], Reason: err.Error()}
+		}
+		name := c.headers[j]
+		if c.repeated[name] {
+			values, _ := item.get(name)
+			list, _ := values.([]interface{})
+			item.set(name, append(list, value))
+			continue
+		}
+		item.set(name, value)
+	}
+	return item, nil
+}
+
+// arrowValue returns the i-th value of arr as a row value. Numbers keep
+// their exact digits; types without a direct counterpart go through their
+// JSON form.
+func arrowValue(arr arrow.Array, i int, opts Options) (interface{}, error) {
+	if arr.IsNull(i) {
+		return nil, nil
+	}
+	switch a := arr.(type) {
+	case *array.Boolean:
+		return a.Value(i), nil
+	case *array.String:
+		return a.Value(i), nil
+	case *array.LargeString:
+		return a.Value(i), nil
+	case *array.Int8:
+		return json.Number(strconv.FormatInt(int64(a.Value(i)), 10)), nil
+	case *array.Int16:
+		return json.Number(strconv.FormatInt(int64(a.Value(i)), 10)), nil
+	case *array.Int32:
+		return json.Number(strconv.FormatInt(int64(a.Value(i)), 10)), nil
+	case *array.Int64:
+		return json.Number(strconv.FormatInt(a.Value(i), 10)), nil
+	case *array.Uint8:
+		return json.Number(strconv.FormatUint(uint64(a.Value(i)), 10)), nil
+	case *array.Uint16:
+		return json.Number(strconv.FormatUint(uint64(a.Value(i)), 10)), nil
+	case *array.Uint32:
+		return json.Number(strconv.FormatUint(uint64(a.Value(i)), 10)), nil
+	case *array.Uint64:
+		return json.Number(strconv.FormatUint(a.Value(i), 10)), nil
+	case *array.Float32:
+		return floatValue(float64(a.Value(i)), 32, opts), nil
+	case *array.Float64:
+		return floatValue(a.Value(i), 64, opts), nil
+	case *array.Timestamp:
+		unit := a.DataType().(*arrow.TimestampType).Unit
+		return a.Value(i).ToTime(unit).UTC().Format(time.RFC3339Nano), nil
+	case *array.Date32:
+		return a.Value(i).ToTime().Format(time.DateOnly), nil
+	case *array.Date64:
+		return a.Value(i).ToTime().Format(time.DateOnly), nil
+	}
+	data, err := json.Marshal(arr.GetOneForMarshal(i))
+	if err != nil {
+		return nil, fmt.Errorf("unsupported %s value: %v", arr.DataType(), err)
+	}
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+	return decodeValue(decoder)
+}
+
+// floatValue returns a float as a JSON number in plain decimal notation.
+// NaN and infinities, which JSON lacks, become text unless
+// Options.NonFiniteAs asks for null, as they do in CSV input.
+func floatValue(f float64, bits int, opts Options) interface{} {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		if opts.NonFiniteAs == NonFiniteNull {
+			return nil
+		}
+		return strconv.FormatFloat(f, 'g', -1, bits)
+	}
+	return json.Number(strconv.FormatFloat(f, 'f', -1, bits))
+}
+
+// recordSink receives the record batches of Arrow or Parquet output.
+type recordSink interface {
+	write(rec arrow.Record) error
+	close() error
+}
+
+// recordRowWriter collects rows into Arrow record batches of
+// arrowBatchSize rows. The columns are the keys of the first row, as for
+// CSV output, and their types are inferred from the first batch: a column
+// of whole numbers becomes int64, other numbers float64, booleans bool and
+// anything else text, with nested values as JSON. Empty strings do not
+// count, and NaN and infinities count as numbers. Options.ColumnTypes
+// overrides the inference. Later rows must fit those types.
+type recordRowWriter struct {
+	opts    Options
+	open    func(*arrow.Schema) (recordSink, error)
+	sink    recordSink
+	columns []string
+	builder *array.RecordBuilder
+	rows    []*object
+	// written counts the rows already in record batches.
+	written int
+}
+
+func (w *recordRowWriter) Write(row *object) error {
+	if w.columns == nil {
+		w.columns = row.keys
+	}
+	w.rows = append(w.rows, row)
+	if len(w.rows) == arrowBatchSize {
+		return w.flush()
+	}
+	return nil
+}
+
+// flush writes the collected rows as a record batch, starting the output
+// with the first.
+func (w *recordRowWriter) flush() error {
+	if w.builder == nil {
+		schema := inferSchema(w.columns, w.rows, w.opts)
+		sink, err := w.open(schema)
+		if err != nil {
+			return err
+		}
+		w.sink = sink
+		w.builder = array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	}
+	if len(w.rows) == 0 {
+		return nil
+	}
+	w.builder.Reserve(len(w.rows))
+	for i, row := range w.rows {
+		for j, column := range w.columns {
+			value, _ := row.get(column)
+			if err := appendValue(w.builder.Field(j), value); err != nil {
+				return fmt.Errorf("error writing row %d: column %q: %v", w.written+i+1, column, err)
+			}
+		}
+	}
+	rec := w.builder.NewRecord()
+	defer rec.Release()
+	if err := w.sink.write(rec); err != nil {
+		return fmt.Errorf("error writing record batch: %v", err)
+	}
+	w.written += len(w.rows)
+	clear(w.rows)
+	w.rows = w.rows[:0]
+	return nil
+}
+
+func (w *recordRowWriter) Close() error {
+	if err := w.flush(); err != nil {
+		return err
+	}
+	w.builder.Release()
+	if err := w.sink.close(); err != nil {
+		return fmt.Errorf("error closing output: %v", err)
+	}
+	return nil
+}
+
+// inferSchema returns the schema for columns from the values of rows.
+func inferSchema(columns []string, rows []*object, opts Options) *arrow.Schema {
+	fields := make([]arrow.Field, len(columns))
+	for i, column := range columns {
+		fields[i] = arrow.Field{Name: column, Type: inferType(column, rows, opts), Nullable: true}
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+func inferType(column string, rows []*object, opts Options) arrow.DataType {
+	switch opts.ColumnTypes[column] {
+	case TypeString, TypeTimestamp:
+		return arrow.BinaryTypes.String
+	case TypeNumber:
+		return arrow.PrimitiveTypes.Float64
+	case TypeBoolean:
+		return arrow.FixedWidthTypes.Boolean
+	}
+	var numbers, floats, bools, others bool
+	for _, row := range rows {
+		switch v := row.values[column].(type) {
+		case nil:
+		case bool:
+			bools = true
+		case json.Number:
+			numbers = true
+			if _, err := strconv.ParseInt(string(v), 10, 64); err != nil {
+				floats = true
+			}
+		case string:
+			// Empty cells are null and NaN or infinities numbers.
+			switch {
+			case v == "":
+			case isNonFinite(v):
+				numbers, floats = true, true
+			default:
+				others = true
+			}
+		default:
+			others = true
+		}
+	}
+	switch {
+	case others || numbers && bools:
+		return arrow.BinaryTypes.String
+	case floats:
+		return arrow.PrimitiveTypes.Float64
+	case numbers:
+		return arrow.PrimitiveTypes.Int64
+	case bools:
+		return arrow.FixedWidthTypes.Boolean
+	}
+	return arrow.BinaryTypes.String
+}
+
+// appendValue appends a row value to the builder of its column. Empty
+// strings are null outside text columns.
+func appendValue(b array.Builder, value interface{}) error {
+	if value == nil || value == "" && b.Type().ID() != arrow.STRING {
+		b.AppendNull()
+		return nil
+	}
+	switch b := b.(type) {
+	case *array.StringBuilder:
+		text, err := valueText(value)
+		if err != nil {
+			return err
+		}
+		b.Append(text)
+		return nil
+	case *array.Int64Builder:
+		if n, ok := value.(json.Number); ok {
+			if i, err := strconv.ParseInt(string(n), 10, 64); err == nil {
+				b.Append(i)
+				return nil
+			}
+		}
+		return fmt.Errorf("value %v is not an integer", value)
+	case *array.Float64Builder:
+		switch v := value.(type) {
+		case json.Number:
+			if f, err := v.Float64(); err == nil {
+				b.Append(f)
+				return nil
+			}
+		case string:
+			if isNonFinite(v) {
+				f, _ := strconv.ParseFloat(v, 64)
+				b.Append(f)
+				return nil
+			}
+		}
+		return fmt.Errorf("value %v is not a number", value)
+	case *array.BooleanBuilder:
+		if v, ok := value.(bool); ok {
+			b.Append(v)
+			return nil
+		}
+		return fmt.Errorf("value %v is not a boolean", value)
+	}
+	return fmt.Errorf("unsupported column type %s", b.Type())
+}
+
+// valueText returns a value of a text column: strings and numbers as they
+// are, and booleans and nested values as JSON.
+func valueText(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return string(v), nil
+	}
+	e := getEncoder()
+	defer putEncoder(e)
+	if err := e.value(value); err != nil {
+		return "", err
+	}
+	return e.buf.String(), nil
+}
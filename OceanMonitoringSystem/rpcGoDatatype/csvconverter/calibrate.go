@@ -0,0 +1,205 @@
+package csvconverter
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CalibrationLookup returns the calibration polynomial of the sensor of a
+// station reporting in column, as in effect at a time. A zero time asks for
+// the latest calibration.
+type CalibrationLookup func(station, column string, at time.Time) ([]float64, bool)
+
+// Calibration turns raw sensor output, such as counts or voltages, into
+// engineering units with polynomials c[0] + c[1]·v + c[2]·v² + … of the raw
+// value v. Null and empty values are left alone; values that are not
+// numbers, or that have no polynomial, are row errors.
+type Calibration struct {
+	// Coefficients maps columns to the polynomials applied to every row.
+	Coefficients map[string][]float64
+	// StationColumn looks the polynomials of Columns up for each row's
+	// station, see Lookup. Coefficients of the same column take precedence.
+	StationColumn string
+	// TimeColumn picks the calibration in effect at each row's time rather
+	// than the latest.
+	TimeColumn string
+	// Columns are the columns calibrated with Lookup.
+	Columns []string
+	// Suffix writes the calibrated values to new columns named after the
+	// raw columns with Suffix appended, keeping the raw values.
+	Suffix string
+	// Lookup finds the calibrations of the station sensors. The server sets
+	// it to its station registry.
+	Lookup CalibrationLookup
+}
+
+// calibrateStep is a compiled Calibration.
+type calibrateStep struct {
+	c Calibration
+}
+
+func newCalibrateStep(c Calibration) (*calibrateStep, error) {
+	for column, coefficients := range c.Coefficients {
+		if len(coefficients) == 0 {
+			return nil, fmt.Errorf("calibration of %s has no coefficients", column)
+		}
+		for _, f := range coefficients {
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return nil, fmt.Errorf("calibration coefficients of %s must be finite", column)
+			}
+		}
+	}
+	if len(c.Columns) > 0 {
+		if c.StationColumn == "" {
+			return nil, fmt.Errorf("calibrating columns from the registry needs a station column")
+		}
+		if c.Lookup == nil {
+			return nil, fmt.Errorf("calibration needs a station registry")
+		}
+	} else if len(c.Coefficients) == 0 {
+		return nil, fmt.Errorf("calibration needs coefficients or registry columns")
+	}
+	return &calibrateStep{c: c}, nil
+}
+
+// calibrated returns the columns of in that the step calibrates.
+func (s *calibrateStep) calibrated(in []string) []string {
+	var named []string
+	for _, column := range in {
+		if _, ok := s.c.Coefficients[column]; ok || hasColumn(s.c.Columns, column) {
+			named = append(named, column)
+		}
+	}
+	return named
+}
+
+func (s *calibrateStep) columns(in []string) ([]string, error) {
+	named := s.calibrated(in)
+	var required []string
+	for column := range s.c.Coefficients {
+		required = append(required, column)
+	}
+	sort.Strings(required)
+	required = append(required, s.c.Columns...)
+	if len(s.c.Columns) > 0 {
+		required = append(required, s.c.StationColumn)
+		if s.c.TimeColumn != "" {
+			required = append(required, s.c.TimeColumn)
+		}
+	}
+	if err := checkColumns(required, in); err != nil {
+		return nil, fmt.Errorf("calibration: %v", err)
+	}
+	if s.c.Suffix == "" {
+		return in, nil
+	}
+	out := append([]string(nil), in...)
+	for _, column := range named {
+		if hasColumn(out, column+s.c.Suffix) {
+			return nil, fmt.Errorf("calibration: column %q already exists", column+s.c.Suffix)
+		}
+		out = append(out, column+s.c.Suffix)
+	}
+	return out, nil
+}
+
+func (s *calibrateStep) reader(src rowReader, columns []string, opts Options, result *Result) rowReader {
+	r := &calibrateReader{src: src, step: s, opts: opts, result: result}
+	if columns != nil {
+		r.columns, _ = s.columns(columns)
+		r.named = s.calibrated(columns)
+	}
+	return r
+}
+
+// calibrateReader calibrates the rows of src. It reads the time column
+// with the conversion options.
+type calibrateReader struct {
+	src     rowReader
+	step    *calibrateStep
+	opts    Options
+	result  *Result
+	columns []string
+	// named are the calibrated columns, when the columns are known ahead.
+	named []string
+}
+
+func (r *calibrateReader) Columns() []string {
+	return r.columns
+}
+
+// Next returns the next calibrated row, or io.EOF.
+func (r *calibrateReader) Next() (*object, error) {
+	for {
+		row, err := r.src.Next()
+		if err != nil {
+			return nil, err
+		}
+		if err := r.calibrate(row); err != nil {
+			if err := r.result.rowError(err, r.opts); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		return row, nil
+	}
+}
+
+func (r *calibrateReader) calibrate(row *object) *RowError {
+	c := r.step.c
+	named := r.named
+	if r.columns == nil {
+		named = r.step.calibrated(row.keys)
+	}
+	var (
+		station string
+		at      time.Time
+	)
+	if len(c.Columns) > 0 {
+		if v, _ := row.get(c.StationColumn); v != nil {
+			station = strings.TrimSpace(fmt.Sprint(v))
+		}
+		if c.TimeColumn != "" {
+			v, _ := row.get(c.TimeColumn)
+			var ok bool
+			if at, ok = timeValue(v, r.opts); !ok {
+				return &RowError{Row: row.line, Column: c.TimeColumn, Reason: fmt.Sprintf("value %v is not a valid time", v)}
+			}
+		}
+	}
+	for _, column := range named {
+		v, _ := row.get(column)
+		f, present, number := qcNumber(v)
+		out := column + c.Suffix
+		if !present {
+			if c.Suffix != "" {
+				row.set(out, nil)
+			}
+			continue
+		}
+		if !number {
+			return &RowError{Row: row.line, Column: column, Reason: fmt.Sprintf("value %v is not a number", v)}
+		}
+		coefficients, ok := c.Coefficients[column]
+		if !ok && station != "" {
+			coefficients, ok = c.Lookup(station, column, at)
+		}
+		if !ok {
+			return &RowError{Row: row.line, Column: column, Reason: fmt.Sprintf("no calibration of %s for station %q", column, station)}
+		}
+		row.set(out, finiteNumber(polynomial(coefficients, f)))
+	}
+	return nil
+}
+
+// polynomial evaluates c[0] + c[1]·x + c[2]·x² + … by Horner's method.
+func polynomial(c []float64, x float64) float64 {
+	var y float64
+	for i := len(c) - 1; i >= 0; i-- {
+		y = y*x + c[i]
+	}
+	return y
+}
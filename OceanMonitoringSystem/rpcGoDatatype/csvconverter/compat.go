@@ -0,0 +1,198 @@
+package csvconverter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Compatibility levels reported by CompatibilityMatrix. Unverified
+// conversions are supported but cannot be checked by a round trip, for lack
+// of samples or of a converter back.
+const (
+	Lossless    = "lossless"
+	Lossy       = "lossy"
+	Unverified  = "unverified"
+	Unsupported = "unsupported"
+)
+
+// Compatibility describes how well data survives a conversion between two formats.
+type Compatibility struct {
+	From   string
+	To     string
+	Level  string
+	Reason string
+}
+
+type corpusCase struct {
+	description string
+	data        string
+	// via is the format data is written in, when it is not the source
+	// format itself. Binary samples are converted from text this way.
+	via string
+}
+
+// sample returns the payload of tc in format.
+func (tc corpusCase) sample(format string) (string, error) {
+	if tc.via == "" {
+		return tc.data, nil
+	}
+	result, err := Convert(tc.via, format, tc.data, Options{})
+	if err != nil {
+		return "", err
+	}
+	return result.Output, nil
+}
+
+// roundTripCorpus holds, per source format, the payloads that are converted
+// to every other format and back to decide whether a conversion is lossless.
+var roundTripCorpus = map[string][]corpusCase{
+	"csv": {
+		{description: "plain text and decimal values", data: "station,temp\nB12,4.5\n"},
+		{description: "numbers with leading zeros", data: "id,temp\n0012,4.5\n"},
+		{description: "quoted separators", data: "name,note\n\"Buoy, north\",ok\n"},
+		{description: "empty cells", data: "station,temp\nB12,\n"},
+	},
+	"json": {
+		{description: "flat objects", data: `[{"station":"B12","temp":4.5}]`},
+		{description: "numeric strings", data: `[{"id":"0012"}]`},
+		{description: "booleans", data: `[{"ok":true}]`},
+		{description: "null values", data: `[{"temp":null}]`},
+		{description: "nested objects", data: `[{"pos":{"lat":41.1,"lon":-8.6}}]`},
+		{description: "objects with differing keys", data: `[{"a":1},{"b":2}]`},
+	},
+	"arrow": {
+		{description: "text and decimal columns", data: `[{"station":"B12","temp":4.5}]`, via: "json"},
+		{description: "numeric strings", data: `[{"id":"0012"}]`, via: "json"},
+		{description: "booleans", data: `[{"ok":true}]`, via: "json"},
+		{description: "null values", data: `[{"temp":4.5},{"temp":null}]`, via: "json"},
+	},
+	"parquet": {
+		{description: "text and decimal columns", data: `[{"station":"B12","temp":4.5}]`, via: "json"},
+		{description: "numeric strings", data: `[{"id":"0012"}]`, via: "json"},
+		{description: "booleans", data: `[{"ok":true}]`, via: "json"},
+		{description: "null values", data: `[{"temp":4.5},{"temp":null}]`, via: "json"},
+	},
+}
+
+var (
+	matrixOnce sync.Once
+	matrix     []Compatibility
+)
+
+// CompatibilityMatrix returns the compatibility of every pair of known
+// formats. The result is computed once by running the round-trip corpus.
+func CompatibilityMatrix() []Compatibility {
+	matrixOnce.Do(func() {
+		formats := Formats()
+		for _, from := range formats {
+			for _, to := range formats {
+				if from == to {
+					continue
+				}
+				matrix = append(matrix, checkCompatibility(from, to))
+			}
+		}
+	})
+	return matrix
+}
+
+func checkCompatibility(from, to string) Compatibility {
+	c := Compatibility{From: from, To: to}
+	switch {
+	case !Supported(from, to):
+		c.Level = Unsupported
+		c.Reason = "no converter from " + from + " to " + to
+		return c
+	case !Supported(to, from):
+		c.Level = Unverified
+		c.Reason = "no converter from " + to + " back to " + from + " to verify a round trip"
+		return c
+	}
+
+	if len(roundTripCorpus[from]) == 0 {
+		c.Level = Unverified
+		c.Reason = "no round-trip samples for " + from + " to verify a round trip"
+		return c
+	}
+
+	var lost []string
+	for _, tc := range roundTripCorpus[from] {
+		data, err := tc.sample(from)
+		if err != nil || !roundTrips(from, to, data) {
+			lost = append(lost, tc.description)
+		}
+	}
+	if len(lost) == 0 {
+		c.Level = Lossless
+		c.Reason = "all round-trip samples preserved"
+		return c
+	}
+	c.Level = Lossy
+	c.Reason = "round trip changes " + strings.Join(lost, ", ")
+	return c
+}
+
+func roundTrips(from, to, data string) bool {
+	converted, err := Convert(from, to, data, Options{})
+	if err != nil {
+		return false
+	}
+	back, err := Convert(to, from, converted.Output, Options{})
+	if err != nil {
+		return false
+	}
+	want, err := canonical(from, data)
+	if err != nil {
+		return false
+	}
+	got, err := canonical(from, back.Output)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(want, got)
+}
+
+// canonical decodes data into a form where semantically equal payloads
+// compare equal. CSV column order is not considered significant. Binary
+// formats compare as their JSON conversion.
+func canonical(format, data string) (interface{}, error) {
+	if isBinaryFormat(format) {
+		result, err := Convert(format, "json", data, Options{})
+		if err != nil {
+			return nil, err
+		}
+		format, data = "json", result.Output
+	}
+	switch format {
+	case "json":
+		var v interface{}
+		err := json.Unmarshal([]byte(data), &v)
+		return v, err
+	case "csv":
+		records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+		if err != nil || len(records) == 0 {
+			return nil, err
+		}
+		order := make([]int, len(records[0]))
+		for i := range order {
+			order[i] = i
+		}
+		sort.Slice(order, func(a, b int) bool { return records[0][order[a]] < records[0][order[b]] })
+		rows := make([][]string, len(records))
+		for r, record := range records {
+			row := make([]string, len(order))
+			for i, idx := range order {
+				if idx < len(record) {
+					row[i] = record[idx]
+				}
+			}
+			rows[r] = row
+		}
+		return rows, nil
+	}
+	return data, nil
+}
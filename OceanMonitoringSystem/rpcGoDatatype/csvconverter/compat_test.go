@@ -0,0 +1,94 @@
+package csvconverter
+
+import "testing"
+
+// wantCompatibility is the maintained compatibility matrix. A converter
+// change that alters a round trip fails TestCompatibilityMatrix until the
+// matrix is updated here.
+var wantCompatibility = map[[2]string]string{
+	{"arrow", "csv"}:        Lossy,
+	{"arrow", "influx"}:     Unverified,
+	{"arrow", "json"}:       Lossless,
+	{"arrow", "parquet"}:    Lossless,
+	{"arrow", "template"}:   Unverified,
+	{"csv", "arrow"}:        Lossy,
+	{"csv", "influx"}:       Unverified,
+	{"csv", "json"}:         Lossy,
+	{"csv", "parquet"}:      Lossy,
+	{"csv", "template"}:     Unverified,
+	{"influx", "arrow"}:     Unsupported,
+	{"influx", "csv"}:       Unsupported,
+	{"influx", "json"}:      Unsupported,
+	{"influx", "parquet"}:   Unsupported,
+	{"influx", "template"}:  Unsupported,
+	{"json", "arrow"}:       Lossy,
+	{"json", "csv"}:         Lossy,
+	{"json", "influx"}:      Unverified,
+	{"json", "parquet"}:     Lossy,
+	{"json", "template"}:    Unverified,
+	{"parquet", "arrow"}:    Lossless,
+	{"parquet", "csv"}:      Lossy,
+	{"parquet", "influx"}:   Unverified,
+	{"parquet", "json"}:     Lossless,
+	{"parquet", "template"}: Unverified,
+	{"template", "arrow"}:   Unsupported,
+	{"template", "csv"}:     Unsupported,
+	{"template", "influx"}:  Unsupported,
+	{"template", "json"}:    Unsupported,
+	{"template", "parquet"}: Unsupported,
+}
+
+func TestCompatibilityMatrix(t *testing.T) {
+	matrix := CompatibilityMatrix()
+	if len(matrix) != len(wantCompatibility) {
+		t.Errorf("matrix has %d entries, want %d", len(matrix), len(wantCompatibility))
+	}
+	for _, c := range matrix {
+		want, ok := wantCompatibility[[2]string{c.From, c.To}]
+		if !ok {
+			t.Errorf("%s to %s: unexpected entry (%s: %s)", c.From, c.To, c.Level, c.Reason)
+			continue
+		}
+		if c.Level != want {
+			t.Errorf("%s to %s: level %s (%s), want %s", c.From, c.To, c.Level, c.Reason, want)
+		}
+	}
+}
+
+// TestRoundTripCorpus checks that every format that can be converted both
+// ways has samples, so that none of its conversions is left unverified.
+func TestRoundTripCorpus(t *testing.T) {
+	for _, from := range Formats() {
+		if !Supported(from, "json") || !Supported("json", from) {
+			continue
+		}
+		if len(roundTripCorpus[from]) == 0 {
+			t.Errorf("%s: no round-trip samples", from)
+		}
+	}
+	for from, cases := range roundTripCorpus {
+		for _, tc := range cases {
+			if _, err := tc.sample(from); err != nil {
+				t.Errorf("%s %s: error building sample: %v", from, tc.description, err)
+			}
+		}
+	}
+}
+
+func TestRoundTrips(t *testing.T) {
+	tests := []struct {
+		from, to, data string
+		want           bool
+	}{
+		{"csv", "json", "station,temp\nB12,4.5\n", true},
+		{"csv", "json", "id,temp\n0012,4.5\n", false},
+		{"json", "csv", `[{"station":"B12","temp":4.5}]`, true},
+		{"json", "csv", `[{"ok":true}]`, false},
+		{"json", "csv", `[{"pos":{"lat":41.1}}]`, false},
+	}
+	for _, tt := range tests {
+		if got := roundTrips(tt.from, tt.to, tt.data); got != tt.want {
+			t.Errorf("roundTrips(%s, %s, %q) = %v, want %v", tt.from, tt.to, tt.data, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package csvconverter
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"rpcGoDatatype/expr"
+)
+
+// computedColumn is a compiled "name = expression" definition.
+type computedColumn struct {
+	name string
+	expr *expr.Expr
+}
+
+// parseComputed compiles a computed column definition such as
+// "temp_f = temp_c * 1.8 + 32".
+func parseComputed(spec string) (computedColumn, error) {
+	eq := -1
+	for i := 0; i < len(spec); i++ {
+		if spec[i] != '=' {
+			continue
+		}
+		if i+1 < len(spec) && spec[i+1] == '=' || i > 0 && strings.ContainsRune("=!<>", rune(spec[i-1])) {
+			continue
+		}
+		eq = i
+		break
+	}
+	if eq < 0 {
+		return computedColumn{}, fmt.Errorf("computed column %q: expected name = expression", spec)
+	}
+	name := strings.Trim(strings.TrimSpace(spec[:eq]), "`")
+	if name == "" {
+		return computedColumn{}, fmt.Errorf("computed column %q: missing name", spec)
+	}
+	e, err := expr.Compile(spec[eq+1:])
+	if err != nil {
+		return computedColumn{}, fmt.Errorf("computed column %s: %v", name, err)
+	}
+	return computedColumn{name: name, expr: e}, nil
+}
+
+// compileComputed compiles Options.ComputedColumns.
+func compileComputed(specs []string) ([]computedColumn, error) {
+	var columns []computedColumn
+	for _, spec := range specs {
+		c, err := parseComputed(spec)
+		if err != nil {
+			return nil, optionError("ComputedColumns", err)
+		}
+		columns = append(columns, c)
+	}
+	return columns, nil
+}
+
+// computedHeaders checks that computed columns only refer to known columns,
+// including earlier computed ones, and returns the headers with the
+// computed columns added.
+func computedHeaders(computed []computedColumn, headers []string) ([]string, error) {
+	if len(computed) == 0 {
+		return headers, nil
+	}
+	out := append([]string(nil), headers...)
+	known := make(map[string]bool, len(out))
+	for _, h := range out {
+		known[h] = true
+	}
+	for _, c := range computed {
+		if err := checkColumns(c.expr.Columns(), out); err != nil {
+			return nil, fmt.Errorf("computed column %s: %v", c.name, err)
+		}
+		if !known[c.name] {
+			known[c.name] = true
+			out = append(out, c.name)
+		}
+	}
+	return out, nil
+}
+
+// compute evaluates the computed columns for a row in order, replacing
+// existing columns of the same name. Missing columns evaluate as null.
+func compute(computed []computedColumn, row *object) *RowError {
+	for _, c := range computed {
+		v, err := c.expr.Eval(func(name string) (interface{}, bool) {
+			v, _ := row.get(name)
+			return v, true
+		})
+		if err != nil {
+			return &RowError{Row: row.line, Column: c.name, Reason: err.Error()}
+		}
+		if f, ok := v.(float64); ok {
+			v = floatNumber(f)
+		}
+		row.set(c.name, v)
+	}
+	return nil
+}
+
+// floatNumber formats a computed number. Fractions are rounded to 12
+// significant digits to hide floating-point noise such as 67.99999999999997;
+// integers, like epoch milliseconds, are kept exact.
+func floatNumber(f float64) json.Number {
+	if f != math.Trunc(f) {
+		f, _ = strconv.ParseFloat(strconv.FormatFloat(f, 'g', 12, 64), 64)
+	}
+	if f == 0 {
+		f = 0 // drop the sign of -0
+	}
+	return json.Number(strconv.FormatFloat(f, 'f', -1, 64))
+}
@@ -0,0 +1,340 @@
+package csvconverter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Result is the output of a conversion together with the warnings raised
+// while producing it.
+type Result struct {
+	Output   string
+	Warnings []string
+	// DuplicatesRemoved counts rows dropped by deduplication.
+	DuplicatesRemoved int
+	// Preamble holds the lines skipped before the CSV header and Metadata
+	// the key/value pairs found in them, when Options.CaptureMetadata is set.
+	Preamble []string
+	Metadata map[string]string
+	// Encoded holds Output in Options.OutputEncoding when that is not UTF-8,
+	// and the bytes of binary output such as Arrow and Parquet.
+	Encoded []byte
+	// RowErrors lists the rows dropped under Options.SkipInvalidRows.
+	RowErrors []RowError
+	// Anomalies lists the values flagged by anomaly detection steps.
+	Anomalies []Anomaly
+	Stats     Stats
+}
+
+// rowReader yields the rows of an input one at a time. Next returns io.EOF
+// after the last row. Columns returns the column names when the format has
+// a fixed column set, and nil otherwise.
+type rowReader interface {
+	Next() (*object, error)
+	Columns() []string
+}
+
+// rowWriter writes rows in an output format. Close completes the output.
+type rowWriter interface {
+	Write(row *object) error
+	Close() error
+}
+
+type readerFunc func(r io.Reader, opts Options, result *Result) (rowReader, error)
+
+type writerFunc func(w io.Writer, opts Options) rowWriter
+
+var readers = map[string]readerFunc{
+	"csv":     newCSVReader,
+	"json":    newJSONReader,
+	"arrow":   newArrowReader,
+	"parquet": newParquetReader,
+}
+
+var writers = map[string]writerFunc{
+	"csv":      newCSVWriter,
+	"json":     newJSONWriter,
+	"template": newTemplateWriter,
+	"influx":   newInfluxWriter,
+	"arrow":    newArrowWriter,
+	"parquet":  newParquetWriter,
+}
+
+type conversion struct {
+	from, to string
+}
+
+var converters = map[conversion]bool{
+	{from: "csv", to: "json"}:      true,
+	{from: "json", to: "csv"}:      true,
+	{from: "csv", to: "template"}:  true,
+	{from: "json", to: "template"}: true,
+	{from: "csv", to: "influx"}:    true,
+	{from: "json", to: "influx"}:   true,
+
+	{from: "csv", to: "arrow"}:        true,
+	{from: "json", to: "arrow"}:       true,
+	{from: "parquet", to: "arrow"}:    true,
+	{from: "csv", to: "parquet"}:      true,
+	{from: "json", to: "parquet"}:     true,
+	{from: "arrow", to: "parquet"}:    true,
+	{from: "arrow", to: "csv"}:        true,
+	{from: "arrow", to: "json"}:       true,
+	{from: "arrow", to: "template"}:   true,
+	{from: "arrow", to: "influx"}:     true,
+	{from: "parquet", to: "csv"}:      true,
+	{from: "parquet", to: "json"}:     true,
+	{from: "parquet", to: "template"}: true,
+	{from: "parquet", to: "influx"}:   true,
+}
+
+// ConverterFunc converts a whole payload between two formats.
+type ConverterFunc func(data string, opts Options) (*Result, error)
+
+var (
+	externalMu sync.RWMutex
+	external   = make(map[conversion]ConverterFunc)
+)
+
+// Register adds a converter for a conversion the package does not support
+// itself, such as an institution-specific format. Register is meant to be
+// called at startup; it fails if the conversion is already supported.
+func Register(from, to string, fn ConverterFunc) error {
+	c := conversion{from: strings.ToLower(from), to: strings.ToLower(to)}
+	if c.from == "" || c.to == "" {
+		return fmt.Errorf("converter needs a from and a to format")
+	}
+	if Supported(c.from, c.to) {
+		return fmt.Errorf("conversion from %s to %s is already supported", from, to)
+	}
+	externalMu.Lock()
+	defer externalMu.Unlock()
+	external[c] = fn
+	return nil
+}
+
+func externalConverter(from, to string) (ConverterFunc, bool) {
+	externalMu.RLock()
+	defer externalMu.RUnlock()
+	fn, ok := external[conversion{from: strings.ToLower(from), to: strings.ToLower(to)}]
+	return fn, ok
+}
+
+// Convert converts data between two formats. Format names are case-insensitive.
+func Convert(from, to, data string, opts Options) (*Result, error) {
+	return ConvertContext(context.Background(), from, to, data, opts)
+}
+
+// ConvertContext converts like Convert, tracing the conversion under ctx.
+// The conversion stops with ctx.Err() once ctx is done.
+func ConvertContext(ctx context.Context, from, to, data string, opts Options) (*Result, error) {
+	return convert(ctx, from, to, data, opts, nil)
+}
+
+// convert converts data, running steps on each row.
+func convert(ctx context.Context, from, to, data string, opts Options, steps []rowStep) (*Result, error) {
+	// data is already text.
+	return convertInput(ctx, from, to, strings.NewReader(data), int64(len(data)), "", opts, steps)
+}
+
+// convertInput converts the size bytes read from r, which are in the named
+// encoding, into a Result holding the whole output.
+func convertInput(ctx context.Context, from, to string, r io.Reader, size int64, encoding string, opts Options, steps []rowStep) (result *Result, err error) {
+	ctx, span := tracer.Start(ctx, "csvconverter.Convert", trace.WithAttributes(
+		attribute.String("csvconverter.from", from),
+		attribute.String("csvconverter.to", to),
+		attribute.Int64("csvconverter.input_bytes", size),
+	))
+	defer func() { endSpan(span, err) }()
+
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if err := opts.checkSize(size); err != nil {
+		return nil, err
+	}
+	// The output is encoded once complete.
+	textOpts := opts
+	textOpts.InputEncoding = encoding
+	textOpts.OutputEncoding = ""
+
+	var out strings.Builder
+	result, err = convertStream(ctx, from, to, r, &out, textOpts, steps)
+	if err != nil {
+		return nil, err
+	}
+	result.Output = out.String()
+	if isBinaryFormat(to) {
+		result.Encoded = []byte(result.Output)
+	} else if opts.Mode != ModeAudit && !isUTF8(opts.OutputEncoding) {
+		_, encode := tracer.Start(ctx, "csvconverter.encode_output")
+		result.Encoded, err = Encode(result.Output, opts.OutputEncoding)
+		endSpan(encode, err)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// readAll reads every row of data.
+func readAll(read readerFunc, data string, opts Options, result *Result) ([]*object, []string, error) {
+	if err := opts.checkSize(int64(len(data))); err != nil {
+		return nil, nil, err
+	}
+	src, err := read(strings.NewReader(strings.TrimPrefix(data, byteOrderMark)), opts, result)
+	if err != nil {
+		return nil, nil, err
+	}
+	if src, err = rangeReader(src, opts, result); err != nil {
+		return nil, nil, err
+	}
+	if src, err = unitReader(src, opts, result); err != nil {
+		return nil, nil, err
+	}
+	rows := []*object{}
+	for {
+		row, err := src.Next()
+		if err == io.EOF {
+			return rows, src.Columns(), nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := opts.checkRow(len(rows)+1, row); err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+}
+
+// readRows reads every row of data and checks the rows against
+// Options.Schema.
+func readRows(read readerFunc, data string, opts Options, result *Result) ([]*object, []string, error) {
+	rows, columns, err := readAll(read, data, opts, result)
+	if err != nil {
+		return nil, nil, err
+	}
+	check, err := newSchemaCheck(opts, result)
+	if err != nil {
+		return nil, nil, err
+	}
+	kept := rows[:0]
+	for _, row := range rows {
+		ok, err := check.keep(row)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			kept = append(kept, row)
+		}
+	}
+	return kept, columns, nil
+}
+
+// encodeResult writes rows to result in the given format and output encoding.
+// Nothing is written in audit mode.
+func encodeResult(result *Result, rows []*object, to string, opts Options) error {
+	if opts.Mode == ModeAudit {
+		return nil
+	}
+	var out strings.Builder
+	dst := writers[strings.ToLower(to)](&out, opts)
+	for _, row := range rows {
+		if err := dst.Write(row); err != nil {
+			return err
+		}
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	result.Output = out.String()
+	if isBinaryFormat(to) {
+		result.Encoded = []byte(result.Output)
+	} else if !isUTF8(opts.OutputEncoding) {
+		var err error
+		if result.Encoded, err = Encode(result.Output, opts.OutputEncoding); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConvertBytes decodes data from Options.InputEncoding, detecting it when
+// unset, and converts it like Convert. Binary input such as Arrow and
+// Parquet is converted as it is. data is read in place rather than copied,
+// so it may be a memory-mapped file.
+func ConvertBytes(from, to string, data []byte, opts Options) (*Result, error) {
+	return ConvertBytesContext(context.Background(), from, to, data, opts)
+}
+
+// ConvertBytesContext converts like ConvertBytes, tracing the conversion
+// under ctx.
+func ConvertBytesContext(ctx context.Context, from, to string, data []byte, opts Options) (*Result, error) {
+	if err := opts.checkSize(int64(len(data))); err != nil {
+		return nil, err
+	}
+	name := ""
+	if !isBinaryFormat(from) {
+		if name = opts.InputEncoding; name == "" {
+			name = DetectEncoding(data)
+		}
+		if _, err := lookupEncoding(name); err != nil {
+			return nil, optionError("InputEncoding", err)
+		}
+		if isUTF8(name) && !utf8.Valid(data) {
+			// Decoding replaces invalid bytes, which reading in place
+			// would pass through.
+			text, err := Decode(data, name)
+			if err != nil {
+				return nil, err
+			}
+			return ConvertContext(ctx, from, to, text, opts)
+		}
+	}
+	return convertInput(ctx, from, to, bytes.NewReader(data), int64(len(data)), name, opts, nil)
+}
+
+// ErrUnsupported is wrapped by the errors for formats, and conversions
+// between them, that have no reader, writer or converter.
+var ErrUnsupported = errors.New("unsupported")
+
+// Supported reports whether a conversion from one format to another exists.
+func Supported(from, to string) bool {
+	if converters[conversion{from: strings.ToLower(from), to: strings.ToLower(to)}] {
+		return true
+	}
+	_, ok := externalConverter(from, to)
+	return ok
+}
+
+// Formats returns the sorted list of known formats.
+func Formats() []string {
+	seen := make(map[string]bool)
+	for c := range converters {
+		seen[c.from] = true
+		seen[c.to] = true
+	}
+	externalMu.RLock()
+	for c := range external {
+		seen[c.from] = true
+		seen[c.to] = true
+	}
+	externalMu.RUnlock()
+	formats := make([]string, 0, len(seen))
+	for f := range seen {
+		formats = append(formats, f)
+	}
+	sort.Strings(formats)
+	return formats
+}
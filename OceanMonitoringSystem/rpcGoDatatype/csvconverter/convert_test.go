@@ -0,0 +1,47 @@
+package csvconverter
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// benchmarkRows is the number of rows converted by the benchmarks.
+const benchmarkRows = 5000
+
+func benchmarkCSV() string {
+	var b strings.Builder
+	b.WriteString("station,time,temp,salinity,depth,status\n")
+	for i := 0; i < benchmarkRows; i++ {
+		fmt.Fprintf(&b, "B%d,2024-03-01T12:%02d:%02dZ,%.2f,%.3f,%d,ok\n",
+			i%40, i/60%60, i%60, 4+float64(i%100)/10, 35+float64(i%50)/100, i%200)
+	}
+	return b.String()
+}
+
+func benchmarkJSON(b *testing.B) string {
+	result, err := Convert("csv", "json", benchmarkCSV(), Options{})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return result.Output
+}
+
+func benchmarkConvert(b *testing.B, from, to, data string) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Convert(from, to, data, Options{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCSVToJSON(b *testing.B) {
+	benchmarkConvert(b, "csv", "json", benchmarkCSV())
+}
+
+func BenchmarkJSONToCSV(b *testing.B) {
+	benchmarkConvert(b, "json", "csv", benchmarkJSON(b))
+}
@@ -0,0 +1,90 @@
+package csvconverter
+
+import (
+	"fmt"
+	"strings"
+
+	"rpcGoDatatype/coords"
+)
+
+// Coordinates converts latitude and longitude columns between coordinate
+// formats, see package coords. Values that cannot be read, are out of
+// range or carry a sign and hemisphere that disagree are row errors.
+// Null and empty values are left alone.
+type Coordinates struct {
+	LatitudeColumn  string
+	LongitudeColumn string
+	// From is the format of the input, coords.Decimal, coords.DMS or
+	// coords.NMEA. Empty accepts decimal and DMS values.
+	From string
+	// To is the format written, coords.Decimal (the default, as numbers),
+	// coords.DMS or coords.NMEA.
+	To string
+}
+
+// coordinatesStep is a compiled Coordinates.
+type coordinatesStep struct {
+	c Coordinates
+	// named holds the configured columns and axes their axes.
+	named []string
+	axes  []coords.Axis
+}
+
+func newCoordinatesStep(c Coordinates) (*coordinatesStep, error) {
+	if c.LatitudeColumn == "" && c.LongitudeColumn == "" {
+		return nil, fmt.Errorf("coordinate conversion needs a latitude or longitude column")
+	}
+	if c.LatitudeColumn == c.LongitudeColumn {
+		return nil, fmt.Errorf("latitude and longitude must be different columns")
+	}
+	if c.From != "" && !coords.ValidFormat(c.From) {
+		return nil, fmt.Errorf("unsupported coordinate format %q", c.From)
+	}
+	if c.To == "" {
+		c.To = coords.Decimal
+	}
+	if !coords.ValidFormat(c.To) {
+		return nil, fmt.Errorf("unsupported coordinate format %q", c.To)
+	}
+	s := &coordinatesStep{c: c}
+	if c.LatitudeColumn != "" {
+		s.named = append(s.named, c.LatitudeColumn)
+		s.axes = append(s.axes, coords.Latitude)
+	}
+	if c.LongitudeColumn != "" {
+		s.named = append(s.named, c.LongitudeColumn)
+		s.axes = append(s.axes, coords.Longitude)
+	}
+	return s, nil
+}
+
+func (s *coordinatesStep) columns(in []string) ([]string, error) {
+	if err := checkColumns(s.named, in); err != nil {
+		return nil, fmt.Errorf("coordinate conversion: %v", err)
+	}
+	return in, nil
+}
+
+func (s *coordinatesStep) apply(row *object) (bool, error) {
+	for i, column := range s.named {
+		axis := s.axes[i]
+		v, ok := row.get(column)
+		if !ok || v == nil {
+			continue
+		}
+		text := strings.TrimSpace(fmt.Sprint(v))
+		if text == "" {
+			continue
+		}
+		f, err := coords.Parse(text, axis, s.c.From)
+		if err != nil {
+			return false, &RowError{Row: row.line, Column: column, Reason: err.Error()}
+		}
+		if s.c.To == coords.Decimal {
+			row.set(column, floatNumber(f))
+		} else {
+			row.set(column, coords.Format(f, axis, s.c.To))
+		}
+	}
+	return true, nil
+}
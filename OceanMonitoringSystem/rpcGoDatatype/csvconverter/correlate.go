@@ -0,0 +1,176 @@
+package csvconverter
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// CorrelationOptions select the columns Correlate compares.
+type CorrelationOptions struct {
+	// Columns are the numeric columns to correlate, at least two.
+	Columns []string
+	// StationColumn splits the rows into one series per station, so that
+	// lagged rows never cross from one station to the next. The pairs of
+	// every station are pooled. Without it all rows form one series.
+	StationColumn string
+	// MaxLag also correlates each pair of columns with the second shifted
+	// by up to MaxLag rows either way. Zero only computes the matrix.
+	MaxLag int
+}
+
+// Correlation is the Pearson correlation R of columns X and Y over N pairs
+// of values. A positive Lag pairs each X with the Y that many rows later,
+// a negative one with the Y that many rows earlier. R is NaN for fewer
+// than two pairs or a column that does not vary.
+type Correlation struct {
+	X, Y string
+	Lag  int
+	R    float64
+	N    int
+}
+
+// CorrelationReport is the outcome of Correlate. Matrix[i][j] is the
+// correlation of Columns[i] and Columns[j] without lag. Lagged holds the
+// correlations of each pair of columns, in the order of Columns, at every
+// lag from -MaxLag to MaxLag.
+type CorrelationReport struct {
+	Rows     int
+	Columns  []string
+	Matrix   [][]float64
+	Lagged   []Correlation
+	Warnings []string
+}
+
+// Correlate reads data with the same options as a conversion and computes
+// the correlations between its columns. Pairs where either value is not
+// a number are left out.
+func Correlate(format, data string, c CorrelationOptions, opts Options) (*CorrelationReport, error) {
+	read, ok := readers[strings.ToLower(format)]
+	if !ok {
+		return nil, fmt.Errorf("%w format: %s", ErrUnsupported, format)
+	}
+	if len(c.Columns) < 2 {
+		return nil, fmt.Errorf("correlation needs at least two columns")
+	}
+	for i, column := range c.Columns {
+		if column == c.StationColumn || hasColumn(c.Columns[:i], column) {
+			return nil, fmt.Errorf("column %s cannot be correlated twice or as the station", column)
+		}
+	}
+	if c.MaxLag < 0 {
+		return nil, fmt.Errorf("correlation max lag must not be negative")
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	rows, columns, err := readRows(read, data, opts, result)
+	if err != nil {
+		return nil, err
+	}
+	named := c.Columns
+	if c.StationColumn != "" {
+		named = append(named[:len(named):len(named)], c.StationColumn)
+	}
+	if columns != nil {
+		if err := checkColumns(named, columns); err != nil {
+			return nil, fmt.Errorf("invalid correlation: %v", err)
+		}
+	}
+
+	// series[s][i] holds the values of Columns[i] in the rows of station s.
+	var series [][][]float64
+	var valid [][][]bool
+	stations := make(map[string]int)
+	for _, row := range rows {
+		name := ""
+		if c.StationColumn != "" {
+			if v, _ := row.get(c.StationColumn); v != nil {
+				name = fmt.Sprint(v)
+			}
+		}
+		s, ok := stations[name]
+		if !ok {
+			s = len(series)
+			stations[name] = s
+			series = append(series, make([][]float64, len(c.Columns)))
+			valid = append(valid, make([][]bool, len(c.Columns)))
+		}
+		for i, column := range c.Columns {
+			v, _ := row.get(column)
+			f, _, number := qcNumber(v)
+			series[s][i] = append(series[s][i], f)
+			valid[s][i] = append(valid[s][i], number)
+		}
+	}
+
+	report := &CorrelationReport{Rows: len(rows), Columns: c.Columns, Warnings: result.Warnings}
+	report.Matrix = make([][]float64, len(c.Columns))
+	for i := range c.Columns {
+		report.Matrix[i] = make([]float64, len(c.Columns))
+	}
+	for i, x := range c.Columns {
+		for j := i; j < len(c.Columns); j++ {
+			for lag := -c.MaxLag; lag <= c.MaxLag; lag++ {
+				if i == j && lag != 0 {
+					continue
+				}
+				var p pearson
+				for s := range series {
+					p.addSeries(series[s][i], valid[s][i], series[s][j], valid[s][j], lag)
+				}
+				r := p.r()
+				if lag == 0 {
+					report.Matrix[i][j], report.Matrix[j][i] = r, r
+				}
+				if c.MaxLag > 0 && i != j {
+					report.Lagged = append(report.Lagged, Correlation{X: x, Y: c.Columns[j], Lag: lag, R: r, N: p.n})
+				}
+			}
+		}
+	}
+	return report, nil
+}
+
+// pearson collects the pairs of values a correlation is computed from.
+type pearson struct {
+	n      int
+	xs, ys []float64
+}
+
+// addSeries adds the pairs of xs[k] and ys[k+lag] where both are valid.
+func (p *pearson) addSeries(xs []float64, xvalid []bool, ys []float64, yvalid []bool, lag int) {
+	for k := max(0, -lag); k < len(xs) && k+lag < len(ys); k++ {
+		if xvalid[k] && yvalid[k+lag] {
+			p.xs = append(p.xs, xs[k])
+			p.ys = append(p.ys, ys[k+lag])
+		}
+	}
+	p.n = len(p.xs)
+}
+
+func (p *pearson) r() float64 {
+	if p.n < 2 {
+		return math.NaN()
+	}
+	mx, my := 0.0, 0.0
+	for k := range p.xs {
+		mx += p.xs[k]
+		my += p.ys[k]
+	}
+	mx /= float64(p.n)
+	my /= float64(p.n)
+	cov, vx, vy := 0.0, 0.0, 0.0
+	for k := range p.xs {
+		dx, dy := p.xs[k]-mx, p.ys[k]-my
+		cov += dx * dy
+		vx += dx * dx
+		vy += dy * dy
+	}
+	if vx == 0 || vy == 0 {
+		return math.NaN()
+	}
+	return max(-1, min(1, cov/math.Sqrt(vx*vy)))
+}
@@ -1,50 +1,618 @@
 package csvconverter
 
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	"regexp"
 	"strconv"
 	"strings"
+
+	"rpcGoDatatype/expr"
 )
 
-func isNumber(s string) bool {
-	_, err := strconv.ParseFloat(s, 64)
-	return err == nil
+// parseNumber returns the JSON number for a CSV value. Integers keep their
+// exact digits, valid JSON number literals are passed through unchanged so
+// 64-bit IDs and long decimals keep their precision, and anything else that
+// parses as a float is written in plain decimal notation.
+func parseNumber(s string) (json.Number, bool) {
+	// Rule out text before calling strconv, whose errors allocate.
+	digits := trimSigns(s)
+	if digits == "" || digits[0] != '.' && !isDigit(digits[0]) {
+		return "", false
+	}
+	if allDigits(digits) {
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return json.Number(strconv.FormatInt(i, 10)), true
+		}
+	}
+	if isJSONNumber(s) {
+		return json.Number(s), true
+	}
+	if strings.Trim(s, floatText) != "" {
+		return "", false
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", false
+	}
+	return json.Number(strconv.FormatFloat(f, 'f', -1, 64)), true
+}
+
+// floatText holds every byte of the finite numbers strconv.ParseFloat
+// accepts, hexadecimal ones included.
+const floatText = "0123456789+-._eEpPxXabcdefABCDEF"
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func allDigits(s string) bool {
+	return trimDigits(s) == ""
+}
+
+// trimDigits and trimSigns are strings.TrimLeft for the cutsets of the
+// numeric checks, which run on every value and cannot afford its setup.
+func trimDigits(s string) string {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[i:]
+}
+
+func trimSigns(s string) string {
+	i := 0
+	for i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	return s[i:]
+}
+
+var plainDecimal = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// parseBool recognizes the boolean tokens of columns typed as boolean.
+func parseBool(s string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", "yes", "1", "t", "y", "on":
+		return true, true
+	case "false", "no", "0", "f", "n", "off":
+		return false, true
+	}
+	return false, false
+}
+
+// isBoolToken reports whether s is one of the tokens that make a column
+// boolean under Options.InferBooleans.
+func isBoolToken(s string) bool {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", "false", "yes", "no", "1", "0":
+		return true
+	}
+	return false
+}
+
+func isNonFinite(s string) bool {
+	switch strings.ToLower(trimSigns(s)) {
+	case "nan", "inf", "infinity":
+		return true
+	}
+	return false
+}
+
+// isJSONNumber reports whether s is a JSON number literal:
+// -?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?
+func isJSONNumber(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	switch {
+	case s == "":
+		return false
+	case s[0] == '0':
+		s = s[1:]
+	case isDigit(s[0]):
+		s = trimDigits(s)
+	default:
+		return false
+	}
+	if strings.HasPrefix(s, ".") {
+		frac := trimDigits(s[1:])
+		if len(frac) == len(s)-1 {
+			return false
+		}
+		s = frac
+	}
+	if s != "" && (s[0] == 'e' || s[0] == 'E') {
+		exp := trimSigns(s[1:])
+		if len(exp) < len(s)-2 {
+			return false
+		}
+		s = trimDigits(exp)
+		if len(s) == len(exp) {
+			return false
+		}
+	}
+	return s == ""
+}
+
+func convertValue(value, typ string, nulls *nullSet, opts Options) (interface{}, error) {
+	if nulls.has(value) {
+		return nil, nil
+	}
+
+	if typ == TypeString {
+		return value, nil
+	}
+
+	if typ == TypeTimestamp {
+		if value == "" {
+			return nil, nil
+		}
+		t, ok := parseTimestamp(value, true, opts)
+		if !ok {
+			return nil, fmt.Errorf("value %q is not a timestamp", value)
+		}
+		return timestampValue(value, t, opts), nil
+	}
+
+	if typ == TypeBoolean {
+		if value == "" {
+			return nil, nil
+		}
+		b, ok := parseBool(value)
+		if !ok {
+			return nil, fmt.Errorf("value %q is not a boolean", value)
+		}
+		return b, nil
+	}
+
+	if isNonFinite(value) {
+		if opts.NonFiniteAs == NonFiniteNull {
+			return nil, nil
+		}
+		return value, nil
+	}
+
+	num, ok := parseNumber(value)
+	if ok && opts.StrictNumbers && !plainDecimal.MatchString(value) {
+		ok = false
+	}
+
+	if typ == TypeNumber {
+		if value == "" {
+			return nil, nil
+		}
+		if !ok {
+			return nil, fmt.Errorf("value %q is not a number", value)
+		}
+		return num, nil
+	}
+
+	if ok {
+		return num, nil
+	}
+	if opts.DetectTimestamps || opts.normalizeTimestamps() {
+		if t, ok := parseTimestamp(value, false, opts); ok {
+			return timestampValue(value, t, opts), nil
+		}
+	}
+	return value, nil
+}
+
+// generatedHeaders names the columns of headerless input column_1..column_n.
+func generatedHeaders(n int) []string {
+	headers := make([]string, n)
+	for i := range headers {
+		headers[i] = fmt.Sprintf("column_%d", i+1)
+	}
+	return headers
 }
 
 func ConvertCSVToJSON(csvString string) (string, error) {
-	reader := csv.NewReader(strings.NewReader(csvString))
+	return ConvertCSVToJSONWithOptions(csvString, Options{})
+}
+
+func ConvertCSVToJSONWithOptions(csvString string, opts Options) (string, error) {
+	result, err := Convert("csv", "json", csvString, opts)
+	if err != nil {
+		return "", err
+	}
+	return result.Output, nil
+}
 
-	headers, err := reader.Read()
+// ConvertCSVToJSONContext converts like ConvertCSVToJSONWithOptions,
+// stopping with ctx.Err() once ctx is done.
+func ConvertCSVToJSONContext(ctx context.Context, csvString string, opts Options) (string, error) {
+	result, err := ConvertContext(ctx, "csv", "json", csvString, opts)
 	if err != nil {
-		return "", fmt.Errorf("error reading headers: %v", err)
+		return "", err
+	}
+	return result.Output, nil
+}
+
+// ConvertCSVToJSONStream converts CSV read from r to JSON written to w, see
+// ConvertStream.
+func ConvertCSVToJSONStream(r io.Reader, w io.Writer, opts Options) error {
+	_, err := ConvertStream("csv", "json", r, w, opts)
+	return err
+}
+
+// csvRowReader parses CSV input one row at a time, applying header
+// handling, value conversion and the row filter.
+type csvRowReader struct {
+	reader  *csvScanner
+	opts    Options
+	result  *Result
+	headers []string
+	// columns are the headers followed by any computed columns.
+	columns  []string
+	repeated map[string]bool
+	computed []computedColumn
+	filter   *expr.Expr
+	nulls    *nullSet
+	pending  [][]string
+	// offset is the number of preamble lines before the CSV data.
+	offset int
+	// With Options.InferBooleans, every record is read into buffered before
+	// the first is returned, and booleans marks the columns, by header
+	// index, whose values are all boolean tokens.
+	buffered []*csvRecord
+	booleans []bool
+}
+
+func newCSVReader(r io.Reader, opts Options, result *Result) (rowReader, error) {
+	body, preamble, err := readPreamble(bufio.NewReader(r), opts.SkipLines, opts.CommentPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("error reading input: %v", err)
+	}
+	if opts.CaptureMetadata && len(preamble) > 0 {
+		result.Preamble = preamble
+		result.Metadata = parseMetadata(preamble, opts.CommentPrefix)
+	}
+
+	reader := newCSVScanner(body)
+	if r := commentRune(opts.CommentPrefix); r != 0 && r != ',' {
+		reader.comment = r
+	}
+	if opts.JaggedRows != "" && opts.JaggedRows != JaggedFail || opts.SkipInvalidRows {
+		reader.fieldsPerRecord = -1
 	}
 
-	records, err := reader.ReadAll()
+	first, err := reader.Read()
 	if err != nil {
-		return "", fmt.Errorf("error reading records: %v", err)
+		return nil, fmt.Errorf("error reading headers: %v", err)
+	}
+	// Rows are converted before the next is read, unless workers read
+	// ahead or boolean inference reads every row first, so later reads can
+	// reuse one record slice. first keeps its own.
+	reader.reuseRecord = opts.Workers <= 1 && !opts.InferBooleans
+
+	c := &csvRowReader{reader: reader, opts: opts, result: result, nulls: newNullSet(opts.NullValues), offset: len(preamble)}
+	headers := first
+	if opts.NoHeader {
+		c.pending = append(c.pending, first)
+		headers = generatedHeaders(len(first))
+	}
+	if len(opts.Headers) > 0 {
+		if len(opts.Headers) != len(first) {
+			return nil, fmt.Errorf("got %d headers for %d columns", len(opts.Headers), len(first))
+		}
+		headers = opts.Headers
+	}
+
+	if c.headers, c.repeated, err = resolveHeaders(headers, opts.DuplicateHeaders); err != nil {
+		return nil, err
+	}
+	if c.headers, err = renameHeaders(c.headers, opts.Rename); err != nil {
+		return nil, err
+	}
+	if c.computed, err = compileComputed(opts.ComputedColumns); err != nil {
+		return nil, err
+	}
+	if c.columns, err = computedHeaders(c.computed, c.headers); err != nil {
+		return nil, err
+	}
+	if c.filter, err = compileFilter(opts.Filter); err != nil {
+		return nil, err
+	}
+	if err := checkInputColumns(c.columns, c.filter, opts); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// checkInputColumns checks the columns named by the reshape, filter and
+// deduplication options against the columns of an input with a fixed
+// column set.
+func checkInputColumns(columns []string, filter *expr.Expr, opts Options) error {
+	if filter != nil {
+		if err := checkColumns(filter.Columns(), columns); err != nil {
+			return &OptionError{Option: "Filter", Reason: fmt.Sprintf("invalid filter: %v", err)}
+		}
+	}
+	// Reshaping and deduplication see the range flag columns and the
+	// columns renamed by Options.Units.
+	columns = unitColumns(rangeColumns(columns, opts), opts)
+	if err := checkColumns(opts.reshapeInputColumns(), columns); err != nil {
+		return &OptionError{Option: "Reshape", Reason: fmt.Sprintf("invalid reshape: %v", err)}
+	}
+	if err := checkColumns(opts.DedupKeys, columns); err != nil {
+		return &OptionError{Option: "DedupKeys", Reason: fmt.Sprintf("invalid dedup keys: %v", err)}
+	}
+	return nil
+}
+
+func (c *csvRowReader) Columns() []string {
+	return c.columns
+}
+
+// Next returns the next row that converts and passes the filter, or io.EOF.
+func (c *csvRowReader) Next() (*object, error) {
+	for {
+		rec, err := c.read()
+		if err != nil {
+			return nil, err
+		}
+		c.build(rec)
+		if row, err := c.accept(rec); row != nil || err != nil {
+			return row, err
+		}
+	}
+}
+
+// csvRecord is a CSV record on its way to becoming a row.
+type csvRecord struct {
+	fields []string
+	line   int
+	// present counts the fields read; padded fields are null.
+	present int
+	// warning and err are found while reading; a record with an error, or
+	// marked skip, is not built.
+	warning string
+	err     *RowError
+	// parseErr marks err as a CSV syntax error.
+	parseErr bool
+	skip     bool
+	// row and rowErr are the outcome of build. row is nil when the filter
+	// drops the record.
+	row    *object
+	rowErr *RowError
+}
+
+// read returns the next record in input order, or io.EOF. It leaves the
+// result alone so records can be read ahead of accept.
+func (c *csvRowReader) read() (*csvRecord, error) {
+	if !c.opts.InferBooleans {
+		return c.readRecord()
+	}
+	if c.booleans == nil {
+		if err := c.inferBooleans(); err != nil {
+			return nil, err
+		}
+	}
+	if len(c.buffered) == 0 {
+		return nil, io.EOF
+	}
+	rec := c.buffered[0]
+	c.buffered[0] = nil
+	c.buffered = c.buffered[1:]
+	return rec, nil
+}
+
+// inferBooleans reads every record and marks the columns without an
+// explicit type whose non-null values are all boolean tokens, given that
+// there is at least one.
+func (c *csvRowReader) inferBooleans() error {
+	for {
+		rec, err := c.readRecord()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		c.buffered = append(c.buffered, rec)
+	}
+	c.booleans = make([]bool, len(c.headers))
+	for i, h := range c.headers {
+		if c.opts.columnType(h) != "" {
+			continue
+		}
+		seen := false
+		for _, rec := range c.buffered {
+			if rec.err != nil || rec.skip || i >= rec.present {
+				continue
+			}
+			value := rec.fields[i]
+			if value == "" || c.nulls.has(value) {
+				continue
+			}
+			if !isBoolToken(value) {
+				seen = false
+				break
+			}
+			seen = true
+		}
+		c.booleans[i] = seen
 	}
+	return nil
+}
 
-	var data []map[string]interface{}
+// readRecord reads the next record from the input, or io.EOF.
+func (c *csvRowReader) readRecord() (*csvRecord, error) {
+	opts, headers := c.opts, c.headers
+	var row []string
+	if len(c.pending) > 0 {
+		row, c.pending = c.pending[0], c.pending[1:]
+	} else {
+		var err error
+		row, err = c.reader.Read()
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		if perr, ok := err.(*csv.ParseError); ok {
+			return &csvRecord{err: &RowError{Row: perr.Line + c.offset, Reason: perr.Err.Error()}, parseErr: true}, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading records: %v", err)
+		}
+	}
+	line := c.reader.Line()
+	present := len(row)
+	rec := &csvRecord{fields: row, line: line + c.offset, present: present}
+	if present != len(headers) {
+		switch {
+		case opts.JaggedRows == JaggedSkip:
+			rec.warning = fmt.Sprintf("line %d: skipped row with %d fields, expected %d", rec.line, present, len(headers))
+			rec.skip = true
+		case present < len(headers) && opts.JaggedRows == JaggedPad:
+			rec.warning = fmt.Sprintf("line %d: padded row with %d fields to %d", rec.line, present, len(headers))
+			rec.fields = append(row, make([]string, len(headers)-present)...)
+		case present > len(headers) && opts.JaggedRows == JaggedTruncate:
+			rec.warning = fmt.Sprintf("line %d: truncated row with %d fields to %d", rec.line, present, len(headers))
+			rec.fields = row[:len(headers)]
+			rec.present = len(headers)
+		default:
+			reason := fmt.Sprintf("wrong number of fields: got %d, expected %d", present, len(headers))
+			rec.err = &RowError{Row: rec.line, Reason: reason}
+		}
+	}
+	return rec, nil
+}
 
-	for _, row := range records {
-		item := make(map[string]interface{})
-		for i, value := range row {
-			if isNumber(value) {
-				num, _ := strconv.ParseFloat(value, 64)
-				item[headers[i]] = num
-			} else {
-				item[headers[i]] = value
+// build converts a record's values and applies computed columns and the
+// filter. It only reads c, so records can be built concurrently.
+func (c *csvRowReader) build(rec *csvRecord) {
+	if rec.err != nil || rec.skip {
+		return
+	}
+	opts, headers, line := c.opts, c.headers, rec.line
+	item := newObjectSize(len(c.columns))
+	item.line = line
+	for i, value := range rec.fields {
+		var converted interface{}
+		if i < rec.present {
+			var err error
+			typ := opts.columnType(headers[i])
+			if c.booleans != nil && c.booleans[i] {
+				typ = TypeBoolean
+			}
+			converted, err = convertValue(value, typ, c.nulls, opts)
+			if err != nil {
+				rec.rowErr = &RowError{Row: line, Column: headers[i], Reason: err.Error()}
+				return
 			}
 		}
-		data = append(data, item)
+		if c.repeated[headers[i]] {
+			values, _ := item.get(headers[i])
+			list, _ := values.([]interface{})
+			item.set(headers[i], append(list, converted))
+			continue
+		}
+		item.set(headers[i], converted)
+	}
+	if rec.rowErr = compute(c.computed, item); rec.rowErr != nil {
+		return
+	}
+	if ok, err := keep(c.filter, item); err != nil {
+		rec.rowErr = &RowError{Row: line, Reason: err.Error()}
+	} else if ok {
+		rec.row = item
 	}
+}
 
-	jsonData, err := json.MarshalIndent(data, "", "  ")
-	if err != nil {
-		return "", fmt.Errorf("error converting to JSON: %v", err)
+// accept counts a built record and reports its errors and warnings, in
+// input order. It returns the record's row, or nil when there is none.
+func (c *csvRowReader) accept(rec *csvRecord) (*object, error) {
+	result := c.result
+	result.Stats.RowsRead++
+	if rec.warning != "" {
+		result.Warnings = append(result.Warnings, rec.warning)
 	}
+	switch {
+	case rec.err != nil:
+		if err := result.rowError(rec.err, c.opts); err != nil {
+			if rec.parseErr {
+				return nil, fmt.Errorf("error reading records: %w", err)
+			}
+			return nil, err
+		}
+	case rec.rowErr != nil:
+		if err := result.rowError(rec.rowErr, c.opts); err != nil {
+			return nil, err
+		}
+	}
+	return rec.row, nil
+}
+
+// jsonRowWriter writes rows as a JSON array, one row at a time. Each row is
+// encoded into a pooled buffer reused for the next, so memory use does not
+// grow with the output. An empty indent writes compact JSON.
+type jsonRowWriter struct {
+	w      io.Writer
+	indent string
+	e      *encoder
+	// indented holds the row after indenting.
+	indented bytes.Buffer
+	n        int
+}
+
+func newJSONWriter(w io.Writer, opts Options) rowWriter {
+	j := &jsonRowWriter{w: w, e: getEncoder()}
+	if !opts.CompactJSON {
+		n := opts.JSONIndent
+		if n == 0 {
+			n = DefaultJSONIndent
+		}
+		j.indent = strings.Repeat(" ", n)
+	}
+	return j
+}
+
+func (j *jsonRowWriter) Write(row *object) error {
+	buf := &j.e.buf
+	buf.Reset()
+	if j.n == 0 {
+		buf.WriteByte('[')
+	} else {
+		buf.WriteByte(',')
+	}
+	if j.indent != "" {
+		buf.WriteByte('\n')
+		buf.WriteString(j.indent)
+	}
+	start := buf.Len()
+	if err := j.e.object(row); err != nil {
+		return fmt.Errorf("error converting to JSON: %v", err)
+	}
+	if j.indent != "" {
+		j.indented.Reset()
+		j.indented.Write(buf.Bytes()[:start])
+		if err := json.Indent(&j.indented, buf.Bytes()[start:], j.indent, j.indent); err != nil {
+			return fmt.Errorf("error converting to JSON: %v", err)
+		}
+		buf = &j.indented
+	}
+	j.n++
+	_, err := j.w.Write(buf.Bytes())
+	return err
+}
 
-	return string(jsonData), nil
+func (j *jsonRowWriter) Close() error {
+	if j.e != nil {
+		putEncoder(j.e)
+		j.e = nil
+	}
+	end := "]"
+	if j.n == 0 {
+		end = "[]"
+	} else if j.indent != "" {
+		end = "\n]"
+	}
+	_, err := io.WriteString(j.w, end)
+	return err
 }
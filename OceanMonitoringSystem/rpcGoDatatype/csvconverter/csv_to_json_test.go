@@ -0,0 +1,48 @@
+package csvconverter
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestInferBooleans(t *testing.T) {
+	input := "ok,flag,note,count,typed\n" +
+		"yes,1,yes,0,no\n" +
+		"No,0,no,1,yes\n" +
+		",1,maybe,2,no\n"
+	result, err := Convert("csv", "json", input, Options{
+		InferBooleans: true,
+		CompactJSON:   true,
+		ColumnTypes:   map[string]string{"typed": TypeString},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []map[string]interface{}
+	if err := json.Unmarshal([]byte(result.Output), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := []map[string]interface{}{
+		{"ok": true, "flag": true, "note": "yes", "count": 0.0, "typed": "no"},
+		{"ok": false, "flag": false, "note": "no", "count": 1.0, "typed": "yes"},
+		{"ok": nil, "flag": true, "note": "maybe", "count": 2.0, "typed": "no"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestInferBooleansFilter(t *testing.T) {
+	result, err := Convert("csv", "json", "station,ok\nB12,1\nB13,0\n", Options{
+		InferBooleans: true,
+		CompactJSON:   true,
+		Filter:        "ok == true",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `[{"station":"B12","ok":true}]`; result.Output != want {
+		t.Errorf("got %s, want %s", result.Output, want)
+	}
+}
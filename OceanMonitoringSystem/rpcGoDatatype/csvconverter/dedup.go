@@ -0,0 +1,30 @@
+package csvconverter
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// dedupRows drops rows that repeat an earlier row, keeping the first
+// occurrence. Rows are compared on the key columns, or on every column when
+// no keys are given. It returns the remaining rows and the number removed.
+func dedupRows(rows []*object, keys []string) ([]*object, int, error) {
+	seen := make(map[string]bool, len(rows))
+	kept := rows[:0]
+	for _, row := range rows {
+		target := row
+		if len(keys) > 0 {
+			target = row.project(keys)
+		}
+		b, err := json.Marshal(target)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error comparing rows: %v", err)
+		}
+		if seen[string(b)] {
+			continue
+		}
+		seen[string(b)] = true
+		kept = append(kept, row)
+	}
+	return kept, len(rows) - len(kept), nil
+}
@@ -0,0 +1,195 @@
+package csvconverter
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// DepthBinning averages profile readings into depth bins of equal size,
+// so that casts sampled at different depths line up. Each output row holds
+// the cast's GroupColumns, the bin centre in DepthColumn and the mean of
+// each column over the readings in the bin, null when there are none. Bins
+// are aligned to whole multiples of BinSize, so 1 m bins are centred on
+// 0.5 m, 1.5 m and so on. Rows come out ordered by cast, in order of
+// appearance, then by depth.
+type DepthBinning struct {
+	DepthColumn string
+	// BinSize is the height of a bin, in the unit of DepthColumn.
+	BinSize float64
+	Columns []string
+	// GroupColumns identify a cast, e.g. the station and cast time. Without
+	// them all rows form one profile.
+	GroupColumns []string
+}
+
+// depthBinStep is a compiled DepthBinning.
+type depthBinStep struct {
+	b   DepthBinning
+	out []string
+}
+
+func newDepthBinStep(b DepthBinning) (*depthBinStep, error) {
+	if b.DepthColumn == "" || len(b.Columns) == 0 {
+		return nil, fmt.Errorf("depth binning needs a depth column and at least one column")
+	}
+	if !(b.BinSize > 0) || math.IsInf(b.BinSize, 0) {
+		return nil, fmt.Errorf("invalid depth bin size: %g", b.BinSize)
+	}
+	s := &depthBinStep{b: b}
+	for i, column := range b.GroupColumns {
+		if column == b.DepthColumn || hasColumn(b.GroupColumns[:i], column) {
+			return nil, fmt.Errorf("group column %s cannot be listed twice or be the depth", column)
+		}
+	}
+	s.out = append(append(s.out, b.GroupColumns...), b.DepthColumn)
+	for i, column := range b.Columns {
+		if column == b.DepthColumn || hasColumn(b.GroupColumns, column) || hasColumn(b.Columns[:i], column) {
+			return nil, fmt.Errorf("column %s cannot be binned twice or as the depth or a group", column)
+		}
+		s.out = append(s.out, column)
+	}
+	return s, nil
+}
+
+func (s *depthBinStep) columns(in []string) ([]string, error) {
+	named := append(append([]string{s.b.DepthColumn}, s.b.GroupColumns...), s.b.Columns...)
+	if err := checkColumns(named, in); err != nil {
+		return nil, fmt.Errorf("depth binning: %v", err)
+	}
+	return s.out, nil
+}
+
+func (s *depthBinStep) reader(src rowReader, columns []string, opts Options, result *Result) rowReader {
+	r := &depthBinReader{src: src, step: s, result: result}
+	if columns != nil {
+		r.columns = s.out
+	}
+	return r
+}
+
+// depthBinReader reads every row of src, keeping only the running sums of
+// each bin, and returns the bin means.
+type depthBinReader struct {
+	src     rowReader
+	step    *depthBinStep
+	result  *Result
+	columns []string
+	rows    []*object
+	read    bool
+}
+
+func (r *depthBinReader) Columns() []string {
+	return r.columns
+}
+
+// Next returns the next bin row, or io.EOF.
+func (r *depthBinReader) Next() (*object, error) {
+	if !r.read {
+		if err := r.readAll(); err != nil {
+			return nil, err
+		}
+		r.read = true
+	}
+	if len(r.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := r.rows[0]
+	r.rows[0] = nil
+	r.rows = r.rows[1:]
+	return row, nil
+}
+
+// depthBin holds the running sums of one cast and bin.
+type depthBin struct {
+	cast   int
+	bin    float64
+	group  []interface{}
+	sums   []float64
+	counts []int
+}
+
+func (r *depthBinReader) readAll() error {
+	b := r.step.b
+	type key struct {
+		cast string
+		bin  float64
+	}
+	bins := make(map[key]*depthBin)
+	casts := make(map[string]int)
+	var all []*depthBin
+	undepthed := 0
+	for {
+		row, err := r.src.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		v, _ := row.get(b.DepthColumn)
+		depth, _, number := qcNumber(v)
+		if !number {
+			undepthed++
+			continue
+		}
+		group := make([]interface{}, len(b.GroupColumns))
+		names := make([]string, len(b.GroupColumns))
+		for i, column := range b.GroupColumns {
+			group[i], _ = row.get(column)
+			if group[i] != nil {
+				names[i] = fmt.Sprint(group[i])
+			}
+		}
+		name := strings.Join(names, "\x00")
+		k := key{name, math.Floor(depth / b.BinSize)}
+		d, ok := bins[k]
+		if !ok {
+			n, ok := casts[name]
+			if !ok {
+				n = len(casts)
+				casts[name] = n
+			}
+			d = &depthBin{cast: n, bin: k.bin, group: group, sums: make([]float64, len(b.Columns)), counts: make([]int, len(b.Columns))}
+			bins[k] = d
+			all = append(all, d)
+		}
+		for i, column := range b.Columns {
+			v, _ := row.get(column)
+			if f, _, number := qcNumber(v); number {
+				d.sums[i] += f
+				d.counts[i]++
+			}
+		}
+	}
+	if undepthed > 0 {
+		r.result.Warnings = append(r.result.Warnings, fmt.Sprintf("depth binning: %d rows without a numeric %s were ignored", undepthed, b.DepthColumn))
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].cast != all[j].cast {
+			return all[i].cast < all[j].cast
+		}
+		return all[i].bin < all[j].bin
+	})
+	r.rows = make([]*object, len(all))
+	for n, d := range all {
+		row := newObjectSize(len(r.step.out))
+		row.line = n + 1
+		for i, column := range b.GroupColumns {
+			row.set(column, d.group[i])
+		}
+		row.set(b.DepthColumn, finiteNumber((d.bin+0.5)*b.BinSize))
+		for i, column := range b.Columns {
+			var value interface{}
+			if d.counts[i] > 0 {
+				value = finiteNumber(d.sums[i] / float64(d.counts[i]))
+			}
+			row.set(column, value)
+		}
+		r.rows[n] = row
+	}
+	return nil
+}
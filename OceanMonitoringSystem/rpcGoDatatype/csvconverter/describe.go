@@ -0,0 +1,121 @@
+package csvconverter
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// defaultPercentiles are computed when Describe is given none.
+var defaultPercentiles = []float64{25, 50, 75}
+
+// Percentile is the value below which P percent of a column's numbers
+// fall, interpolated linearly between the closest ranks.
+type Percentile struct {
+	P     float64
+	Value float64
+}
+
+// ColumnSummary holds the descriptive statistics of one column. Count is
+// the number of values that are neither null nor empty, and Numeric the
+// number of those that are finite numbers. Min, Max, Mean, StdDev and
+// Percentiles are computed from the numbers only and are unset when there
+// are none. StdDev is the sample standard deviation, zero for a single
+// number.
+type ColumnSummary struct {
+	Name        string
+	Count       int
+	NullCount   int
+	Numeric     int
+	Min, Max    float64
+	Mean        float64
+	StdDev      float64
+	Percentiles []Percentile
+}
+
+// Description is the outcome of Describe.
+type Description struct {
+	Columns  []ColumnSummary
+	Rows     int
+	Warnings []string
+}
+
+// Describe reads data with the same options as a conversion and summarizes
+// each of its columns. percentiles lists the percentiles to compute, from 0
+// to 100; none computes the quartiles.
+func Describe(format, data string, percentiles []float64, opts Options) (*Description, error) {
+	read, ok := readers[strings.ToLower(format)]
+	if !ok {
+		return nil, fmt.Errorf("%w format: %s", ErrUnsupported, format)
+	}
+	if len(percentiles) == 0 {
+		percentiles = defaultPercentiles
+	}
+	for _, p := range percentiles {
+		if !(p >= 0 && p <= 100) {
+			return nil, fmt.Errorf("invalid percentile: %g", p)
+		}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	rows, columns, err := readAll(read, data, opts, result)
+	if err != nil {
+		return nil, err
+	}
+	if columns == nil {
+		columns = rowColumns(rows)
+	}
+
+	d := &Description{Rows: len(rows), Warnings: result.Warnings}
+	values := make([]float64, 0, len(rows))
+	for _, c := range columns {
+		s := ColumnSummary{Name: c}
+		values = values[:0]
+		for _, row := range rows {
+			v, _ := row.get(c)
+			if v == nil || v == "" {
+				s.NullCount++
+				continue
+			}
+			s.Count++
+			if f, _, number := qcNumber(v); number {
+				values = append(values, f)
+			}
+		}
+		s.Numeric = len(values)
+		if s.Numeric > 0 {
+			s.describe(values, percentiles)
+		}
+		d.Columns = append(d.Columns, s)
+	}
+	return d, nil
+}
+
+// describe computes the statistics of a column's numbers, which it sorts.
+func (s *ColumnSummary) describe(values []float64, percentiles []float64) {
+	sort.Float64s(values)
+	n := len(values)
+	s.Min, s.Max = values[0], values[n-1]
+	for _, f := range values {
+		s.Mean += f
+	}
+	s.Mean /= float64(n)
+	if n > 1 {
+		sum := 0.0
+		for _, f := range values {
+			sum += (f - s.Mean) * (f - s.Mean)
+		}
+		s.StdDev = math.Sqrt(sum / float64(n-1))
+	}
+	for _, p := range percentiles {
+		rank := p / 100 * float64(n-1)
+		lo := int(math.Floor(rank))
+		hi := min(lo+1, n-1)
+		v := values[lo] + (values[hi]-values[lo])*(rank-float64(lo))
+		s.Percentiles = append(s.Percentiles, Percentile{P: p, Value: v})
+	}
+}
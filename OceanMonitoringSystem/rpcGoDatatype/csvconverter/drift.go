@@ -0,0 +1,152 @@
+package csvconverter
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DriftPoint is the offset to add to a sensor's readings at a calibration
+// event, such as the difference from a reference before or after a
+// deployment.
+type DriftPoint struct {
+	Time   time.Time
+	Offset float64
+}
+
+// DriftCorrection adds to the values of Columns an offset interpolated
+// linearly in time between the drift points: one line between pre and post
+// deployment offsets, or a piecewise line through several calibration
+// events. Before the first point and after the last the offset is held.
+// Null and empty values are left alone; values that are not numbers and
+// rows without a valid time are row errors.
+type DriftCorrection struct {
+	Columns    []string
+	TimeColumn string
+	Points     []DriftPoint
+	// Suffix writes the corrected values to new columns named after the
+	// columns with Suffix appended, keeping the raw values.
+	Suffix string
+}
+
+// driftStep is a compiled DriftCorrection.
+type driftStep struct {
+	d DriftCorrection
+}
+
+func newDriftStep(d DriftCorrection) (*driftStep, error) {
+	if len(d.Columns) == 0 {
+		return nil, fmt.Errorf("drift correction needs at least one column")
+	}
+	if d.TimeColumn == "" {
+		return nil, fmt.Errorf("drift correction needs a time column")
+	}
+	if len(d.Points) == 0 {
+		return nil, fmt.Errorf("drift correction needs at least one drift point")
+	}
+	d.Points = append([]DriftPoint(nil), d.Points...)
+	sort.SliceStable(d.Points, func(i, j int) bool { return d.Points[i].Time.Before(d.Points[j].Time) })
+	for i := 1; i < len(d.Points); i++ {
+		if d.Points[i].Time.Equal(d.Points[i-1].Time) {
+			return nil, fmt.Errorf("drift points at %s are listed twice", d.Points[i].Time.Format(time.RFC3339))
+		}
+	}
+	return &driftStep{d: d}, nil
+}
+
+func (s *driftStep) columns(in []string) ([]string, error) {
+	if err := checkColumns(append([]string{s.d.TimeColumn}, s.d.Columns...), in); err != nil {
+		return nil, fmt.Errorf("drift correction: %v", err)
+	}
+	if s.d.Suffix == "" {
+		return in, nil
+	}
+	out := append([]string(nil), in...)
+	for _, column := range s.d.Columns {
+		if hasColumn(out, column+s.d.Suffix) {
+			return nil, fmt.Errorf("drift correction: column %q already exists", column+s.d.Suffix)
+		}
+		out = append(out, column+s.d.Suffix)
+	}
+	return out, nil
+}
+
+func (s *driftStep) reader(src rowReader, columns []string, opts Options, result *Result) rowReader {
+	r := &driftReader{src: src, step: s, opts: opts, result: result}
+	if columns != nil {
+		r.columns, _ = s.columns(columns)
+	}
+	return r
+}
+
+// offset returns the drift offset at t.
+func (s *driftStep) offset(t time.Time) float64 {
+	p := s.d.Points
+	i := sort.Search(len(p), func(i int) bool { return p[i].Time.After(t) })
+	switch {
+	case i == 0:
+		return p[0].Offset
+	case i == len(p):
+		return p[len(p)-1].Offset
+	}
+	a, b := p[i-1], p[i]
+	frac := float64(t.Sub(a.Time)) / float64(b.Time.Sub(a.Time))
+	return a.Offset + frac*(b.Offset-a.Offset)
+}
+
+// driftReader corrects the rows of src. It reads the time column with the
+// conversion options.
+type driftReader struct {
+	src     rowReader
+	step    *driftStep
+	opts    Options
+	result  *Result
+	columns []string
+}
+
+func (r *driftReader) Columns() []string {
+	return r.columns
+}
+
+// Next returns the next corrected row, or io.EOF.
+func (r *driftReader) Next() (*object, error) {
+	for {
+		row, err := r.src.Next()
+		if err != nil {
+			return nil, err
+		}
+		if err := r.correct(row); err != nil {
+			if err := r.result.rowError(err, r.opts); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		return row, nil
+	}
+}
+
+func (r *driftReader) correct(row *object) *RowError {
+	d := r.step.d
+	v, _ := row.get(d.TimeColumn)
+	t, ok := timeValue(v, r.opts)
+	if !ok {
+		return &RowError{Row: row.line, Column: d.TimeColumn, Reason: fmt.Sprintf("value %v is not a valid time", v)}
+	}
+	offset := r.step.offset(t)
+	for _, column := range d.Columns {
+		v, _ := row.get(column)
+		f, present, number := qcNumber(v)
+		out := column + d.Suffix
+		if !present {
+			if d.Suffix != "" {
+				row.set(out, nil)
+			}
+			continue
+		}
+		if !number {
+			return &RowError{Row: row.line, Column: column, Reason: fmt.Sprintf("value %v is not a number", v)}
+		}
+		row.set(out, floatNumber(f+offset))
+	}
+	return nil
+}
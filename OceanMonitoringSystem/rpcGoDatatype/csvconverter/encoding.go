@@ -0,0 +1,154 @@
+package csvconverter
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// Text encodings accepted in Options.InputEncoding and Options.OutputEncoding.
+const (
+	EncodingUTF8        = "utf-8"
+	EncodingUTF16       = "utf-16"
+	EncodingUTF16LE     = "utf-16le"
+	EncodingUTF16BE     = "utf-16be"
+	EncodingLatin1      = "latin-1"
+	EncodingWindows1252 = "windows-1252"
+)
+
+const byteOrderMark = "\ufeff"
+
+func lookupEncoding(name string) (encoding.Encoding, error) {
+	switch strings.ToLower(strings.ReplaceAll(name, "_", "-")) {
+	case "", EncodingUTF8, "utf8":
+		return unicode.UTF8, nil
+	case EncodingUTF16, "utf16":
+		// Writes a little-endian BOM; reads honour whichever BOM is present.
+		return unicode.UTF16(unicode.LittleEndian, unicode.ExpectBOM), nil
+	case EncodingUTF16LE:
+		return unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), nil
+	case EncodingUTF16BE:
+		return unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM), nil
+	case EncodingLatin1, "latin1", "iso-8859-1":
+		return charmap.ISO8859_1, nil
+	case EncodingWindows1252, "cp1252":
+		return charmap.Windows1252, nil
+	}
+	return nil, fmt.Errorf("unsupported encoding %q", name)
+}
+
+// DetectEncoding guesses the encoding of data from its byte order mark or,
+// failing that, from the pattern of zero bytes typical for UTF-16 text.
+// Data that is neither UTF-16 nor valid UTF-8 is assumed to be Latin-1.
+func DetectEncoding(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xEF, 0xBB, 0xBF}):
+		return EncodingUTF8
+	case bytes.HasPrefix(data, []byte{0xFF, 0xFE}), bytes.HasPrefix(data, []byte{0xFE, 0xFF}):
+		return EncodingUTF16
+	}
+
+	sample := data
+	if len(sample) > 512 {
+		sample = sample[:512]
+	}
+	var evenZeros, oddZeros int
+	for i, b := range sample {
+		if b != 0 {
+			continue
+		}
+		if i%2 == 0 {
+			evenZeros++
+		} else {
+			oddZeros++
+		}
+	}
+	half := len(sample) / 2
+	switch {
+	case half > 0 && oddZeros*10 > half*3 && evenZeros*10 < half:
+		return EncodingUTF16LE
+	case half > 0 && evenZeros*10 > half*3 && oddZeros*10 < half:
+		return EncodingUTF16BE
+	case utf8.Valid(data):
+		return EncodingUTF8
+	}
+	return EncodingLatin1
+}
+
+// Decode transcodes data from the named encoding to UTF-8 and strips any
+// byte order mark. An empty name detects the encoding.
+func Decode(data []byte, name string) (string, error) {
+	if name == "" {
+		name = DetectEncoding(data)
+	}
+	enc, err := lookupEncoding(name)
+	if err != nil {
+		return "", optionError("InputEncoding", err)
+	}
+	decoded, err := enc.NewDecoder().Bytes(data)
+	if err != nil {
+		return "", fmt.Errorf("error decoding %s input: %v", name, err)
+	}
+	return strings.TrimPrefix(string(decoded), byteOrderMark), nil
+}
+
+// Encode transcodes UTF-8 text to the named encoding.
+func Encode(text string, name string) ([]byte, error) {
+	enc, err := lookupEncoding(name)
+	if err != nil {
+		return nil, optionError("OutputEncoding", err)
+	}
+	encoded, err := enc.NewEncoder().Bytes([]byte(text))
+	if err != nil {
+		return nil, fmt.Errorf("error encoding %s output: %v", name, err)
+	}
+	return encoded, nil
+}
+
+// decodeReader returns a reader of r's text as UTF-8, without any byte order
+// mark. An empty name means UTF-8.
+func decodeReader(r io.Reader, name string) (io.Reader, error) {
+	if !isUTF8(name) {
+		enc, err := lookupEncoding(name)
+		if err != nil {
+			return nil, err
+		}
+		r = transform.NewReader(r, enc.NewDecoder())
+	}
+	br := bufio.NewReader(r)
+	if b, err := br.Peek(len(byteOrderMark)); err == nil && string(b) == byteOrderMark {
+		br.Discard(len(byteOrderMark))
+	}
+	return br, nil
+}
+
+// encodeWriter returns a writer that transcodes the UTF-8 text written to it
+// to the named encoding. When the returned closer is not nil it must be
+// closed to flush the output.
+func encodeWriter(w io.Writer, name string) (io.Writer, io.Closer, error) {
+	if isUTF8(name) {
+		return w, nil, nil
+	}
+	enc, err := lookupEncoding(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	tw := transform.NewWriter(w, enc.NewEncoder())
+	return tw, tw, nil
+}
+
+func isUTF8(name string) bool {
+	switch strings.ToLower(name) {
+	case "", EncodingUTF8, "utf8":
+		return true
+	}
+	return false
+}
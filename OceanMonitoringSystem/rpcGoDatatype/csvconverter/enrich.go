@@ -0,0 +1,221 @@
+package csvconverter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Station fields an enrichment step can append.
+const (
+	FieldName        = "name"
+	FieldType        = "type"
+	FieldInstitution = "institution"
+	FieldLatitude    = "latitude"
+	FieldLongitude   = "longitude"
+	FieldDepth       = "depth"
+)
+
+// EnrichmentFields are the station fields in the order they are appended
+// by default.
+var EnrichmentFields = []string{FieldName, FieldType, FieldInstitution, FieldLatitude, FieldLongitude, FieldDepth}
+
+// StationInfo is the metadata of a station an enrichment step appends.
+type StationInfo struct {
+	Name, Type, Institution string
+	// Located reports whether Latitude, Longitude and Depth are known.
+	Located                    bool
+	Latitude, Longitude, Depth float64
+}
+
+// StationLookup returns the metadata of a station. A zero time asks for
+// its current metadata.
+type StationLookup func(station string, at time.Time) (StationInfo, bool)
+
+// Enrichment appends station metadata to the rows, joined on the station
+// ID in StationColumn. Rows of unknown stations get nulls.
+type Enrichment struct {
+	StationColumn string
+	// TimeColumn looks up each row's station at the row's time, so that
+	// positions follow the station's deployments; rows without a valid
+	// time get no position. Without it the current metadata is used.
+	TimeColumn string
+	// Fields are the fields to append, from EnrichmentFields. Empty appends
+	// them all.
+	Fields []string
+	// Prefix is prepended to the field names to name the new columns. It
+	// defaults to "station_".
+	Prefix string
+	// RequireStation makes rows of unknown stations row errors.
+	RequireStation bool
+	// Lookup finds the stations. The server sets it to its station
+	// registry.
+	Lookup StationLookup
+}
+
+// DefaultEnrichmentPrefix is the default Enrichment.Prefix.
+const DefaultEnrichmentPrefix = "station_"
+
+// enrichStep is a compiled Enrichment.
+type enrichStep struct {
+	e     Enrichment
+	added []string
+	// cache holds the lookups of the stations seen without a time column.
+	cache map[string]*StationInfo
+}
+
+func newEnrichStep(e Enrichment) (*enrichStep, error) {
+	if e.StationColumn == "" {
+		return nil, fmt.Errorf("enrichment needs a station column")
+	}
+	if e.Lookup == nil {
+		return nil, fmt.Errorf("enrichment needs a station registry")
+	}
+	if len(e.Fields) == 0 {
+		e.Fields = EnrichmentFields
+	}
+	if e.Prefix == "" {
+		e.Prefix = DefaultEnrichmentPrefix
+	}
+	s := &enrichStep{e: e, cache: make(map[string]*StationInfo)}
+	for i, field := range e.Fields {
+		if !hasColumn(EnrichmentFields, field) {
+			return nil, fmt.Errorf("unknown station field %q, expected one of %s", field, strings.Join(EnrichmentFields, ", "))
+		}
+		if hasColumn(e.Fields[:i], field) {
+			return nil, fmt.Errorf("station field %s is listed twice", field)
+		}
+		s.added = append(s.added, e.Prefix+field)
+	}
+	return s, nil
+}
+
+func (s *enrichStep) columns(in []string) ([]string, error) {
+	named := []string{s.e.StationColumn}
+	if s.e.TimeColumn != "" {
+		named = append(named, s.e.TimeColumn)
+	}
+	if err := checkColumns(named, in); err != nil {
+		return nil, fmt.Errorf("enrichment: %v", err)
+	}
+	out := append([]string(nil), in...)
+	for _, column := range s.added {
+		if hasColumn(in, column) {
+			return nil, fmt.Errorf("enrichment: column %q already exists", column)
+		}
+		out = append(out, column)
+	}
+	return out, nil
+}
+
+func (s *enrichStep) reader(src rowReader, columns []string, opts Options, result *Result) rowReader {
+	r := &enrichReader{src: src, step: s, opts: opts, result: result}
+	if columns != nil {
+		r.columns, _ = s.columns(columns)
+	}
+	return r
+}
+
+// enrichReader appends the station columns to the rows of src. It reads
+// the time column with the conversion options.
+type enrichReader struct {
+	src     rowReader
+	step    *enrichStep
+	opts    Options
+	result  *Result
+	columns []string
+}
+
+func (r *enrichReader) Columns() []string {
+	return r.columns
+}
+
+// Next returns the next enriched row, or io.EOF.
+func (r *enrichReader) Next() (*object, error) {
+	s := r.step
+	for {
+		row, err := r.src.Next()
+		if err != nil {
+			return nil, err
+		}
+		info := s.lookup(row, r.opts)
+		if info == nil && s.e.RequireStation {
+			v, _ := row.get(s.e.StationColumn)
+			rowErr := &RowError{Row: row.line, Column: s.e.StationColumn, Reason: fmt.Sprintf("station %v is not registered", v)}
+			if err := r.result.rowError(rowErr, r.opts); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		for i, field := range s.e.Fields {
+			var v interface{}
+			if info != nil {
+				v = info.value(field)
+			}
+			row.set(s.added[i], v)
+		}
+		return row, nil
+	}
+}
+
+// lookup finds the station of a row, or returns nil.
+func (s *enrichStep) lookup(row *object, opts Options) *StationInfo {
+	v, _ := row.get(s.e.StationColumn)
+	if v == nil {
+		return nil
+	}
+	station := strings.TrimSpace(fmt.Sprint(v))
+	if station == "" {
+		return nil
+	}
+	if s.e.TimeColumn == "" {
+		if info, ok := s.cache[station]; ok {
+			return info
+		}
+		var found *StationInfo
+		if info, ok := s.e.Lookup(station, time.Time{}); ok {
+			found = &info
+		}
+		s.cache[station] = found
+		return found
+	}
+	tv, _ := row.get(s.e.TimeColumn)
+	t, timed := timeValue(tv, opts)
+	info, ok := s.e.Lookup(station, t)
+	if !ok {
+		return nil
+	}
+	if !timed {
+		// Without a time the current position would be a guess.
+		info.Located = false
+	}
+	return &info
+}
+
+func (info *StationInfo) value(field string) interface{} {
+	switch field {
+	case FieldName:
+		return nullString(info.Name)
+	case FieldType:
+		return nullString(info.Type)
+	case FieldInstitution:
+		return nullString(info.Institution)
+	}
+	if !info.Located {
+		return nil
+	}
+	switch field {
+	case FieldLatitude:
+		return floatNumber(info.Latitude)
+	case FieldLongitude:
+		return floatNumber(info.Longitude)
+	}
+	return floatNumber(info.Depth)
+}
+
+func nullString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
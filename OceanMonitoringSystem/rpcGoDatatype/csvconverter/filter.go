@@ -0,0 +1,35 @@
+package csvconverter
+
+import (
+	"fmt"
+
+	"rpcGoDatatype/expr"
+)
+
+// compileFilter compiles Options.Filter, returning nil when no filter is set.
+func compileFilter(src string) (*expr.Expr, error) {
+	if src == "" {
+		return nil, nil
+	}
+	filter, err := expr.Compile(src)
+	if err != nil {
+		return nil, &OptionError{Option: "Filter", Reason: fmt.Sprintf("invalid filter: %v", err)}
+	}
+	return filter, nil
+}
+
+// keep reports whether a row passes the filter. Columns missing from the
+// row evaluate as null.
+func keep(filter *expr.Expr, item *object) (bool, error) {
+	if filter == nil {
+		return true, nil
+	}
+	ok, err := filter.EvalBool(func(name string) (interface{}, bool) {
+		v, _ := item.get(name)
+		return v, true
+	})
+	if err != nil {
+		return false, fmt.Errorf("filter: %v", err)
+	}
+	return ok, nil
+}
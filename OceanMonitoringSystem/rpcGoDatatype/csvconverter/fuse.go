@@ -0,0 +1,138 @@
+package csvconverter
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// timedRow is a row of a fused input with its time.
+type timedRow struct {
+	t   time.Time
+	row *object
+}
+
+// fuse appends to the rows of the first input the values of the other
+// inputs at the same times, see MergeFuse. Rows without a valid time are
+// skipped with a warning, except in the first input where they are kept
+// without the other inputs' values.
+func fuse(inputs []mergeInput, mopts MergeOptions, opts Options, result *Result) ([]*object, []string, error) {
+	tolerance, _ := time.ParseDuration(mopts.Tolerance)
+	base := inputs[0]
+	if !hasColumn(base.columns, mopts.TimeColumn) {
+		return nil, nil, fmt.Errorf("input %s: unknown time column %q", base.name, mopts.TimeColumn)
+	}
+	columns := append([]string(nil), base.columns...)
+	seen := make(map[string]bool)
+	for _, c := range columns {
+		seen[c] = true
+	}
+
+	times := make([]time.Time, len(base.rows))
+	timed := make([]bool, len(base.rows))
+	for j, row := range base.rows {
+		v, _ := row.get(mopts.TimeColumn)
+		if times[j], timed[j] = timeValue(v, opts); !timed[j] {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("input %s: row %d: invalid time %v, not fused", base.name, j+1, v))
+		}
+	}
+
+	for _, in := range inputs[1:] {
+		if !hasColumn(in.columns, mopts.TimeColumn) {
+			return nil, nil, fmt.Errorf("input %s: unknown time column %q", in.name, mopts.TimeColumn)
+		}
+		// names maps the input's columns to their names in the output.
+		var own, names []string
+		for _, c := range in.columns {
+			if c == mopts.TimeColumn {
+				continue
+			}
+			name := c
+			if seen[c] {
+				name = c + "_" + in.name
+				if seen[name] {
+					return nil, nil, fmt.Errorf("input %s: column %q already exists", in.name, name)
+				}
+			}
+			seen[name] = true
+			columns = append(columns, name)
+			own = append(own, c)
+			names = append(names, name)
+		}
+
+		var rows []timedRow
+		for j, row := range in.rows {
+			v, _ := row.get(mopts.TimeColumn)
+			t, ok := timeValue(v, opts)
+			if !ok {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("input %s: row %d: invalid time %v, skipped", in.name, j+1, v))
+				continue
+			}
+			rows = append(rows, timedRow{t: t, row: row})
+		}
+		sort.SliceStable(rows, func(i, j int) bool { return rows[i].t.Before(rows[j].t) })
+
+		for j, row := range base.rows {
+			if !timed[j] {
+				continue
+			}
+			t := times[j]
+			i := sort.Search(len(rows), func(i int) bool { return !rows[i].t.Before(t) })
+			var prev, next *timedRow
+			if i > 0 && t.Sub(rows[i-1].t) <= tolerance {
+				prev = &rows[i-1]
+			}
+			if i < len(rows) && rows[i].t.Sub(t) <= tolerance {
+				next = &rows[i]
+			}
+			switch {
+			case next != nil && next.t.Equal(t):
+				copyFused(row, next.row, own, names)
+			case mopts.Method == FuseLinear:
+				if prev != nil && next != nil {
+					interpolateFused(row, t, prev, next, own, names)
+				}
+			case prev != nil && (next == nil || t.Sub(prev.t) <= next.t.Sub(t)):
+				copyFused(row, prev.row, own, names)
+			case next != nil:
+				copyFused(row, next.row, own, names)
+			}
+		}
+	}
+
+	rows := make([]*object, len(base.rows))
+	for j, row := range base.rows {
+		rows[j] = row.project(columns)
+	}
+	return rows, columns, nil
+}
+
+func copyFused(dst, src *object, own, names []string) {
+	for k, c := range own {
+		v, _ := src.get(c)
+		dst.set(names[k], v)
+	}
+}
+
+// interpolateFused sets the values at t between two rows: numbers linearly,
+// other values from the nearer row. Values missing in either row are null.
+func interpolateFused(dst *object, t time.Time, prev, next *timedRow, own, names []string) {
+	frac := float64(t.Sub(prev.t)) / float64(next.t.Sub(prev.t))
+	for k, c := range own {
+		a, _ := prev.row.get(c)
+		b, _ := next.row.get(c)
+		fa, presentA, numberA := qcNumber(a)
+		fb, presentB, numberB := qcNumber(b)
+		var v interface{}
+		switch {
+		case !presentA || !presentB:
+		case numberA && numberB:
+			v = floatNumber(fa + frac*(fb-fa))
+		case frac <= 0.5:
+			v = a
+		default:
+			v = b
+		}
+		dst.set(names[k], v)
+	}
+}
@@ -0,0 +1,205 @@
+package csvconverter
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// GapOptions describe the sampling cadence DetectGaps checks rows against.
+type GapOptions struct {
+	// TimeColumn holds the time of each row.
+	TimeColumn string
+	// StationColumn splits the rows into one series per station. Without
+	// it all rows form one series.
+	StationColumn string
+	// Interval is the expected time between samples, as a Go duration
+	// such as "10m".
+	Interval string
+	// Tolerance is how late a sample may be before the time up to it is a
+	// gap, as a Go duration. It defaults to half the interval.
+	Tolerance string
+	// Start and End optionally bound the period every series should
+	// cover, so that missing samples at either end are gaps too.
+	Start, End string
+}
+
+// Gap is a period without samples. Start and End are the samples around
+// it, or the bounds of GapOptions for gaps at either end, and Missing the
+// number of samples expected in between.
+type Gap struct {
+	Station  string
+	Start    time.Time
+	End      time.Time
+	Duration time.Duration
+	Missing  int
+}
+
+// GapSeries summarizes one series: its first and last samples, the number
+// of distinct sample times and of samples missing from its gaps.
+type GapSeries struct {
+	Station string
+	First   time.Time
+	Last    time.Time
+	Samples int
+	Missing int
+}
+
+// GapReport is the outcome of DetectGaps. Gaps are ordered by series, in
+// order of their first row, then by time.
+type GapReport struct {
+	Rows     int
+	Gaps     []Gap
+	Series   []GapSeries
+	Warnings []string
+}
+
+// gapCadence is a parsed GapOptions.
+type gapCadence struct {
+	interval, tolerance time.Duration
+	start, end          time.Time
+	hasStart, hasEnd    bool
+}
+
+func (g GapOptions) cadence(opts Options) (*gapCadence, error) {
+	if g.TimeColumn == "" {
+		return nil, fmt.Errorf("gap detection needs a time column")
+	}
+	c := &gapCadence{}
+	var err error
+	if c.interval, err = time.ParseDuration(g.Interval); err != nil || c.interval <= 0 {
+		return nil, fmt.Errorf("invalid gap interval: %q", g.Interval)
+	}
+	c.tolerance = c.interval / 2
+	if g.Tolerance != "" {
+		if c.tolerance, err = time.ParseDuration(g.Tolerance); err != nil || c.tolerance < 0 {
+			return nil, fmt.Errorf("invalid gap tolerance: %q", g.Tolerance)
+		}
+	}
+	if g.Start != "" {
+		if c.start, c.hasStart = parseTimestamp(g.Start, true, opts); !c.hasStart {
+			return nil, fmt.Errorf("invalid gap start: %q", g.Start)
+		}
+	}
+	if g.End != "" {
+		if c.end, c.hasEnd = parseTimestamp(g.End, true, opts); !c.hasEnd {
+			return nil, fmt.Errorf("invalid gap end: %q", g.End)
+		}
+	}
+	if c.hasStart && c.hasEnd && c.end.Before(c.start) {
+		return nil, fmt.Errorf("gap end %s is before its start %s", g.End, g.Start)
+	}
+	return c, nil
+}
+
+// DetectGaps reads data with the same options as a conversion and reports
+// the periods each series goes without samples. Consecutive samples
+// further apart than the interval plus the tolerance leave a gap between
+// them; so do the bounds and the first or last sample when they are
+// further apart than the tolerance. Rows without a time are counted in a
+// warning.
+func DetectGaps(format, data string, gopts GapOptions, opts Options) (*GapReport, error) {
+	read, ok := readers[strings.ToLower(format)]
+	if !ok {
+		return nil, fmt.Errorf("%w format: %s", ErrUnsupported, format)
+	}
+	c, err := gopts.cadence(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	rows, columns, err := readRows(read, data, opts, result)
+	if err != nil {
+		return nil, err
+	}
+	named := []string{gopts.TimeColumn}
+	if gopts.StationColumn != "" {
+		named = append(named, gopts.StationColumn)
+	}
+	if columns != nil {
+		if err := checkColumns(named, columns); err != nil {
+			return nil, fmt.Errorf("invalid gap detection: %v", err)
+		}
+	}
+
+	series := make(map[string][]time.Time)
+	var stations []string
+	untimed := 0
+	for _, row := range rows {
+		name := ""
+		if gopts.StationColumn != "" {
+			if v, _ := row.get(gopts.StationColumn); v != nil {
+				name = fmt.Sprint(v)
+			}
+		}
+		v, _ := row.get(gopts.TimeColumn)
+		t, ok := timeValue(v, opts)
+		if !ok {
+			untimed++
+			continue
+		}
+		if _, ok := series[name]; !ok {
+			stations = append(stations, name)
+		}
+		series[name] = append(series[name], t)
+	}
+
+	report := &GapReport{Rows: len(rows), Warnings: result.Warnings}
+	if untimed > 0 {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("%d rows without a valid %s were ignored", untimed, gopts.TimeColumn))
+	}
+	for _, name := range stations {
+		gaps, s := c.gaps(name, series[name])
+		report.Gaps = append(report.Gaps, gaps...)
+		report.Series = append(report.Series, s)
+	}
+	return report, nil
+}
+
+// gaps finds the gaps of one series, whose times it sorts.
+func (c *gapCadence) gaps(station string, times []time.Time) ([]Gap, GapSeries) {
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	distinct := times[:1]
+	for _, t := range times[1:] {
+		if !t.Equal(distinct[len(distinct)-1]) {
+			distinct = append(distinct, t)
+		}
+	}
+	s := GapSeries{Station: station, First: distinct[0], Last: distinct[len(distinct)-1], Samples: len(distinct)}
+
+	var gaps []Gap
+	add := func(start, end time.Time, missing int) {
+		if missing < 1 {
+			missing = 1
+		}
+		gaps = append(gaps, Gap{Station: station, Start: start, End: end, Duration: end.Sub(start), Missing: missing})
+		s.Missing += missing
+	}
+	if c.hasStart {
+		if d := s.First.Sub(c.start); d > c.tolerance {
+			add(c.start, s.First, c.samples(d))
+		}
+	}
+	for i := 1; i < len(distinct); i++ {
+		if d := distinct[i].Sub(distinct[i-1]); d > c.interval+c.tolerance {
+			add(distinct[i-1], distinct[i], c.samples(d)-1)
+		}
+	}
+	if c.hasEnd {
+		if d := c.end.Sub(s.Last); d > c.tolerance {
+			add(s.Last, c.end, c.samples(d))
+		}
+	}
+	return gaps, s
+}
+
+// samples returns the number of intervals in d, rounded.
+func (c *gapCadence) samples(d time.Duration) int {
+	return int(math.Round(float64(d) / float64(c.interval)))
+}
@@ -0,0 +1,76 @@
+package csvconverter
+
+import (
+	"fmt"
+	"strings"
+
+	"rpcGoDatatype/coords"
+)
+
+// Geofence keeps the rows whose coordinates fall in a region, or drops
+// them when Exclude is set. Coordinates are read in decimal or DMS form;
+// rows without valid coordinates are outside every region.
+type Geofence struct {
+	LatitudeColumn  string
+	LongitudeColumn string
+	// The region is either BoundingBox or Polygon, a GeoJSON Polygon or
+	// MultiPolygon, or a Feature or FeatureCollection of them.
+	BoundingBox *coords.BoundingBox
+	Polygon     string
+	Exclude     bool
+}
+
+// geofenceStep is a compiled Geofence.
+type geofenceStep struct {
+	g      Geofence
+	region coords.Region
+}
+
+func newGeofenceStep(g Geofence) (*geofenceStep, error) {
+	if g.LatitudeColumn == "" || g.LongitudeColumn == "" {
+		return nil, fmt.Errorf("geofence needs a latitude and a longitude column")
+	}
+	s := &geofenceStep{g: g}
+	switch {
+	case g.BoundingBox != nil && g.Polygon != "":
+		return nil, fmt.Errorf("geofence takes a bounding box or a polygon, not both")
+	case g.BoundingBox != nil:
+		if err := g.BoundingBox.Validate(); err != nil {
+			return nil, err
+		}
+		s.region = *g.BoundingBox
+	case g.Polygon != "":
+		p, err := coords.ParseGeoJSON(g.Polygon)
+		if err != nil {
+			return nil, err
+		}
+		s.region = p
+	default:
+		return nil, fmt.Errorf("geofence needs a bounding box or a polygon")
+	}
+	return s, nil
+}
+
+func (s *geofenceStep) columns(in []string) ([]string, error) {
+	if err := checkColumns([]string{s.g.LatitudeColumn, s.g.LongitudeColumn}, in); err != nil {
+		return nil, fmt.Errorf("geofence: %v", err)
+	}
+	return in, nil
+}
+
+func (s *geofenceStep) apply(row *object) (bool, error) {
+	lat, ok := coordinate(row, s.g.LatitudeColumn, coords.Latitude)
+	lon, ok2 := coordinate(row, s.g.LongitudeColumn, coords.Longitude)
+	inside := ok && ok2 && s.region.Contains(lat, lon)
+	return inside != s.g.Exclude, nil
+}
+
+// coordinate reads a row's coordinate column in decimal or DMS form.
+func coordinate(row *object, column string, axis coords.Axis) (float64, bool) {
+	v, _ := row.get(column)
+	if v == nil {
+		return 0, false
+	}
+	f, err := coords.Parse(strings.TrimSpace(fmt.Sprint(v)), axis, "")
+	return f, err == nil
+}
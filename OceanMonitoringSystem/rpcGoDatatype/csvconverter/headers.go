@@ -0,0 +1,94 @@
+package csvconverter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Policies for repeated CSV header names accepted in Options.DuplicateHeaders.
+const (
+	DuplicateSuffix = "suffix"
+	DuplicateError  = "error"
+	DuplicateArray  = "array"
+)
+
+// resolveHeaders names blank header cells column_N and applies the duplicate
+// policy. With DuplicateArray the names are kept as they are and the returned
+// set lists the names whose values must be collected into arrays.
+func resolveHeaders(headers []string, policy string) ([]string, map[string]bool, error) {
+	resolved := make([]string, len(headers))
+	count := make(map[string]int)
+	for i, h := range headers {
+		h = strings.TrimSpace(h)
+		if h == "" {
+			h = fmt.Sprintf("column_%d", i+1)
+		}
+		resolved[i] = h
+		count[h]++
+	}
+
+	repeated := make(map[string]bool)
+	for h, n := range count {
+		if n > 1 {
+			repeated[h] = true
+		}
+	}
+	if len(repeated) == 0 {
+		return resolved, nil, nil
+	}
+
+	switch policy {
+	case DuplicateError:
+		for _, h := range resolved {
+			if repeated[h] {
+				return nil, nil, fmt.Errorf("duplicate header %q", h)
+			}
+		}
+	case DuplicateArray:
+		return resolved, repeated, nil
+	}
+
+	used := make(map[string]bool, len(resolved))
+	for _, h := range resolved {
+		used[h] = true
+	}
+	seen := make(map[string]bool)
+	for i, h := range resolved {
+		if !seen[h] {
+			seen[h] = true
+			continue
+		}
+		n := 2
+		for used[fmt.Sprintf("%s_%d", h, n)] {
+			n++
+		}
+		resolved[i] = fmt.Sprintf("%s_%d", h, n)
+		used[resolved[i]] = true
+	}
+	return resolved, nil, nil
+}
+
+// renameHeaders applies a rename map to the header names. Renaming a column
+// onto another existing column is an error.
+func renameHeaders(headers []string, rename map[string]string) ([]string, error) {
+	if len(rename) == 0 {
+		return headers, nil
+	}
+	renamed := make([]string, len(headers))
+	// seen records each output name and whether it came from a rename, so
+	// duplicates already present in the input are left to resolveHeaders.
+	seen := make(map[string]bool, len(headers))
+	for i, h := range headers {
+		wasRenamed := false
+		if to, ok := rename[h]; ok && to != "" && to != h {
+			h = to
+			wasRenamed = true
+		}
+		if prev, ok := seen[h]; ok && (prev || wasRenamed) {
+			return nil, fmt.Errorf("renaming produces duplicate column %q", h)
+		}
+		seen[h] = seen[h] || wasRenamed
+		renamed[i] = h
+	}
+	return renamed, nil
+}
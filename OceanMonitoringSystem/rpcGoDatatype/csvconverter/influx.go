@@ -0,0 +1,156 @@
+package csvconverter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"rpcGoDatatype/timeparse"
+)
+
+// Timestamp precisions of influx output.
+const (
+	PrecisionNanoseconds  = "ns"
+	PrecisionMicroseconds = "us"
+	PrecisionMilliseconds = "ms"
+	PrecisionSeconds      = "s"
+)
+
+var (
+	measurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `)
+	keyEscaper         = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+	stringEscaper      = strings.NewReplacer(`"`, `\"`, `\`, `\\`)
+)
+
+// influxRowWriter writes rows as InfluxDB line protocol, one point per row.
+type influxRowWriter struct {
+	w    io.Writer
+	opts Options
+	err  error
+	tags map[string]bool
+}
+
+func newInfluxWriter(w io.Writer, opts Options) rowWriter {
+	i := &influxRowWriter{w: w, opts: opts, tags: make(map[string]bool, len(opts.InfluxTags))}
+	if opts.InfluxMeasurement == "" {
+		i.err = fmt.Errorf("influx output needs a measurement")
+	}
+	for _, t := range opts.InfluxTags {
+		i.tags[t] = true
+	}
+	return i
+}
+
+// Write writes a row's tags, fields and timestamp. Numbers are written as
+// floats so that a column keeps one field type across rows.
+func (i *influxRowWriter) Write(row *object) error {
+	if i.err != nil {
+		return i.err
+	}
+	var b strings.Builder
+	b.WriteString(measurementEscaper.Replace(i.opts.InfluxMeasurement))
+	for _, k := range row.keys {
+		if !i.tags[k] {
+			continue
+		}
+		v, _ := row.get(k)
+		if v == nil || fmt.Sprint(v) == "" {
+			continue
+		}
+		fmt.Fprintf(&b, ",%s=%s", keyEscaper.Replace(k), keyEscaper.Replace(fmt.Sprint(v)))
+	}
+
+	fields := i.opts.InfluxFields
+	if len(fields) == 0 {
+		fields = row.keys
+	}
+	n := 0
+	for _, k := range fields {
+		if i.tags[k] || k == i.opts.InfluxTimeColumn {
+			continue
+		}
+		// Empty cells are left out like nulls, so that a numeric column
+		// does not get string values.
+		v, ok := row.get(k)
+		if !ok || v == nil || v == "" {
+			continue
+		}
+		sep := ","
+		if n == 0 {
+			sep = " "
+		}
+		n++
+		fmt.Fprintf(&b, "%s%s=%s", sep, keyEscaper.Replace(k), influxValue(v))
+	}
+	if n == 0 {
+		return &RowError{Row: row.line, Reason: "row has no field values"}
+	}
+
+	if col := i.opts.InfluxTimeColumn; col != "" {
+		if v, _ := row.get(col); v != nil {
+			t, err := i.timestamp(v)
+			if err != nil {
+				return &RowError{Row: row.line, Reason: err.Error()}
+			}
+			fmt.Fprintf(&b, " %d", t)
+		}
+	}
+	b.WriteByte('\n')
+	_, err := io.WriteString(i.w, b.String())
+	return err
+}
+
+// timestamp converts a time column value into an integer in the output
+// precision. Numbers are read as epoch timestamps.
+func (i *influxRowWriter) timestamp(v interface{}) (int64, error) {
+	var t time.Time
+	switch v := v.(type) {
+	case json.Number:
+		var err error
+		if t, err = timeparse.ParseEpoch(string(v)); err != nil {
+			return 0, err
+		}
+	default:
+		var ok bool
+		if t, ok = parseTimestamp(fmt.Sprint(v), true, i.opts); !ok {
+			return 0, fmt.Errorf("invalid timestamp %q", fmt.Sprint(v))
+		}
+	}
+	switch i.opts.InfluxPrecision {
+	case PrecisionSeconds:
+		return t.Unix(), nil
+	case PrecisionMilliseconds:
+		return t.UnixMilli(), nil
+	case PrecisionMicroseconds:
+		return t.UnixMicro(), nil
+	}
+	return t.UnixNano(), nil
+}
+
+// influxValue formats a field value: numbers as floats, booleans as is and
+// anything else as a quoted string, nested values as their JSON text.
+func influxValue(v interface{}) string {
+	switch v := v.(type) {
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return strconv.FormatFloat(f, 'g', -1, 64)
+		}
+		return `"` + stringEscaper.Replace(string(v)) + `"`
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return `"` + stringEscaper.Replace(v) + `"`
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		b = []byte(fmt.Sprint(v))
+	}
+	return `"` + stringEscaper.Replace(string(b)) + `"`
+}
+
+func (i *influxRowWriter) Close() error {
+	return i.err
+}
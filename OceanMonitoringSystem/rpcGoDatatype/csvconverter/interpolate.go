@@ -0,0 +1,349 @@
+package csvconverter
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+)
+
+// Interpolation methods accepted in Interpolation.Method.
+const (
+	InterpolateLinear      = "linear"
+	InterpolateNearest     = "nearest"
+	InterpolateForwardFill = "forward_fill"
+)
+
+// Interpolation fills missing values of numeric columns from the values
+// around them in time. Without an Interval, null and empty values are
+// filled in place. With one, each series is resampled onto a regular grid
+// of whole multiples of that interval, so a 10m grid falls on :00, :10 and
+// so on: the output has a row per grid time holding the time column, in
+// UTC RFC 3339, the station column and the interpolated columns.
+type Interpolation struct {
+	TimeColumn string
+	// StationColumn splits the rows into one series per station. Without
+	// it all rows form one series.
+	StationColumn string
+	Columns       []string
+	// Method is InterpolateLinear (the default), InterpolateNearest or
+	// InterpolateForwardFill.
+	Method string
+	// Interval is the grid spacing as a Go duration such as "10m".
+	Interval string
+	// MaxGap, a Go duration, leaves values missing when the samples they
+	// would be filled from are further apart, or for forward filling,
+	// further back. Empty fills gaps of any length.
+	MaxGap string
+}
+
+// interpolateStep is a compiled Interpolation.
+type interpolateStep struct {
+	ip               Interpolation
+	interval, maxGap time.Duration
+}
+
+func newInterpolateStep(ip Interpolation) (*interpolateStep, error) {
+	if ip.TimeColumn == "" || len(ip.Columns) == 0 {
+		return nil, fmt.Errorf("interpolation needs a time column and at least one column")
+	}
+	switch ip.Method {
+	case "":
+		ip.Method = InterpolateLinear
+	case InterpolateLinear, InterpolateNearest, InterpolateForwardFill:
+	default:
+		return nil, fmt.Errorf("unsupported interpolation method %q", ip.Method)
+	}
+	s := &interpolateStep{ip: ip}
+	var err error
+	if ip.Interval != "" {
+		if s.interval, err = time.ParseDuration(ip.Interval); err != nil || s.interval <= 0 {
+			return nil, fmt.Errorf("invalid interpolation interval: %q", ip.Interval)
+		}
+	}
+	if ip.MaxGap != "" {
+		if s.maxGap, err = time.ParseDuration(ip.MaxGap); err != nil || s.maxGap <= 0 {
+			return nil, fmt.Errorf("invalid interpolation max gap: %q", ip.MaxGap)
+		}
+	}
+	for i, column := range ip.Columns {
+		if column == ip.TimeColumn || column == ip.StationColumn || hasColumn(ip.Columns[:i], column) {
+			return nil, fmt.Errorf("column %s cannot be interpolated twice or as the time or station", column)
+		}
+	}
+	return s, nil
+}
+
+func hasColumn(columns []string, column string) bool {
+	for _, c := range columns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *interpolateStep) columns(in []string) ([]string, error) {
+	named := append([]string{s.ip.TimeColumn}, s.ip.Columns...)
+	if s.ip.StationColumn != "" {
+		named = append(named, s.ip.StationColumn)
+	}
+	if err := checkColumns(named, in); err != nil {
+		return nil, fmt.Errorf("interpolation: %v", err)
+	}
+	if s.interval == 0 {
+		return in, nil
+	}
+	return s.gridColumns(), nil
+}
+
+// gridColumns are the columns of resampled rows.
+func (s *interpolateStep) gridColumns() []string {
+	out := []string{s.ip.TimeColumn}
+	if s.ip.StationColumn != "" {
+		out = append(out, s.ip.StationColumn)
+	}
+	return append(out, s.ip.Columns...)
+}
+
+func (s *interpolateStep) reader(src rowReader, columns []string, opts Options, result *Result) rowReader {
+	r := &interpolateReader{src: src, step: s, opts: opts, result: result}
+	if columns != nil {
+		r.columns, _ = s.columns(columns)
+	}
+	return r
+}
+
+// interpolateReader fills the rows of src. Values are filled from the
+// samples after them too, so every row is read before the first is
+// returned.
+type interpolateReader struct {
+	src     rowReader
+	step    *interpolateStep
+	opts    Options
+	result  *Result
+	columns []string
+	rows    []*object
+	read    bool
+}
+
+func (r *interpolateReader) Columns() []string {
+	return r.columns
+}
+
+// Next returns the next filled or resampled row, or io.EOF.
+func (r *interpolateReader) Next() (*object, error) {
+	if !r.read {
+		if err := r.readAll(); err != nil {
+			return nil, err
+		}
+		r.read = true
+	}
+	if len(r.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := r.rows[0]
+	r.rows[0] = nil
+	r.rows = r.rows[1:]
+	return row, nil
+}
+
+// sample is a numeric value of a series at a time.
+type sample struct {
+	t time.Time
+	v float64
+}
+
+// interpolateSeries is the rows of one station.
+type interpolateSeries struct {
+	rows  []*object
+	times []time.Time
+	timed []bool
+}
+
+func (r *interpolateReader) readAll() error {
+	ip := r.step.ip
+	var all []*interpolateSeries
+	byName := make(map[string]*interpolateSeries)
+	untimed := 0
+	for {
+		row, err := r.src.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		name := ""
+		if ip.StationColumn != "" {
+			if v, _ := row.get(ip.StationColumn); v != nil {
+				name = fmt.Sprint(v)
+			}
+		}
+		se, ok := byName[name]
+		if !ok {
+			se = &interpolateSeries{}
+			byName[name] = se
+			all = append(all, se)
+		}
+		v, _ := row.get(ip.TimeColumn)
+		t, ok := timeValue(v, r.opts)
+		if !ok {
+			untimed++
+		}
+		r.rows = append(r.rows, row)
+		se.rows = append(se.rows, row)
+		se.times = append(se.times, t)
+		se.timed = append(se.timed, ok)
+	}
+	if untimed > 0 {
+		r.result.Warnings = append(r.result.Warnings, fmt.Sprintf("interpolation: %d rows without a valid %s were not interpolated", untimed, ip.TimeColumn))
+	}
+
+	if r.step.interval == 0 {
+		for _, se := range all {
+			for _, column := range ip.Columns {
+				r.fill(se, column)
+			}
+		}
+		return nil
+	}
+	var out []*object
+	for _, se := range all {
+		grid, err := r.grid(se, len(out))
+		if err != nil {
+			return err
+		}
+		out = append(out, grid...)
+	}
+	r.rows = out
+	return nil
+}
+
+// samples returns the numeric values of a column, sorted by time.
+func (se *interpolateSeries) samples(column string) []sample {
+	var out []sample
+	for i, row := range se.rows {
+		if !se.timed[i] {
+			continue
+		}
+		v, _ := row.get(column)
+		if f, _, number := qcNumber(v); number {
+			out = append(out, sample{se.times[i], f})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].t.Before(out[j].t) })
+	return out
+}
+
+// fill sets the missing values of a column in place.
+func (r *interpolateReader) fill(se *interpolateSeries, column string) {
+	samples := se.samples(column)
+	for i, row := range se.rows {
+		if !se.timed[i] {
+			continue
+		}
+		v, _ := row.get(column)
+		if _, present, _ := qcNumber(v); present {
+			continue
+		}
+		if f, ok := r.step.at(samples, se.times[i]); ok {
+			row.set(column, floatNumber(f))
+		}
+	}
+}
+
+// grid resamples a series. n is the number of rows resampled before it,
+// counted against Options.MaxRows.
+func (r *interpolateReader) grid(se *interpolateSeries, n int) ([]*object, error) {
+	ip := r.step.ip
+	samples := make([][]sample, len(ip.Columns))
+	var first, last time.Time
+	found := false
+	for i, column := range ip.Columns {
+		samples[i] = se.samples(column)
+		if s := samples[i]; len(s) > 0 {
+			if !found || s[0].t.Before(first) {
+				first = s[0].t
+			}
+			if !found || s[len(s)-1].t.After(last) {
+				last = s[len(s)-1].t
+			}
+			found = true
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+	step := r.step.interval
+	start := first.Truncate(step)
+	if start.Before(first) {
+		start = start.Add(step)
+	}
+	var out []*object
+	for t := start; !t.After(last); t = t.Add(step) {
+		n++
+		if r.opts.MaxRows > 0 && n > r.opts.MaxRows {
+			return nil, fmt.Errorf("%w: interpolation grid has more than %d rows", ErrLimitExceeded, r.opts.MaxRows)
+		}
+		row := newObjectSize(len(ip.Columns) + 2)
+		row.line = n
+		row.set(ip.TimeColumn, t.UTC().Format(time.RFC3339Nano))
+		if ip.StationColumn != "" {
+			if len(se.rows) > 0 {
+				v, _ := se.rows[0].get(ip.StationColumn)
+				row.set(ip.StationColumn, v)
+			}
+		}
+		for i, column := range ip.Columns {
+			var value interface{}
+			if f, ok := r.step.at(samples[i], t); ok {
+				value = floatNumber(f)
+			}
+			row.set(column, value)
+		}
+		out = append(out, row)
+	}
+	return out, nil
+}
+
+// at returns the value of a series at t, interpolated from its samples.
+func (s *interpolateStep) at(samples []sample, t time.Time) (float64, bool) {
+	i := sort.Search(len(samples), func(i int) bool { return !samples[i].t.Before(t) })
+	if i < len(samples) && samples[i].t.Equal(t) {
+		return samples[i].v, true
+	}
+	var prev, next *sample
+	if i > 0 {
+		prev = &samples[i-1]
+	}
+	if i < len(samples) {
+		next = &samples[i]
+	}
+	if s.ip.Method == InterpolateForwardFill {
+		if prev == nil || s.maxGap > 0 && t.Sub(prev.t) > s.maxGap {
+			return 0, false
+		}
+		return prev.v, true
+	}
+	if prev == nil || next == nil {
+		return 0, false
+	}
+	span := next.t.Sub(prev.t)
+	if s.maxGap > 0 && span > s.maxGap {
+		return 0, false
+	}
+	if s.ip.Method == InterpolateNearest {
+		if t.Sub(prev.t) <= next.t.Sub(t) {
+			return prev.v, true
+		}
+		return next.v, true
+	}
+	frac := float64(t.Sub(prev.t)) / float64(span)
+	v := prev.v + (next.v-prev.v)*frac
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return 0, false
+	}
+	return v, true
+}
@@ -1,59 +1,278 @@
 package csvconverter
 
 import (
+	"context"
 	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"strings"
+	"io"
+
+	"rpcGoDatatype/expr"
 )
 
 func ConvertJSONToCSV(jsonString string) (string, error) {
-	// Parse JSON array of objects
-	var data []map[string]interface{}
-	if err := json.Unmarshal([]byte(jsonString), &data); err != nil {
-		return "", fmt.Errorf("error parsing JSON: %v", err)
+	return ConvertJSONToCSVWithOptions(jsonString, Options{})
+}
+
+func ConvertJSONToCSVWithOptions(jsonString string, opts Options) (string, error) {
+	result, err := Convert("json", "csv", jsonString, opts)
+	if err != nil {
+		return "", err
+	}
+	return result.Output, nil
+}
+
+// ConvertJSONToCSVContext converts like ConvertJSONToCSVWithOptions,
+// stopping with ctx.Err() once ctx is done.
+func ConvertJSONToCSVContext(ctx context.Context, jsonString string, opts Options) (string, error) {
+	result, err := ConvertContext(ctx, "json", "csv", jsonString, opts)
+	if err != nil {
+		return "", err
 	}
+	return result.Output, nil
+}
+
+// ConvertJSONToCSVStream converts a JSON array read from r to CSV written
+// to w, see ConvertStream.
+func ConvertJSONToCSVStream(r io.Reader, w io.Writer, opts Options) error {
+	_, err := ConvertStream("json", "csv", r, w, opts)
+	return err
+}
+
+var errEmptyArray = errors.New("empty JSON array")
+
+// jsonRowReader parses a JSON array of objects one object at a time,
+// keeping key order and exact numbers, and applies renames, computed
+// columns and the row filter. JSON input has no fixed column set.
+type jsonRowReader struct {
+	decoder  *json.Decoder
+	opts     Options
+	result   *Result
+	computed []computedColumn
+	filter   *expr.Expr
+	started  bool
+	n        int
+	// width is the key count of the last object, used to size the next.
+	width int
+}
 
-	if len(data) == 0 {
-		return "", fmt.Errorf("empty JSON array")
+func newJSONReader(r io.Reader, opts Options, result *Result) (rowReader, error) {
+	computed, err := compileComputed(opts.ComputedColumns)
+	if err != nil {
+		return nil, err
 	}
+	filter, err := compileFilter(opts.Filter)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	return &jsonRowReader{decoder: decoder, opts: opts, result: result, computed: computed, filter: filter}, nil
+}
+
+func (j *jsonRowReader) Columns() []string {
+	return nil
+}
 
-	// Get headers from first object
-	headers := make([]string, 0)
-	for key := range data[0] {
-		headers = append(headers, key)
+// Next returns the next object that passes the filter, or io.EOF.
+func (j *jsonRowReader) Next() (*object, error) {
+	for {
+		item, err := j.decode()
+		if err == io.EOF || err == errEmptyArray {
+			return nil, err
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error parsing JSON: %v", err)
+		}
+
+		if err := item.rename(j.opts.Rename); err != nil {
+			if err := j.result.rowError(&RowError{Row: item.line, Reason: err.Error()}, j.opts); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if rowErr := compute(j.computed, item); rowErr != nil {
+			if err := j.result.rowError(rowErr, j.opts); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		ok, err := keep(j.filter, item)
+		if err != nil {
+			if err := j.result.rowError(&RowError{Row: item.line, Reason: err.Error()}, j.opts); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if ok {
+			return item, nil
+		}
 	}
+}
 
-	// Create CSV writer
-	var csvBuilder strings.Builder
-	writer := csv.NewWriter(&csvBuilder)
+func (j *jsonRowReader) decode() (*object, error) {
+	decoder := j.decoder
+	if !j.started {
+		if err := expectDelim(decoder, '['); err != nil {
+			return nil, err
+		}
+		j.started = true
+	}
 
-	// Write headers
-	if err := writer.Write(headers); err != nil {
-		return "", fmt.Errorf("error writing headers: %v", err)
+	if !decoder.More() {
+		if err := expectDelim(decoder, ']'); err != nil {
+			return nil, err
+		}
+		if _, err := decoder.Token(); err != io.EOF {
+			return nil, fmt.Errorf("unexpected data after JSON array")
+		}
+		if j.n == 0 {
+			return nil, errEmptyArray
+		}
+		return nil, io.EOF
 	}
 
-	// Write data rows
-	for _, item := range data {
-		row := make([]string, len(headers))
-		for i, header := range headers {
-			value := item[header]
-			// Convert value to string
-			if value == nil {
-				row[i] = ""
-			} else {
-				row[i] = fmt.Sprintf("%v", value)
+	obj, err := decodeObject(decoder, j.width)
+	if err != nil {
+		return nil, err
+	}
+	j.n++
+	j.width = len(obj.keys)
+	j.result.Stats.RowsRead++
+	obj.line = j.n
+	return obj, nil
+}
+
+// decodeObject decodes the next JSON object, keeping its key order. width
+// is the expected number of keys.
+func decodeObject(decoder *json.Decoder, width int) (*object, error) {
+	if err := expectDelim(decoder, '{'); err != nil {
+		return nil, err
+	}
+	obj := newObjectSize(width)
+	for decoder.More() {
+		tok, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected object key, got %v", tok)
+		}
+		value, err := decodeValue(decoder)
+		if err != nil {
+			return nil, err
+		}
+		obj.set(key, value)
+	}
+	if err := expectDelim(decoder, '}'); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// decodeValue decodes the next value like Decode into an interface{} would,
+// reading scalars as tokens to avoid Decode's reflection.
+func decodeValue(decoder *json.Decoder) (interface{}, error) {
+	tok, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('['):
+		list := []interface{}{}
+		for decoder.More() {
+			v, err := decodeValue(decoder)
+			if err != nil {
+				return nil, err
 			}
+			list = append(list, v)
 		}
-		if err := writer.Write(row); err != nil {
-			return "", fmt.Errorf("error writing row: %v", err)
+		_, err := decoder.Token()
+		return list, err
+	case json.Delim('{'):
+		m := map[string]interface{}{}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			v, err := decodeValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			m[key.(string)] = v
 		}
+		_, err := decoder.Token()
+		return m, err
 	}
+	return tok, nil
+}
 
-	writer.Flush()
-	if err := writer.Error(); err != nil {
-		return "", fmt.Errorf("error flushing CSV: %v", err)
+// csvRowWriter writes rows as CSV. The header is taken from the first row,
+// which is already projected to the requested columns.
+type csvRowWriter struct {
+	writer  *csv.Writer
+	opts    Options
+	headers []string
+	started bool
+	// row is reused for each row written.
+	row []string
+}
+
+func newCSVWriter(w io.Writer, opts Options) rowWriter {
+	return &csvRowWriter{writer: csv.NewWriter(w), opts: opts}
+}
+
+func (c *csvRowWriter) start(headers []string) error {
+	c.started = true
+	c.headers = headers
+	if c.opts.OmitHeader {
+		return nil
+	}
+	if err := c.writer.Write(headers); err != nil {
+		return fmt.Errorf("error writing headers: %v", err)
 	}
+	return nil
+}
 
-	return csvBuilder.String(), nil
+func (c *csvRowWriter) Write(item *object) error {
+	if !c.started {
+		if err := c.start(item.keys); err != nil {
+			return err
+		}
+	}
+	row := c.row[:0]
+	for _, header := range c.headers {
+		value, _ := item.get(header)
+		switch v := value.(type) {
+		case nil:
+			row = append(row, c.opts.NullOutput)
+		case string:
+			row = append(row, v)
+		case json.Number:
+			row = append(row, string(v))
+		default:
+			row = append(row, fmt.Sprintf("%v", value))
+		}
+	}
+	c.row = row
+	if err := c.writer.Write(row); err != nil {
+		return fmt.Errorf("error writing row: %v", err)
+	}
+	return nil
+}
+
+func (c *csvRowWriter) Close() error {
+	if !c.started {
+		if err := c.start(nil); err != nil {
+			return err
+		}
+	}
+	c.writer.Flush()
+	if err := c.writer.Error(); err != nil {
+		return fmt.Errorf("error flushing CSV: %v", err)
+	}
+	return nil
 }
@@ -0,0 +1,66 @@
+package csvconverter
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+)
+
+// ErrLimitExceeded is wrapped by the errors for input over
+// Options.MaxInputBytes, Options.MaxRows or Options.MaxColumns, and for
+// template output over its size limit.
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// checkSize fails when an input of n bytes is over Options.MaxInputBytes.
+func (o Options) checkSize(n int64) error {
+	if o.MaxInputBytes > 0 && n > o.MaxInputBytes {
+		return fmt.Errorf("%w: input is larger than %d bytes", ErrLimitExceeded, o.MaxInputBytes)
+	}
+	return nil
+}
+
+// checkRow fails when row, the n-th row of the input, is over
+// Options.MaxRows or Options.MaxColumns.
+func (o Options) checkRow(n int, row *object) error {
+	if o.MaxRows > 0 && n > o.MaxRows {
+		return fmt.Errorf("%w: input has more than %d rows", ErrLimitExceeded, o.MaxRows)
+	}
+	if o.MaxColumns > 0 && len(row.keys) > o.MaxColumns {
+		return fmt.Errorf("%w: row %d has %d columns, more than %d", ErrLimitExceeded, n, len(row.keys), o.MaxColumns)
+	}
+	return nil
+}
+
+// sizeLimiter stops reading once more than Options.MaxInputBytes have
+// been read. Readers wrap or rephrase read errors, so callers pass their
+// errors through check to report the limit. n is atomic as check can run
+// while workers read ahead.
+type sizeLimiter struct {
+	r    io.Reader
+	opts Options
+	n    atomic.Int64
+}
+
+func limitSize(r io.Reader, opts Options) *sizeLimiter {
+	return &sizeLimiter{r: r, opts: opts}
+}
+
+func (l *sizeLimiter) Read(p []byte) (int, error) {
+	if err := l.opts.checkSize(l.n.Load()); err != nil {
+		return 0, err
+	}
+	n, err := l.r.Read(p)
+	l.n.Add(int64(n))
+	return n, err
+}
+
+// check returns the limit error in place of err once the limit was hit.
+func (l *sizeLimiter) check(err error) error {
+	if err != nil {
+		if lerr := l.opts.checkSize(l.n.Load()); lerr != nil {
+			return lerr
+		}
+	}
+	return err
+}
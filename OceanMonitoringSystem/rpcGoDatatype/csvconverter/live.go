@@ -0,0 +1,98 @@
+package csvconverter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ConvertRows reads rows from r as they arrive and passes each one to emit
+// as a JSON object together with its input line, so a caller can forward
+// rows while r is still being written. Sorting, reshaping and
+// deduplication need every row and are not supported.
+//
+// The conversion stops with the first error from emit, and with ctx.Err()
+// once ctx is done. A blocked read on r is not interrupted; callers close
+// r to end it.
+func ConvertRows(ctx context.Context, from string, r io.Reader, opts Options, emit func(row []byte, line int) error) (*Result, error) {
+	read, ok := readers[strings.ToLower(from)]
+	if !ok {
+		return nil, fmt.Errorf("%w live conversion from %s", ErrUnsupported, from)
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if opts.needsAllRows() {
+		return nil, fmt.Errorf("sorting, reshaping and deduplication are not supported for live conversion")
+	}
+	opts = opts.withMode()
+
+	input := limitSize(r, opts)
+	r, err := decodeReader(input, opts.InputEncoding)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{}
+	src, err := read(r, opts, result)
+	if err != nil {
+		return nil, input.check(err)
+	}
+	if src, err = rangeReader(src, opts, result); err != nil {
+		return nil, err
+	}
+	if src, err = unitReader(src, opts, result); err != nil {
+		return nil, err
+	}
+	check, err := newSchemaCheck(opts, result)
+	if err != nil {
+		return nil, err
+	}
+	if columns := src.Columns(); columns != nil {
+		if err := checkColumns(opts.Columns, columns); err != nil {
+			return nil, optionError("Columns", err)
+		}
+	}
+
+	for n := 1; ; n++ {
+		// Rows arrive slowly on a live feed, so check every one.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		row, err := src.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, input.check(err)
+		}
+		if err := opts.checkRow(n, row); err != nil {
+			return nil, err
+		}
+		if ok, err := check.keep(row); err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+		if err := opts.checkMode(result.Warnings); err != nil {
+			return nil, err
+		}
+		if len(opts.Columns) > 0 {
+			row = row.project(opts.Columns)
+		}
+		b, err := row.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("error converting to JSON: %v", err)
+		}
+		if opts.Observe != nil {
+			opts.Observe(row.values)
+		}
+		if err := emit(b, row.line); err != nil {
+			return nil, err
+		}
+	}
+	if err := opts.checkMode(result.Warnings); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
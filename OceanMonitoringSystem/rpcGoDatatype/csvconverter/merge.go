@@ -0,0 +1,375 @@
+package csvconverter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Merge modes accepted in MergeOptions.Mode.
+const (
+	MergeConcat = "concat"
+	MergeJoin   = "join"
+	// MergeFuse aligns the inputs by time, see MergeOptions.TimeColumn.
+	MergeFuse = "fuse"
+)
+
+// Fusion methods accepted in MergeOptions.Method.
+const (
+	FuseNearest = "nearest"
+	FuseLinear  = "linear"
+)
+
+// Join types accepted in MergeOptions.Join.
+const (
+	JoinOuter = "outer"
+	JoinInner = "inner"
+	JoinLeft  = "left"
+)
+
+// Input is one dataset passed to Merge.
+type Input struct {
+	// Name identifies the input in warnings, suffixes and the source column.
+	// It defaults to the input's 1-based position.
+	Name   string
+	Format string
+	Data   string
+}
+
+// MergeOptions control how Merge combines its inputs.
+type MergeOptions struct {
+	// Mode is MergeConcat (the default) to append rows, or MergeJoin to
+	// combine rows sharing the same Keys.
+	Mode string
+	Keys []string
+	// Join selects which keys a join keeps: JoinOuter (the default) keeps
+	// every key, JoinInner only keys found in all inputs and JoinLeft only
+	// keys found in the first input.
+	Join string
+	// SourceColumn, when set, adds a column naming the input each row came
+	// from. It only applies to MergeConcat.
+	SourceColumn string
+	// TimeColumn, Tolerance and Method configure MergeFuse, which keeps the
+	// rows of the first input and appends the columns of the others at the
+	// same times. Method FuseNearest (the default) takes the row nearest in
+	// time; FuseLinear interpolates numbers between the rows before and
+	// after. Rows further than Tolerance, a Go duration such as "10m", are
+	// not used. Clashing columns are suffixed as for MergeJoin.
+	TimeColumn string
+	Tolerance  string
+	Method     string
+}
+
+func (m MergeOptions) validate() error {
+	switch m.Mode {
+	case "", MergeConcat:
+	case MergeJoin:
+		if len(m.Keys) == 0 {
+			return fmt.Errorf("join requires at least one key column")
+		}
+		switch m.Join {
+		case "", JoinOuter, JoinInner, JoinLeft:
+		default:
+			return fmt.Errorf("invalid join type: %q", m.Join)
+		}
+	case MergeFuse:
+		if m.TimeColumn == "" {
+			return fmt.Errorf("fusion requires a time column")
+		}
+		if d, err := time.ParseDuration(m.Tolerance); err != nil || d <= 0 {
+			return fmt.Errorf("invalid fusion tolerance: %q", m.Tolerance)
+		}
+		switch m.Method {
+		case "", FuseNearest, FuseLinear:
+		default:
+			return fmt.Errorf("invalid fusion method: %q", m.Method)
+		}
+	default:
+		return fmt.Errorf("invalid merge mode: %q", m.Mode)
+	}
+	return nil
+}
+
+// mergeInput holds an input after decoding.
+type mergeInput struct {
+	name    string
+	rows    []*object
+	columns []string
+}
+
+// Merge reads each input, combines them into one dataset and writes it in the
+// to format. Differing column sets are aligned, with missing values null.
+// The row filter, deduplication, reshaping, sorting and projection in opts
+// apply to the merged rows.
+func Merge(inputs []Input, to string, mopts MergeOptions, opts Options) (*Result, error) {
+	return MergeContext(context.Background(), inputs, to, mopts, opts)
+}
+
+// MergeContext merges like Merge, tracing the merge under ctx. The merge
+// stops with ctx.Err() once ctx is done.
+func MergeContext(ctx context.Context, inputs []Input, to string, mopts MergeOptions, opts Options) (merged *Result, err error) {
+	_, span := tracer.Start(ctx, "csvconverter.Merge", trace.WithAttributes(
+		attribute.String("csvconverter.to", to),
+		attribute.Int("csvconverter.inputs", len(inputs)),
+	))
+	defer func() { endSpan(span, err) }()
+
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("no inputs to merge")
+	}
+	if _, ok := writers[strings.ToLower(to)]; !ok {
+		return nil, fmt.Errorf("%w output format: %s", ErrUnsupported, to)
+	}
+	if err := mopts.validate(); err != nil {
+		return nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	opts = opts.withMode()
+	start := time.Now()
+
+	// Steps that may refer to columns of other inputs run after merging.
+	inputOpts := opts
+	inputOpts.Filter = ""
+	inputOpts.DedupKeys = nil
+	inputOpts.Reshape = ""
+
+	result := &Result{}
+	read := 0
+	decoded := make([]mergeInput, len(inputs))
+	for i, in := range inputs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		name := in.Name
+		if name == "" {
+			name = strconv.Itoa(i + 1)
+		}
+		newReader, ok := readers[strings.ToLower(in.Format)]
+		if !ok {
+			return nil, fmt.Errorf("input %s: %w format: %s", name, ErrUnsupported, in.Format)
+		}
+		rows, columns, err := readRows(newReader, in.Data, inputOpts, result)
+		if err != nil {
+			return nil, fmt.Errorf("input %s: %w", name, err)
+		}
+		if columns == nil {
+			columns = rowColumns(rows)
+		}
+		read += len(rows)
+		decoded[i] = mergeInput{name: name, rows: rows, columns: columns}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var (
+		rows    []*object
+		columns []string
+	)
+	switch mopts.Mode {
+	case MergeJoin:
+		rows, columns, err = join(decoded, mopts, result)
+	case MergeFuse:
+		rows, columns, err = fuse(decoded, mopts, inputOpts, result)
+	default:
+		rows, columns, err = concat(decoded, mopts.SourceColumn)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkColumns(opts.reshapeInputColumns(), columns); err != nil {
+		return nil, &OptionError{Option: "Reshape", Reason: fmt.Sprintf("invalid reshape: %v", err)}
+	}
+	if err := checkColumns(opts.DedupKeys, columns); err != nil {
+		return nil, &OptionError{Option: "DedupKeys", Reason: fmt.Sprintf("invalid dedup keys: %v", err)}
+	}
+	filter, err := compileFilter(opts.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if filter != nil {
+		if err := checkColumns(filter.Columns(), columns); err != nil {
+			return nil, &OptionError{Option: "Filter", Reason: fmt.Sprintf("invalid filter: %v", err)}
+		}
+		kept := rows[:0]
+		for i, row := range rows {
+			ok, err := keep(filter, row)
+			if err != nil {
+				return nil, fmt.Errorf("merged row %d: %v", i+1, err)
+			}
+			if ok {
+				kept = append(kept, row)
+			}
+		}
+		read -= len(rows) - len(kept)
+		rows = kept
+	}
+
+	if rows, err = transformRows(rows, columns, opts, result); err != nil {
+		return nil, err
+	}
+	stats := newStatsCollector(opts)
+	for _, row := range rows {
+		stats.add(row)
+	}
+	stats.finish(result, read, start)
+	if err := opts.checkMode(result.Warnings); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := encodeResult(result, rows, to, opts); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// rowColumns returns the union of the rows' keys in first-seen order.
+func rowColumns(rows []*object) []string {
+	var columns []string
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		for _, k := range row.keys {
+			if !seen[k] {
+				seen[k] = true
+				columns = append(columns, k)
+			}
+		}
+	}
+	return columns
+}
+
+// concat appends the rows of every input, aligned to the union of their
+// columns.
+func concat(inputs []mergeInput, sourceColumn string) ([]*object, []string, error) {
+	var columns []string
+	seen := make(map[string]bool)
+	if sourceColumn != "" {
+		columns = append(columns, sourceColumn)
+		seen[sourceColumn] = true
+	}
+	for _, in := range inputs {
+		for _, c := range in.columns {
+			if c == sourceColumn {
+				return nil, nil, fmt.Errorf("input %s: source column %q already exists", in.name, c)
+			}
+			if !seen[c] {
+				seen[c] = true
+				columns = append(columns, c)
+			}
+		}
+	}
+
+	var rows []*object
+	for _, in := range inputs {
+		for _, row := range in.rows {
+			if sourceColumn != "" {
+				row.values[sourceColumn] = in.name
+			}
+			rows = append(rows, row.project(columns))
+		}
+	}
+	return rows, columns, nil
+}
+
+// join combines rows that share the same key values. Non-key columns that
+// clash with a column of an earlier input are suffixed with the input name.
+// When a key repeats within one input only its first row is used.
+func join(inputs []mergeInput, mopts MergeOptions, result *Result) ([]*object, []string, error) {
+	columns := append([]string(nil), mopts.Keys...)
+	seen := make(map[string]bool)
+	for _, k := range mopts.Keys {
+		seen[k] = true
+	}
+
+	var (
+		order []string
+		count = make(map[string]int)
+		byKey = make(map[string]*object)
+	)
+	for i, in := range inputs {
+		if err := checkColumns(mopts.Keys, in.columns); err != nil {
+			return nil, nil, fmt.Errorf("input %s: invalid join key: %v", in.name, err)
+		}
+
+		rename := make(map[string]string)
+		for _, c := range in.columns {
+			if isKey(c, mopts.Keys) {
+				continue
+			}
+			name := c
+			if seen[c] {
+				name = c + "_" + in.name
+				if seen[name] {
+					return nil, nil, fmt.Errorf("input %s: column %q already exists", in.name, name)
+				}
+				rename[c] = name
+			}
+			seen[name] = true
+			columns = append(columns, name)
+		}
+
+		found := make(map[string]bool)
+		for j, row := range in.rows {
+			b, err := json.Marshal(row.project(mopts.Keys))
+			if err != nil {
+				return nil, nil, fmt.Errorf("input %s: row %d: %v", in.name, j+1, err)
+			}
+			key := string(b)
+			if found[key] {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("input %s: row %d: duplicate key %s, keeping the first row", in.name, j+1, key))
+				continue
+			}
+			found[key] = true
+
+			merged, ok := byKey[key]
+			if !ok {
+				if i > 0 && mopts.Join == JoinLeft {
+					continue
+				}
+				merged = row.project(mopts.Keys)
+				byKey[key] = merged
+				order = append(order, key)
+			}
+			count[key]++
+			for _, c := range row.keys {
+				if isKey(c, mopts.Keys) {
+					continue
+				}
+				name := c
+				if r, ok := rename[c]; ok {
+					name = r
+				}
+				merged.set(name, row.values[c])
+			}
+		}
+	}
+
+	rows := make([]*object, 0, len(order))
+	for _, key := range order {
+		if mopts.Join == JoinInner && count[key] < len(inputs) {
+			continue
+		}
+		rows = append(rows, byKey[key].project(columns))
+	}
+	return rows, columns, nil
+}
+
+func isKey(column string, keys []string) bool {
+	for _, k := range keys {
+		if k == column {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package csvconverter
+
+import "fmt"
+
+// Conversion modes accepted in Options.Mode.
+const (
+	// ModeStrict fails on any anomaly, including those that would otherwise
+	// only raise a warning.
+	ModeStrict = "strict"
+	// ModeLenient converts what it can: short rows are padded and rows that
+	// cannot be converted are dropped and reported.
+	ModeLenient = "lenient"
+	// ModeAudit reads the input like ModeLenient but writes no output, so
+	// the result only holds the warnings and row errors.
+	ModeAudit = "audit"
+)
+
+// withMode returns the options with the settings implied by Options.Mode.
+func (o Options) withMode() Options {
+	switch o.Mode {
+	case ModeStrict:
+		o.JaggedRows = JaggedFail
+		o.SkipInvalidRows = false
+	case ModeLenient, ModeAudit:
+		if o.JaggedRows == "" || o.JaggedRows == JaggedFail {
+			o.JaggedRows = JaggedPad
+		}
+		o.SkipInvalidRows = true
+	}
+	return o
+}
+
+// checkMode fails a strict conversion that raised warnings.
+func (o Options) checkMode(warnings []string) error {
+	if o.Mode == ModeStrict && len(warnings) > 0 {
+		return fmt.Errorf("strict mode: %s", warnings[0])
+	}
+	return nil
+}
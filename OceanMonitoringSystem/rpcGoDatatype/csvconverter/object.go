@@ -0,0 +1,181 @@
+package csvconverter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// object is a JSON object that keeps its keys in a fixed order.
+type object struct {
+	keys   []string
+	values map[string]interface{}
+	// line is the CSV line or 1-based JSON object index the row was read
+	// from, or 0 for rows built by merging or reshaping.
+	line int
+}
+
+func newObject() *object {
+	return &object{values: make(map[string]interface{})}
+}
+
+// newObjectSize returns an object with room for n keys.
+func newObjectSize(n int) *object {
+	return &object{keys: make([]string, 0, n), values: make(map[string]interface{}, n)}
+}
+
+func (o *object) get(key string) (interface{}, bool) {
+	v, ok := o.values[key]
+	return v, ok
+}
+
+func (o *object) set(key string, value interface{}) {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+// rename renames keys in place, keeping their position.
+func (o *object) rename(rename map[string]string) error {
+	keys, err := renameHeaders(o.keys, rename)
+	if err != nil {
+		return err
+	}
+	for i, k := range o.keys {
+		if keys[i] != k {
+			o.values[keys[i]] = o.values[k]
+			delete(o.values, k)
+		}
+	}
+	o.keys = keys
+	return nil
+}
+
+// project returns an object holding only the given keys, in that order.
+func (o *object) project(keys []string) *object {
+	p := &object{keys: keys, values: make(map[string]interface{}, len(keys)), line: o.line}
+	for _, k := range keys {
+		p.values[k] = o.values[k]
+	}
+	return p
+}
+
+func (o *object) MarshalJSON() ([]byte, error) {
+	e := getEncoder()
+	defer putEncoder(e)
+	if err := e.object(o); err != nil {
+		return nil, err
+	}
+	return bytes.Clone(e.buf.Bytes()), nil
+}
+
+// encoder writes compact JSON into buf. Encoders are pooled so converting a
+// row does not allocate a buffer per key and value.
+type encoder struct {
+	buf bytes.Buffer
+	enc *json.Encoder
+}
+
+var encoders = sync.Pool{New: func() interface{} {
+	e := &encoder{}
+	e.enc = json.NewEncoder(&e.buf)
+	return e
+}}
+
+func getEncoder() *encoder {
+	e := encoders.Get().(*encoder)
+	e.buf.Reset()
+	return e
+}
+
+// putEncoder returns e to the pool unless its buffer grew large, so one
+// huge row does not pin its memory.
+func putEncoder(e *encoder) {
+	if e.buf.Cap() <= maxPooledBuffer {
+		encoders.Put(e)
+	}
+}
+
+const maxPooledBuffer = 64 << 10
+
+// object appends o. Plain strings and the scalars rows mostly hold are
+// written directly; other values go through the pooled json.Encoder, which
+// unlike json.Marshal does not allocate a result for each.
+func (e *encoder) object(o *object) error {
+	e.buf.WriteByte('{')
+	for i, k := range o.keys {
+		if i > 0 {
+			e.buf.WriteByte(',')
+		}
+		if err := e.string(k); err != nil {
+			return err
+		}
+		e.buf.WriteByte(':')
+		if err := e.value(o.values[k]); err != nil {
+			return err
+		}
+	}
+	e.buf.WriteByte('}')
+	return nil
+}
+
+func (e *encoder) value(v interface{}) error {
+	switch x := v.(type) {
+	case nil:
+		e.buf.WriteString("null")
+		return nil
+	case bool:
+		if x {
+			e.buf.WriteString("true")
+		} else {
+			e.buf.WriteString("false")
+		}
+		return nil
+	case string:
+		return e.string(x)
+	case json.Number:
+		if isJSONNumber(string(x)) {
+			e.buf.WriteString(string(x))
+			return nil
+		}
+	case *object:
+		return e.object(x)
+	}
+	return e.encode(v)
+}
+
+// string writes s quoted, leaving strings that need escaping to the
+// json.Encoder.
+func (e *encoder) string(s string) error {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < 0x20 || c > 0x7e || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' {
+			return e.encode(s)
+		}
+	}
+	e.buf.WriteByte('"')
+	e.buf.WriteString(s)
+	e.buf.WriteByte('"')
+	return nil
+}
+
+func (e *encoder) encode(v interface{}) error {
+	if err := e.enc.Encode(v); err != nil {
+		return err
+	}
+	// Encode ends each value with a newline.
+	e.buf.Truncate(e.buf.Len() - 1)
+	return nil
+}
+
+func expectDelim(decoder *json.Decoder, want json.Delim) error {
+	tok, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != want {
+		return fmt.Errorf("expected %v, got %v", want, tok)
+	}
+	return nil
+}
@@ -0,0 +1,412 @@
+package csvconverter
+
+import "fmt"
+
+// Column types accepted in Options.ColumnTypes.
+const (
+	TypeString    = "string"
+	TypeNumber    = "number"
+	TypeTimestamp = "timestamp"
+	TypeBoolean   = "boolean"
+)
+
+// DefaultJSONIndent is the indentation of JSON output when
+// Options.JSONIndent is zero, and MaxJSONIndent the largest allowed.
+const (
+	DefaultJSONIndent = 2
+	MaxJSONIndent     = 16
+)
+
+// Treatments for NaN and Inf tokens accepted in Options.NonFiniteAs.
+const (
+	NonFiniteString = "string"
+	NonFiniteNull   = "null"
+)
+
+// Policies for rows whose field count differs from the header, accepted in
+// Options.JaggedRows.
+const (
+	JaggedFail     = "fail"
+	JaggedPad      = "pad"
+	JaggedTruncate = "truncate"
+	JaggedSkip     = "skip"
+)
+
+// Options controls how values are interpreted during conversion.
+type Options struct {
+	// DisableTypeInference keeps every value as a string.
+	DisableTypeInference bool
+	// StringColumns lists columns that are never coerced to numbers.
+	StringColumns []string
+	// ColumnTypes forces the type of individual columns.
+	ColumnTypes map[string]string
+	// StrictNumbers only accepts plain decimal syntax such as -12 or 4.50 as
+	// numbers; exponents, hex floats and signs like "+4" stay strings.
+	StrictNumbers bool
+	// NonFiniteAs decides whether NaN and Inf tokens become strings (the
+	// default) or nulls. They never become numbers.
+	NonFiniteAs string
+	// DetectTimestamps recognizes textual timestamps in inferred columns.
+	// Epoch values are only read as timestamps in "timestamp" columns.
+	DetectTimestamps bool
+	// NormalizeTimestamps rewrites detected timestamps as RFC 3339 in
+	// TargetTimezone. It implies DetectTimestamps.
+	NormalizeTimestamps bool
+	// SourceTimezone is the IANA zone, e.g. "Europe/Lisbon", of timestamps
+	// without an offset. TargetTimezone is the zone normalized timestamps
+	// are written in. Both default to UTC, and setting either implies
+	// NormalizeTimestamps.
+	SourceTimezone string
+	TargetTimezone string
+	// InferBooleans makes JSON booleans of the CSV columns whose non-null
+	// values are all true/false, yes/no or 0/1, in any case. Columns with
+	// other values, or typed by ColumnTypes, are left alone. The whole
+	// input is read before the first row is converted.
+	InferBooleans bool
+	// NullValues lists tokens that mean null, e.g. "NA" or "-999". Numeric
+	// tokens also match equal numbers written differently, such as "-999.0".
+	NullValues []string
+	// NullOutput is written to CSV in place of null values.
+	NullOutput string
+	// CompactJSON writes JSON output without whitespace. Otherwise each
+	// level is indented by JSONIndent spaces, or DefaultJSONIndent when zero.
+	CompactJSON bool
+	JSONIndent  int
+	// SkipLines drops a fixed number of lines before the CSV header.
+	SkipLines int
+	// CommentPrefix marks comment lines, e.g. "#". Comment and blank lines
+	// before the header are skipped; single-rune prefixes also skip comment
+	// lines between rows.
+	CommentPrefix string
+	// CaptureMetadata returns the skipped preamble lines and the key/value
+	// pairs found in them with the result.
+	CaptureMetadata bool
+	// NoHeader treats the first CSV row as data. Columns are named by
+	// Headers or, when that is empty, column_1..column_n.
+	NoHeader bool
+	// Headers supplies column names for CSV input, replacing the header row
+	// unless NoHeader is set.
+	Headers []string
+	// DuplicateHeaders decides what happens to repeated header names:
+	// "suffix" (the default) renames them temp, temp_2, ..., "error" rejects
+	// the input and "array" collects their values into a JSON array.
+	DuplicateHeaders string
+	// JaggedRows decides how rows with too few or too many fields are
+	// handled: "fail" (the default), "pad" short rows with nulls, "truncate"
+	// long rows, or "skip" them with a warning.
+	JaggedRows string
+	// SkipInvalidRows drops rows that cannot be converted instead of
+	// failing, and reports them in Result.RowErrors. It overrides the
+	// "fail" jagged row policy.
+	SkipInvalidRows bool
+	// Mode is "strict", "lenient" or "audit", see ModeStrict. It overrides
+	// JaggedRows and SkipInvalidRows. By default those options apply as set.
+	Mode string
+	// Rename maps input column names to output names. It is applied before
+	// any other option, so the remaining options refer to the new names.
+	Rename map[string]string
+	// ComputedColumns adds columns, or replaces existing ones, with the value
+	// of an expression, each written as "name = expression", e.g.
+	// "speed = sqrt(u^2 + v^2)". They are computed in order after renaming
+	// and before filtering, so later definitions and the filter can use
+	// them. See package expr.
+	ComputedColumns []string
+	// Filter keeps only the rows for which the expression is true, e.g.
+	// `temperature > 4 && station == "B12"`. See package expr.
+	Filter string
+	// Units lists at most one unit per quantity, e.g. ["degF", "knots"].
+	// Columns whose name is annotated with another unit of that quantity,
+	// as in temp_degC, "temp (degC)" or "temp [°C]", are converted to it
+	// and renamed to match, temp_degF. This runs after Filter and before
+	// any pipeline steps.
+	Units []string
+	// Ranges bound the acceptable values of columns, e.g. temp between -2
+	// and 40. Values outside their range are treated as OutOfRange says:
+	// "flag" (the default) adds a boolean column named by RangeFlagSuffix,
+	// "null" replaces the value and "drop" drops the row. Ranges are
+	// checked after Filter and before Units, so they are in the input's
+	// units.
+	Ranges     []RangeRule
+	OutOfRange string
+	// SensorType adds the ranges SensorRanges holds for it, typically
+	// configured by the server. Ranges replace those of the same column.
+	SensorType   string
+	SensorRanges map[string][]RangeRule
+	// Schema is a JSON Schema that every row, seen as an object, must
+	// satisfy. The first violation rejects the input.
+	Schema string
+	// Deduplicate drops rows identical to an earlier row.
+	Deduplicate bool
+	// DedupKeys drops rows whose key columns repeat an earlier row, e.g.
+	// station and timestamp. Setting it implies Deduplicate.
+	DedupKeys []string
+	// Reshape converts between long and wide layouts: "pivot" turns rows of
+	// (id columns, name, value) into one column per name, "unpivot" does the
+	// reverse. Sorting and column selection apply to the reshaped rows.
+	Reshape string
+	// ReshapeIDColumns identify an observation, e.g. station and timestamp.
+	ReshapeIDColumns []string
+	// ReshapeNameColumn and ReshapeValueColumn name the long-format columns;
+	// they default to "parameter" and "value".
+	ReshapeNameColumn  string
+	ReshapeValueColumn string
+	// UnpivotColumns limits unpivoting to these columns. By default every
+	// non-id column is unpivoted.
+	UnpivotColumns []string
+	// SortBy orders the output rows by one or more columns, each written as
+	// "column", "column asc" or "column desc".
+	SortBy []string
+	// Columns selects the columns to output and their order. By default all
+	// columns are written in input order.
+	Columns []string
+	// OmitHeader leaves the header row out of CSV output.
+	OmitHeader bool
+	// Template is the text/template executed for each row of "template"
+	// output. The row's values are addressed by column name, e.g.
+	// `{{.station}} {{fixed 1 .temp}}` or `{{index . "air temp"}}`, and
+	// nulls are given as NullOutput. Besides the built-ins, upper, lower,
+	// trim, fixed (n decimals) and pad (right-align to width n) are
+	// available; n is capped at 64. Each execution of a template may write
+	// at most 64 KiB.
+	Template string
+	// TemplateHeader and TemplateFooter are executed once before and after
+	// the rows, with .Columns and, for the footer, .Rows.
+	TemplateHeader string
+	TemplateFooter string
+	// InfluxMeasurement names the measurement of "influx" output. Columns
+	// in InfluxTags are written as tags, InfluxFields (by default every
+	// other column) as fields, and InfluxTimeColumn, a timestamp or epoch
+	// number, as the point's timestamp in InfluxPrecision: ns (the
+	// default), us, ms or s.
+	InfluxMeasurement string
+	InfluxTags        []string
+	InfluxFields      []string
+	InfluxTimeColumn  string
+	InfluxPrecision   string
+	// InputEncoding declares the encoding of byte input passed to
+	// ConvertBytes. When empty it is detected.
+	InputEncoding string
+	// OutputEncoding requests the output in another encoding than UTF-8.
+	OutputEncoding string
+	// YearPivot sets the century cut-off for two-digit years, see
+	// timeparse.Parser. Zero uses timeparse.DefaultPivot.
+	YearPivot int
+	// MaxInputBytes, MaxRows and MaxColumns reject larger inputs with an
+	// error wrapping ErrLimitExceeded. Zero means no limit.
+	MaxInputBytes int64
+	MaxRows       int
+	MaxColumns    int
+	// SpillThreshold is roughly the memory, in bytes, that sorting,
+	// deduplication and unpivoting hold rows in before writing them to
+	// temporary files in SpillDir, or the default directory for temporary
+	// files. Zero keeps every row in memory, as pivoting always does.
+	SpillThreshold int64
+	SpillDir       string
+	// Workers is the number of goroutines converting the values of CSV
+	// rows in ConvertStream and Convert. Rows are still read and written in
+	// input order. Zero or one converts on the calling goroutine.
+	Workers int
+	// Observe, when set, is called with every row output, including the
+	// rows ConvertRows emits, mapping its columns to their values. The map
+	// must not be kept after it returns.
+	Observe func(row map[string]interface{})
+}
+
+// OptionError reports an invalid option. Option is the name of the Options
+// field at fault.
+type OptionError struct {
+	Option string
+	Reason string
+}
+
+func (e *OptionError) Error() string {
+	return e.Reason
+}
+
+// optionError returns err as an OptionError for option, or nil.
+func optionError(option string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &OptionError{Option: option, Reason: err.Error()}
+}
+
+func (o Options) validate() error {
+	for column, typ := range o.ColumnTypes {
+		switch typ {
+		case TypeString, TypeNumber, TypeTimestamp, TypeBoolean:
+		default:
+			return &OptionError{Option: "ColumnTypes", Reason: fmt.Sprintf("unsupported type %q for column %q", typ, column)}
+		}
+	}
+	switch o.DuplicateHeaders {
+	case "", DuplicateSuffix, DuplicateError, DuplicateArray:
+	default:
+		return &OptionError{Option: "DuplicateHeaders", Reason: fmt.Sprintf("unsupported duplicate header policy %q", o.DuplicateHeaders)}
+	}
+	if o.SkipLines < 0 {
+		return &OptionError{Option: "SkipLines", Reason: "skip lines must not be negative"}
+	}
+	if o.JSONIndent < 0 || o.JSONIndent > MaxJSONIndent {
+		return &OptionError{Option: "JSONIndent", Reason: fmt.Sprintf("JSON indent must be between 0 and %d", MaxJSONIndent)}
+	}
+	for _, l := range []struct {
+		option string
+		value  int64
+	}{
+		{"MaxInputBytes", o.MaxInputBytes},
+		{"MaxRows", int64(o.MaxRows)},
+		{"MaxColumns", int64(o.MaxColumns)},
+	} {
+		if l.value < 0 {
+			return &OptionError{Option: l.option, Reason: "limits must not be negative"}
+		}
+	}
+	if o.Workers < 0 {
+		return &OptionError{Option: "Workers", Reason: "workers must not be negative"}
+	}
+	if o.SpillThreshold < 0 {
+		return &OptionError{Option: "SpillThreshold", Reason: "spill threshold must not be negative"}
+	}
+	for _, e := range []struct{ option, name string }{
+		{"InputEncoding", o.InputEncoding},
+		{"OutputEncoding", o.OutputEncoding},
+	} {
+		if e.name == "" {
+			continue
+		}
+		if _, err := lookupEncoding(e.name); err != nil {
+			return optionError(e.option, err)
+		}
+	}
+	for _, z := range []struct{ option, name string }{
+		{"SourceTimezone", o.SourceTimezone},
+		{"TargetTimezone", o.TargetTimezone},
+	} {
+		if _, err := loadLocation(z.name); err != nil {
+			return optionError(z.option, err)
+		}
+	}
+	switch o.JaggedRows {
+	case "", JaggedFail, JaggedPad, JaggedTruncate, JaggedSkip:
+	default:
+		return &OptionError{Option: "JaggedRows", Reason: fmt.Sprintf("unsupported jagged row policy %q", o.JaggedRows)}
+	}
+	if _, err := unitTargets(o.Units); err != nil {
+		return optionError("Units", err)
+	}
+	switch o.OutOfRange {
+	case "", OutOfRangeFlag, OutOfRangeNull, OutOfRangeDrop:
+	default:
+		return &OptionError{Option: "OutOfRange", Reason: fmt.Sprintf("unsupported out of range treatment %q", o.OutOfRange)}
+	}
+	if _, err := o.rangeRules(); err != nil {
+		return err
+	}
+	switch o.Reshape {
+	case "", ReshapePivot, ReshapeUnpivot:
+	default:
+		return &OptionError{Option: "Reshape", Reason: fmt.Sprintf("unsupported reshape %q", o.Reshape)}
+	}
+	switch o.Mode {
+	case "", ModeStrict, ModeLenient, ModeAudit:
+	default:
+		return &OptionError{Option: "Mode", Reason: fmt.Sprintf("unsupported mode %q", o.Mode)}
+	}
+	if _, _, _, err := parseTemplates(o); err != nil {
+		return err
+	}
+	switch o.InfluxPrecision {
+	case "", PrecisionNanoseconds, PrecisionMicroseconds, PrecisionMilliseconds, PrecisionSeconds:
+	default:
+		return &OptionError{Option: "InfluxPrecision", Reason: fmt.Sprintf("unsupported influx precision %q", o.InfluxPrecision)}
+	}
+	switch o.NonFiniteAs {
+	case "", NonFiniteString, NonFiniteNull:
+	default:
+		return &OptionError{Option: "NonFiniteAs", Reason: fmt.Sprintf("unsupported non-finite treatment %q", o.NonFiniteAs)}
+	}
+	return nil
+}
+
+// checkColumns verifies that every selected column exists in the input.
+func checkColumns(columns, headers []string) error {
+	known := make(map[string]bool, len(headers))
+	for _, h := range headers {
+		known[h] = true
+	}
+	for _, c := range columns {
+		if !known[c] {
+			return fmt.Errorf("unknown column %q", c)
+		}
+	}
+	return nil
+}
+
+// nullSet holds Options.NullValues with the numeric tokens parsed, so that
+// checking a value parses it at most once.
+type nullSet struct {
+	tokens  map[string]bool
+	numbers []float64
+}
+
+func newNullSet(values []string) *nullSet {
+	s := &nullSet{tokens: make(map[string]bool, len(values))}
+	for _, token := range values {
+		s.tokens[token] = true
+		if n, ok := parseNumber(token); ok {
+			s.tokens[n.String()] = true
+			if f, err := n.Float64(); err == nil {
+				s.numbers = append(s.numbers, f)
+			}
+		}
+	}
+	return s
+}
+
+// has reports whether value is one of the null tokens or, for a number,
+// equal to one of the numeric tokens.
+func (s *nullSet) has(value string) bool {
+	if len(s.tokens) == 0 {
+		return false
+	}
+	if s.tokens[value] {
+		return true
+	}
+	if len(s.numbers) == 0 {
+		return false
+	}
+	n, ok := parseNumber(value)
+	if !ok {
+		return false
+	}
+	if s.tokens[n.String()] {
+		return true
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return false
+	}
+	for _, x := range s.numbers {
+		if f == x {
+			return true
+		}
+	}
+	return false
+}
+
+// columnType returns the forced type of a column, or "" when it should be inferred.
+func (o Options) columnType(column string) string {
+	if typ, ok := o.ColumnTypes[column]; ok {
+		return typ
+	}
+	for _, c := range o.StringColumns {
+		if c == column {
+			return TypeString
+		}
+	}
+	if o.DisableTypeInference {
+		return TypeString
+	}
+	return ""
+}
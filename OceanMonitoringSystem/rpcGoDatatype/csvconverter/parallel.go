@@ -0,0 +1,126 @@
+package csvconverter
+
+import "sync"
+
+// parallelBatchSize is the number of records a worker builds at a time.
+const parallelBatchSize = 256
+
+// parallelRows reads CSV records on one goroutine, builds them on several
+// and hands the rows out in input order. Memory is bounded by the batches
+// in flight, a few per worker.
+type parallelRows struct {
+	src     *csvRowReader
+	batches chan *recordBatch
+	stop    chan struct{}
+	once    sync.Once
+	// wg tracks the reader and the workers, which may still use the input.
+	wg sync.WaitGroup
+
+	current *recordBatch
+	i       int
+}
+
+// recordBatch is a run of records. err ends the input after the records,
+// and done is closed once they are built.
+type recordBatch struct {
+	records []*csvRecord
+	err     error
+	done    chan struct{}
+}
+
+func newParallelRows(src *csvRowReader, workers int) *parallelRows {
+	p := &parallelRows{
+		src:     src,
+		batches: make(chan *recordBatch, workers),
+		stop:    make(chan struct{}),
+	}
+	work := make(chan *recordBatch, workers)
+	p.wg.Add(workers + 1)
+	go p.read(work)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer p.wg.Done()
+			for b := range work {
+				if !p.stopped() {
+					for _, rec := range b.records {
+						src.build(rec)
+					}
+				}
+				close(b.done)
+			}
+		}()
+	}
+	return p
+}
+
+// read batches the records and queues each batch both for a worker and,
+// in order, for Next.
+func (p *parallelRows) read(work chan<- *recordBatch) {
+	defer p.wg.Done()
+	defer close(work)
+	for {
+		b := &recordBatch{done: make(chan struct{})}
+		for len(b.records) < parallelBatchSize {
+			if p.stopped() {
+				return
+			}
+			rec, err := p.src.read()
+			if err != nil {
+				b.err = err
+				break
+			}
+			b.records = append(b.records, rec)
+		}
+		select {
+		case work <- b:
+		case <-p.stop:
+			return
+		}
+		select {
+		case p.batches <- b:
+		case <-p.stop:
+			return
+		}
+		if b.err != nil {
+			return
+		}
+	}
+}
+
+func (p *parallelRows) Columns() []string {
+	return p.src.Columns()
+}
+
+func (p *parallelRows) Next() (*object, error) {
+	for {
+		if p.current == nil || p.i == len(p.current.records) {
+			if p.current != nil && p.current.err != nil {
+				return nil, p.current.err
+			}
+			p.current, p.i = <-p.batches, 0
+			<-p.current.done
+			continue
+		}
+		rec := p.current.records[p.i]
+		p.i++
+		if row, err := p.src.accept(rec); row != nil || err != nil {
+			return row, err
+		}
+	}
+}
+
+func (p *parallelRows) stopped() bool {
+	select {
+	case <-p.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+// close stops reading ahead and waits for the reader and the workers to
+// exit, so that the input may be released once it returns.
+func (p *parallelRows) close() {
+	p.once.Do(func() { close(p.stop) })
+	p.wg.Wait()
+}
@@ -0,0 +1,72 @@
+package csvconverter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+)
+
+// newParquetReader reads a Parquet file. The file's footer comes last, so
+// the whole input is read into memory first.
+func newParquetReader(r io.Reader, opts Options, result *Result) (rowReader, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading input: %v", err)
+	}
+	pf, err := file.NewParquetReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error reading Parquet file: %v", err)
+	}
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{BatchSize: arrowBatchSize}, memory.DefaultAllocator)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Parquet file: %v", err)
+	}
+	schema, err := fr.Schema()
+	if err != nil {
+		return nil, fmt.Errorf("error reading Parquet file: %v", err)
+	}
+	if len(schema.Fields()) == 0 {
+		// The record reader needs a column to read.
+		records, err := array.NewRecordReader(schema, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error reading Parquet file: %v", err)
+		}
+		return newRecordRows(records, "Parquet file", opts, result)
+	}
+	records, err := fr.GetRecordReader(context.Background(), nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Parquet file: %v", err)
+	}
+	return newRecordRows(records, "Parquet file", opts, result)
+}
+
+func newParquetWriter(w io.Writer, opts Options) rowWriter {
+	return &recordRowWriter{opts: opts, open: func(schema *arrow.Schema) (recordSink, error) {
+		props := parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy))
+		// The file writer closes a sink that is an io.Closer; w is not ours
+		// to close.
+		fw, err := pqarrow.NewFileWriter(schema, struct{ io.Writer }{w}, props, pqarrow.DefaultWriterProps())
+		if err != nil {
+			return nil, fmt.Errorf("error writing Parquet file: %v", err)
+		}
+		return parquetSink{fw}, nil
+	}}
+}
+
+// parquetSink writes each record batch as a row group of a Parquet file.
+type parquetSink struct {
+	w *pqarrow.FileWriter
+}
+
+func (s parquetSink) write(rec arrow.Record) error { return s.w.Write(rec) }
+
+func (s parquetSink) close() error { return s.w.Close() }
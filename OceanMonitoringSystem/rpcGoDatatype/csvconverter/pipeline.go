@@ -0,0 +1,484 @@
+package csvconverter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"rpcGoDatatype/expr"
+)
+
+// Pipeline step types accepted in Step.Type.
+const (
+	StepFilter       = "filter"
+	StepRename       = "rename"
+	StepConvertUnits = "convert_units"
+	StepCompute      = "compute"
+	// StepQualityControl appends QARTOD flag columns, see QualityControl.
+	StepQualityControl = "quality_control"
+	// StepDetectAnomalies appends anomaly flag columns, see
+	// AnomalyDetection.
+	StepDetectAnomalies = "detect_anomalies"
+	// StepInterpolate fills missing values, see Interpolation.
+	StepInterpolate = "interpolate"
+	// StepAggregate replaces the rows with time window aggregates, see
+	// Aggregation.
+	StepAggregate = "aggregate"
+	// StepSmooth replaces values with rolling window means or medians, see
+	// Smoothing.
+	StepSmooth = "smooth"
+	// StepDepthBin replaces the rows with depth bin means, see
+	// DepthBinning.
+	StepDepthBin = "depth_bin"
+	// StepConvertCoordinates converts and validates coordinate columns,
+	// see Coordinates.
+	StepConvertCoordinates = "convert_coordinates"
+	// StepGeofence keeps or drops the rows in a region, see Geofence.
+	StepGeofence = "geofence"
+	// StepTrack appends distances and speeds between position fixes, see
+	// Track.
+	StepTrack = "track"
+	// StepEnrich appends station metadata from the station registry, see
+	// Enrichment.
+	StepEnrich = "enrich"
+	// StepCalibrate applies sensor calibration polynomials, see
+	// Calibration.
+	StepCalibrate = "calibrate"
+	// StepCorrectDrift removes sensor drift between calibration events,
+	// see DriftCorrection.
+	StepCorrectDrift = "correct_drift"
+)
+
+// Step is one transformation of a pipeline. Which fields apply depends on
+// Type.
+type Step struct {
+	Type string
+	// Filter keeps only the rows for which the expression is true.
+	Filter string
+	// Rename maps column names to new names.
+	Rename map[string]string
+	// Column is the column a unit conversion or computation writes.
+	Column string
+	// FromUnit and ToUnit convert the numbers of Column between units of the
+	// same quantity, e.g. degC to degF or m/s to knots. FromUnit defaults to
+	// the unit Column is annotated with, as in temp_degC, and the annotation
+	// is rewritten to ToUnit. Without a Column, every annotated column of
+	// ToUnit's quantity is converted, like Options.Units.
+	FromUnit string
+	ToUnit   string
+	// Expression computes Column, see Options.ComputedColumns.
+	Expression string
+	// QualityControl configures a quality control step.
+	QualityControl *QualityControl
+	// Anomalies configures an anomaly detection step.
+	Anomalies *AnomalyDetection
+	// Interpolation configures an interpolation step.
+	Interpolation *Interpolation
+	// Aggregation configures an aggregation step.
+	Aggregation *Aggregation
+	// Smoothing configures a smoothing step.
+	Smoothing *Smoothing
+	// DepthBinning configures a depth binning step.
+	DepthBinning *DepthBinning
+	// Coordinates configures a coordinate conversion step.
+	Coordinates *Coordinates
+	// Geofence configures a geofence step.
+	Geofence *Geofence
+	// Track configures a track step.
+	Track *Track
+	// Enrichment configures an enrichment step.
+	Enrichment *Enrichment
+	// Calibration configures a calibration step.
+	Calibration *Calibration
+	// Drift configures a drift correction step.
+	Drift *DriftCorrection
+}
+
+// rowStep is a compiled pipeline step. It is a rowTransform, or a
+// readerStep when it needs the rows after the one it transforms.
+type rowStep interface {
+	// columns checks the step against the incoming columns and returns the
+	// columns it produces.
+	columns(in []string) ([]string, error)
+}
+
+// rowTransform is a step applied to one row at a time.
+type rowTransform interface {
+	rowStep
+	// apply transforms a row in place; false drops the row.
+	apply(row *object) (bool, error)
+}
+
+// readerStep is a step that reads ahead. It wraps the reader of the rows
+// before it, which has been checked with columns.
+type readerStep interface {
+	rowStep
+	reader(src rowReader, columns []string, opts Options, result *Result) rowReader
+}
+
+// Pipeline parses data, runs the steps in order on each row and encodes the
+// rows in the target format, all in one pass. The target may be the input
+// format. The options apply as for Convert; Options.Rename and
+// Options.Filter run before the first step, and sorting and column
+// selection after the last.
+func Pipeline(from, to, data string, steps []Step, opts Options) (*Result, error) {
+	return PipelineContext(context.Background(), from, to, data, steps, opts)
+}
+
+// PipelineContext runs a pipeline like Pipeline, tracing it under ctx.
+func PipelineContext(ctx context.Context, from, to, data string, steps []Step, opts Options) (*Result, error) {
+	compiled := make([]rowStep, len(steps))
+	for i, s := range steps {
+		step, err := compileStep(s)
+		if err != nil {
+			return nil, fmt.Errorf("step %d: %v", i+1, err)
+		}
+		compiled[i] = step
+	}
+	if _, ok := externalConverter(from, to); ok {
+		if len(steps) > 0 {
+			return nil, fmt.Errorf("pipeline steps are not supported for the %s to %s converter", from, to)
+		}
+		return ConvertContext(ctx, from, to, data, opts)
+	}
+	return convert(ctx, from, to, data, opts, compiled)
+}
+
+func compileStep(s Step) (rowStep, error) {
+	switch s.Type {
+	case StepFilter:
+		filter, err := compileFilter(s.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if filter == nil {
+			return nil, fmt.Errorf("filter step needs an expression")
+		}
+		return filterStep{filter}, nil
+	case StepRename:
+		if len(s.Rename) == 0 {
+			return nil, fmt.Errorf("rename step needs at least one column")
+		}
+		return renameStep{s.Rename}, nil
+	case StepConvertUnits:
+		if s.Column == "" {
+			if s.FromUnit != "" {
+				return nil, fmt.Errorf("unit conversion step needs a column to convert from %s", s.FromUnit)
+			}
+			targets, err := unitTargets([]string{s.ToUnit})
+			if err != nil {
+				return nil, err
+			}
+			return newUnitsStep(targets), nil
+		}
+		return newUnitStep(s.Column, s.FromUnit, s.ToUnit)
+	case StepCompute:
+		if s.Column == "" {
+			return nil, fmt.Errorf("compute step needs a column")
+		}
+		c, err := parseComputed(s.Column + " = " + s.Expression)
+		if err != nil {
+			return nil, err
+		}
+		return computeStep{c}, nil
+	case StepQualityControl:
+		if s.QualityControl == nil {
+			return nil, fmt.Errorf("quality control step needs a configuration")
+		}
+		return newQCStep(*s.QualityControl)
+	case StepDetectAnomalies:
+		if s.Anomalies == nil {
+			return nil, fmt.Errorf("anomaly detection step needs a configuration")
+		}
+		return newAnomalyStep(*s.Anomalies)
+	case StepInterpolate:
+		if s.Interpolation == nil {
+			return nil, fmt.Errorf("interpolation step needs a configuration")
+		}
+		return newInterpolateStep(*s.Interpolation)
+	case StepAggregate:
+		if s.Aggregation == nil {
+			return nil, fmt.Errorf("aggregation step needs a configuration")
+		}
+		return newAggregateStep(*s.Aggregation)
+	case StepSmooth:
+		if s.Smoothing == nil {
+			return nil, fmt.Errorf("smoothing step needs a configuration")
+		}
+		return newSmoothStep(*s.Smoothing)
+	case StepDepthBin:
+		if s.DepthBinning == nil {
+			return nil, fmt.Errorf("depth binning step needs a configuration")
+		}
+		return newDepthBinStep(*s.DepthBinning)
+	case StepConvertCoordinates:
+		if s.Coordinates == nil {
+			return nil, fmt.Errorf("coordinate conversion step needs a configuration")
+		}
+		return newCoordinatesStep(*s.Coordinates)
+	case StepGeofence:
+		if s.Geofence == nil {
+			return nil, fmt.Errorf("geofence step needs a configuration")
+		}
+		return newGeofenceStep(*s.Geofence)
+	case StepTrack:
+		if s.Track == nil {
+			return nil, fmt.Errorf("track step needs a configuration")
+		}
+		return newTrackStep(*s.Track)
+	case StepEnrich:
+		if s.Enrichment == nil {
+			return nil, fmt.Errorf("enrichment step needs a configuration")
+		}
+		return newEnrichStep(*s.Enrichment)
+	case StepCalibrate:
+		if s.Calibration == nil {
+			return nil, fmt.Errorf("calibration step needs a configuration")
+		}
+		return newCalibrateStep(*s.Calibration)
+	case StepCorrectDrift:
+		if s.Drift == nil {
+			return nil, fmt.Errorf("drift correction step needs a configuration")
+		}
+		return newDriftStep(*s.Drift)
+	}
+	return nil, fmt.Errorf("unsupported step type %q", s.Type)
+}
+
+type filterStep struct {
+	filter *expr.Expr
+}
+
+func (s filterStep) columns(in []string) ([]string, error) {
+	if err := checkColumns(s.filter.Columns(), in); err != nil {
+		return nil, fmt.Errorf("invalid filter: %v", err)
+	}
+	return in, nil
+}
+
+func (s filterStep) apply(row *object) (bool, error) {
+	return keep(s.filter, row)
+}
+
+type renameStep struct {
+	rename map[string]string
+}
+
+func (s renameStep) columns(in []string) ([]string, error) {
+	return renameHeaders(in, s.rename)
+}
+
+func (s renameStep) apply(row *object) (bool, error) {
+	return true, row.rename(s.rename)
+}
+
+type unitStep struct {
+	column  string
+	convert func(float64) float64
+	// rename renames column when its unit annotation is rewritten.
+	rename map[string]string
+}
+
+// newUnitStep converts column from one unit to another, reading the unit
+// from the column's annotation when from is empty.
+func newUnitStep(column, from, to string) (*unitStep, error) {
+	start, end, annotated := columnUnit(column)
+	if from == "" {
+		if !annotated {
+			return nil, fmt.Errorf("column %q is not annotated with a unit", column)
+		}
+		from = column[start:end]
+	}
+	fn, err := unitConverter(from, to)
+	if err != nil {
+		return nil, err
+	}
+	s := &unitStep{column: column, convert: fn}
+	if annotated && sameUnit(column[start:end], from) && !sameUnit(from, to) {
+		s.rename = map[string]string{column: column[:start] + to + column[end:]}
+	}
+	return s, nil
+}
+
+func (s *unitStep) columns(in []string) ([]string, error) {
+	if err := checkColumns([]string{s.column}, in); err != nil {
+		return nil, err
+	}
+	return renameHeaders(in, s.rename)
+}
+
+func (s *unitStep) apply(row *object) (bool, error) {
+	if err := convertUnit(row, s.column, s.convert); err != nil {
+		return false, err
+	}
+	return true, row.rename(s.rename)
+}
+
+// convertUnit converts the number in a row's column in place. Missing,
+// null and empty values are left alone.
+func convertUnit(row *object, column string, convert func(float64) float64) error {
+	v, ok := row.get(column)
+	if s, isString := v.(string); !ok || v == nil || isString && strings.TrimSpace(s) == "" {
+		return nil
+	}
+	var f float64
+	var err error
+	switch n := v.(type) {
+	case json.Number:
+		f, err = n.Float64()
+	case string:
+		f, err = strconv.ParseFloat(strings.TrimSpace(n), 64)
+	default:
+		err = fmt.Errorf("not a number")
+	}
+	if err != nil {
+		return &RowError{Row: row.line, Column: column, Reason: fmt.Sprintf("value %v is not a number", v)}
+	}
+	row.set(column, floatNumber(convert(f)))
+	return nil
+}
+
+// unitsStep converts every column annotated with a unit to the target unit
+// of its quantity, and rewrites the annotation to match.
+type unitsStep struct {
+	targets map[string]string
+	// conversions caches the conversion of each column seen, nil for
+	// columns left alone, and rename the new names of converted columns.
+	conversions map[string]*unitStep
+	rename      map[string]string
+}
+
+func newUnitsStep(targets map[string]string) *unitsStep {
+	return &unitsStep{targets: targets, conversions: make(map[string]*unitStep), rename: make(map[string]string)}
+}
+
+func (s *unitsStep) conversion(column string) *unitStep {
+	c, ok := s.conversions[column]
+	if ok {
+		return c
+	}
+	if start, end, ok := columnUnit(column); ok {
+		from := column[start:end]
+		u, _ := lookupUnit(from)
+		if to, ok := s.targets[u.quantity]; ok && !sameUnit(from, to) {
+			// The units are known and of one quantity, so this cannot fail.
+			c, _ = newUnitStep(column, from, to)
+			s.rename[column] = c.rename[column]
+		}
+	}
+	s.conversions[column] = c
+	return c
+}
+
+func (s *unitsStep) columns(in []string) ([]string, error) {
+	for _, column := range in {
+		s.conversion(column)
+	}
+	return renameHeaders(in, s.rename)
+}
+
+func (s *unitsStep) apply(row *object) (bool, error) {
+	renamed := false
+	for _, column := range row.keys {
+		if c := s.conversion(column); c != nil {
+			if err := convertUnit(row, column, c.convert); err != nil {
+				return false, err
+			}
+			renamed = true
+		}
+	}
+	if !renamed {
+		return true, nil
+	}
+	return true, row.rename(s.rename)
+}
+
+type computeStep struct {
+	computed computedColumn
+}
+
+func (s computeStep) columns(in []string) ([]string, error) {
+	return computedHeaders([]computedColumn{s.computed}, in)
+}
+
+func (s computeStep) apply(row *object) (bool, error) {
+	if rowErr := compute([]computedColumn{s.computed}, row); rowErr != nil {
+		return false, rowErr
+	}
+	return true, nil
+}
+
+// stepReader runs pipeline steps on the rows of another reader.
+type stepReader struct {
+	src rowReader
+	// steps are numbered from first+1 in errors.
+	steps   []rowTransform
+	first   int
+	opts    Options
+	result  *Result
+	columns []string
+}
+
+// newStepReader chains the steps onto src. Runs of row transforms share
+// one stepReader; reader steps wrap the reader before them.
+func newStepReader(src rowReader, steps []rowStep, opts Options, result *Result) (rowReader, error) {
+	s := &stepReader{src: src, opts: opts, result: result, columns: src.Columns()}
+	for i, step := range steps {
+		var out []string
+		if s.columns != nil {
+			var err error
+			if out, err = step.columns(s.columns); err != nil {
+				return nil, fmt.Errorf("step %d: %w", i+1, err)
+			}
+		}
+		if r, ok := step.(readerStep); ok {
+			var src rowReader = s
+			if len(s.steps) == 0 {
+				src = s.src
+			}
+			s = &stepReader{src: r.reader(src, s.columns, opts, result), first: i + 1, opts: opts, result: result}
+		} else {
+			s.steps = append(s.steps, step.(rowTransform))
+		}
+		s.columns = out
+	}
+	if len(s.steps) == 0 {
+		return s.src, nil
+	}
+	return s, nil
+}
+
+func (s *stepReader) Columns() []string {
+	return s.columns
+}
+
+// Next returns the next row that passes every step, or io.EOF.
+func (s *stepReader) Next() (*object, error) {
+next:
+	for {
+		row, err := s.src.Next()
+		if err != nil {
+			return nil, err
+		}
+		for i, step := range s.steps {
+			ok, err := step.apply(row)
+			if err != nil {
+				rowErr, isRowErr := err.(*RowError)
+				if !isRowErr {
+					rowErr = &RowError{Row: row.line, Reason: err.Error()}
+				}
+				rowErr.Reason = fmt.Sprintf("step %d: %s", s.first+i+1, rowErr.Reason)
+				if err := s.result.rowError(rowErr, s.opts); err != nil {
+					return nil, err
+				}
+				continue next
+			}
+			if !ok {
+				continue next
+			}
+		}
+		return row, nil
+	}
+}
@@ -0,0 +1,68 @@
+package csvconverter
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// readPreamble reads the first skip lines and any following comment or
+// blank lines from r. It returns a reader for the remaining data and the
+// lines read.
+func readPreamble(r *bufio.Reader, skip int, commentPrefix string) (io.Reader, []string, error) {
+	var preamble []string
+	for {
+		raw, err := r.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, nil, err
+		}
+		if raw == "" {
+			return r, preamble, nil
+		}
+		line := strings.TrimSuffix(strings.TrimSuffix(raw, "\n"), "\r")
+		switch {
+		case skip > 0:
+			skip--
+		case commentPrefix != "" && strings.HasPrefix(line, commentPrefix):
+		case commentPrefix != "" && strings.TrimSpace(line) == "":
+		default:
+			return io.MultiReader(strings.NewReader(raw), r), preamble, nil
+		}
+		preamble = append(preamble, line)
+		if err == io.EOF {
+			return r, preamble, nil
+		}
+	}
+}
+
+// parseMetadata extracts "key: value" and "key=value" pairs from preamble
+// lines, ignoring the comment prefix.
+func parseMetadata(lines []string, commentPrefix string) map[string]string {
+	metadata := make(map[string]string)
+	for _, line := range lines {
+		if commentPrefix != "" {
+			line = strings.TrimPrefix(line, commentPrefix)
+		}
+		sep := strings.IndexAny(line, ":=")
+		if sep <= 0 {
+			continue
+		}
+		key := strings.TrimSpace(line[:sep])
+		if key == "" || strings.ContainsAny(key, ",;\t") {
+			continue
+		}
+		metadata[key] = strings.TrimSpace(line[sep+1:])
+	}
+	return metadata
+}
+
+// commentRune returns the rune csv.Reader should treat as a comment marker
+// for lines after the preamble, or 0 when the prefix is not a single rune.
+func commentRune(prefix string) rune {
+	r, size := utf8.DecodeRuneInString(prefix)
+	if size == 0 || size != len(prefix) || r == '"' || r == '\r' || r == '\n' || r == utf8.RuneError {
+		return 0
+	}
+	return r
+}
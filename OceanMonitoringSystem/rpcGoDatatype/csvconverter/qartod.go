@@ -0,0 +1,526 @@
+package csvconverter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// QARTOD quality flags written to the flag columns of QualityControl.
+const (
+	FlagPass         = 1
+	FlagNotEvaluated = 2
+	FlagSuspect      = 3
+	FlagFail         = 4
+	FlagMissing      = 9
+)
+
+// DefaultFlagSuffix is appended to a variable's name to name its flag
+// column, and to "location" for the location flag.
+const DefaultFlagSuffix = "_qc"
+
+// QualityControl configures QARTOD tests. Each tested variable gets a flag
+// column holding the worst result of its tests: FlagFail, FlagSuspect,
+// FlagPass, or FlagNotEvaluated when no test applied. Missing values,
+// null, empty or NaN, are flagged FlagMissing.
+type QualityControl struct {
+	// StationColumn identifies the station of each row. The rows of each
+	// station form a series of their own, tested in input order. Without
+	// it all rows form one series.
+	StationColumn string
+	// TimeColumn holds the time of each row, for the rate of change test.
+	TimeColumn string
+	// FlagSuffix names the flag columns, DefaultFlagSuffix when empty.
+	FlagSuffix string
+	// Tests configure the tests of each variable. A test naming a Station
+	// replaces, for that station, the test of the same column without one.
+	Tests []QCTest
+	// Locations configure the location test the same way.
+	Locations []LocationTest
+}
+
+// QCTest configures the tests of one variable. Unset tests are skipped.
+type QCTest struct {
+	Station string
+	Column  string
+	// GrossRange fails values outside the sensor's range and flags values
+	// outside the expected range as suspect.
+	GrossRange *GrossRange
+	// Spike compares each value to the mean of its neighbours.
+	Spike *SpikeTest
+	// RateOfChange flags values as suspect when they changed from the
+	// previous one by more than this, per second with a TimeColumn and
+	// per row without. Zero skips the test.
+	RateOfChange float64
+	// FlatLine flags values repeating the previous ones.
+	FlatLine *FlatLineTest
+}
+
+// GrossRange holds the fail and suspect spans. A span whose minimum is not
+// below its maximum is not tested.
+type GrossRange struct {
+	FailMin, FailMax       float64
+	SuspectMin, SuspectMax float64
+}
+
+// SpikeTest flags a value departing from the mean of the values before and
+// after it by more than Suspect or Fail. Zero thresholds are not tested.
+type SpikeTest struct {
+	Suspect, Fail float64
+}
+
+// FlatLineTest flags a value as suspect once it and the values before it,
+// Suspect of them in all, stay within Tolerance of each other, and fails it
+// at Fail values. Zero counts are not tested.
+type FlatLineTest struct {
+	Suspect, Fail int
+	Tolerance     float64
+}
+
+// LocationTest fails positions that are not on the globe and flags those
+// outside the bounding box as suspect. A box whose minimum is not below
+// its maximum, in either axis, is not tested.
+type LocationTest struct {
+	Station         string
+	LatitudeColumn  string
+	LongitudeColumn string
+	MinLatitude     float64
+	MaxLatitude     float64
+	MinLongitude    float64
+	MaxLongitude    float64
+}
+
+// QualityControlContext converts data like Convert, appending the flag
+// columns of qc. It is a pipeline of one quality control step.
+func QualityControlContext(ctx context.Context, from, to, data string, qc QualityControl, opts Options) (*Result, error) {
+	return PipelineContext(ctx, from, to, data, []Step{{Type: StepQualityControl, QualityControl: &qc}}, opts)
+}
+
+// qcStep is a compiled QualityControl.
+type qcStep struct {
+	qc QualityControl
+	// variables are the tested columns and flags their flag columns, in
+	// the order the tests first name them. locationFlag is empty without
+	// location tests.
+	variables    []string
+	flags        []string
+	locationFlag string
+	tests        map[string]map[string]*QCTest
+	locations    map[string]*LocationTest
+}
+
+func newQCStep(qc QualityControl) (*qcStep, error) {
+	if len(qc.Tests) == 0 && len(qc.Locations) == 0 {
+		return nil, fmt.Errorf("quality control needs at least one test")
+	}
+	suffix := qc.FlagSuffix
+	if suffix == "" {
+		suffix = DefaultFlagSuffix
+	}
+	s := &qcStep{qc: qc, tests: make(map[string]map[string]*QCTest), locations: make(map[string]*LocationTest)}
+	for i := range qc.Tests {
+		t := &qc.Tests[i]
+		if t.Column == "" {
+			return nil, fmt.Errorf("quality control test %d needs a column", i+1)
+		}
+		if t.FlatLine != nil && (t.FlatLine.Suspect < 0 || t.FlatLine.Fail < 0 || t.FlatLine.Tolerance < 0) {
+			return nil, fmt.Errorf("flat line test of %s must not be negative", t.Column)
+		}
+		byStation, ok := s.tests[t.Column]
+		if !ok {
+			byStation = make(map[string]*QCTest)
+			s.tests[t.Column] = byStation
+			s.variables = append(s.variables, t.Column)
+			s.flags = append(s.flags, t.Column+suffix)
+		}
+		if _, ok := byStation[t.Station]; ok {
+			return nil, fmt.Errorf("column %s is tested twice for station %q", t.Column, t.Station)
+		}
+		byStation[t.Station] = t
+	}
+	for i := range qc.Locations {
+		l := &qc.Locations[i]
+		if l.LatitudeColumn == "" || l.LongitudeColumn == "" {
+			return nil, fmt.Errorf("location test %d needs latitude and longitude columns", i+1)
+		}
+		if _, ok := s.locations[l.Station]; ok {
+			return nil, fmt.Errorf("location is tested twice for station %q", l.Station)
+		}
+		s.locations[l.Station] = l
+		s.locationFlag = "location" + suffix
+	}
+	return s, nil
+}
+
+func (s *qcStep) columns(in []string) ([]string, error) {
+	used := []string{s.qc.StationColumn, s.qc.TimeColumn}
+	used = append(used, s.variables...)
+	for _, l := range s.qc.Locations {
+		used = append(used, l.LatitudeColumn, l.LongitudeColumn)
+	}
+	var named []string
+	for _, c := range used {
+		if c != "" {
+			named = append(named, c)
+		}
+	}
+	if err := checkColumns(named, in); err != nil {
+		return nil, fmt.Errorf("quality control: %v", err)
+	}
+	flags := s.flags
+	if s.locationFlag != "" {
+		flags = append(flags[:len(flags):len(flags)], s.locationFlag)
+	}
+	out := append([]string(nil), in...)
+	for _, f := range flags {
+		for _, c := range in {
+			if c == f {
+				return nil, fmt.Errorf("quality control: flag column %q already exists", f)
+			}
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+func (s *qcStep) reader(src rowReader, columns []string, opts Options, _ *Result) rowReader {
+	r := &qcReader{src: src, step: s, opts: opts, stations: make(map[string]*qcStation)}
+	if columns != nil {
+		r.columns, _ = s.columns(columns)
+	}
+	return r
+}
+
+// qcReader flags the rows of src. The spike test needs the next value of
+// a series, so each row waits for the next row of its station; rows are
+// still returned in input order.
+type qcReader struct {
+	src      rowReader
+	step     *qcStep
+	opts     Options
+	columns  []string
+	stations map[string]*qcStation
+	pending  []*qcRow
+	eof      bool
+}
+
+// qcStation holds the tests of a station's series and its state.
+type qcStation struct {
+	tests    []*QCTest
+	location *LocationTest
+	vars     []qcState
+	spikes   bool
+	// held is the last row, waiting for the next one.
+	held *qcRow
+}
+
+// qcState follows one variable of a series.
+type qcState struct {
+	last     float64
+	hasLast  bool
+	lastTime time.Time
+	hasTime  bool
+	// run counts the values within tolerance of runStart, which began it.
+	runStart float64
+	run      int
+}
+
+type qcRow struct {
+	row      *object
+	vars     []qcValue
+	location int
+	done     bool
+}
+
+// qcValue is one variable of a row: its value, whether it is a number,
+// the value before it, and its flag so far.
+type qcValue struct {
+	value, prev              float64
+	missing, number, hasPrev bool
+	flag                     int
+}
+
+func (r *qcReader) Columns() []string {
+	return r.columns
+}
+
+// Next returns the next row with its flags, or io.EOF.
+func (r *qcReader) Next() (*object, error) {
+	for {
+		if len(r.pending) > 0 && r.pending[0].done {
+			q := r.pending[0]
+			r.pending[0] = nil
+			r.pending = r.pending[1:]
+			r.finish(q)
+			return q.row, nil
+		}
+		if r.eof {
+			if len(r.pending) == 0 {
+				return nil, io.EOF
+			}
+			// The last rows have no next value to test spikes against.
+			for _, q := range r.pending {
+				q.done = true
+			}
+			continue
+		}
+		row, err := r.src.Next()
+		if err == io.EOF {
+			r.eof = true
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		r.add(row)
+	}
+}
+
+// station returns the series of a station, resolving its tests.
+func (r *qcReader) station(name string) *qcStation {
+	st, ok := r.stations[name]
+	if ok {
+		return st
+	}
+	s := r.step
+	st = &qcStation{tests: make([]*QCTest, len(s.variables)), vars: make([]qcState, len(s.variables))}
+	for i, column := range s.variables {
+		t, ok := s.tests[column][name]
+		if !ok {
+			t = s.tests[column][""]
+		}
+		st.tests[i] = t
+		st.spikes = st.spikes || t != nil && t.Spike != nil
+	}
+	if l, ok := s.locations[name]; ok {
+		st.location = l
+	} else {
+		st.location = s.locations[""]
+	}
+	r.stations[name] = st
+	return st
+}
+
+// add runs the tests that only look back on a row, and the spike test of
+// the station's previous row.
+func (r *qcReader) add(row *object) {
+	qc := r.step.qc
+	name := ""
+	if qc.StationColumn != "" {
+		if v, _ := row.get(qc.StationColumn); v != nil {
+			name = fmt.Sprint(v)
+		}
+	}
+	st := r.station(name)
+	q := &qcRow{row: row, vars: make([]qcValue, len(st.tests))}
+	var t time.Time
+	hasTime := false
+	if qc.TimeColumn != "" {
+		v, _ := row.get(qc.TimeColumn)
+		t, hasTime = timeValue(v, r.opts)
+	}
+
+	for i, test := range st.tests {
+		v := &q.vars[i]
+		state := &st.vars[i]
+		raw, _ := row.get(r.step.variables[i])
+		f, present, number := qcNumber(raw)
+		v.missing, v.number = !present, number
+		v.prev, v.hasPrev = state.last, state.hasLast
+		v.flag = FlagNotEvaluated
+		switch {
+		case test == nil || !present:
+		case !number:
+			v.flag = FlagFail
+		default:
+			v.value = f
+			v.flag = worse(v.flag, grossRange(test.GrossRange, f))
+			if test.RateOfChange > 0 && state.hasLast {
+				dt := 1.0
+				if qc.TimeColumn != "" {
+					dt = -1
+					if hasTime && state.hasTime {
+						dt = t.Sub(state.lastTime).Seconds()
+					}
+				}
+				if dt > 0 {
+					flag := FlagPass
+					if math.Abs(f-state.last)/dt > test.RateOfChange {
+						flag = FlagSuspect
+					}
+					v.flag = worse(v.flag, flag)
+				}
+			}
+			if test.FlatLine != nil {
+				v.flag = worse(v.flag, state.flatLine(test.FlatLine, f))
+			}
+		}
+		state.last, state.hasLast = f, present && number
+		state.lastTime, state.hasTime = t, hasTime
+		if !present || !number {
+			state.run = 0
+		}
+	}
+	if st.location != nil {
+		q.location = locationFlag(st.location, row)
+	}
+
+	if h := st.held; h != nil {
+		for i, test := range st.tests {
+			if test != nil && test.Spike != nil {
+				h.vars[i].flag = worse(h.vars[i].flag, spike(test.Spike, h.vars[i], q.vars[i]))
+			}
+		}
+		h.done = true
+		st.held = nil
+	}
+	if st.spikes {
+		st.held = q
+	} else {
+		q.done = true
+	}
+	r.pending = append(r.pending, q)
+}
+
+// finish writes a row's flags.
+func (r *qcReader) finish(q *qcRow) {
+	for i, flag := range r.step.flags {
+		v := q.vars[i]
+		f := v.flag
+		if v.missing {
+			f = FlagMissing
+		}
+		q.row.set(flag, json.Number(strconv.Itoa(f)))
+	}
+	if r.step.locationFlag != "" {
+		f := q.location
+		if f == 0 {
+			f = FlagNotEvaluated
+		}
+		q.row.set(r.step.locationFlag, json.Number(strconv.Itoa(f)))
+	}
+}
+
+// worse returns the worse of two flags, where any result beats
+// FlagNotEvaluated.
+func worse(a, b int) int {
+	rank := func(f int) int {
+		switch f {
+		case FlagPass:
+			return 1
+		case FlagSuspect:
+			return 2
+		case FlagFail:
+			return 3
+		}
+		return 0
+	}
+	if rank(b) > rank(a) {
+		return b
+	}
+	return a
+}
+
+// qcNumber reads a value to test. present is false for null, empty and NaN
+// values, and number false for values that are not numbers.
+func qcNumber(v interface{}) (f float64, present, number bool) {
+	var err error
+	switch x := v.(type) {
+	case nil:
+		return 0, false, false
+	case json.Number:
+		f, err = x.Float64()
+	case string:
+		s := strings.TrimSpace(x)
+		if s == "" {
+			return 0, false, false
+		}
+		f, err = strconv.ParseFloat(s, 64)
+	default:
+		return 0, true, false
+	}
+	if err != nil {
+		return 0, true, false
+	}
+	if math.IsNaN(f) {
+		return 0, false, false
+	}
+	return f, true, !math.IsInf(f, 0)
+}
+
+func grossRange(g *GrossRange, f float64) int {
+	if g == nil {
+		return FlagNotEvaluated
+	}
+	flag := FlagNotEvaluated
+	if g.FailMin < g.FailMax {
+		if f < g.FailMin || f > g.FailMax {
+			return FlagFail
+		}
+		flag = FlagPass
+	}
+	if g.SuspectMin < g.SuspectMax {
+		if f < g.SuspectMin || f > g.SuspectMax {
+			return FlagSuspect
+		}
+		flag = FlagPass
+	}
+	return flag
+}
+
+// spike tests v against the values before and after it.
+func spike(t *SpikeTest, v, next qcValue) int {
+	if !v.number || !v.hasPrev || !next.number {
+		return FlagNotEvaluated
+	}
+	d := math.Abs(v.value - (v.prev+next.value)/2)
+	switch {
+	case t.Fail > 0 && d > t.Fail:
+		return FlagFail
+	case t.Suspect > 0 && d > t.Suspect:
+		return FlagSuspect
+	case t.Fail > 0 || t.Suspect > 0:
+		return FlagPass
+	}
+	return FlagNotEvaluated
+}
+
+// flatLine adds f to the current run and tests its length.
+func (s *qcState) flatLine(t *FlatLineTest, f float64) int {
+	if s.run > 0 && math.Abs(f-s.runStart) <= t.Tolerance {
+		s.run++
+	} else {
+		s.runStart, s.run = f, 1
+	}
+	switch {
+	case t.Fail > 0 && s.run >= t.Fail:
+		return FlagFail
+	case t.Suspect > 0 && s.run >= t.Suspect:
+		return FlagSuspect
+	case t.Fail > 0 || t.Suspect > 0:
+		return FlagPass
+	}
+	return FlagNotEvaluated
+}
+
+func locationFlag(l *LocationTest, row *object) int {
+	lat, latPresent, latNumber := qcNumber(row.values[l.LatitudeColumn])
+	lon, lonPresent, lonNumber := qcNumber(row.values[l.LongitudeColumn])
+	switch {
+	case !latPresent || !lonPresent:
+		return FlagMissing
+	case !latNumber || !lonNumber || math.Abs(lat) > 90 || math.Abs(lon) > 180:
+		return FlagFail
+	}
+	if l.MinLatitude < l.MaxLatitude && l.MinLongitude < l.MaxLongitude {
+		if lat < l.MinLatitude || lat > l.MaxLatitude || lon < l.MinLongitude || lon > l.MaxLongitude {
+			return FlagSuspect
+		}
+	}
+	return FlagPass
+}
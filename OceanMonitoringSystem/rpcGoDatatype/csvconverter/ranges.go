@@ -0,0 +1,182 @@
+package csvconverter
+
+import (
+	"fmt"
+	"io"
+	"math"
+)
+
+// Treatments of values outside their range, see Options.OutOfRange.
+const (
+	OutOfRangeFlag = "flag"
+	OutOfRangeNull = "null"
+	OutOfRangeDrop = "drop"
+)
+
+// RangeFlagSuffix is appended to a column's name to name its range flag
+// column.
+const RangeFlagSuffix = "_out_of_range"
+
+// RangeRule bounds the acceptable values of a column, inclusively.
+type RangeRule struct {
+	Column   string
+	Min, Max float64
+}
+
+// rangeRules returns the rules of Options.SensorType followed by
+// Options.Ranges, which replace sensor rules of the same column.
+func (o Options) rangeRules() ([]RangeRule, error) {
+	var rules []RangeRule
+	if o.SensorType != "" {
+		sensor, ok := o.SensorRanges[o.SensorType]
+		if !ok {
+			return nil, &OptionError{Option: "SensorType", Reason: fmt.Sprintf("unknown sensor type %q", o.SensorType)}
+		}
+		for _, r := range sensor {
+			if !hasRangeRule(o.Ranges, r.Column) {
+				rules = append(rules, r)
+			}
+		}
+	}
+	for i, r := range o.Ranges {
+		if r.Column == "" {
+			return nil, &OptionError{Option: "Ranges", Reason: fmt.Sprintf("range %d needs a column", i+1)}
+		}
+		if hasRangeRule(o.Ranges[:i], r.Column) {
+			return nil, &OptionError{Option: "Ranges", Reason: fmt.Sprintf("column %s has more than one range", r.Column)}
+		}
+	}
+	rules = append(rules, o.Ranges...)
+	for _, r := range rules {
+		if math.IsNaN(r.Min) || math.IsNaN(r.Max) || r.Min > r.Max {
+			return nil, &OptionError{Option: "Ranges", Reason: fmt.Sprintf("range of %s must have a minimum no greater than its maximum", r.Column)}
+		}
+	}
+	return rules, nil
+}
+
+func hasRangeRule(rules []RangeRule, column string) bool {
+	for _, r := range rules {
+		if r.Column == column {
+			return true
+		}
+	}
+	return false
+}
+
+// rangeReader checks the rows of src against the range rules of opts.
+func rangeReader(src rowReader, opts Options, result *Result) (rowReader, error) {
+	rules, err := opts.rangeRules()
+	if err != nil || len(rules) == 0 {
+		return src, err
+	}
+	r := &rangeRows{src: src, rules: rules, action: opts.OutOfRange, result: result, counts: make([]int, len(rules))}
+	if r.action == "" {
+		r.action = OutOfRangeFlag
+	}
+	if columns := src.Columns(); columns != nil {
+		if r.columns, err = rangeHeaders(columns, rules, r.action); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// rangeColumns returns columns with the flag columns of the range rules.
+// Errors are reported once the rows are read.
+func rangeColumns(columns []string, opts Options) []string {
+	rules, err := opts.rangeRules()
+	if err != nil || len(rules) == 0 {
+		return columns
+	}
+	if out, err := rangeHeaders(columns, rules, opts.OutOfRange); err == nil {
+		return out
+	}
+	return columns
+}
+
+func rangeHeaders(columns []string, rules []RangeRule, action string) ([]string, error) {
+	named := make([]string, len(rules))
+	for i, r := range rules {
+		named[i] = r.Column
+	}
+	if err := checkColumns(named, columns); err != nil {
+		return nil, &OptionError{Option: "Ranges", Reason: fmt.Sprintf("invalid range: %v", err)}
+	}
+	if action != "" && action != OutOfRangeFlag {
+		return columns, nil
+	}
+	out := append([]string(nil), columns...)
+	for _, column := range named {
+		flag := column + RangeFlagSuffix
+		if err := checkColumns([]string{flag}, columns); err == nil {
+			return nil, &OptionError{Option: "Ranges", Reason: fmt.Sprintf("flag column %q already exists", flag)}
+		}
+		out = append(out, flag)
+	}
+	return out, nil
+}
+
+// rangeRows applies Options.OutOfRange to the values outside their range:
+// a true flag column, a null value or a dropped row. Values that are not
+// numbers are left alone and flagged null. The number of values out of
+// range in each column is reported as a warning at the end of the input.
+type rangeRows struct {
+	src     rowReader
+	rules   []RangeRule
+	action  string
+	result  *Result
+	columns []string
+	counts  []int
+}
+
+func (r *rangeRows) Columns() []string {
+	return r.columns
+}
+
+// Next returns the next row that is kept, or io.EOF.
+func (r *rangeRows) Next() (*object, error) {
+	for {
+		row, err := r.src.Next()
+		if err == io.EOF {
+			r.warn()
+		}
+		if err != nil {
+			return nil, err
+		}
+		drop := false
+		for i, rule := range r.rules {
+			v, _ := row.get(rule.Column)
+			f, present, number := qcNumber(v)
+			var flag interface{}
+			if number || present && math.IsInf(f, 0) {
+				out := f < rule.Min || f > rule.Max
+				flag = out
+				if out {
+					r.counts[i]++
+					switch r.action {
+					case OutOfRangeNull:
+						row.set(rule.Column, nil)
+					case OutOfRangeDrop:
+						drop = true
+					}
+				}
+			}
+			if r.action == OutOfRangeFlag {
+				row.set(rule.Column+RangeFlagSuffix, flag)
+			}
+		}
+		if !drop {
+			return row, nil
+		}
+	}
+}
+
+func (r *rangeRows) warn() {
+	for i, rule := range r.rules {
+		if n := r.counts[i]; n > 0 {
+			r.result.Warnings = append(r.result.Warnings, fmt.Sprintf("column %s: %d values outside [%g, %g]", rule.Column, n, rule.Min, rule.Max))
+			r.counts[i] = 0
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package csvconverter
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Reshape modes accepted in Options.Reshape.
+const (
+	ReshapePivot   = "pivot"
+	ReshapeUnpivot = "unpivot"
+)
+
+const (
+	defaultNameColumn  = "parameter"
+	defaultValueColumn = "value"
+)
+
+func (o Options) nameColumn() string {
+	if o.ReshapeNameColumn != "" {
+		return o.ReshapeNameColumn
+	}
+	return defaultNameColumn
+}
+
+func (o Options) valueColumn() string {
+	if o.ReshapeValueColumn != "" {
+		return o.ReshapeValueColumn
+	}
+	return defaultValueColumn
+}
+
+// pivot turns long rows (id columns, parameter, value) into wide rows with
+// one column per parameter. Parameters missing for an id are null.
+func pivot(rows []*object, opts Options) ([]*object, []string, error) {
+	nameColumn, valueColumn := opts.nameColumn(), opts.valueColumn()
+
+	var (
+		warnings []string
+		params   []string
+		groups   []*object
+	)
+	knownParam := make(map[string]bool)
+	byID := make(map[string]*object)
+
+	for i, row := range rows {
+		id := row.project(opts.ReshapeIDColumns)
+		key, err := json.Marshal(id)
+		if err != nil {
+			return nil, nil, err
+		}
+		group, ok := byID[string(key)]
+		if !ok {
+			group = id
+			byID[string(key)] = group
+			groups = append(groups, group)
+		}
+
+		rawName, _ := row.get(nameColumn)
+		if rawName == nil {
+			return nil, nil, fmt.Errorf("row %d: missing %s", i+1, nameColumn)
+		}
+		name := fmt.Sprint(rawName)
+		if !knownParam[name] {
+			knownParam[name] = true
+			params = append(params, name)
+		}
+		if _, dup := group.get(name); dup {
+			warnings = append(warnings, fmt.Sprintf("row %d: duplicate %s %q for the same id, keeping the last value", i+1, nameColumn, name))
+		}
+		value, _ := row.get(valueColumn)
+		group.set(name, value)
+	}
+
+	keys := append(append([]string(nil), opts.ReshapeIDColumns...), params...)
+	for i, group := range groups {
+		groups[i] = group.project(keys)
+	}
+	return groups, warnings, nil
+}
+
+// unpivot turns wide rows into long rows holding the id columns plus one
+// parameter/value pair per value column.
+func unpivot(rows []*object, opts Options) []*object {
+	isID := idColumns(opts)
+	var long []*object
+	for _, row := range rows {
+		long = append(long, unpivotRow(row, opts, isID)...)
+	}
+	return long
+}
+
+func idColumns(opts Options) map[string]bool {
+	isID := make(map[string]bool, len(opts.ReshapeIDColumns))
+	for _, c := range opts.ReshapeIDColumns {
+		isID[c] = true
+	}
+	return isID
+}
+
+// unpivotRow returns the long rows for one wide row.
+func unpivotRow(row *object, opts Options, isID map[string]bool) []*object {
+	nameColumn, valueColumn := opts.nameColumn(), opts.valueColumn()
+	valueColumns := opts.UnpivotColumns
+	if len(valueColumns) == 0 {
+		for _, k := range row.keys {
+			if !isID[k] {
+				valueColumns = append(valueColumns, k)
+			}
+		}
+	}
+	long := make([]*object, 0, len(valueColumns))
+	for _, column := range valueColumns {
+		out := row.project(opts.ReshapeIDColumns)
+		value, _ := row.get(column)
+		out.set(nameColumn, column)
+		out.set(valueColumn, value)
+		long = append(long, out)
+	}
+	return long
+}
+
+// reshapeInputColumns lists the input columns a reshape needs.
+func (o Options) reshapeInputColumns() []string {
+	switch o.Reshape {
+	case ReshapePivot:
+		return append(append([]string(nil), o.ReshapeIDColumns...), o.nameColumn(), o.valueColumn())
+	case ReshapeUnpivot:
+		return append(append([]string(nil), o.ReshapeIDColumns...), o.UnpivotColumns...)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package csvconverter
+
+import "fmt"
+
+// RowError describes a row that could not be converted. Row is the CSV line
+// number or the 1-based index of the JSON object; Column is empty when the
+// problem is not tied to one column.
+type RowError struct {
+	Row    int
+	Column string
+	Reason string
+}
+
+func (e *RowError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("row %d: %s", e.Row, e.Reason)
+	}
+	return fmt.Sprintf("row %d, column %s: %s", e.Row, e.Column, e.Reason)
+}
+
+// rowError fails the conversion with e, or records it and returns nil when
+// Options.SkipInvalidRows is set, in which case the caller drops the row.
+func (r *Result) rowError(e *RowError, opts Options) error {
+	if !opts.SkipInvalidRows {
+		return e
+	}
+	r.RowErrors = append(r.RowErrors, *e)
+	return nil
+}
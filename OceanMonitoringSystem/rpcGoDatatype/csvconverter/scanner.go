@@ -0,0 +1,256 @@
+package csvconverter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"unicode/utf8"
+)
+
+// csvScanner reads CSV records the way csv.Reader does with a comma
+// delimiter, but splits each line in place instead of copying it field by
+// field. Lines without quotes, or whose quoted fields close on the same
+// line, are split directly. The first record it cannot split that way, a
+// multi-line field or a syntax error, hands the rest of the input to a
+// csv.Reader, so edge cases keep its exact results and errors.
+type csvScanner struct {
+	r *bufio.Reader
+	// comment, fieldsPerRecord and reuseRecord are csv.Reader's Comment,
+	// FieldsPerRecord and ReuseRecord.
+	comment         rune
+	fieldsPerRecord int
+	reuseRecord     bool
+
+	// numLine is the number of lines read and recLine the line the last
+	// record started on.
+	numLine int
+	recLine int
+	raw     []byte
+	// cr notes a \r that readLine dropped from the line's end.
+	cr   bool
+	buf  []byte
+	ends []int
+	last []string
+
+	// fallback reads the input once a record needs it, with its lines
+	// counted from offset.
+	fallback *csv.Reader
+	offset   int
+}
+
+func newCSVScanner(r io.Reader) *csvScanner {
+	return &csvScanner{r: bufio.NewReader(r)}
+}
+
+// Read returns the next record, like csv.Reader.Read.
+func (s *csvScanner) Read() ([]string, error) {
+	if s.fallback != nil {
+		return s.readFallback()
+	}
+	var line []byte
+	var err error
+	for err == nil {
+		line, err = s.readLine()
+		if s.comment != 0 && nextRune(line) == s.comment {
+			continue
+		}
+		if err == nil && len(line) == lengthNL(line) {
+			continue
+		}
+		break
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.recLine = s.numLine
+
+	var dst []string
+	if s.reuseRecord {
+		dst = s.last[:0]
+	} else if s.fieldsPerRecord > 0 {
+		dst = make([]string, 0, s.fieldsPerRecord)
+	}
+	body := line[:len(line)-lengthNL(line)]
+	if bytes.IndexByte(body, '"') < 0 {
+		dst = splitFields(dst, string(body))
+	} else if s.unquote(body) {
+		str := string(s.buf)
+		start := 0
+		for _, end := range s.ends {
+			dst = append(dst, str[start:end])
+			start = end
+		}
+	} else {
+		s.startFallback(line)
+		return s.readFallback()
+	}
+	if s.reuseRecord {
+		s.last = dst
+	}
+
+	if s.fieldsPerRecord > 0 {
+		if len(dst) != s.fieldsPerRecord {
+			return dst, &csv.ParseError{StartLine: s.recLine, Line: s.recLine, Column: 1, Err: csv.ErrFieldCount}
+		}
+	} else if s.fieldsPerRecord == 0 {
+		s.fieldsPerRecord = len(dst)
+	}
+	return dst, nil
+}
+
+// Line returns the line the last record read started on.
+func (s *csvScanner) Line() int {
+	if s.fallback != nil {
+		line, _ := s.fallback.FieldPos(0)
+		return line + s.offset
+	}
+	return s.recLine
+}
+
+// readLine reads a line like csv.Reader does, normalizing a trailing \r\n
+// to \n and dropping a \r before the end of the input.
+func (s *csvScanner) readLine() ([]byte, error) {
+	line, err := s.r.ReadSlice('\n')
+	if err == bufio.ErrBufferFull {
+		s.raw = append(s.raw[:0], line...)
+		for err == bufio.ErrBufferFull {
+			line, err = s.r.ReadSlice('\n')
+			s.raw = append(s.raw, line...)
+		}
+		line = s.raw
+	}
+	s.cr = false
+	n := len(line)
+	if n > 0 && err == io.EOF {
+		err = nil
+		if line[n-1] == '\r' {
+			line = line[:n-1]
+			s.cr = true
+		}
+	}
+	s.numLine++
+	if n := len(line); n >= 2 && line[n-2] == '\r' && line[n-1] == '\n' {
+		line[n-2] = '\n'
+		line = line[:n-1]
+		s.cr = true
+	}
+	return line, err
+}
+
+// splitFields appends the comma separated fields of line to dst. The
+// fields share line's memory.
+func splitFields(dst []string, line string) []string {
+	for {
+		i := indexComma(line)
+		if i < 0 {
+			return append(dst, line)
+		}
+		dst = append(dst, line[:i])
+		line = line[i+1:]
+	}
+}
+
+// indexComma is strings.IndexByte for the short fields of numeric data,
+// where a plain loop beats the setup of a vectorized search.
+func indexComma(s string) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] == ',' {
+			return i
+		}
+	}
+	return -1
+}
+
+// unquote splits a line holding quoted fields into s.buf and s.ends. It
+// reports false when csv.Reader must read the record: a quoted field runs
+// past the line, or a quote is out of place.
+func (s *csvScanner) unquote(line []byte) bool {
+	s.buf, s.ends = s.buf[:0], s.ends[:0]
+	for {
+		if len(line) == 0 || line[0] != '"' {
+			i := bytes.IndexByte(line, ',')
+			field := line
+			if i >= 0 {
+				field = line[:i]
+			}
+			if bytes.IndexByte(field, '"') >= 0 {
+				return false
+			}
+			s.buf = append(s.buf, field...)
+			s.ends = append(s.ends, len(s.buf))
+			if i < 0 {
+				return true
+			}
+			line = line[i+1:]
+			continue
+		}
+		line = line[1:]
+		for {
+			i := bytes.IndexByte(line, '"')
+			if i < 0 {
+				return false
+			}
+			s.buf = append(s.buf, line[:i]...)
+			line = line[i+1:]
+			switch {
+			case len(line) > 0 && line[0] == '"':
+				s.buf = append(s.buf, '"')
+				line = line[1:]
+				continue
+			case len(line) == 0:
+				s.ends = append(s.ends, len(s.buf))
+				return true
+			case line[0] == ',':
+				s.ends = append(s.ends, len(s.buf))
+				line = line[1:]
+			default:
+				return false
+			}
+			break
+		}
+	}
+}
+
+// startFallback hands the input, from line on, to a csv.Reader. The line
+// gets back the \r readLine dropped, as csv.Reader drops it again.
+func (s *csvScanner) startFallback(line []byte) {
+	raw := bytes.Clone(line)
+	if s.cr {
+		if n := lengthNL(raw); n > 0 {
+			raw = append(raw[:len(raw)-n], "\r\n"...)
+		} else {
+			raw = append(raw, '\r')
+		}
+	}
+	rest := io.MultiReader(bytes.NewReader(raw), s.r)
+	s.fallback = csv.NewReader(rest)
+	s.fallback.Comment = s.comment
+	s.fallback.FieldsPerRecord = s.fieldsPerRecord
+	s.fallback.ReuseRecord = s.reuseRecord
+	s.offset = s.numLine - 1
+}
+
+func (s *csvScanner) readFallback() ([]string, error) {
+	record, err := s.fallback.Read()
+	if perr, ok := err.(*csv.ParseError); ok {
+		moved := *perr
+		moved.StartLine += s.offset
+		moved.Line += s.offset
+		err = &moved
+	}
+	return record, err
+}
+
+func nextRune(b []byte) rune {
+	r, _ := utf8.DecodeRune(b)
+	return r
+}
+
+// lengthNL reports the number of bytes for the trailing \n.
+func lengthNL(b []byte) int {
+	if len(b) > 0 && b[len(b)-1] == '\n' {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,134 @@
+package csvconverter
+
+import (
+	"fmt"
+	"strings"
+)
+
+const defaultSampleSize = 5
+
+// ColumnSchema describes one column of an inferred schema.
+type ColumnSchema struct {
+	Name string
+	// Type is one of the Type* constants. Columns holding values of mixed
+	// types, or only nulls, are TypeString.
+	Type      string
+	Nullable  bool
+	NullCount int
+	// Min and Max are only set for columns of a single type.
+	Min, Max string
+	// Samples holds up to the requested number of distinct values, in order
+	// of first appearance.
+	Samples []string
+}
+
+// Schema is the inferred schema of a payload.
+type Schema struct {
+	Columns  []ColumnSchema
+	Rows     int
+	Warnings []string
+}
+
+type columnStats struct {
+	schema   ColumnSchema
+	kinds    map[int]bool
+	min, max sortValue
+	minRaw   interface{}
+	maxRaw   interface{}
+	seen     map[string]bool
+}
+
+// InferSchema reads data with the same options as a conversion and describes
+// its columns without producing any output. At most sampleSize distinct
+// sample values are kept per column; zero uses a default of 5.
+func InferSchema(format, data string, sampleSize int, opts Options) (*Schema, error) {
+	read, ok := readers[strings.ToLower(format)]
+	if !ok {
+		return nil, fmt.Errorf("%w format: %s", ErrUnsupported, format)
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if sampleSize <= 0 {
+		sampleSize = defaultSampleSize
+	}
+
+	result := &Result{}
+	rows, columns, err := readAll(read, data, opts, result)
+	if err != nil {
+		return nil, err
+	}
+	if columns == nil {
+		columns = rowColumns(rows)
+	}
+
+	stats := make([]*columnStats, len(columns))
+	for i, c := range columns {
+		stats[i] = &columnStats{
+			schema: ColumnSchema{Name: c},
+			kinds:  make(map[int]bool),
+			seen:   make(map[string]bool),
+		}
+	}
+	for _, row := range rows {
+		for i, c := range columns {
+			v, _ := row.get(c)
+			stats[i].add(v, sampleSize, opts)
+		}
+	}
+
+	schema := &Schema{Rows: len(rows), Warnings: result.Warnings}
+	for _, s := range stats {
+		schema.Columns = append(schema.Columns, s.finish())
+	}
+	return schema, nil
+}
+
+func (s *columnStats) add(v interface{}, sampleSize int, opts Options) {
+	sv := toSortValue(v, opts)
+	if sv.kind == kindNull {
+		s.schema.Nullable = true
+		s.schema.NullCount++
+		return
+	}
+
+	if !s.kinds[sv.kind] {
+		s.kinds[sv.kind] = true
+		s.min, s.max, s.minRaw, s.maxRaw = sv, sv, v, v
+	} else if len(s.kinds) == 1 {
+		if compareSortValues(sv, s.min) < 0 {
+			s.min, s.minRaw = sv, v
+		}
+		if compareSortValues(sv, s.max) > 0 {
+			s.max, s.maxRaw = sv, v
+		}
+	}
+
+	if len(s.schema.Samples) >= sampleSize {
+		return
+	}
+	text := fmt.Sprint(v)
+	if !s.seen[text] {
+		s.seen[text] = true
+		s.schema.Samples = append(s.schema.Samples, text)
+	}
+}
+
+func (s *columnStats) finish() ColumnSchema {
+	schema := s.schema
+	schema.Type = TypeString
+	if len(s.kinds) != 1 {
+		return schema
+	}
+	switch s.min.kind {
+	case kindNumber:
+		schema.Type = TypeNumber
+	case kindTime:
+		schema.Type = TypeTimestamp
+	case kindBool:
+		schema.Type = TypeBoolean
+	}
+	schema.Min = fmt.Sprint(s.minRaw)
+	schema.Max = fmt.Sprint(s.maxRaw)
+	return schema
+}
@@ -0,0 +1,195 @@
+package csvconverter
+
+import (
+	"fmt"
+	"io"
+)
+
+// Smoothing methods accepted in Smoothing.Method.
+const (
+	SmoothMean   = "mean"
+	SmoothMedian = "median"
+)
+
+// Smoothing replaces the values of numeric columns with the mean or median
+// of a rolling window of rows. Missing values stay missing and are left
+// out of the windows around them.
+type Smoothing struct {
+	Columns []string
+	// Method is SmoothMean (the default) or SmoothMedian.
+	Method string
+	// Window is the number of rows in the window, at least 1. The window
+	// is centred on each row, or ends at it when Trailing is set, so that
+	// no later rows are used.
+	Window   int
+	Trailing bool
+	// StationColumn smooths the rows of each station separately. Without
+	// it all rows form one series.
+	StationColumn string
+	// Suffix writes the smoothed values to <column><Suffix>, keeping the
+	// original column. Empty replaces the column's values.
+	Suffix string
+}
+
+// smoothStep is a compiled Smoothing.
+type smoothStep struct {
+	sm Smoothing
+}
+
+func newSmoothStep(sm Smoothing) (*smoothStep, error) {
+	if len(sm.Columns) == 0 {
+		return nil, fmt.Errorf("smoothing needs at least one column")
+	}
+	switch sm.Method {
+	case "":
+		sm.Method = SmoothMean
+	case SmoothMean, SmoothMedian:
+	default:
+		return nil, fmt.Errorf("unsupported smoothing method %q", sm.Method)
+	}
+	if sm.Window < 1 {
+		return nil, fmt.Errorf("smoothing window must be at least 1 row")
+	}
+	for i, column := range sm.Columns {
+		if hasColumn(sm.Columns[:i], column) {
+			return nil, fmt.Errorf("column %s is listed twice", column)
+		}
+	}
+	return &smoothStep{sm: sm}, nil
+}
+
+func (s *smoothStep) columns(in []string) ([]string, error) {
+	named := s.sm.Columns
+	if s.sm.StationColumn != "" {
+		named = append(named[:len(named):len(named)], s.sm.StationColumn)
+	}
+	if err := checkColumns(named, in); err != nil {
+		return nil, fmt.Errorf("smoothing: %v", err)
+	}
+	if s.sm.Suffix == "" {
+		return in, nil
+	}
+	out := append([]string(nil), in...)
+	for _, column := range s.sm.Columns {
+		smoothed := column + s.sm.Suffix
+		if hasColumn(in, smoothed) {
+			return nil, fmt.Errorf("smoothing: column %q already exists", smoothed)
+		}
+		out = append(out, smoothed)
+	}
+	return out, nil
+}
+
+func (s *smoothStep) reader(src rowReader, columns []string, opts Options, result *Result) rowReader {
+	r := &smoothReader{src: src, step: s}
+	if columns != nil {
+		r.columns, _ = s.columns(columns)
+	}
+	return r
+}
+
+// smoothReader smooths the rows of src. Centred windows need the rows
+// after each one, so every row is read before the first is returned.
+type smoothReader struct {
+	src     rowReader
+	step    *smoothStep
+	columns []string
+	rows    []*object
+	read    bool
+}
+
+func (r *smoothReader) Columns() []string {
+	return r.columns
+}
+
+// Next returns the next smoothed row, or io.EOF.
+func (r *smoothReader) Next() (*object, error) {
+	if !r.read {
+		if err := r.readAll(); err != nil {
+			return nil, err
+		}
+		r.read = true
+	}
+	if len(r.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := r.rows[0]
+	r.rows[0] = nil
+	r.rows = r.rows[1:]
+	return row, nil
+}
+
+func (r *smoothReader) readAll() error {
+	sm := r.step.sm
+	series := make(map[string][]*object)
+	var stations []string
+	for {
+		row, err := r.src.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		name := ""
+		if sm.StationColumn != "" {
+			if v, _ := row.get(sm.StationColumn); v != nil {
+				name = fmt.Sprint(v)
+			}
+		}
+		if _, ok := series[name]; !ok {
+			stations = append(stations, name)
+		}
+		series[name] = append(series[name], row)
+		r.rows = append(r.rows, row)
+	}
+
+	// Smoothed values are computed from the originals before any is
+	// written back.
+	before, after := sm.Window/2, sm.Window-1-sm.Window/2
+	if sm.Trailing {
+		before, after = sm.Window-1, 0
+	}
+	var window []float64
+	for _, name := range stations {
+		rows := series[name]
+		values := make([]float64, len(rows))
+		valid := make([]bool, len(rows))
+		smoothed := make([]interface{}, len(rows))
+		for _, column := range sm.Columns {
+			for i, row := range rows {
+				v, _ := row.get(column)
+				values[i], _, valid[i] = qcNumber(v)
+			}
+			for i, row := range rows {
+				smoothed[i], _ = row.get(column)
+				if !valid[i] {
+					continue
+				}
+				window = window[:0]
+				for j := max(0, i-before); j <= min(len(rows)-1, i+after); j++ {
+					if valid[j] {
+						window = append(window, values[j])
+					}
+				}
+				smoothed[i] = floatNumber(smooth(window, sm.Method))
+			}
+			for i, row := range rows {
+				row.set(column+sm.Suffix, smoothed[i])
+			}
+		}
+	}
+	return nil
+}
+
+// smooth returns the mean or median of values, which it may reorder.
+func smooth(values []float64, method string) float64 {
+	if method == SmoothMedian {
+		return median(values)
+	}
+	sum := 0.0
+	for _, f := range values {
+		sum += f
+	}
+	return sum / float64(len(values))
+}
@@ -0,0 +1,152 @@
+package csvconverter
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+type sortKey struct {
+	column     string
+	descending bool
+}
+
+// parseSortKeys parses Options.SortBy entries of the form "column",
+// "column asc" or "column desc".
+func parseSortKeys(spec []string) ([]sortKey, error) {
+	keys := make([]sortKey, 0, len(spec))
+	for _, s := range spec {
+		k := sortKey{column: strings.TrimSpace(s)}
+		if i := strings.LastIndexByte(k.column, ' '); i > 0 {
+			switch strings.ToLower(k.column[i+1:]) {
+			case "desc":
+				k.descending = true
+				k.column = strings.TrimSpace(k.column[:i])
+			case "asc":
+				k.column = strings.TrimSpace(k.column[:i])
+			}
+		}
+		if k.column == "" {
+			return nil, fmt.Errorf("empty sort column")
+		}
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+func sortColumns(keys []sortKey) []string {
+	columns := make([]string, len(keys))
+	for i, k := range keys {
+		columns[i] = k.column
+	}
+	return columns
+}
+
+// Kinds of sort values, in the order they sort relative to each other.
+const (
+	kindNumber = iota
+	kindTime
+	kindBool
+	kindString
+	kindNull
+)
+
+type sortValue struct {
+	kind int
+	num  float64
+	t    time.Time
+	s    string
+}
+
+func toSortValue(v interface{}, opts Options) sortValue {
+	switch x := v.(type) {
+	case nil:
+		return sortValue{kind: kindNull}
+	case json.Number:
+		if f, err := x.Float64(); err == nil {
+			return sortValue{kind: kindNumber, num: f}
+		}
+	case float64:
+		return sortValue{kind: kindNumber, num: x}
+	case bool:
+		if x {
+			return sortValue{kind: kindBool, num: 1}
+		}
+		return sortValue{kind: kindBool}
+	case string:
+		if x == "" {
+			return sortValue{kind: kindNull}
+		}
+		if t, ok := parseTimestamp(x, false, opts); ok {
+			return sortValue{kind: kindTime, t: t}
+		}
+		return sortValue{kind: kindString, s: x}
+	}
+	return sortValue{kind: kindString, s: fmt.Sprint(v)}
+}
+
+func compareSortValues(a, b sortValue) int {
+	if a.kind != b.kind {
+		if a.kind < b.kind {
+			return -1
+		}
+		return 1
+	}
+	switch a.kind {
+	case kindNumber, kindBool:
+		switch {
+		case a.num < b.num:
+			return -1
+		case a.num > b.num:
+			return 1
+		}
+	case kindTime:
+		return a.t.Compare(b.t)
+	case kindString:
+		return strings.Compare(a.s, b.s)
+	}
+	return 0
+}
+
+// sortRows orders rows by the sort keys. Numbers compare numerically,
+// timestamps chronologically and strings lexically; nulls always sort last.
+// The sort is stable, so rows with equal keys keep their input order.
+func sortRows(rows []*object, keys []sortKey, opts Options) {
+	if len(keys) == 0 {
+		return
+	}
+	values := make(map[*object][]sortValue, len(rows))
+	for _, row := range rows {
+		values[row] = sortValues(row, keys, opts)
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return compareRows(values[rows[i]], values[rows[j]], keys) < 0
+	})
+}
+
+// sortValues returns the values row is sorted on.
+func sortValues(row *object, keys []sortKey, opts Options) []sortValue {
+	vs := make([]sortValue, len(keys))
+	for i, k := range keys {
+		v, _ := row.get(k.column)
+		vs[i] = toSortValue(v, opts)
+	}
+	return vs
+}
+
+// compareRows compares the sort values of two rows.
+func compareRows(a, b []sortValue, keys []sortKey) int {
+	for k, key := range keys {
+		c := compareSortValues(a[k], b[k])
+		if c == 0 {
+			continue
+		}
+		if key.descending && a[k].kind != kindNull && b[k].kind != kindNull {
+			c = -c
+		}
+		return c
+	}
+	return 0
+}
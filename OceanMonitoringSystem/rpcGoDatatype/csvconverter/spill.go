@@ -0,0 +1,362 @@
+package csvconverter
+
+import (
+	"bufio"
+	"container/heap"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// spillBufferSize is the buffer of each spill file while writing and
+// merging. Past maxSpillRuns files the runs are merged into one, bounding
+// the files open at once.
+const (
+	spillBufferSize = 64 << 10
+	maxSpillRuns    = 128
+)
+
+// spilling reports whether the rows held for sorting, deduplication or
+// unpivoting go to disk past Options.SpillThreshold. Pivoting needs every
+// row at once and is always done in memory.
+func (o Options) spilling() bool {
+	return o.SpillThreshold > 0 && o.Reshape != ReshapePivot
+}
+
+// spillTransform is transformRows for inputs that may not fit in memory.
+// Rows are deduplicated on a hash of their content and unpivoted as they
+// arrive, then sorted in runs of about Options.SpillThreshold bytes that
+// are written to temporary files and merged. Without sort keys rows pass
+// straight through.
+type spillTransform struct {
+	opts    Options
+	result  *Result
+	keys    []sortKey
+	columns []string
+	checked bool
+	isID    map[string]bool
+	seen    map[[sha256.Size]byte]bool
+	e       *encoder
+
+	rows []*object
+	size int64
+	runs []*os.File
+}
+
+func newSpillTransform(columns []string, opts Options, result *Result) (*spillTransform, error) {
+	keys, err := parseSortKeys(opts.SortBy)
+	if err != nil {
+		return nil, err
+	}
+	t := &spillTransform{opts: opts, result: result, keys: keys, columns: columns, e: getEncoder()}
+	if opts.Deduplicate || len(opts.DedupKeys) > 0 {
+		t.seen = make(map[[sha256.Size]byte]bool)
+	}
+	if opts.Reshape == ReshapeUnpivot {
+		t.isID = idColumns(opts)
+	}
+	return t, nil
+}
+
+// add takes the next input row. Rows come out of finish.
+func (t *spillTransform) add(row *object, emit func(*object) error) error {
+	if t.seen != nil {
+		target := row
+		if len(t.opts.DedupKeys) > 0 {
+			target = row.project(t.opts.DedupKeys)
+		}
+		t.e.buf.Reset()
+		if err := t.e.object(target); err != nil {
+			return fmt.Errorf("error comparing rows: %v", err)
+		}
+		sum := sha256.Sum256(t.e.buf.Bytes())
+		if t.seen[sum] {
+			t.result.DuplicatesRemoved++
+			return nil
+		}
+		t.seen[sum] = true
+	}
+	if t.isID == nil {
+		return t.hold(row, emit)
+	}
+	for _, long := range unpivotRow(row, t.opts, t.isID) {
+		if err := t.hold(long, emit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hold checks the columns on the first row, like transformRows does after
+// reshaping, and keeps the row for sorting.
+func (t *spillTransform) hold(row *object, emit func(*object) error) error {
+	if !t.checked {
+		t.checked = true
+		columns := t.columns
+		if t.opts.Reshape != "" {
+			columns = row.keys
+		}
+		if err := t.checkColumns(columns); err != nil {
+			return err
+		}
+	}
+	if len(t.keys) == 0 {
+		return emit(t.project(row))
+	}
+	t.rows = append(t.rows, row)
+	if t.size += rowSize(row); t.size > t.opts.SpillThreshold {
+		return t.spill()
+	}
+	return nil
+}
+
+func (t *spillTransform) checkColumns(columns []string) error {
+	if columns == nil {
+		return nil
+	}
+	if err := checkColumns(sortColumns(t.keys), columns); err != nil {
+		return &OptionError{Option: "SortBy", Reason: fmt.Sprintf("invalid sort: %v", err)}
+	}
+	return optionError("Columns", checkColumns(t.opts.Columns, columns))
+}
+
+func (t *spillTransform) project(row *object) *object {
+	if len(t.opts.Columns) > 0 {
+		return row.project(t.opts.Columns)
+	}
+	return row
+}
+
+// spill writes the held rows, sorted, to a new run file.
+func (t *spillTransform) spill() error {
+	if len(t.runs) == maxSpillRuns {
+		if err := t.compact(); err != nil {
+			return err
+		}
+	}
+	sortRows(t.rows, t.keys, t.opts)
+	err := t.writeRun(func(write func(*object) error) error {
+		for _, row := range t.rows {
+			if err := write(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	clear(t.rows)
+	t.rows, t.size = t.rows[:0], 0
+	return nil
+}
+
+// compact merges the runs into one.
+func (t *spillTransform) compact() error {
+	runs := t.runs
+	t.runs = nil
+	defer removeRuns(runs)
+	return t.writeRun(func(write func(*object) error) error {
+		return merge(runs, t.keys, t.opts, write)
+	})
+}
+
+// writeRun appends a run file holding the rows fill writes. Each row is
+// its line number followed by the row as a JSON object.
+func (t *spillTransform) writeRun(fill func(write func(*object) error) error) error {
+	f, err := os.CreateTemp(t.opts.SpillDir, "csvconverter-spill-*")
+	if err != nil {
+		return fmt.Errorf("error creating spill file: %v", err)
+	}
+	t.runs = append(t.runs, f)
+	w := bufio.NewWriterSize(f, spillBufferSize)
+	err = fill(func(row *object) error {
+		t.e.buf.Reset()
+		t.e.buf.WriteString(strconv.Itoa(row.line))
+		t.e.buf.WriteByte(' ')
+		if err := t.e.object(row); err != nil {
+			return fmt.Errorf("error spilling row: %v", err)
+		}
+		t.e.buf.WriteByte('\n')
+		if _, err := w.Write(t.e.buf.Bytes()); err != nil {
+			return fmt.Errorf("error writing spill file: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("error writing spill file: %v", err)
+	}
+	return nil
+}
+
+// finish emits the remaining rows in order, merging any spilled runs.
+func (t *spillTransform) finish(emit func(*object) error) error {
+	if !t.checked && t.opts.Reshape == "" {
+		if err := t.checkColumns(t.columns); err != nil {
+			return err
+		}
+	}
+	if len(t.runs) == 0 {
+		sortRows(t.rows, t.keys, t.opts)
+		for _, row := range t.rows {
+			if err := emit(t.project(row)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(t.rows) > 0 {
+		if err := t.spill(); err != nil {
+			return err
+		}
+	}
+	return merge(t.runs, t.keys, t.opts, func(row *object) error {
+		return emit(t.project(row))
+	})
+}
+
+// merge emits the rows of the runs in sort order. Equal rows come from
+// the earliest run first, which keeps the sort stable.
+func merge(runs []*os.File, keys []sortKey, opts Options, emit func(*object) error) error {
+	h := &runHeap{keys: keys}
+	for i, f := range runs {
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("error reading spill file: %v", err)
+		}
+		decoder := json.NewDecoder(bufio.NewReaderSize(f, spillBufferSize))
+		decoder.UseNumber()
+		c := &runCursor{index: i, decoder: decoder}
+		if ok, err := c.advance(keys, opts); err != nil {
+			return err
+		} else if ok {
+			h.cursors = append(h.cursors, c)
+		}
+	}
+	heap.Init(h)
+	for h.Len() > 0 {
+		c := h.cursors[0]
+		if err := emit(c.row); err != nil {
+			return err
+		}
+		ok, err := c.advance(keys, opts)
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return nil
+}
+
+// advance reads the next row of a run, reporting false at its end.
+func (c *runCursor) advance(keys []sortKey, opts Options) (bool, error) {
+	tok, err := c.decoder.Token()
+	if err == io.EOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error reading spill file: %v", err)
+	}
+	line, ok := tok.(json.Number)
+	if !ok {
+		return false, fmt.Errorf("error reading spill file: expected a line number, got %v", tok)
+	}
+	width := 0
+	if c.row != nil {
+		width = len(c.row.keys)
+	}
+	row, err := decodeObject(c.decoder, width)
+	if err != nil {
+		return false, fmt.Errorf("error reading spill file: %v", err)
+	}
+	n, _ := line.Int64()
+	row.line = int(n)
+	c.row, c.values = row, sortValues(row, keys, opts)
+	return true, nil
+}
+
+// close removes the spill files.
+func (t *spillTransform) close() {
+	putEncoder(t.e)
+	removeRuns(t.runs)
+	t.runs = nil
+}
+
+func removeRuns(runs []*os.File) {
+	for _, f := range runs {
+		f.Close()
+		os.Remove(f.Name())
+	}
+}
+
+type runCursor struct {
+	index   int
+	decoder *json.Decoder
+	row     *object
+	values  []sortValue
+}
+
+type runHeap struct {
+	keys    []sortKey
+	cursors []*runCursor
+}
+
+func (h *runHeap) Len() int { return len(h.cursors) }
+
+func (h *runHeap) Less(i, j int) bool {
+	a, b := h.cursors[i], h.cursors[j]
+	if c := compareRows(a.values, b.values, h.keys); c != 0 {
+		return c < 0
+	}
+	return a.index < b.index
+}
+
+func (h *runHeap) Swap(i, j int) { h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i] }
+
+func (h *runHeap) Push(x interface{}) { h.cursors = append(h.cursors, x.(*runCursor)) }
+
+func (h *runHeap) Pop() interface{} {
+	c := h.cursors[len(h.cursors)-1]
+	h.cursors = h.cursors[:len(h.cursors)-1]
+	return c
+}
+
+// rowSize estimates the memory a row holds, including its map entries.
+func rowSize(row *object) int64 {
+	size := int64(96)
+	for _, k := range row.keys {
+		size += 112 + int64(len(k)) + valueSize(row.values[k])
+	}
+	return size
+}
+
+func valueSize(v interface{}) int64 {
+	switch x := v.(type) {
+	case string:
+		return int64(len(x))
+	case json.Number:
+		return int64(len(x))
+	case []interface{}:
+		size := int64(24)
+		for _, e := range x {
+			size += 16 + valueSize(e)
+		}
+		return size
+	case map[string]interface{}:
+		size := int64(48)
+		for k, e := range x {
+			size += 48 + int64(len(k)) + valueSize(e)
+		}
+		return size
+	}
+	return 0
+}
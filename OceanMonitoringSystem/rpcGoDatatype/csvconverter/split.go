@@ -0,0 +1,278 @@
+package csvconverter
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Split modes accepted in SplitOptions.By.
+const (
+	SplitRows   = "rows"
+	SplitTime   = "time"
+	SplitColumn = "column"
+)
+
+const defaultSplitWindow = 24 * time.Hour
+
+// SplitOptions control how Split partitions its output.
+type SplitOptions struct {
+	By string
+	// Rows is the maximum number of rows per part for SplitRows.
+	Rows int
+	// Column holds the timestamps for SplitTime or the partition value for
+	// SplitColumn.
+	Column string
+	// Window is the length of each time window for SplitTime, as a Go
+	// duration such as "1h". It defaults to 24h, giving daily parts.
+	// Windows are aligned to the Unix epoch in UTC.
+	Window string
+}
+
+// Part is one partition of a split result.
+type Part struct {
+	Name   string
+	Rows   int
+	Output string
+	// Encoded holds Output in Options.OutputEncoding when that is not UTF-8.
+	Encoded []byte
+}
+
+// SplitResult is the output of Split. Parts are in order of their first row.
+type SplitResult struct {
+	Parts             []Part
+	Warnings          []string
+	DuplicatesRemoved int
+	Preamble          []string
+	Metadata          map[string]string
+	RowErrors         []RowError
+}
+
+func (s SplitOptions) window() (time.Duration, error) {
+	if s.Window == "" {
+		return defaultSplitWindow, nil
+	}
+	d, err := time.ParseDuration(s.Window)
+	if err != nil {
+		return 0, fmt.Errorf("invalid split window: %v", err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid split window: %s", s.Window)
+	}
+	return d, nil
+}
+
+func (s SplitOptions) validate() error {
+	switch s.By {
+	case SplitRows:
+		if s.Rows <= 0 {
+			return fmt.Errorf("split by rows requires a positive row count")
+		}
+	case SplitTime, SplitColumn:
+		if s.Column == "" {
+			return fmt.Errorf("split by %s requires a column", s.By)
+		}
+		if s.By == SplitTime {
+			if _, err := s.window(); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("invalid split mode: %q", s.By)
+	}
+	return nil
+}
+
+// Split converts data like Convert and partitions the converted rows into
+// parts by row count, time window or column value. Each part is a complete
+// document in the to format, which may be the same as the from format.
+func Split(from, to, data string, sopts SplitOptions, opts Options) (*SplitResult, error) {
+	return SplitContext(context.Background(), from, to, data, sopts, opts)
+}
+
+// SplitContext splits like Split, tracing the split under ctx. The split
+// stops with ctx.Err() once ctx is done.
+func SplitContext(ctx context.Context, from, to, data string, sopts SplitOptions, opts Options) (split *SplitResult, err error) {
+	_, span := tracer.Start(ctx, "csvconverter.Split", trace.WithAttributes(
+		attribute.String("csvconverter.from", from),
+		attribute.String("csvconverter.to", to),
+		attribute.Int64("csvconverter.input_bytes", int64(len(data))),
+	))
+	defer func() { endSpan(span, err) }()
+
+	read, ok := readers[strings.ToLower(from)]
+	if !ok {
+		return nil, fmt.Errorf("%w input format: %s", ErrUnsupported, from)
+	}
+	if _, ok := writers[strings.ToLower(to)]; !ok {
+		return nil, fmt.Errorf("%w output format: %s", ErrUnsupported, to)
+	}
+	if err := sopts.validate(); err != nil {
+		return nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	opts = opts.withMode()
+
+	result := &Result{}
+	rows, columns, err := readRows(read, data, opts, result)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if rows, err = transformRows(rows, columns, opts, result); err != nil {
+		return nil, err
+	}
+	if sopts.By != SplitRows && len(rows) > 0 {
+		if err := checkColumns([]string{sopts.Column}, rows[0].keys); err != nil {
+			return nil, fmt.Errorf("invalid split: %v", err)
+		}
+	}
+
+	names, groups, warnings := partition(rows, sopts, opts)
+	split = &SplitResult{
+		Warnings:          append(result.Warnings, warnings...),
+		DuplicatesRemoved: result.DuplicatesRemoved,
+		Preamble:          result.Preamble,
+		Metadata:          result.Metadata,
+		RowErrors:         result.RowErrors,
+	}
+	if err := opts.checkMode(split.Warnings); err != nil {
+		return nil, err
+	}
+	if opts.Mode == ModeAudit {
+		return split, nil
+	}
+	for i, group := range groups {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		part := &Result{}
+		if err := encodeResult(part, group, to, opts); err != nil {
+			return nil, fmt.Errorf("part %s: %v", names[i], err)
+		}
+		split.Parts = append(split.Parts, Part{
+			Name:    names[i],
+			Rows:    len(group),
+			Output:  part.Output,
+			Encoded: part.Encoded,
+		})
+	}
+	return split, nil
+}
+
+// partition groups rows and names each group. Rows whose timestamp cannot be
+// parsed go to an "unknown" part.
+func partition(rows []*object, sopts SplitOptions, opts Options) ([]string, [][]*object, []string) {
+	var (
+		names    []string
+		groups   [][]*object
+		warnings []string
+	)
+	if sopts.By == SplitRows {
+		for start := 0; start < len(rows); start += sopts.Rows {
+			end := start + sopts.Rows
+			if end > len(rows) {
+				end = len(rows)
+			}
+			names = append(names, fmt.Sprintf("part-%04d", len(names)+1))
+			groups = append(groups, rows[start:end])
+		}
+		return names, groups, warnings
+	}
+
+	window, _ := sopts.window()
+	index := make(map[string]int)
+	used := make(map[string]bool)
+	for i, row := range rows {
+		value, _ := row.get(sopts.Column)
+		var key, name string
+		switch {
+		case value == nil:
+			key, name = "\x00null", "null"
+		case sopts.By == SplitTime:
+			t, ok := parseTimestamp(fmt.Sprint(value), true, opts)
+			if !ok {
+				warnings = append(warnings, fmt.Sprintf("row %d: cannot parse timestamp %q, placed in part unknown", i+1, fmt.Sprint(value)))
+				key, name = "\x00unknown", "unknown"
+				break
+			}
+			name = windowName(t.UTC().Truncate(window), window)
+			key = name
+		default:
+			key = fmt.Sprint(value)
+			name = partName(key)
+		}
+
+		n, ok := index[key]
+		if !ok {
+			// Distinct values can map to the same safe name.
+			for base, j := name, 2; used[name]; j++ {
+				name = fmt.Sprintf("%s-%d", base, j)
+			}
+			used[name] = true
+			n = len(groups)
+			index[key] = n
+			names = append(names, name)
+			groups = append(groups, nil)
+		}
+		groups[n] = append(groups[n], row)
+	}
+	return names, groups, warnings
+}
+
+func windowName(start time.Time, window time.Duration) string {
+	switch {
+	case window%(24*time.Hour) == 0:
+		return start.Format("20060102")
+	case window%time.Minute == 0:
+		return start.Format("20060102T1504")
+	}
+	return start.Format("20060102T150405.000000000")
+}
+
+var unsafePartChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// partName turns a column value into a name that is safe to use as a file
+// name.
+func partName(value string) string {
+	name := strings.Trim(unsafePartChars.ReplaceAllString(value, "_"), "._")
+	if name == "" {
+		return "_"
+	}
+	return name
+}
+
+// Archive packs the parts into a zip file, one file per part named after the
+// part with ext as its extension.
+func (s *SplitResult) Archive(ext string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, part := range s.Parts {
+		f, err := w.Create(part.Name + "." + strings.ToLower(ext))
+		if err != nil {
+			return nil, fmt.Errorf("error archiving part %s: %v", part.Name, err)
+		}
+		content := part.Encoded
+		if content == nil {
+			content = []byte(part.Output)
+		}
+		if _, err := f.Write(content); err != nil {
+			return nil, fmt.Errorf("error archiving part %s: %v", part.Name, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("error archiving parts: %v", err)
+	}
+	return buf.Bytes(), nil
+}
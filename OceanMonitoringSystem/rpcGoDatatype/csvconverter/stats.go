@@ -0,0 +1,62 @@
+package csvconverter
+
+import "time"
+
+// Stats summarizes a conversion.
+type Stats struct {
+	// RowsRead counts the data rows in the input, including invalid ones.
+	RowsRead int
+	// RowsSkipped counts the rows dropped as invalid, by the filter or by
+	// deduplication.
+	RowsSkipped int
+	RowsWritten int
+	// Columns lists the output columns and ColumnTypes the type inferred for
+	// each, as one of the Type* constants.
+	Columns     []string
+	ColumnTypes map[string]string
+	Duration    time.Duration
+}
+
+// statsCollector accumulates the output columns and their types as rows
+// are written.
+type statsCollector struct {
+	opts    Options
+	written int
+	columns []string
+	byName  map[string]*columnStats
+}
+
+func newStatsCollector(opts Options) *statsCollector {
+	return &statsCollector{opts: opts, byName: make(map[string]*columnStats)}
+}
+
+func (s *statsCollector) add(row *object) {
+	s.written++
+	if s.opts.Observe != nil {
+		s.opts.Observe(row.values)
+	}
+	for _, c := range row.keys {
+		cs, ok := s.byName[c]
+		if !ok {
+			cs = &columnStats{kinds: make(map[int]bool)}
+			s.byName[c] = cs
+			s.columns = append(s.columns, c)
+		}
+		cs.add(row.values[c], 0, s.opts)
+	}
+}
+
+// finish fills in the statistics of result. read is the number of rows left
+// after reading, before any transformation.
+func (s *statsCollector) finish(result *Result, read int, start time.Time) {
+	stats := &result.Stats
+	// Resampling steps may emit more rows than they read.
+	stats.RowsSkipped = max(stats.RowsRead-read, 0) + result.DuplicatesRemoved
+	stats.RowsWritten = s.written
+	stats.Columns = s.columns
+	stats.ColumnTypes = make(map[string]string, len(s.columns))
+	for _, c := range s.columns {
+		stats.ColumnTypes[c] = s.byName[c].finish().Type
+	}
+	stats.Duration = time.Since(start)
+}
@@ -0,0 +1,319 @@
+package csvconverter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// cancelCheckInterval is the number of rows processed between checks for
+// cancellation of the conversion's context.
+const cancelCheckInterval = 1024
+
+// needsAllRows reports whether the options include steps that only work on
+// the complete set of rows.
+func (o Options) needsAllRows() bool {
+	return len(o.SortBy) > 0 || o.Reshape != "" || o.Deduplicate || len(o.DedupKeys) > 0
+}
+
+// ConvertStream converts the input read from r and writes the output to w
+// row by row, so memory use does not grow with the input. Sorting,
+// reshaping and deduplication need every row and hold the rows in memory
+// until the input ends, or past Options.SpillThreshold in temporary files.
+//
+// The input is read as UTF-8 unless Options.InputEncoding names another
+// encoding, and the output is written in Options.OutputEncoding. The
+// returned Result holds everything but the output. On error, w may hold
+// partial output.
+func ConvertStream(from, to string, r io.Reader, w io.Writer, opts Options) (*Result, error) {
+	return ConvertStreamContext(context.Background(), from, to, r, w, opts)
+}
+
+// ConvertStreamContext converts like ConvertStream, tracing the conversion
+// under ctx. The conversion stops with ctx.Err() once ctx is done.
+func ConvertStreamContext(ctx context.Context, from, to string, r io.Reader, w io.Writer, opts Options) (*Result, error) {
+	return convertStream(ctx, from, to, r, w, opts, nil)
+}
+
+// convertStream converts like ConvertStream, running steps on each row.
+func convertStream(ctx context.Context, from, to string, r io.Reader, w io.Writer, opts Options, steps []rowStep) (result *Result, err error) {
+	ctx, span := tracer.Start(ctx, "csvconverter.stream", trace.WithAttributes(
+		attribute.String("csvconverter.from", from),
+		attribute.String("csvconverter.to", to),
+	))
+	defer func() { endSpan(span, err) }()
+
+	supported := Supported(from, to)
+	if steps != nil {
+		// Pipelines can write rows back in their input format.
+		_, readable := readers[strings.ToLower(from)]
+		_, writable := writers[strings.ToLower(to)]
+		supported = readable && writable
+	}
+	if !supported {
+		return nil, fmt.Errorf("%w conversion: from %s to %s", ErrUnsupported, from, to)
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	opts = opts.withMode()
+	start := time.Now()
+	// Text encodings do not apply to binary formats.
+	if isBinaryFormat(from) {
+		opts.InputEncoding = ""
+	}
+	if isBinaryFormat(to) {
+		opts.OutputEncoding = ""
+	}
+
+	input := limitSize(r, opts)
+	r, err = decodeReader(input, opts.InputEncoding)
+	if err != nil {
+		return nil, err
+	}
+	var encoder io.Closer
+	if w, encoder, err = encodeWriter(w, opts.OutputEncoding); err != nil {
+		return nil, err
+	}
+
+	if fn, ok := externalConverter(from, to); ok {
+		result, err = convertExternal(ctx, fn, r, w, encoder, opts)
+		return result, input.check(err)
+	}
+
+	result = &Result{}
+	src, err := readers[strings.ToLower(from)](r, opts, result)
+	if err != nil {
+		return nil, input.check(err)
+	}
+	if c, ok := src.(*csvRowReader); ok && opts.Workers > 1 {
+		p := newParallelRows(c, opts.Workers)
+		defer p.close()
+		src = p
+	}
+	if src, err = rangeReader(src, opts, result); err != nil {
+		return nil, err
+	}
+	if src, err = unitReader(src, opts, result); err != nil {
+		return nil, err
+	}
+	if len(steps) > 0 {
+		if src, err = newStepReader(src, steps, opts, result); err != nil {
+			return nil, err
+		}
+	}
+	check, err := newSchemaCheck(opts, result)
+	if err != nil {
+		return nil, err
+	}
+	var dst rowWriter = discardRows{}
+	if opts.Mode != ModeAudit {
+		dst = writers[strings.ToLower(to)](w, opts)
+	}
+	stats := newStatsCollector(opts)
+	watch := &stopwatch{on: span.IsRecording()}
+	defer watch.record(span)
+
+	// cancelled checks ctx every cancelCheckInterval rows.
+	processed := 0
+	cancelled := func() error {
+		if processed++; processed%cancelCheckInterval == 0 {
+			return ctx.Err()
+		}
+		return nil
+	}
+
+	// next returns the next row that satisfies the schema.
+	read, scanned := 0, 0
+	next := func() (row *object, err error) {
+		err = watch.time(&watch.parse, func() error {
+			for {
+				if err := cancelled(); err != nil {
+					return err
+				}
+				row, err = src.Next()
+				if err != nil {
+					return input.check(err)
+				}
+				scanned++
+				if err := opts.checkRow(scanned, row); err != nil {
+					return err
+				}
+				if ok, err := check.keep(row); err != nil {
+					return err
+				} else if ok {
+					read++
+					return nil
+				}
+			}
+		})
+		return row, err
+	}
+	write := func(row *object) error {
+		stats.add(row)
+		return watch.time(&watch.encode, func() error { return dst.Write(row) })
+	}
+
+	if opts.needsAllRows() && opts.spilling() {
+		t, err := newSpillTransform(src.Columns(), opts, result)
+		if err != nil {
+			return nil, err
+		}
+		defer t.close()
+		emit := func(row *object) error {
+			if err := cancelled(); err != nil {
+				return err
+			}
+			if err := opts.checkMode(result.Warnings); err != nil {
+				return err
+			}
+			return write(row)
+		}
+		for {
+			row, err := next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if err := t.add(row, emit); err != nil {
+				return nil, err
+			}
+		}
+		if err := t.finish(emit); err != nil {
+			return nil, err
+		}
+		if err := opts.checkMode(result.Warnings); err != nil {
+			return nil, err
+		}
+	} else if opts.needsAllRows() {
+		rows := []*object{}
+		for {
+			row, err := next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, row)
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		_, transform := tracer.Start(ctx, "csvconverter.transform", trace.WithAttributes(
+			attribute.Int("csvconverter.rows", len(rows)),
+		))
+		rows, err = transformRows(rows, src.Columns(), opts, result)
+		endSpan(transform, err)
+		if err != nil {
+			return nil, err
+		}
+		if err := opts.checkMode(result.Warnings); err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			if err := cancelled(); err != nil {
+				return nil, err
+			}
+			if err := write(row); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		if columns := src.Columns(); columns != nil {
+			if err := checkColumns(opts.Columns, columns); err != nil {
+				return nil, optionError("Columns", err)
+			}
+		}
+		for {
+			row, err := next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			// Fail a strict conversion at the first warning rather than
+			// after writing the remaining rows.
+			if err := opts.checkMode(result.Warnings); err != nil {
+				return nil, err
+			}
+			if len(opts.Columns) > 0 {
+				row = row.project(opts.Columns)
+			}
+			if err := write(row); err != nil {
+				return nil, err
+			}
+		}
+		if err := opts.checkMode(result.Warnings); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := watch.time(&watch.encode, dst.Close); err != nil {
+		return nil, err
+	}
+	if encoder != nil {
+		if err := encoder.Close(); err != nil {
+			return nil, fmt.Errorf("error encoding %s output: %v", opts.OutputEncoding, err)
+		}
+	}
+	stats.finish(result, read, start)
+	span.SetAttributes(attribute.Int("csvconverter.rows", read))
+	return result, nil
+}
+
+// convertExternal runs a registered converter, which needs the whole input.
+// Registered converters take no context, so cancellation is only noticed
+// before and after they run.
+func convertExternal(ctx context.Context, fn ConverterFunc, r io.Reader, w io.Writer, encoder io.Closer, opts Options) (*Result, error) {
+	start := time.Now()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading input: %v", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	_, span := tracer.Start(ctx, "csvconverter.external")
+	result, err := fn(string(data), opts)
+	endSpan(span, err)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := opts.checkMode(result.Warnings); err != nil {
+		return nil, err
+	}
+	if opts.Mode != ModeAudit {
+		if _, err := io.WriteString(w, result.Output); err != nil {
+			return nil, err
+		}
+	}
+	result.Output = ""
+	if encoder != nil {
+		if err := encoder.Close(); err != nil {
+			return nil, fmt.Errorf("error encoding %s output: %v", opts.OutputEncoding, err)
+		}
+	}
+	result.Stats.Duration = time.Since(start)
+	return result, nil
+}
+
+// discardRows is the rowWriter used in audit mode.
+type discardRows struct{}
+
+func (discardRows) Write(*object) error { return nil }
+func (discardRows) Close() error        { return nil }
@@ -0,0 +1,178 @@
+package csvconverter
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Template output limits. Decimals and widths asked of fixed and pad are
+// clamped to maxTemplateWidth, and one execution of a template may write at
+// most maxTemplateOutput bytes, so that a template cannot exhaust memory.
+const (
+	maxTemplateWidth  = 64
+	maxTemplateOutput = 64 << 10
+)
+
+func clampWidth(n int) int {
+	return max(0, min(n, maxTemplateWidth))
+}
+
+// templateFuncs are available to output templates besides the text/template
+// built-ins.
+var templateFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"trim":  strings.TrimSpace,
+	// fixed formats a number with n decimals; other values pass unchanged.
+	"fixed": func(n int, v interface{}) string {
+		f, err := strconv.ParseFloat(fmt.Sprint(v), 64)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return strconv.FormatFloat(f, 'f', clampWidth(n), 64)
+	},
+	// pad right-aligns a value in a field of width n.
+	"pad": func(n int, v interface{}) string {
+		return fmt.Sprintf("%*v", clampWidth(n), v)
+	},
+}
+
+// templateHeader is the data passed to the header and footer templates.
+type templateHeader struct {
+	Columns []string
+	// Rows is the number of rows written, set for the footer only.
+	Rows int
+}
+
+// parseTemplates compiles the output templates. The row template is
+// required for template output.
+func parseTemplates(opts Options) (row, header, footer *template.Template, err error) {
+	parse := func(name, option, text string) (*template.Template, error) {
+		if text == "" {
+			return nil, nil
+		}
+		t, err := template.New(name).Funcs(templateFuncs).Parse(text)
+		if err != nil {
+			return nil, &OptionError{Option: option, Reason: fmt.Sprintf("invalid %s template: %v", name, err)}
+		}
+		return t, nil
+	}
+	if row, err = parse("row", "Template", opts.Template); err != nil {
+		return nil, nil, nil, err
+	}
+	if header, err = parse("header", "TemplateHeader", opts.TemplateHeader); err != nil {
+		return nil, nil, nil, err
+	}
+	if footer, err = parse("footer", "TemplateFooter", opts.TemplateFooter); err != nil {
+		return nil, nil, nil, err
+	}
+	return row, header, footer, nil
+}
+
+// templateRowWriter executes the row template once per row, between the
+// optional header and footer templates.
+type templateRowWriter struct {
+	w                   io.Writer
+	opts                Options
+	row, header, footer *template.Template
+	err                 error
+	columns             []string
+	started             bool
+	n                   int
+}
+
+func newTemplateWriter(w io.Writer, opts Options) rowWriter {
+	t := &templateRowWriter{w: w, opts: opts}
+	t.row, t.header, t.footer, t.err = parseTemplates(opts)
+	if t.err == nil && t.row == nil {
+		t.err = fmt.Errorf("template output needs a row template")
+	}
+	return t
+}
+
+func (t *templateRowWriter) start(columns []string) error {
+	t.started = true
+	t.columns = columns
+	if t.header == nil {
+		return nil
+	}
+	return t.execute(t.header, templateHeader{Columns: columns})
+}
+
+// execute runs tmpl, failing with ErrLimitExceeded once it writes more than
+// maxTemplateOutput bytes.
+func (t *templateRowWriter) execute(tmpl *template.Template, data interface{}) error {
+	lw := &templateLimitWriter{w: t.w}
+	if err := tmpl.Execute(lw, data); err != nil {
+		if lw.exceeded {
+			return fmt.Errorf("%w: %s template output is larger than %d bytes", ErrLimitExceeded, tmpl.Name(), maxTemplateOutput)
+		}
+		return fmt.Errorf("error executing %s template: %v", tmpl.Name(), err)
+	}
+	return nil
+}
+
+// templateLimitWriter fails writes past maxTemplateOutput bytes, which
+// stops the template executing.
+type templateLimitWriter struct {
+	w        io.Writer
+	n        int
+	exceeded bool
+}
+
+func (l *templateLimitWriter) Write(p []byte) (int, error) {
+	if l.n+len(p) > maxTemplateOutput {
+		l.exceeded = true
+		return 0, ErrLimitExceeded
+	}
+	l.n += len(p)
+	return l.w.Write(p)
+}
+
+// Write executes the row template with the row's values by column name.
+// Null values are given as Options.NullOutput.
+func (t *templateRowWriter) Write(row *object) error {
+	if t.err != nil {
+		return t.err
+	}
+	if !t.started {
+		if err := t.start(row.keys); err != nil {
+			return err
+		}
+	}
+	values := make(map[string]interface{}, len(row.keys))
+	for _, k := range row.keys {
+		v, _ := row.get(k)
+		if v == nil {
+			v = t.opts.NullOutput
+		}
+		values[k] = v
+	}
+	t.n++
+	if err := t.execute(t.row, values); err != nil {
+		if errors.Is(err, ErrLimitExceeded) {
+			return err
+		}
+		return &RowError{Row: row.line, Reason: err.Error()}
+	}
+	return nil
+}
+
+func (t *templateRowWriter) Close() error {
+	if t.err != nil {
+		return t.err
+	}
+	if !t.started {
+		if err := t.start(nil); err != nil {
+			return err
+		}
+	}
+	if t.footer == nil {
+		return nil
+	}
+	return t.execute(t.footer, templateHeader{Columns: t.columns, Rows: t.n})
+}
@@ -0,0 +1,32 @@
+package csvconverter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestTemplateWidthsAreClamped(t *testing.T) {
+	result, err := Convert("csv", "template", "temp\n4.5\n", Options{
+		Template: `{{fixed 1000000000 .temp}}|{{pad 1000000 .temp}}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixed, pad, _ := strings.Cut(result.Output, "|")
+	if want := 2 + maxTemplateWidth; len(fixed) != want {
+		t.Errorf("fixed wrote %d bytes, want %d", len(fixed), want)
+	}
+	if len(pad) != maxTemplateWidth {
+		t.Errorf("pad wrote %d bytes, want %d", len(pad), maxTemplateWidth)
+	}
+}
+
+func TestTemplateOutputIsBounded(t *testing.T) {
+	_, err := Convert("csv", "template", "temp\n4.5\n", Options{
+		Template: `{{range 1000000000}}{{pad 64 $.temp}}{{end}}`,
+	})
+	if !errors.Is(err, ErrLimitExceeded) {
+		t.Fatalf("got error %v, want ErrLimitExceeded", err)
+	}
+}
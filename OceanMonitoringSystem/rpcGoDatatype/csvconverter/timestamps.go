@@ -0,0 +1,82 @@
+package csvconverter
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"rpcGoDatatype/timeparse"
+)
+
+func (o Options) timeParser() timeparse.Parser {
+	pivot := o.YearPivot
+	if pivot == 0 {
+		pivot = timeparse.DefaultPivot
+	}
+	loc, _ := loadLocation(o.SourceTimezone)
+	return timeparse.Parser{Pivot: pivot, Location: loc}
+}
+
+// locations caches the zones loaded by loadLocation.
+var locations sync.Map
+
+// loadLocation returns the IANA zone called name, or UTC for an empty name.
+func loadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q", name)
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// normalizeTimestamps reports whether recognized timestamps are rewritten.
+func (o Options) normalizeTimestamps() bool {
+	return o.NormalizeTimestamps || o.SourceTimezone != "" || o.TargetTimezone != ""
+}
+
+// parseTimestamp parses a timestamp value. Epoch seconds and milliseconds
+// are only accepted when allowEpoch is set, since plain numbers are far more
+// often measurements or IDs.
+func parseTimestamp(value string, allowEpoch bool, opts Options) (time.Time, bool) {
+	if timeparse.Plausible(value) {
+		if t, err := opts.timeParser().Parse(value); err == nil {
+			return t, true
+		}
+	}
+	if allowEpoch {
+		if t, err := timeparse.ParseEpoch(value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// timestampValue returns the JSON value for a recognized timestamp.
+func timestampValue(value string, t time.Time, opts Options) interface{} {
+	if opts.normalizeTimestamps() {
+		loc, _ := loadLocation(opts.TargetTimezone)
+		return t.In(loc).Format(time.RFC3339Nano)
+	}
+	return value
+}
+
+// timeValue reads a row's time column. Numbers are read as epoch
+// timestamps.
+func timeValue(v interface{}, opts Options) (time.Time, bool) {
+	switch v := v.(type) {
+	case nil:
+		return time.Time{}, false
+	case json.Number:
+		t, err := timeparse.ParseEpoch(string(v))
+		return t, err == nil
+	}
+	return parseTimestamp(fmt.Sprint(v), true, opts)
+}
@@ -0,0 +1,51 @@
+package csvconverter
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer records conversion spans. It does nothing until the program
+// installs a tracer provider.
+var tracer = otel.Tracer("rpcGoDatatype/csvconverter")
+
+// endSpan ends span, marking it failed when err is set.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// stopwatch sums the time spent in the interleaved parsing and encoding of
+// a streaming conversion, which cannot be separate spans. It only reads
+// the clock when the span is recorded.
+type stopwatch struct {
+	on            bool
+	parse, encode time.Duration
+}
+
+func (s *stopwatch) time(d *time.Duration, fn func() error) error {
+	if !s.on {
+		return fn()
+	}
+	start := time.Now()
+	err := fn()
+	*d += time.Since(start)
+	return err
+}
+
+func (s *stopwatch) record(span trace.Span) {
+	if !s.on {
+		return
+	}
+	span.SetAttributes(
+		attribute.Float64("csvconverter.parse_ms", float64(s.parse)/float64(time.Millisecond)),
+		attribute.Float64("csvconverter.encode_ms", float64(s.encode)/float64(time.Millisecond)),
+	)
+}
@@ -0,0 +1,173 @@
+package csvconverter
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"rpcGoDatatype/coords"
+)
+
+// Default names of the columns a track step appends. Their unit
+// annotations let a later convert_units step convert them, e.g. to knots.
+const (
+	DefaultDistanceColumn = "distance_m"
+	DefaultStepColumn     = "step_m"
+	DefaultSpeedColumn    = "speed_m/s"
+)
+
+// Track appends the great-circle distances of position fixes, in metres.
+// StepColumn holds the distance from the previous fix of the same station
+// and SpeedColumn that distance over the time between the two fixes, in
+// metres per second. Fixes are taken in row order; the first of each
+// station, and rows without valid coordinates, get nulls and leave the
+// previous fix in place. Coordinates are read in decimal or DMS form.
+type Track struct {
+	LatitudeColumn  string
+	LongitudeColumn string
+	// TimeColumn enables the speed column.
+	TimeColumn string
+	// StationColumn tracks each station separately. Without it all rows
+	// form one track.
+	StationColumn string
+	// Reference enables DistanceColumn, the distance from that point.
+	Reference *coords.Point
+	// DistanceColumn, StepColumn and SpeedColumn default to
+	// DefaultDistanceColumn, DefaultStepColumn and DefaultSpeedColumn.
+	DistanceColumn string
+	StepColumn     string
+	SpeedColumn    string
+}
+
+// trackStep is a compiled Track.
+type trackStep struct {
+	t     Track
+	added []string
+}
+
+func newTrackStep(t Track) (*trackStep, error) {
+	if t.LatitudeColumn == "" || t.LongitudeColumn == "" {
+		return nil, fmt.Errorf("track needs a latitude and a longitude column")
+	}
+	if t.DistanceColumn == "" {
+		t.DistanceColumn = DefaultDistanceColumn
+	}
+	if t.StepColumn == "" {
+		t.StepColumn = DefaultStepColumn
+	}
+	if t.SpeedColumn == "" {
+		t.SpeedColumn = DefaultSpeedColumn
+	}
+	if r := t.Reference; r != nil && !(math.Abs(r.Latitude) <= 90 && math.Abs(r.Longitude) <= 180) {
+		return nil, fmt.Errorf("invalid track reference: %g, %g", r.Latitude, r.Longitude)
+	}
+	s := &trackStep{t: t}
+	if t.Reference != nil {
+		s.added = append(s.added, t.DistanceColumn)
+	}
+	s.added = append(s.added, t.StepColumn)
+	if t.TimeColumn != "" {
+		s.added = append(s.added, t.SpeedColumn)
+	}
+	for i, column := range s.added {
+		if hasColumn(s.added[:i], column) {
+			return nil, fmt.Errorf("track column %s is used twice", column)
+		}
+	}
+	return s, nil
+}
+
+func (s *trackStep) columns(in []string) ([]string, error) {
+	named := []string{s.t.LatitudeColumn, s.t.LongitudeColumn}
+	for _, column := range []string{s.t.TimeColumn, s.t.StationColumn} {
+		if column != "" {
+			named = append(named, column)
+		}
+	}
+	if err := checkColumns(named, in); err != nil {
+		return nil, fmt.Errorf("track: %v", err)
+	}
+	out := append([]string(nil), in...)
+	for _, column := range s.added {
+		if hasColumn(in, column) {
+			return nil, fmt.Errorf("track: column %q already exists", column)
+		}
+		out = append(out, column)
+	}
+	return out, nil
+}
+
+func (s *trackStep) reader(src rowReader, columns []string, opts Options, result *Result) rowReader {
+	r := &trackReader{src: src, step: s, opts: opts, last: make(map[string]trackFix)}
+	if columns != nil {
+		r.columns, _ = s.columns(columns)
+	}
+	return r
+}
+
+// trackFix is the last valid fix of a station.
+type trackFix struct {
+	at    coords.Point
+	t     time.Time
+	timed bool
+}
+
+// trackReader appends the track columns to the rows of src as they are
+// read, keeping the last fix of each station.
+type trackReader struct {
+	src     rowReader
+	step    *trackStep
+	opts    Options
+	columns []string
+	last    map[string]trackFix
+}
+
+func (r *trackReader) Columns() []string {
+	return r.columns
+}
+
+// Next returns the next row with its track columns, or io.EOF.
+func (r *trackReader) Next() (*object, error) {
+	row, err := r.src.Next()
+	if err != nil {
+		return nil, err
+	}
+	t := r.step.t
+	var distance, step, speed interface{}
+	lat, okLat := coordinate(row, t.LatitudeColumn, coords.Latitude)
+	lon, okLon := coordinate(row, t.LongitudeColumn, coords.Longitude)
+	if okLat && okLon {
+		fix := trackFix{at: coords.Point{Latitude: lat, Longitude: lon}}
+		if t.Reference != nil {
+			distance = floatNumber(coords.Distance(*t.Reference, fix.at))
+		}
+		if t.TimeColumn != "" {
+			v, _ := row.get(t.TimeColumn)
+			fix.t, fix.timed = timeValue(v, r.opts)
+		}
+		name := ""
+		if t.StationColumn != "" {
+			if v, _ := row.get(t.StationColumn); v != nil {
+				name = fmt.Sprint(v)
+			}
+		}
+		if prev, ok := r.last[name]; ok {
+			d := coords.Distance(prev.at, fix.at)
+			step = floatNumber(d)
+			if prev.timed && fix.timed {
+				if elapsed := fix.t.Sub(prev.t).Seconds(); elapsed > 0 {
+					speed = floatNumber(d / elapsed)
+				}
+			}
+		}
+		r.last[name] = fix
+	}
+	if t.Reference != nil {
+		row.set(t.DistanceColumn, distance)
+	}
+	row.set(t.StepColumn, step)
+	if t.TimeColumn != "" {
+		row.set(t.SpeedColumn, speed)
+	}
+	return row, nil
+}
@@ -0,0 +1,53 @@
+package csvconverter
+
+import "fmt"
+
+// transformRows applies the row-set steps that follow parsing and filtering:
+// deduplication, reshaping, sorting and column projection. When the input
+// has a fixed column set, sort and projection columns are checked against it.
+func transformRows(rows []*object, columns []string, opts Options, result *Result) ([]*object, error) {
+	sortKeys, err := parseSortKeys(opts.SortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Deduplicate || len(opts.DedupKeys) > 0 {
+		if rows, result.DuplicatesRemoved, err = dedupRows(rows, opts.DedupKeys); err != nil {
+			return nil, err
+		}
+	}
+
+	switch opts.Reshape {
+	case ReshapePivot:
+		var warnings []string
+		if rows, warnings, err = pivot(rows, opts); err != nil {
+			return nil, fmt.Errorf("pivot: %v", err)
+		}
+		result.Warnings = append(result.Warnings, warnings...)
+	case ReshapeUnpivot:
+		rows = unpivot(rows, opts)
+	}
+
+	if opts.Reshape != "" {
+		columns = nil
+		if len(rows) > 0 {
+			columns = rows[0].keys
+		}
+	}
+	if columns != nil {
+		if err := checkColumns(sortColumns(sortKeys), columns); err != nil {
+			return nil, &OptionError{Option: "SortBy", Reason: fmt.Sprintf("invalid sort: %v", err)}
+		}
+		if err := checkColumns(opts.Columns, columns); err != nil {
+			return nil, optionError("Columns", err)
+		}
+	}
+
+	sortRows(rows, sortKeys, opts)
+	if len(opts.Columns) > 0 {
+		for i, row := range rows {
+			rows[i] = row.project(opts.Columns)
+		}
+	}
+	return rows, nil
+}
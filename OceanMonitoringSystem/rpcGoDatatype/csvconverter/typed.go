@@ -0,0 +1,271 @@
+package csvconverter
+
+import (
+	"encoding"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// structField maps a CSV column to a struct field.
+type structField struct {
+	column string
+	index  []int
+}
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// structFields returns the columns of a struct type. Exported fields map to
+// the column named by their `csv` tag, or to the field name when untagged;
+// fields tagged `csv:"-"` are ignored.
+func structFields(t reflect.Type) ([]structField, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s is not a struct", t)
+	}
+	var fields []structField
+	seen := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		column := f.Name
+		if tag, ok := f.Tag.Lookup("csv"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				column = tag
+			}
+		}
+		if seen[column] {
+			return nil, fmt.Errorf("%s: duplicate column %q", t, column)
+		}
+		seen[column] = true
+		fields = append(fields, structField{column: column, index: f.Index})
+	}
+	return fields, nil
+}
+
+// DecodeCSV reads CSV with a header row from r into a slice of structs, see
+// DecodeCSVWithOptions.
+func DecodeCSV[T any](r io.Reader) ([]T, error) {
+	return DecodeCSVWithOptions[T](r, Options{})
+}
+
+// DecodeCSVWithOptions reads CSV from r into a slice of structs, matching
+// columns to fields by their `csv` tag. Columns without a field are ignored
+// and fields without a column keep their zero value. Empty cells and
+// Options.NullValues leave fields at their zero value, or nil for pointers.
+//
+// Strings, integers, floats, booleans, time.Time and types implementing
+// encoding.TextUnmarshaler are supported, as are pointers to them. Header,
+// preamble, null and filter options apply as for Convert; the first value
+// that does not fit its field fails with a *RowError.
+func DecodeCSVWithOptions[T any](r io.Reader, opts Options) ([]T, error) {
+	fields, err := structFields(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	opts.DisableTypeInference = true
+	opts.ColumnTypes = nil
+
+	r, err = decodeReader(r, opts.InputEncoding)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{}
+	src, err := newCSVReader(r, opts, result)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []T{}
+	for {
+		row, err := src.Next()
+		if err == io.EOF {
+			return items, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var item T
+		v := reflect.ValueOf(&item).Elem()
+		for _, f := range fields {
+			value, ok := row.get(f.column)
+			if !ok || value == nil {
+				continue
+			}
+			// Values are strings unless computed.
+			s := fmt.Sprint(value)
+			if s == "" {
+				continue
+			}
+			if err := setField(v.FieldByIndex(f.index), s, opts); err != nil {
+				return nil, &RowError{Row: row.line, Column: f.column, Reason: err.Error()}
+			}
+		}
+		items = append(items, item)
+	}
+}
+
+func setField(v reflect.Value, s string, opts Options) error {
+	if v.Kind() == reflect.Pointer {
+		p := reflect.New(v.Type().Elem())
+		if err := setField(p.Elem(), s, opts); err != nil {
+			return err
+		}
+		v.Set(p)
+		return nil
+	}
+	if v.Type() == timeType {
+		t, ok := parseTimestamp(s, true, opts)
+		if !ok {
+			return fmt.Errorf("value %q is not a timestamp", s)
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+	if v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, ok := parseBool(s)
+		if !ok {
+			return fmt.Errorf("value %q is not a boolean", s)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("value %q is not a valid %s", s, v.Type())
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("value %q is not a valid %s", s, v.Type())
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("value %q is not a number", s)
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported field type %s", v.Type())
+	}
+	return nil
+}
+
+// EncodeCSV writes a slice of structs to w as CSV, see
+// EncodeCSVWithOptions.
+func EncodeCSV[T any](w io.Writer, items []T) error {
+	return EncodeCSVWithOptions(w, items, Options{})
+}
+
+// EncodeCSVWithOptions writes a slice of structs to w as CSV with a header
+// row of the columns named by the fields' `csv` tags, in field order. Times
+// are written as RFC 3339 and nil pointers as Options.NullOutput.
+// Options.Columns, OmitHeader and OutputEncoding apply as for Convert.
+func EncodeCSVWithOptions[T any](w io.Writer, items []T, opts Options) error {
+	fields, err := structFields(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return err
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	columns := make([]string, len(fields))
+	for i, f := range fields {
+		columns[i] = f.column
+	}
+	if err := checkColumns(opts.Columns, columns); err != nil {
+		return optionError("Columns", err)
+	}
+	if len(opts.Columns) > 0 {
+		columns = opts.Columns
+	}
+
+	var closer io.Closer
+	if w, closer, err = encodeWriter(w, opts.OutputEncoding); err != nil {
+		return err
+	}
+	dst := &csvRowWriter{writer: csv.NewWriter(w), opts: opts}
+	if err := dst.start(columns); err != nil {
+		return err
+	}
+	for _, item := range items {
+		v := reflect.ValueOf(item)
+		row := newObject()
+		for _, f := range fields {
+			s, ok, err := formatField(v.FieldByIndex(f.index))
+			if err != nil {
+				return fmt.Errorf("column %s: %v", f.column, err)
+			}
+			if ok {
+				row.set(f.column, s)
+			} else {
+				row.set(f.column, nil)
+			}
+		}
+		if err := dst.Write(row.project(columns)); err != nil {
+			return err
+		}
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	if closer != nil {
+		if err := closer.Close(); err != nil {
+			return fmt.Errorf("error encoding %s output: %v", opts.OutputEncoding, err)
+		}
+	}
+	return nil
+}
+
+// formatField returns the CSV text for a field, or false for a nil pointer.
+func formatField(v reflect.Value) (string, bool, error) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", false, nil
+		}
+		v = v.Elem()
+	}
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).Format(time.RFC3339Nano), true, nil
+	}
+	if v.Type().Implements(textMarshalerType) {
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), true, err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true, nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), true, nil
+	}
+	return "", false, fmt.Errorf("unsupported field type %s", v.Type())
+}
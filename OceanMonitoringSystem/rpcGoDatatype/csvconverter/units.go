@@ -0,0 +1,244 @@
+package csvconverter
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// unit converts to its quantity's base unit as base = value*scale + offset.
+type unit struct {
+	quantity      string
+	scale, offset float64
+}
+
+// Oxygen concentrations per litre are converted to per kilogram at
+// seawaterDensity, in kg/L. Practical salinity is converted to absolute
+// salinity in g/kg by the reference composition ratio 35.16504/35.
+const (
+	seawaterDensity = 1.025
+	salinityRatio   = 35.16504 / 35
+)
+
+var units = map[string]unit{
+	"k":          {"temperature", 1, 0},
+	"kelvin":     {"temperature", 1, 0},
+	"degc":       {"temperature", 1, 273.15},
+	"°c":         {"temperature", 1, 273.15},
+	"c":          {"temperature", 1, 273.15},
+	"celsius":    {"temperature", 1, 273.15},
+	"degf":       {"temperature", 5.0 / 9, 273.15 - 32*5.0/9},
+	"°f":         {"temperature", 5.0 / 9, 273.15 - 32*5.0/9},
+	"f":          {"temperature", 5.0 / 9, 273.15 - 32*5.0/9},
+	"fahrenheit": {"temperature", 5.0 / 9, 273.15 - 32*5.0/9},
+
+	"m":      {"length", 1, 0},
+	"cm":     {"length", 0.01, 0},
+	"mm":     {"length", 0.001, 0},
+	"km":     {"length", 1000, 0},
+	"ft":     {"length", 0.3048, 0},
+	"fathom": {"length", 1.8288, 0},
+
+	"m/s":   {"speed", 1, 0},
+	"cm/s":  {"speed", 0.01, 0},
+	"km/h":  {"speed", 1 / 3.6, 0},
+	"kn":    {"speed", 1852.0 / 3600, 0},
+	"knots": {"speed", 1852.0 / 3600, 0},
+
+	"dbar": {"pressure", 1, 0},
+	"bar":  {"pressure", 10, 0},
+	"mbar": {"pressure", 0.01, 0},
+	"hpa":  {"pressure", 0.01, 0},
+	"kpa":  {"pressure", 0.1, 0},
+	"pa":   {"pressure", 0.0001, 0},
+	"psi":  {"pressure", 0.689475729, 0},
+
+	"g/kg":   {"salinity", 1, 0},
+	"ppt":    {"salinity", 1, 0},
+	"‰":      {"salinity", 1, 0},
+	"psu":    {"salinity", salinityRatio, 0},
+	"pss-78": {"salinity", salinityRatio, 0},
+	"pss78":  {"salinity", salinityRatio, 0},
+
+	"umol/kg": {"oxygen", 1, 0},
+	"µmol/kg": {"oxygen", 1, 0},
+	"μmol/kg": {"oxygen", 1, 0},
+	"umol/l":  {"oxygen", 1 / seawaterDensity, 0},
+	"µmol/l":  {"oxygen", 1 / seawaterDensity, 0},
+	"μmol/l":  {"oxygen", 1 / seawaterDensity, 0},
+	"mmol/m3": {"oxygen", 1 / seawaterDensity, 0},
+	"mg/l":    {"oxygen", 1000 / 31.998 / seawaterDensity, 0},
+	"ml/l":    {"oxygen", 44.661 / seawaterDensity, 0},
+}
+
+func lookupUnit(name string) (unit, bool) {
+	u, ok := units[strings.ToLower(name)]
+	return u, ok
+}
+
+// unitConverter returns a function converting values from one unit to
+// another of the same quantity. Unit names are case-insensitive. Pressures
+// also convert to depths and back, see depthFromPressure.
+func unitConverter(from, to string) (func(float64) float64, error) {
+	f, ok := lookupUnit(from)
+	if !ok {
+		return nil, fmt.Errorf("unknown unit %q", from)
+	}
+	t, ok := lookupUnit(to)
+	if !ok {
+		return nil, fmt.Errorf("unknown unit %q", to)
+	}
+	switch {
+	case f.quantity == "pressure" && t.quantity == "length":
+		return func(v float64) float64 {
+			return (depthFromPressure(v*f.scale+f.offset) - t.offset) / t.scale
+		}, nil
+	case f.quantity == "length" && t.quantity == "pressure":
+		return func(v float64) float64 {
+			return (pressureFromDepth(v*f.scale+f.offset) - t.offset) / t.scale
+		}, nil
+	case f.quantity != t.quantity:
+		return nil, fmt.Errorf("cannot convert %s (%s) to %s (%s)", from, f.quantity, to, t.quantity)
+	}
+	scale, offset := f.scale/t.scale, (f.offset-t.offset)/t.scale
+	return func(v float64) float64 {
+		return v*scale + offset
+	}, nil
+}
+
+// depthLatitude is the latitude, in degrees, at which pressures and depths
+// are converted. Gravity elsewhere changes depths by less than 0.3%.
+const depthLatitude = 45
+
+// depthFromPressure returns the depth in metres of seawater at a pressure
+// in dbar, by the UNESCO 1983 formula (Fofonoff and Millard).
+func depthFromPressure(p float64) float64 {
+	x := math.Sin(depthLatitude * math.Pi / 180)
+	x *= x
+	g := 9.780318*(1+(5.2788e-3+2.36e-5*x)*x) + 1.092e-6*p
+	return (((-1.82e-15*p+2.279e-10)*p-2.2512e-5)*p + 9.72659) * p / g
+}
+
+// pressureFromDepth inverts depthFromPressure. Depth is nearly linear in
+// pressure, so a few rescaling steps converge.
+func pressureFromDepth(z float64) float64 {
+	p := z
+	for i := 0; i < 8 && p != 0; i++ {
+		p *= z / depthFromPressure(p)
+	}
+	return p
+}
+
+// sameUnit reports whether two known unit names mean the same unit.
+func sameUnit(a, b string) bool {
+	ua, _ := lookupUnit(a)
+	ub, _ := lookupUnit(b)
+	return ua == ub
+}
+
+// columnUnit finds the unit a column name is annotated with, as a suffix
+// after the last underscore (temp_degC) or in trailing parentheses or
+// brackets ("temp (degC)", "temp [°C]"). It returns the unit's position in
+// the name, or ok false when the name carries no known unit.
+func columnUnit(column string) (start, end int, ok bool) {
+	end = len(column)
+	if n := len(column); n > 0 && (column[n-1] == ')' || column[n-1] == ']') {
+		open := "("
+		if column[n-1] == ']' {
+			open = "["
+		}
+		start = strings.LastIndex(column, open) + 1
+		end = n - 1
+	} else {
+		start = strings.LastIndexByte(column, '_') + 1
+	}
+	if start == 0 || start == end {
+		return 0, 0, false
+	}
+	name := strings.TrimSpace(column[start:end])
+	if _, ok := lookupUnit(name); !ok {
+		return 0, 0, false
+	}
+	start += strings.Index(column[start:end], name)
+	return start, start + len(name), true
+}
+
+// unitTargets parses Options.Units, the unit to convert each quantity to.
+func unitTargets(names []string) (map[string]string, error) {
+	targets := make(map[string]string, len(names))
+	for _, name := range names {
+		u, ok := lookupUnit(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown unit %q", name)
+		}
+		if other, ok := targets[u.quantity]; ok {
+			return nil, fmt.Errorf("units %s and %s both convert %s", other, name, u.quantity)
+		}
+		targets[u.quantity] = name
+	}
+	return targets, nil
+}
+
+// unitReader converts the rows of src to Options.Units.
+func unitReader(src rowReader, opts Options, result *Result) (rowReader, error) {
+	if len(opts.Units) == 0 {
+		return src, nil
+	}
+	targets, err := unitTargets(opts.Units)
+	if err != nil {
+		return nil, optionError("Units", err)
+	}
+	u := &unitRows{src: src, step: newUnitsStep(targets), opts: opts, result: result}
+	if columns := src.Columns(); columns != nil {
+		if u.columns, err = u.step.columns(columns); err != nil {
+			return nil, optionError("Units", err)
+		}
+	}
+	return u, nil
+}
+
+// unitColumns returns columns as renamed by Options.Units. Renaming
+// errors are reported once the rows are read.
+func unitColumns(columns []string, opts Options) []string {
+	targets, err := unitTargets(opts.Units)
+	if err != nil || len(targets) == 0 {
+		return columns
+	}
+	if renamed, err := newUnitsStep(targets).columns(columns); err == nil {
+		return renamed
+	}
+	return columns
+}
+
+type unitRows struct {
+	src     rowReader
+	step    *unitsStep
+	opts    Options
+	result  *Result
+	columns []string
+}
+
+func (u *unitRows) Columns() []string {
+	return u.columns
+}
+
+// Next returns the next row whose values convert, or io.EOF.
+func (u *unitRows) Next() (*object, error) {
+	for {
+		row, err := u.src.Next()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := u.step.apply(row); err != nil {
+			rowErr, ok := err.(*RowError)
+			if !ok {
+				rowErr = &RowError{Row: row.line, Reason: err.Error()}
+			}
+			if err := u.result.rowError(rowErr, u.opts); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		return row, nil
+	}
+}
@@ -0,0 +1,324 @@
+package csvconverter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// Violation is a rule broken by one row. Row is the CSV line number or the
+// 1-based index of the JSON object, and 0 for a column missing from the CSV
+// header; Column is empty when the violation concerns the whole row.
+type Violation struct {
+	Row    int
+	Column string
+	Reason string
+}
+
+func (v Violation) String() string {
+	if v.Row == 0 {
+		return fmt.Sprintf("column %q: %s", v.Column, v.Reason)
+	}
+	if v.Column == "" {
+		return fmt.Sprintf("row %d: %s", v.Row, v.Reason)
+	}
+	return fmt.Sprintf("row %d, column %q: %s", v.Row, v.Column, v.Reason)
+}
+
+// ValidationRules describe what valid rows look like. JSONSchema is a JSON
+// Schema document applied to each row as an object; Columns are column
+// rules such as those returned by InferSchema. Both may be given.
+type ValidationRules struct {
+	JSONSchema string
+	Columns    []ColumnSchema
+	// MaxViolations stops validation once this many violations are found.
+	// Zero means no limit.
+	MaxViolations int
+}
+
+// ValidationReport is the outcome of Validate.
+type ValidationReport struct {
+	Rows       int
+	Violations []Violation
+	Warnings   []string
+}
+
+// Validate reads data with the same options as a conversion and checks every
+// row against rules, without producing any output.
+func Validate(format, data string, rules ValidationRules, opts Options) (*ValidationReport, error) {
+	read, ok := readers[strings.ToLower(format)]
+	if !ok {
+		return nil, fmt.Errorf("%w format: %s", ErrUnsupported, format)
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	rows, columns, err := readAll(read, data, opts, result)
+	if err != nil {
+		return nil, err
+	}
+	violations, err := validateRows(rows, columns, rules, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidationReport{Rows: len(rows), Violations: violations, Warnings: result.Warnings}, nil
+}
+
+// schemaCheck checks rows one at a time against Options.Schema.
+type schemaCheck struct {
+	schema *jsonschema.Schema
+	opts   Options
+	result *Result
+}
+
+func newSchemaCheck(opts Options, result *Result) (*schemaCheck, error) {
+	schema, err := compileSchema(opts.Schema)
+	if err != nil {
+		return nil, err
+	}
+	return &schemaCheck{schema: schema, opts: opts, result: result}, nil
+}
+
+// keep reports whether row satisfies the schema. A violation fails the
+// conversion or, under Options.SkipInvalidRows, drops the row.
+func (c *schemaCheck) keep(row *object) (bool, error) {
+	if c.schema == nil {
+		return true, nil
+	}
+	violations, err := validateJSONSchema(c.schema, row)
+	if err != nil {
+		return false, fmt.Errorf("row %d: %v", row.line, err)
+	}
+	if len(violations) == 0 {
+		return true, nil
+	}
+	if !c.opts.SkipInvalidRows {
+		v := violations[0]
+		return false, fmt.Errorf("schema validation failed: %w", &RowError{Row: row.line, Column: v.Column, Reason: v.Reason})
+	}
+	for _, v := range violations {
+		c.result.RowErrors = append(c.result.RowErrors, RowError{Row: row.line, Column: v.Column, Reason: v.Reason})
+	}
+	return false, nil
+}
+
+// compileSchema compiles a JSON Schema, returning nil when src is empty.
+func compileSchema(src string) (*jsonschema.Schema, error) {
+	if src == "" {
+		return nil, nil
+	}
+	schema, err := jsonschema.CompileString("schema.json", src)
+	if err != nil {
+		return nil, &OptionError{Option: "Schema", Reason: fmt.Sprintf("invalid schema: %v", err)}
+	}
+	return schema, nil
+}
+
+func validateRows(rows []*object, columns []string, rules ValidationRules, opts Options) ([]Violation, error) {
+	schema, err := compileSchema(rules.JSONSchema)
+	if err != nil {
+		return nil, err
+	}
+	bounds, err := columnBounds(rules.Columns, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []Violation
+	full := func() bool {
+		return rules.MaxViolations > 0 && len(violations) >= rules.MaxViolations
+	}
+
+	// Columns missing from a CSV header break the rule on every row, so they
+	// are reported once.
+	missing := make(map[string]bool)
+	if columns != nil {
+		for _, c := range rules.Columns {
+			if err := checkColumns([]string{c.Name}, columns); err != nil && !c.Nullable {
+				missing[c.Name] = true
+				violations = append(violations, Violation{Column: c.Name, Reason: "missing column"})
+			}
+		}
+	}
+
+	for _, row := range rows {
+		if full() {
+			break
+		}
+		if schema != nil {
+			vs, err := validateJSONSchema(schema, row)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %v", row.line, err)
+			}
+			for _, v := range vs {
+				v.Row = row.line
+				violations = append(violations, v)
+			}
+		}
+		for j, c := range rules.Columns {
+			if missing[c.Name] {
+				continue
+			}
+			value, _ := row.get(c.Name)
+			if reason := checkColumnValue(value, c, bounds[j], opts); reason != "" {
+				violations = append(violations, Violation{Row: row.line, Column: c.Name, Reason: reason})
+			}
+		}
+	}
+	if full() {
+		violations = violations[:rules.MaxViolations]
+	}
+	return violations, nil
+}
+
+// validateJSONSchema checks one row and returns a violation per failing
+// keyword.
+func validateJSONSchema(schema *jsonschema.Schema, row *object) ([]Violation, error) {
+	b, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var instance interface{}
+	if err := dec.Decode(&instance); err != nil {
+		return nil, err
+	}
+
+	err = schema.Validate(instance)
+	if err == nil {
+		return nil, nil
+	}
+	ve, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return nil, err
+	}
+	var violations []Violation
+	var leaves func(*jsonschema.ValidationError)
+	leaves = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			violations = append(violations, Violation{Column: instanceColumn(e.InstanceLocation), Reason: e.Message})
+			return
+		}
+		for _, c := range e.Causes {
+			leaves(c)
+		}
+	}
+	leaves(ve)
+	return violations, nil
+}
+
+// instanceColumn returns the column named by the first token of a JSON
+// pointer.
+func instanceColumn(pointer string) string {
+	token := strings.TrimPrefix(pointer, "/")
+	if i := strings.IndexByte(token, '/'); i >= 0 {
+		token = token[:i]
+	}
+	return strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+}
+
+type bounds struct {
+	min, max *sortValue
+}
+
+// columnBounds parses the Min and Max of each column rule according to its
+// type.
+func columnBounds(columns []ColumnSchema, opts Options) ([]bounds, error) {
+	out := make([]bounds, len(columns))
+	for i, c := range columns {
+		switch c.Type {
+		case "", TypeString, TypeNumber, TypeTimestamp, TypeBoolean:
+		default:
+			return nil, fmt.Errorf("unsupported type %q for column %q", c.Type, c.Name)
+		}
+		for _, b := range []struct {
+			src string
+			dst **sortValue
+		}{{c.Min, &out[i].min}, {c.Max, &out[i].max}} {
+			if b.src == "" {
+				continue
+			}
+			v, err := boundValue(b.src, c.Type, opts)
+			if err != nil {
+				return nil, fmt.Errorf("column %q: %v", c.Name, err)
+			}
+			*b.dst = &v
+		}
+	}
+	return out, nil
+}
+
+func boundValue(s, typ string, opts Options) (sortValue, error) {
+	switch typ {
+	case TypeNumber:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return sortValue{}, fmt.Errorf("invalid bound %q", s)
+		}
+		return sortValue{kind: kindNumber, num: f}, nil
+	case TypeTimestamp:
+		t, ok := parseTimestamp(s, true, opts)
+		if !ok {
+			return sortValue{}, fmt.Errorf("invalid bound %q", s)
+		}
+		return sortValue{kind: kindTime, t: t}, nil
+	case TypeBoolean:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return sortValue{}, fmt.Errorf("invalid bound %q", s)
+		}
+		return toSortValue(b, opts), nil
+	}
+	return sortValue{kind: kindString, s: s}, nil
+}
+
+// checkColumnValue returns why value breaks the column rule, or "" when it
+// does not.
+func checkColumnValue(value interface{}, c ColumnSchema, b bounds, opts Options) string {
+	v := toSortValue(value, opts)
+	if v.kind == kindNull {
+		if !c.Nullable {
+			return "null value"
+		}
+		return ""
+	}
+
+	switch c.Type {
+	case TypeNumber:
+		if v.kind != kindNumber {
+			return fmt.Sprintf("%q is not a number", fmt.Sprint(value))
+		}
+	case TypeTimestamp:
+		if v.kind != kindTime {
+			// Epoch values are valid timestamps when declared as such.
+			t, ok := parseTimestamp(fmt.Sprint(value), true, opts)
+			if !ok {
+				return fmt.Sprintf("%q is not a timestamp", fmt.Sprint(value))
+			}
+			v = sortValue{kind: kindTime, t: t}
+		}
+	case TypeBoolean:
+		if v.kind != kindBool {
+			return fmt.Sprintf("%q is not a boolean", fmt.Sprint(value))
+		}
+	case "", TypeString:
+		if v.kind != kindString {
+			v = sortValue{kind: kindString, s: fmt.Sprint(value)}
+		}
+	}
+
+	if b.min != nil && compareSortValues(v, *b.min) < 0 {
+		return fmt.Sprintf("%v is below the minimum %s", value, c.Min)
+	}
+	if b.max != nil && compareSortValues(v, *b.max) > 0 {
+		return fmt.Sprintf("%v is above the maximum %s", value, c.Max)
+	}
+	return ""
+}
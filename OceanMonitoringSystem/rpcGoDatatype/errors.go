@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"rpcGoDatatype/csvconverter"
+	"rpcGoDatatype/pool"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain is the domain of the ErrorInfo details on conversion errors.
+const errorDomain = "rpcGoDatatype"
+
+// The reasons of the ErrorInfo details, which clients can match on.
+const (
+	reasonUnsupportedFormat = "UNSUPPORTED_FORMAT"
+	reasonInvalidOption     = "INVALID_OPTION"
+	reasonMalformedRow      = "MALFORMED_ROW"
+	reasonLimitExceeded     = "LIMIT_EXCEEDED"
+	reasonOverloaded        = "OVERLOADED"
+)
+
+// convertError turns a conversion error into a status whose details tell
+// clients what went wrong: an ErrorInfo with the reason, the row and column
+// of a malformed row, and a BadRequest naming an invalid option. Statuses
+// and unclassified errors are returned as they are.
+func convertError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	var optErr *csvconverter.OptionError
+	var rowErr *csvconverter.RowError
+	switch {
+	case errors.As(err, &optErr):
+		field := optionField(optErr.Option)
+		return withDetails(codes.InvalidArgument, err.Error(),
+			errorInfo(reasonInvalidOption, map[string]string{"field": field}),
+			&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: field, Description: optErr.Reason},
+			}})
+	case errors.As(err, &rowErr):
+		md := map[string]string{"row": strconv.Itoa(rowErr.Row)}
+		if rowErr.Column != "" {
+			md["column"] = rowErr.Column
+		}
+		return withDetails(codes.InvalidArgument, err.Error(), errorInfo(reasonMalformedRow, md))
+	case errors.Is(err, csvconverter.ErrUnsupported):
+		return withDetails(codes.InvalidArgument, err.Error(), errorInfo(reasonUnsupportedFormat, nil))
+	case errors.Is(err, csvconverter.ErrLimitExceeded):
+		return withDetails(codes.ResourceExhausted, err.Error(), errorInfo(reasonLimitExceeded, nil))
+	case errors.Is(err, pool.ErrQueueFull) || errors.Is(err, pool.ErrQueueTimeout):
+		return withDetails(codes.ResourceExhausted, err.Error(), errorInfo(reasonOverloaded, nil))
+	}
+	return err
+}
+
+// badRequest reports request fields that do not go together.
+func badRequest(msg string, fields ...string) error {
+	br := &errdetails.BadRequest{}
+	for _, f := range fields {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{Field: f, Description: msg})
+	}
+	return withDetails(codes.InvalidArgument, msg, br)
+}
+
+func errorInfo(reason string, md map[string]string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain, Metadata: md}
+}
+
+func withDetails(code codes.Code, msg string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, msg)
+	if d, err := st.WithDetails(details...); err == nil {
+		st = d
+	}
+	return st.Err()
+}
+
+// optionField returns the request field of a csvconverter.Options field:
+// InputEncoding is options.input_encoding and JSONIndent options.json_indent.
+func optionField(option string) string {
+	var b strings.Builder
+	b.WriteString("options.")
+	runes := []rune(option)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// A word starts at an upper case letter after a lower case one,
+			// or at the last letter of an acronym followed by a word.
+			if i > 0 && (!unicode.IsUpper(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
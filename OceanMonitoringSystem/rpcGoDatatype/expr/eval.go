@@ -0,0 +1,241 @@
+package expr
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type node interface {
+	eval(env Env) (interface{}, error)
+}
+
+type literalNode struct {
+	value interface{}
+}
+
+func (n *literalNode) eval(Env) (interface{}, error) {
+	return n.value, nil
+}
+
+type columnNode struct {
+	name string
+}
+
+func (n *columnNode) eval(env Env) (interface{}, error) {
+	v, ok := env(n.name)
+	if !ok {
+		return nil, fmt.Errorf("unknown column %q", n.name)
+	}
+	if num, ok := v.(json.Number); ok {
+		f, err := num.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("column %q: invalid number %q", n.name, num)
+		}
+		return f, nil
+	}
+	return v, nil
+}
+
+type unaryNode struct {
+	op      string
+	operand node
+}
+
+func (n *unaryNode) eval(env Env) (interface{}, error) {
+	v, err := n.operand.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "!":
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("operator ! needs a boolean, got %v", v)
+		}
+		return !b, nil
+	default:
+		if v == nil {
+			return nil, nil
+		}
+		f, ok := toNumber(v)
+		if !ok {
+			return nil, fmt.Errorf("operator - needs a number, got %v", v)
+		}
+		return -f, nil
+	}
+}
+
+type binaryNode struct {
+	op          string
+	left, right node
+}
+
+func (n *binaryNode) eval(env Env) (interface{}, error) {
+	left, err := n.left.eval(env)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "&&", "||":
+		l, ok := left.(bool)
+		if !ok {
+			return nil, fmt.Errorf("operator %s needs booleans, got %v", n.op, left)
+		}
+		if n.op == "&&" && !l || n.op == "||" && l {
+			return l, nil
+		}
+		right, err := n.right.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		r, ok := right.(bool)
+		if !ok {
+			return nil, fmt.Errorf("operator %s needs booleans, got %v", n.op, right)
+		}
+		return r, nil
+	}
+
+	right, err := n.right.eval(env)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==", "!=", "<", "<=", ">", ">=":
+		return compare(n.op, left, right)
+	}
+	return arithmetic(n.op, left, right)
+}
+
+// toNumber converts numbers and numeric strings to float64.
+func toNumber(v interface{}) (float64, bool) {
+	switch x := v.(type) {
+	case float64:
+		return x, true
+	case int:
+		return float64(x), true
+	case int64:
+		return float64(x), true
+	case json.Number:
+		f, err := x.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(x), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// isBlank reports whether v is an empty string, which is how empty CSV
+// cells arrive.
+func isBlank(v interface{}) bool {
+	s, ok := v.(string)
+	return ok && strings.TrimSpace(s) == ""
+}
+
+// compare applies a comparison operator. Null equals only null and is
+// neither less nor greater than anything, so ordering against null is false.
+// Empty strings compared with numbers behave like null.
+func compare(op string, left, right interface{}) (interface{}, error) {
+	if _, ok := toNumber(right); ok && isBlank(left) {
+		left = nil
+	} else if _, ok := toNumber(left); ok && isBlank(right) {
+		right = nil
+	}
+	if left == nil || right == nil {
+		switch op {
+		case "==":
+			return left == nil && right == nil, nil
+		case "!=":
+			return !(left == nil && right == nil), nil
+		}
+		return false, nil
+	}
+
+	var cmp int
+	ls, lIsString := left.(string)
+	rs, rIsString := right.(string)
+	lb, lIsBool := left.(bool)
+	rb, rIsBool := right.(bool)
+	switch {
+	case lIsBool && rIsBool:
+		if op != "==" && op != "!=" {
+			return nil, fmt.Errorf("operator %s is not defined for booleans", op)
+		}
+		return (lb == rb) == (op == "=="), nil
+	case lIsString && rIsString:
+		cmp = strings.Compare(ls, rs)
+	default:
+		l, lok := toNumber(left)
+		r, rok := toNumber(right)
+		if !lok || !rok {
+			if op == "==" || op == "!=" {
+				return (op == "!="), nil
+			}
+			return nil, fmt.Errorf("cannot compare %v and %v", left, right)
+		}
+		switch {
+		case l < r:
+			cmp = -1
+		case l > r:
+			cmp = 1
+		}
+	}
+
+	switch op {
+	case "==":
+		return cmp == 0, nil
+	case "!=":
+		return cmp != 0, nil
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">":
+		return cmp > 0, nil
+	default:
+		return cmp >= 0, nil
+	}
+}
+
+// arithmetic applies an arithmetic operator. Null and empty operands give
+// null, and + concatenates when either side is a non-numeric string.
+func arithmetic(op string, left, right interface{}) (interface{}, error) {
+	if left == nil || right == nil || isBlank(left) || isBlank(right) {
+		return nil, nil
+	}
+	l, lok := toNumber(left)
+	r, rok := toNumber(right)
+	if !lok || !rok {
+		if op == "+" {
+			return fmt.Sprint(left) + fmt.Sprint(right), nil
+		}
+		return nil, fmt.Errorf("operator %s needs numbers, got %v and %v", op, left, right)
+	}
+
+	switch op {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		if r == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return l / r, nil
+	case "%":
+		if r == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return math.Mod(l, r), nil
+	case "^":
+		return math.Pow(l, r), nil
+	}
+	return nil, fmt.Errorf("unknown operator %s", op)
+}
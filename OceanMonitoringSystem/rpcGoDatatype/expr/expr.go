@@ -0,0 +1,194 @@
+// Package expr implements the small expression language used for row
+// filters, e.g. `temperature > 4 && station == "B12"`, and computed
+// columns, e.g. `sqrt(u^2 + v^2)`.
+//
+// Expressions support number, string, true/false and null literals, column
+// references (bare identifiers or `quoted names`), arithmetic (+ - * / % ^),
+// comparisons, the logical operators && || !, and numeric functions such as
+// sqrt(x), abs(x), round(x, n), min(a, b, ...) and atan2(y, x).
+package expr
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	// MaxLength is the longest expression Compile accepts, in bytes.
+	MaxLength = 8192
+	// MaxDepth is the deepest nesting of parentheses, unary operators,
+	// calls and powers Compile accepts.
+	MaxDepth = 256
+)
+
+// Env resolves column names to row values.
+type Env func(name string) (interface{}, bool)
+
+// Expr is a compiled expression.
+type Expr struct {
+	src     string
+	root    node
+	columns []string
+}
+
+// Compile parses an expression.
+func Compile(src string) (*Expr, error) {
+	if len(src) > MaxLength {
+		return nil, fmt.Errorf("expression longer than %d bytes", MaxLength)
+	}
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens, columns: make(map[string]bool)}
+	root, err := p.parseBinary(1)
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q at %d", tok.text, tok.pos)
+	}
+	return &Expr{src: src, root: root, columns: p.order}, nil
+}
+
+// Columns returns the column names referenced by the expression.
+func (e *Expr) Columns() []string {
+	return e.columns
+}
+
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Eval evaluates the expression against a row. Numbers are returned as
+// float64.
+func (e *Expr) Eval(env Env) (interface{}, error) {
+	return e.root.eval(env)
+}
+
+// EvalBool evaluates the expression and requires a boolean result.
+func (e *Expr) EvalBool(env Env) (bool, error) {
+	v, err := e.Eval(env)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression %q does not evaluate to a boolean", e.src)
+	}
+	return b, nil
+}
+
+var precedence = map[string]int{
+	"||": 1,
+	"&&": 2,
+	"==": 3, "!=": 3,
+	"<": 4, "<=": 4, ">": 4, ">=": 4,
+	"+": 5, "-": 5,
+	"*": 6, "/": 6, "%": 6,
+	"^": 7,
+}
+
+type parser struct {
+	tokens  []token
+	pos     int
+	depth   int
+	columns map[string]bool
+	order   []string
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) parseBinary(minPrec int) (node, error) {
+	if p.depth++; p.depth > MaxDepth {
+		return nil, fmt.Errorf("expression nested deeper than %d at %d", MaxDepth, p.peek().pos)
+	}
+	defer func() { p.depth-- }()
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		prec, ok := precedence[tok.text]
+		if tok.kind != tokOp || !ok || prec < minPrec {
+			return left, nil
+		}
+		p.next()
+		nextMin := prec + 1
+		if tok.text == "^" {
+			nextMin = prec
+		}
+		right, err := p.parseBinary(nextMin)
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: tok.text, left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary() (node, error) {
+	tok := p.peek()
+	if tok.kind == tokOp && (tok.text == "-" || tok.text == "!") {
+		p.next()
+		operand, err := p.parseBinary(precedence["^"])
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: tok.text, operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokNumber:
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", tok.text)
+		}
+		return &literalNode{value: f}, nil
+	case tokString:
+		return &literalNode{value: tok.text}, nil
+	case tokIdent:
+		if p.peek().kind == tokLParen {
+			return p.parseCall(tok)
+		}
+		switch tok.text {
+		case "true":
+			return &literalNode{value: true}, nil
+		case "false":
+			return &literalNode{value: false}, nil
+		case "null":
+			return &literalNode{value: nil}, nil
+		}
+		if !p.columns[tok.text] {
+			p.columns[tok.text] = true
+			p.order = append(p.order, tok.text)
+		}
+		return &columnNode{name: tok.text}, nil
+	case tokLParen:
+		inner, err := p.parseBinary(1)
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokRParen {
+			return nil, fmt.Errorf("expected ) at %d", closing.pos)
+		}
+		return inner, nil
+	case tokEOF:
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return nil, fmt.Errorf("unexpected %q at %d", tok.text, tok.pos)
+}
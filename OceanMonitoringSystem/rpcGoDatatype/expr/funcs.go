@@ -0,0 +1,120 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+)
+
+// function is a numeric built-in. maxArgs < 0 allows any number of
+// arguments from minArgs up.
+type function struct {
+	minArgs, maxArgs int
+	call             func(args []float64) float64
+}
+
+func unary(f func(float64) float64) function {
+	return function{1, 1, func(args []float64) float64 { return f(args[0]) }}
+}
+
+var functions = map[string]function{
+	"sqrt":  unary(math.Sqrt),
+	"abs":   unary(math.Abs),
+	"floor": unary(math.Floor),
+	"ceil":  unary(math.Ceil),
+	"exp":   unary(math.Exp),
+	"log":   unary(math.Log),
+	"log10": unary(math.Log10),
+	"sin":   unary(math.Sin),
+	"cos":   unary(math.Cos),
+	"tan":   unary(math.Tan),
+	"asin":  unary(math.Asin),
+	"acos":  unary(math.Acos),
+	"atan":  unary(math.Atan),
+	"atan2": {2, 2, func(args []float64) float64 { return math.Atan2(args[0], args[1]) }},
+	"hypot": {2, 2, func(args []float64) float64 { return math.Hypot(args[0], args[1]) }},
+	// round(x) rounds to an integer, round(x, n) to n decimals.
+	"round": {1, 2, func(args []float64) float64 {
+		if len(args) == 1 {
+			return math.Round(args[0])
+		}
+		scale := math.Pow(10, math.Trunc(args[1]))
+		return math.Round(args[0]*scale) / scale
+	}},
+	"min": {1, -1, func(args []float64) float64 {
+		m := args[0]
+		for _, a := range args[1:] {
+			m = math.Min(m, a)
+		}
+		return m
+	}},
+	"max": {1, -1, func(args []float64) float64 {
+		m := args[0]
+		for _, a := range args[1:] {
+			m = math.Max(m, a)
+		}
+		return m
+	}},
+}
+
+type callNode struct {
+	name string
+	fn   function
+	args []node
+}
+
+// eval calls the function. A null or empty argument gives null, like the
+// arithmetic operators.
+func (n *callNode) eval(env Env) (interface{}, error) {
+	args := make([]float64, len(n.args))
+	for i, arg := range n.args {
+		v, err := arg.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil || isBlank(v) {
+			return nil, nil
+		}
+		f, ok := toNumber(v)
+		if !ok {
+			return nil, fmt.Errorf("%s needs numbers, got %v", n.name, v)
+		}
+		args[i] = f
+	}
+	result := n.fn.call(args)
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		return nil, fmt.Errorf("%s is undefined for %v", n.name, args)
+	}
+	return result, nil
+}
+
+// parseCall parses the arguments of a function call after its name.
+func (p *parser) parseCall(name token) (node, error) {
+	fn, ok := functions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at %d", name.text, name.pos)
+	}
+	p.next()
+	var args []node
+	if p.peek().kind == tokRParen {
+		p.next()
+	} else {
+		for {
+			arg, err := p.parseBinary(1)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			tok := p.next()
+			if tok.kind == tokRParen {
+				break
+			}
+			if tok.kind != tokComma {
+				return nil, fmt.Errorf("expected , or ) at %d", tok.pos)
+			}
+		}
+	}
+	if len(args) < fn.minArgs || fn.maxArgs >= 0 && len(args) > fn.maxArgs {
+		return nil, fmt.Errorf("wrong number of arguments to %s at %d", name.text, name.pos)
+	}
+	return &callNode{name: name.text, fn: fn, args: args}, nil
+}
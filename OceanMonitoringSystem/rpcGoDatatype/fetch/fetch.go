@@ -0,0 +1,107 @@
+// Package fetch downloads conversion input from HTTP(S) URLs, restricted to
+// an allow-list of hosts and bounded in size and time.
+package fetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultMaxBytes = 512 << 20
+	DefaultTimeout  = 5 * time.Minute
+)
+
+var ErrDisabled = errors.New("fetching by URL is disabled")
+
+// Fetcher downloads URLs. A nil Fetcher refuses every URL.
+type Fetcher struct {
+	allowed  []string
+	maxBytes int64
+	client   *http.Client
+}
+
+// New creates a Fetcher for the given hosts. A host matches exactly, or
+// "*.example.org" matches any subdomain of example.org. Zero limits use
+// DefaultMaxBytes and DefaultTimeout.
+func New(allowedHosts []string, maxBytes int64, timeout time.Duration) *Fetcher {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBytes
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	f := &Fetcher{maxBytes: maxBytes}
+	for _, h := range allowedHosts {
+		if h = strings.ToLower(strings.TrimSpace(h)); h != "" {
+			f.allowed = append(f.allowed, h)
+		}
+	}
+	f.client = &http.Client{
+		Timeout: timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return fmt.Errorf("too many redirects")
+			}
+			return f.check(req.URL)
+		},
+	}
+	return f
+}
+
+func (f *Fetcher) check(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported URL scheme %q", u.Scheme)
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, a := range f.allowed {
+		if host == a || strings.HasPrefix(a, "*.") && strings.HasSuffix(host, a[1:]) {
+			return nil
+		}
+	}
+	return fmt.Errorf("host %q is not allowed", host)
+}
+
+// Fetch downloads rawURL and returns its body.
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) ([]byte, error) {
+	if f == nil || len(f.allowed) == 0 {
+		return nil, ErrDisabled
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %v", err)
+	}
+	if err := f.check(u); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %v", err)
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching %s: %v", u.Redacted(), err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("error fetching %s: %s", u.Redacted(), resp.Status)
+	}
+	if resp.ContentLength > f.maxBytes {
+		return nil, fmt.Errorf("%s is larger than %d bytes", u.Redacted(), f.maxBytes)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, f.maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching %s: %v", u.Redacted(), err)
+	}
+	if int64(len(data)) > f.maxBytes {
+		return nil, fmt.Errorf("%s is larger than %d bytes", u.Redacted(), f.maxBytes)
+	}
+	return data, nil
+}
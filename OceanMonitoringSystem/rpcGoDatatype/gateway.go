@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	_ "embed"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"rpcGoDatatype/auth"
+	"rpcGoDatatype/config"
+	"rpcGoDatatype/logging"
+	pb "rpcGoDatatype/proto"
+	pbv2 "rpcGoDatatype/proto/v2"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+//go:embed proto/data.swagger.json
+var openAPI []byte
+
+//go:embed proto/v2/data.swagger.json
+var openAPIv2 []byte
+
+// forwardedHeaders are the HTTP headers the REST gateway and the Connect
+// handler pass on to the gRPC server as metadata, besides the standard
+// headers grpc-gateway forwards itself.
+var forwardedHeaders = map[string]bool{
+	"authorization":         true,
+	auth.APIKeyHeader:       true,
+	logging.RequestIDHeader: true,
+}
+
+// clientAddressKey is the metadata key the HTTP facades set to the
+// client's address. It is only trusted on calls over the internal
+// connection, and never taken from client headers.
+const clientAddressKey = "x-client-address"
+
+func gatewayHeader(key string) (string, bool) {
+	if k := strings.ToLower(key); forwardedHeaders[k] {
+		return k, true
+	}
+	k, ok := runtime.DefaultHeaderMatcher(key)
+	if strings.EqualFold(k, clientAddressKey) {
+		return "", false
+	}
+	return k, ok
+}
+
+// gatewayMetadata passes the client's address of a REST call on.
+func gatewayMetadata(_ context.Context, r *http.Request) metadata.MD {
+	return metadata.Pairs(clientAddressKey, remoteHost(r.RemoteAddr))
+}
+
+// forwardedMetadata returns the forwarded headers in h and the address of
+// the client as gRPC metadata.
+func forwardedMetadata(h http.Header, addr string) metadata.MD {
+	md := metadata.MD{}
+	for key, values := range h {
+		if key = strings.ToLower(key); forwardedHeaders[key] {
+			md[key] = values
+		}
+	}
+	md.Set(clientAddressKey, remoteHost(addr))
+	return md
+}
+
+// remoteHost strips the port from a host:port address.
+func remoteHost(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// internalAddr is the address of both ends of internal connections.
+type internalAddr struct{}
+
+func (internalAddr) Network() string { return "internal" }
+func (internalAddr) String() string  { return "internal" }
+
+type internalConn struct {
+	net.Conn
+}
+
+func (internalConn) LocalAddr() net.Addr  { return internalAddr{} }
+func (internalConn) RemoteAddr() net.Addr { return internalAddr{} }
+
+// pipeListener accepts in-memory connections made with dial.
+type pipeListener struct {
+	conns chan net.Conn
+	done  chan struct{}
+	once  sync.Once
+}
+
+func newPipeListener() *pipeListener {
+	return &pipeListener{conns: make(chan net.Conn), done: make(chan struct{})}
+}
+
+func (l *pipeListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *pipeListener) Close() error {
+	l.once.Do(func() { close(l.done) })
+	return nil
+}
+
+func (l *pipeListener) Addr() net.Addr {
+	return internalAddr{}
+}
+
+func (l *pipeListener) dial(ctx context.Context, _ string) (net.Conn, error) {
+	server, client := net.Pipe()
+	select {
+	case l.conns <- internalConn{server}:
+		return internalConn{client}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+}
+
+// internal is an in-memory connection to a gRPC server with the public
+// server's interceptors but without its transport credentials. The HTTP
+// facades call through it so authentication, limits and accounting apply
+// as for gRPC clients; methods that need a client certificate are not
+// reachable over HTTP.
+type internal struct {
+	server *grpc.Server
+	conn   *grpc.ClientConn
+}
+
+func newInternal(srv *server, opts []grpc.ServerOption, limits config.GRPC) (*internal, error) {
+	lis := newPipeListener()
+	s := grpc.NewServer(opts...)
+	pb.RegisterDataParserServer(s, srv)
+	pbv2.RegisterDataParserServer(s, v2Server{s: srv})
+	pb.RegisterStationRegistryServer(s, registryServer{s: srv})
+	pb.RegisterAlertingServer(s, alertingServer{s: srv})
+	go s.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough:///internal",
+		grpc.WithContextDialer(lis.dial),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallSendMsgSize(limits.MaxRecvMsgSize),
+			grpc.MaxCallRecvMsgSize(limits.MaxSendMsgSize),
+		),
+	)
+	if err != nil {
+		s.Stop()
+		return nil, err
+	}
+	return &internal{server: s, conn: conn}, nil
+}
+
+func (in *internal) Close() {
+	in.conn.Close()
+	in.server.Stop()
+}
+
+// gatewayHandler serves the REST/JSON facade of both API versions, their
+// OpenAPI documents and the live conversion WebSocket.
+func gatewayHandler(conn *grpc.ClientConn, limits config.GRPC) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(gatewayHeader),
+		runtime.WithMetadata(gatewayMetadata),
+	)
+	if err := pb.RegisterDataParserHandler(context.Background(), mux, conn); err != nil {
+		return nil, err
+	}
+	if err := pbv2.RegisterDataParserHandler(context.Background(), mux, conn); err != nil {
+		return nil, err
+	}
+	err := mux.HandlePath(http.MethodGet, "/v1/openapi.json", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(openAPI)
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = mux.HandlePath(http.MethodGet, "/v2/openapi.json", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(openAPIv2)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := mux.HandlePath(http.MethodGet, "/v1/live", liveHandler(pb.NewDataParserClient(conn), limits.MaxRecvMsgSize)); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
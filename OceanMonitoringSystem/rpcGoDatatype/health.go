@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"rpcGoDatatype/csvconverter"
+	pb "rpcGoDatatype/proto"
+	pbv2 "rpcGoDatatype/proto/v2"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// pinger is a downstream dependency that can be checked.
+type pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// checkReady reports why the server cannot serve conversions, if it can't:
+// no converters are registered or a configured store is unreachable.
+func (s *server) checkReady(ctx context.Context) error {
+	if len(csvconverter.Formats()) == 0 {
+		return errors.New("no converters registered")
+	}
+	var deps []pinger
+	if p, ok := s.cache.(pinger); ok {
+		deps = append(deps, p)
+	}
+	if p, ok := s.jobs.(pinger); ok {
+		deps = append(deps, p)
+	}
+	if s.objects != nil {
+		deps = append(deps, s.objects)
+	}
+	for _, d := range deps {
+		if err := d.Ping(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// watchHealth updates the health service from checkReady every interval
+// until ctx ends. The server and the DataParser service share one status.
+func (s *server) watchHealth(ctx context.Context, hs *health.Server, interval time.Duration) {
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, interval)
+		err := s.checkReady(checkCtx)
+		cancel()
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		if status != last {
+			if err != nil {
+				slog.Warn("not ready", "error", err)
+			} else {
+				slog.Info("ready")
+			}
+			last = status
+		}
+		hs.SetServingStatus("", status)
+		hs.SetServingStatus(pb.DataParser_ServiceDesc.ServiceName, status)
+		hs.SetServingStatus(pbv2.DataParser_ServiceDesc.ServiceName, status)
+		hs.SetServingStatus(pb.StationRegistry_ServiceDesc.ServiceName, status)
+		hs.SetServingStatus(pb.Alerting_ServiceDesc.ServiceName, status)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
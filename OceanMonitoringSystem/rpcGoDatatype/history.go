@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"path"
+	"time"
+
+	"rpcGoDatatype/history"
+	"rpcGoDatatype/jobs"
+	pb "rpcGoDatatype/proto"
+	pbv2 "rpcGoDatatype/proto/v2"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// conversionMethods are the calls recorded in the history database and
+// the audit log.
+var conversionMethods = map[string]bool{
+	pb.DataParser_Parse_FullMethodName:      true,
+	pb.DataParser_ParseBatch_FullMethodName: true,
+	pb.DataParser_Merge_FullMethodName:      true,
+	pb.DataParser_Split_FullMethodName:      true,
+	pb.DataParser_Pipeline_FullMethodName:   true,
+	pb.DataParser_SubmitJob_FullMethodName:  true,
+	pb.DataParser_ParseLive_FullMethodName:  true,
+
+	pbv2.DataParser_Convert_FullMethodName:       true,
+	pbv2.DataParser_ConvertStream_FullMethodName: true,
+	pbv2.DataParser_StreamRecords_FullMethodName: true,
+}
+
+// historyInterceptor records conversion calls: the caller, the formats,
+// the sizes converted and the outcome.
+func (s *server) historyInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !conversionMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	ctx, call := withCallInfo(ctx)
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	c := history.Conversion{
+		Time:     start.UTC(),
+		Client:   call.identity(ctx),
+		Method:   path.Base(info.FullMethod),
+		Duration: time.Since(start),
+	}
+	if r, ok := req.(interface{ GetFrom() string }); ok {
+		c.From = r.GetFrom()
+	}
+	if r, ok := req.(interface{ GetTo() string }); ok {
+		c.To = r.GetTo()
+	}
+	c.BytesIn, c.BytesOut = call.sizes(messageSize(req), messageSize(resp))
+	if err != nil {
+		c.BytesOut = 0
+	}
+	s.record(ctx, c, err)
+	return resp, err
+}
+
+// historyStreamInterceptor records streaming conversions once their stream ends.
+func (s *server) historyStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !conversionMethods[info.FullMethod] {
+		return handler(srv, ss)
+	}
+	ctx, call := withCallInfo(ss.Context())
+	start := time.Now()
+	counted := &countingStream{ServerStream: &callStream{ServerStream: ss, ctx: ctx}}
+	err := handler(srv, counted)
+	s.record(ctx, history.Conversion{
+		Time:     start.UTC(),
+		Client:   call.identity(ctx),
+		Method:   path.Base(info.FullMethod),
+		From:     counted.from,
+		To:       counted.to,
+		BytesIn:  counted.in,
+		BytesOut: counted.out,
+		Duration: time.Since(start),
+	}, err)
+	return err
+}
+
+// countingStream adds up the sizes of a stream's messages and notes the
+// formats of the conversion.
+type countingStream struct {
+	grpc.ServerStream
+	from, to string
+	in, out  int64
+}
+
+func (c *countingStream) RecvMsg(m interface{}) error {
+	err := c.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		c.in += int64(proto.Size(msg))
+	}
+	if from, to, ok := streamFormats(m); ok && c.from == "" {
+		c.from, c.to = from, to
+	}
+	return nil
+}
+
+func (c *countingStream) SendMsg(m interface{}) error {
+	if msg, ok := m.(proto.Message); ok {
+		c.out += int64(proto.Size(msg))
+	}
+	return c.ServerStream.SendMsg(m)
+}
+
+// callStream passes the call info on to the interceptors and the handler
+// of a stream.
+type callStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (c *callStream) Context() context.Context {
+	return c.ctx
+}
+
+// streamFormats returns the formats named by the first message of a
+// streaming conversion.
+func streamFormats(m interface{}) (from, to string, ok bool) {
+	var h *pbv2.StreamHeader
+	switch r := m.(type) {
+	case *pb.LiveRequest:
+		return r.From, "json", r.From != ""
+	case *pbv2.ConvertStreamRequest:
+		h = r.GetHeader()
+	case *pbv2.StreamRecordsRequest:
+		if h = r.GetHeader(); h != nil && h.To == "" {
+			return h.From, "json", true
+		}
+	}
+	if h == nil {
+		return "", "", false
+	}
+	return h.From, h.To, true
+}
+
+// record stores a conversion with the outcome of err. Failing to record
+// does not fail the call.
+func (s *server) record(ctx context.Context, c history.Conversion, err error) {
+	st := status.Convert(err)
+	c.Code = st.Code().String()
+	if err != nil {
+		c.Error = st.Message()
+	}
+	if err := s.history.Record(context.WithoutCancel(ctx), c); err != nil {
+		slog.ErrorContext(ctx, "error recording conversion", "method", c.Method, "error", err)
+	}
+}
+
+// pruneHistory deletes conversions older than keep, hourly.
+func (s *server) pruneHistory(keep time.Duration) {
+	for ; ; time.Sleep(time.Hour) {
+		n, err := s.history.Prune(context.Background(), time.Now().Add(-keep))
+		if err != nil {
+			slog.Error("error pruning conversion history", "error", err)
+		} else if n > 0 {
+			slog.Info("pruned conversion history", "deleted", n)
+		}
+	}
+}
+
+// ListJobs lists the asynchronous jobs, newest first. It needs the admin
+// token.
+func (s *server) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (*pb.ListJobsResponse, error) {
+	if !s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "admin token required")
+	}
+	switch req.Status {
+	case "", jobs.StatusQueued, jobs.StatusRunning, jobs.StatusSucceeded, jobs.StatusFailed, jobs.StatusCancelled:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown job status %q", req.Status)
+	}
+	list, err := s.jobs.List(req.Status, int(req.Limit))
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	resp := &pb.ListJobsResponse{}
+	for _, job := range list {
+		resp.Jobs = append(resp.Jobs, jobStatus(&job))
+	}
+	return resp, nil
+}
+
+// GetHistory lists recorded conversions, newest first, optionally for one
+// client or method, within [since, until) given in RFC 3339, or only the
+// failed ones. It needs the admin token.
+func (s *server) GetHistory(ctx context.Context, req *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	if !s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "admin token required")
+	}
+	if s.history == nil {
+		return nil, status.Error(codes.FailedPrecondition, "conversion history is not enabled")
+	}
+	f := history.Filter{
+		Client:     req.Client,
+		Method:     req.Method,
+		FailedOnly: req.FailedOnly,
+		Limit:      int(req.Limit),
+	}
+	var err error
+	if req.Since != "" {
+		if f.Since, err = time.Parse(time.RFC3339, req.Since); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid since: %v", err)
+		}
+	}
+	if req.Until != "" {
+		if f.Until, err = time.Parse(time.RFC3339, req.Until); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid until: %v", err)
+		}
+	}
+	list, err := s.history.List(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.HistoryResponse{}
+	for _, c := range list {
+		resp.Conversions = append(resp.Conversions, &pb.Conversion{
+			Id:         c.ID,
+			Time:       c.Time.Format(time.RFC3339Nano),
+			Client:     c.Client,
+			Method:     c.Method,
+			From:       c.From,
+			To:         c.To,
+			BytesIn:    c.BytesIn,
+			BytesOut:   c.BytesOut,
+			DurationMs: float64(c.Duration) / float64(time.Millisecond),
+			Code:       c.Code,
+			Error:      c.Error,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,222 @@
+// Package history keeps an SQLite database of the conversions the service
+// handled and, as a jobs.Store, of the state of asynchronous jobs.
+package history
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// DefaultLimit and MaxLimit bound the conversions List returns.
+const (
+	DefaultLimit = 100
+	MaxLimit     = 1000
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS conversions (
+	id          INTEGER PRIMARY KEY,
+	time        INTEGER NOT NULL,
+	client      TEXT NOT NULL,
+	method      TEXT NOT NULL,
+	from_format TEXT NOT NULL,
+	to_format   TEXT NOT NULL,
+	bytes_in    INTEGER NOT NULL,
+	bytes_out   INTEGER NOT NULL,
+	duration_ms REAL NOT NULL,
+	code        TEXT NOT NULL,
+	error       TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS conversions_time ON conversions (time);
+CREATE INDEX IF NOT EXISTS conversions_client ON conversions (client, time);
+CREATE TABLE IF NOT EXISTS jobs (
+	id              TEXT PRIMARY KEY,
+	status          TEXT NOT NULL,
+	error           TEXT NOT NULL,
+	created_at      INTEGER NOT NULL,
+	started_at      INTEGER NOT NULL,
+	finished_at     INTEGER NOT NULL,
+	owner           TEXT NOT NULL DEFAULT '',
+	idempotency_key TEXT NOT NULL,
+	payload_hash    TEXT NOT NULL,
+	payload         BLOB,
+	result          BLOB
+);
+`
+
+// Conversion is one conversion call: who made it, when, between which
+// formats, how large it was and how it ended.
+type Conversion struct {
+	ID       int64
+	Time     time.Time
+	Client   string
+	Method   string
+	From     string
+	To       string
+	BytesIn  int64
+	BytesOut int64
+	Duration time.Duration
+	// Code is the gRPC status code name, "OK" on success.
+	Code  string
+	Error string
+}
+
+// Filter selects conversions. Zero fields match everything.
+type Filter struct {
+	Client     string
+	Method     string
+	Since      time.Time
+	Until      time.Time
+	FailedOnly bool
+	// Limit caps the number of conversions, DefaultLimit when zero and at
+	// most MaxLimit.
+	Limit int
+}
+
+// DB is an open history database.
+type DB struct {
+	db *sql.DB
+}
+
+// Open opens or creates the database at path.
+func Open(path string) (*DB, error) {
+	dsn := "file:" + (&url.URL{Path: path}).EscapedPath() + "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("error opening history database: %v", err)
+	}
+	// SQLite has one writer at a time; a single connection serializes
+	// writes instead of failing them as busy.
+	db.SetMaxOpenConns(1)
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("error creating history tables: %v", err)
+	}
+	if err := migrate(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &DB{db: db}, nil
+}
+
+// migrate adds the columns that tables created by earlier versions lack.
+func migrate(db *sql.DB) error {
+	var n int
+	if err := db.QueryRow(`SELECT count(*) FROM pragma_table_info('jobs') WHERE name = 'owner'`).Scan(&n); err != nil {
+		return fmt.Errorf("error reading history tables: %v", err)
+	}
+	if n == 0 {
+		if _, err := db.Exec(`ALTER TABLE jobs ADD COLUMN owner TEXT NOT NULL DEFAULT ''`); err != nil {
+			return fmt.Errorf("error updating history tables: %v", err)
+		}
+	}
+	return nil
+}
+
+// Close closes the database.
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+// Record adds a conversion.
+func (d *DB) Record(ctx context.Context, c Conversion) error {
+	_, err := d.db.ExecContext(ctx, `INSERT INTO conversions
+		(time, client, method, from_format, to_format, bytes_in, bytes_out, duration_ms, code, error)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		unixNano(c.Time), c.Client, c.Method, c.From, c.To, c.BytesIn, c.BytesOut,
+		float64(c.Duration)/float64(time.Millisecond), c.Code, c.Error)
+	if err != nil {
+		return fmt.Errorf("error recording conversion: %v", err)
+	}
+	return nil
+}
+
+// List returns the conversions matching f, newest first.
+func (d *DB) List(ctx context.Context, f Filter) ([]Conversion, error) {
+	var where []string
+	var args []interface{}
+	if f.Client != "" {
+		where = append(where, "client = ?")
+		args = append(args, f.Client)
+	}
+	if f.Method != "" {
+		where = append(where, "method = ?")
+		args = append(args, f.Method)
+	}
+	if !f.Since.IsZero() {
+		where = append(where, "time >= ?")
+		args = append(args, f.Since.UnixNano())
+	}
+	if !f.Until.IsZero() {
+		where = append(where, "time < ?")
+		args = append(args, f.Until.UnixNano())
+	}
+	if f.FailedOnly {
+		where = append(where, "code <> 'OK'")
+	}
+	limit := f.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+
+	query := `SELECT id, time, client, method, from_format, to_format, bytes_in, bytes_out, duration_ms, code, error FROM conversions`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY time DESC, id DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error reading history: %v", err)
+	}
+	defer rows.Close()
+	var list []Conversion
+	for rows.Next() {
+		var c Conversion
+		var t int64
+		var ms float64
+		if err := rows.Scan(&c.ID, &t, &c.Client, &c.Method, &c.From, &c.To, &c.BytesIn, &c.BytesOut, &ms, &c.Code, &c.Error); err != nil {
+			return nil, fmt.Errorf("error reading history: %v", err)
+		}
+		c.Time = fromUnixNano(t)
+		c.Duration = time.Duration(ms * float64(time.Millisecond))
+		list = append(list, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error reading history: %v", err)
+	}
+	return list, nil
+}
+
+// Prune deletes the conversions recorded before t and returns how many
+// were deleted.
+func (d *DB) Prune(ctx context.Context, t time.Time) (int64, error) {
+	res, err := d.db.ExecContext(ctx, `DELETE FROM conversions WHERE time < ?`, t.UnixNano())
+	if err != nil {
+		return 0, fmt.Errorf("error pruning history: %v", err)
+	}
+	return res.RowsAffected()
+}
+
+// unixNano stores the zero time as 0.
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+func fromUnixNano(n int64) time.Time {
+	if n == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, n).UTC()
+}
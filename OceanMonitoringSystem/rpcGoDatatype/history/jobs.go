@@ -0,0 +1,93 @@
+package history
+
+import (
+	"fmt"
+
+	"rpcGoDatatype/jobs"
+)
+
+// jobStore keeps jobs in the jobs table.
+type jobStore struct {
+	d *DB
+}
+
+// Jobs returns a jobs.Store keeping jobs in the database.
+func (d *DB) Jobs() jobs.Store {
+	return jobStore{d: d}
+}
+
+func (s jobStore) Load() ([]jobs.Stored, error) {
+	rows, err := s.d.db.Query(`SELECT id, status, error, created_at, started_at, finished_at,
+		owner, idempotency_key, payload_hash, payload FROM jobs`)
+	if err != nil {
+		return nil, fmt.Errorf("error listing jobs: %v", err)
+	}
+	defer rows.Close()
+	var stored []jobs.Stored
+	for rows.Next() {
+		var st jobs.Stored
+		var created, started, finished int64
+		j := &st.Job
+		if err := rows.Scan(&j.ID, &j.Status, &j.Error, &created, &started, &finished,
+			&j.Owner, &j.IdempotencyKey, &j.PayloadHash, &st.Payload); err != nil {
+			return nil, fmt.Errorf("error reading job: %v", err)
+		}
+		j.CreatedAt = fromUnixNano(created)
+		j.StartedAt = fromUnixNano(started)
+		j.FinishedAt = fromUnixNano(finished)
+		stored = append(stored, st)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error reading job: %v", err)
+	}
+	return stored, nil
+}
+
+func (s jobStore) Save(job jobs.Job, payload []byte) error {
+	// A nil payload keeps the stored one.
+	_, err := s.d.db.Exec(`INSERT INTO jobs
+		(id, status, error, created_at, started_at, finished_at, owner, idempotency_key, payload_hash, payload)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET status = excluded.status, error = excluded.error,
+			started_at = excluded.started_at, finished_at = excluded.finished_at,
+			payload = coalesce(excluded.payload, jobs.payload)`,
+		job.ID, job.Status, job.Error, unixNano(job.CreatedAt), unixNano(job.StartedAt), unixNano(job.FinishedAt),
+		job.Owner, job.IdempotencyKey, job.PayloadHash, payload)
+	if err != nil {
+		return fmt.Errorf("error writing job: %v", err)
+	}
+	return nil
+}
+
+func (s jobStore) Finish(job jobs.Job, result []byte) error {
+	if job.Status != jobs.StatusSucceeded {
+		result = nil
+	} else if result == nil {
+		result = []byte{}
+	}
+	res, err := s.d.db.Exec(`UPDATE jobs SET status = ?, error = ?, started_at = ?, finished_at = ?,
+		payload = NULL, result = ? WHERE id = ?`,
+		job.Status, job.Error, unixNano(job.StartedAt), unixNano(job.FinishedAt), result, job.ID)
+	if err != nil {
+		return fmt.Errorf("error writing job result: %v", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("error writing job result: job %s is not stored", job.ID)
+	}
+	return nil
+}
+
+func (s jobStore) Result(id string) ([]byte, error) {
+	var result []byte
+	if err := s.d.db.QueryRow(`SELECT result FROM jobs WHERE id = ?`, id).Scan(&result); err != nil {
+		return nil, fmt.Errorf("error reading job result: %v", err)
+	}
+	return result, nil
+}
+
+func (s jobStore) Remove(id string) error {
+	if _, err := s.d.db.Exec(`DELETE FROM jobs WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("error deleting job: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+//go:build !unix
+
+package hooks
+
+import "fmt"
+
+func diskUsage(path string) (float64, error) {
+	return 0, fmt.Errorf("disk usage checks are not supported on this platform")
+}
@@ -0,0 +1,23 @@
+//go:build unix
+
+package hooks
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// diskUsage returns the used fraction of the filesystem containing path.
+func diskUsage(path string) (float64, error) {
+	var st unix.Statfs_t
+	if err := unix.Statfs(path, &st); err != nil {
+		return 0, fmt.Errorf("error checking disk usage of %s: %v", path, err)
+	}
+	total := uint64(st.Blocks) * uint64(st.Bsize)
+	if total == 0 {
+		return 0, nil
+	}
+	free := uint64(st.Bavail) * uint64(st.Bsize)
+	return 1 - float64(free)/float64(total), nil
+}
@@ -0,0 +1,231 @@
+// Package hooks runs operator-defined actions when the server hits a
+// condition such as a growing dead letter topic, a failing output sink or a
+// nearly full disk. A Runner fires each hook's command or webhook, at most
+// once per cooldown; a nil Runner ignores every event.
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+// Conditions that can trigger a hook.
+const (
+	DeadLetterGrowth = "dead_letter_growth"
+	SinkCircuitOpen  = "sink_circuit_open"
+	DiskNearlyFull   = "disk_nearly_full"
+)
+
+var conditions = map[string]bool{
+	DeadLetterGrowth: true,
+	SinkCircuitOpen:  true,
+	DiskNearlyFull:   true,
+}
+
+const (
+	defaultCooldown = 5 * time.Minute
+	actionTimeout   = 30 * time.Second
+)
+
+// Hook runs a command and/or calls a webhook when its condition fires.
+type Hook struct {
+	Condition string   `json:"condition"`
+	Command   []string `json:"command,omitempty"`
+	Webhook   string   `json:"webhook,omitempty"`
+	// Cooldown is the minimum time between two runs, e.g. "10m".
+	Cooldown string `json:"cooldown,omitempty"`
+
+	cooldown time.Duration
+}
+
+// DiskWatch configures the disk usage check behind DiskNearlyFull.
+type DiskWatch struct {
+	Path      string  `json:"path"`
+	Threshold float64 `json:"threshold"`
+	Interval  string  `json:"interval,omitempty"`
+}
+
+// Config is the on-disk hook configuration.
+type Config struct {
+	Hooks []Hook     `json:"hooks"`
+	Disk  *DiskWatch `json:"disk,omitempty"`
+}
+
+// Event describes a condition occurrence passed to hooks.
+type Event struct {
+	Condition string            `json:"condition"`
+	Message   string            `json:"message"`
+	Details   map[string]string `json:"details,omitempty"`
+	Time      time.Time         `json:"time"`
+}
+
+// LoadConfig reads a JSON hook configuration file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading hooks config: %v", err)
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("error parsing hooks config: %v", err)
+	}
+	for i := range cfg.Hooks {
+		h := &cfg.Hooks[i]
+		if h.Condition == "" {
+			return nil, fmt.Errorf("hook %d: missing condition", i)
+		}
+		if !conditions[h.Condition] {
+			return nil, fmt.Errorf("hook %d: unknown condition %q", i, h.Condition)
+		}
+		if len(h.Command) == 0 && h.Webhook == "" {
+			return nil, fmt.Errorf("hook %d: needs a command or a webhook", i)
+		}
+		h.cooldown = defaultCooldown
+		if h.Cooldown != "" {
+			if h.cooldown, err = time.ParseDuration(h.Cooldown); err != nil {
+				return nil, fmt.Errorf("hook %d: invalid cooldown: %v", i, err)
+			}
+		}
+	}
+	if d := cfg.Disk; d != nil {
+		if d.Path == "" {
+			return nil, fmt.Errorf("disk watch needs a path")
+		}
+		if d.Threshold <= 0 || d.Threshold > 1 {
+			return nil, fmt.Errorf("disk threshold must be between 0 and 1")
+		}
+		if _, err := d.interval(); err != nil {
+			return nil, err
+		}
+	}
+	return &cfg, nil
+}
+
+// Runner dispatches events to the configured hooks. A nil Runner ignores
+// all events, so callers don't need to check whether hooks are configured.
+type Runner struct {
+	hooks  []Hook
+	client *http.Client
+
+	mu        sync.Mutex
+	lastFired map[int]time.Time
+}
+
+// NewRunner creates a Runner for the given hooks.
+func NewRunner(hooks []Hook) *Runner {
+	return &Runner{
+		hooks:     hooks,
+		client:    &http.Client{Timeout: actionTimeout},
+		lastFired: make(map[int]time.Time),
+	}
+}
+
+// Fire runs every hook registered for the event's condition, skipping hooks
+// that are still in their cooldown. Actions run in the background.
+func (r *Runner) Fire(ev Event) {
+	if r == nil {
+		return
+	}
+	if ev.Time.IsZero() {
+		ev.Time = time.Now().UTC()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, h := range r.hooks {
+		if h.Condition != ev.Condition {
+			continue
+		}
+		if last, ok := r.lastFired[i]; ok && ev.Time.Sub(last) < h.cooldown {
+			continue
+		}
+		r.lastFired[i] = ev.Time
+		go r.run(h, ev)
+	}
+}
+
+func (r *Runner) run(h Hook, ev Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), actionTimeout)
+	defer cancel()
+
+	if len(h.Command) > 0 {
+		cmd := exec.CommandContext(ctx, h.Command[0], h.Command[1:]...)
+		cmd.Env = append(os.Environ(),
+			"HOOK_CONDITION="+ev.Condition,
+			"HOOK_MESSAGE="+ev.Message,
+		)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			slog.ErrorContext(ctx, "hook command failed", "condition", ev.Condition, "error", err, "output", string(out))
+		}
+	}
+
+	if h.Webhook != "" {
+		body, _ := json.Marshal(ev)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.Webhook, bytes.NewReader(body))
+		if err != nil {
+			slog.ErrorContext(ctx, "invalid hook webhook", "condition", ev.Condition, "error", err)
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := r.client.Do(req)
+		if err != nil {
+			slog.ErrorContext(ctx, "hook webhook failed", "condition", ev.Condition, "error", err)
+			return
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			slog.ErrorContext(ctx, "hook webhook rejected event", "condition", ev.Condition, "status", resp.Status)
+		}
+	}
+}
+
+func (w DiskWatch) interval() (time.Duration, error) {
+	if w.Interval == "" {
+		return time.Minute, nil
+	}
+	d, err := time.ParseDuration(w.Interval)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid disk interval %q", w.Interval)
+	}
+	return d, nil
+}
+
+// WatchDisk periodically checks disk usage and fires DiskNearlyFull when
+// the used fraction reaches the configured threshold. Failed checks are
+// logged and retried at the next interval. It returns when ctx is
+// cancelled.
+func (r *Runner) WatchDisk(ctx context.Context, w DiskWatch) error {
+	interval, err := w.interval()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		used, err := diskUsage(w.Path)
+		if err != nil {
+			slog.WarnContext(ctx, "error checking disk usage", "path", w.Path, "error", err)
+		} else if used >= w.Threshold {
+			r.Fire(Event{
+				Condition: DiskNearlyFull,
+				Message:   fmt.Sprintf("disk %s is %.0f%% full", w.Path, used*100),
+				Details:   map[string]string{"path": w.Path, "used": fmt.Sprintf("%.4f", used)},
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
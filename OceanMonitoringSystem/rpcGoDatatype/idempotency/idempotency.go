@@ -0,0 +1,87 @@
+// Package idempotency remembers the outcome of keyed requests so that a
+// retried request returns the original result instead of running again.
+package idempotency
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var ErrKeyReused = errors.New("idempotency key was used for a different request")
+
+type call struct {
+	key     string
+	hash    string
+	expires time.Time
+	done    chan struct{}
+	result  []byte
+	err     error
+}
+
+// Store keeps successful results for a fixed TTL. Failed calls are
+// forgotten so that they can be retried.
+type Store struct {
+	ttl time.Duration
+
+	mu    sync.Mutex
+	calls map[string]*list.Element
+	order *list.List // oldest first; all entries share the TTL
+}
+
+// New creates a Store remembering results for ttl.
+func New(ttl time.Duration) *Store {
+	return &Store{ttl: ttl, calls: make(map[string]*list.Element), order: list.New()}
+}
+
+// Do runs fn once per key. hash identifies the request, so that reusing a
+// key for a different request fails with ErrKeyReused. A call made while
+// the first one is still running waits for its result. replayed reports
+// whether the result came from an earlier call.
+func (s *Store) Do(ctx context.Context, key, hash string, fn func() ([]byte, error)) (result []byte, replayed bool, err error) {
+	s.mu.Lock()
+	s.expire(time.Now())
+	if el, ok := s.calls[key]; ok {
+		c := el.Value.(*call)
+		s.mu.Unlock()
+		if c.hash != hash {
+			return nil, false, ErrKeyReused
+		}
+		select {
+		case <-c.done:
+			return c.result, true, c.err
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+	}
+	c := &call{key: key, hash: hash, expires: time.Now().Add(s.ttl), done: make(chan struct{})}
+	el := s.order.PushBack(c)
+	s.calls[key] = el
+	s.mu.Unlock()
+
+	defer close(c.done)
+	c.result, c.err = fn()
+	if c.err != nil {
+		s.mu.Lock()
+		if s.calls[key] == el {
+			delete(s.calls, key)
+			s.order.Remove(el)
+		}
+		s.mu.Unlock()
+	}
+	return c.result, false, c.err
+}
+
+// expire drops entries past their TTL. Callers must hold s.mu.
+func (s *Store) expire(now time.Time) {
+	for el := s.order.Front(); el != nil; el = s.order.Front() {
+		c := el.Value.(*call)
+		if now.Before(c.expires) {
+			return
+		}
+		s.order.Remove(el)
+		delete(s.calls, c.key)
+	}
+}
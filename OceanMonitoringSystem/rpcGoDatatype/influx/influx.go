@@ -0,0 +1,86 @@
+// Package influx writes line protocol to an InfluxDB 2 server, addressed
+// as influx://bucket.
+package influx
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Config holds the connection settings.
+type Config struct {
+	// URL is the server's base URL, e.g. "http://influxdb:8086".
+	URL   string
+	Token string
+	Org   string
+}
+
+// Client writes points to the buckets of one organization.
+type Client struct {
+	cfg  Config
+	http *http.Client
+}
+
+// New creates a Client for the server at cfg.URL.
+func New(cfg Config) (*Client, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid InfluxDB URL %q", cfg.URL)
+	}
+	cfg.URL = strings.TrimSuffix(cfg.URL, "/")
+	return &Client{cfg: cfg, http: &http.Client{Timeout: 30 * time.Second}}, nil
+}
+
+// IsURI reports whether uri refers to an InfluxDB bucket.
+func IsURI(uri string) bool {
+	return strings.HasPrefix(uri, "influx://")
+}
+
+// ParseURI returns the bucket of influx://bucket.
+func ParseURI(uri string) (bucket string, err error) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "influx" {
+		return "", fmt.Errorf("invalid InfluxDB URI %q", uri)
+	}
+	if u.Host == "" || strings.Trim(u.Path, "/") != "" {
+		return "", fmt.Errorf("InfluxDB URI %q needs a bucket and nothing else", uri)
+	}
+	return u.Host, nil
+}
+
+// Write writes line protocol with timestamps in precision (ns, us, ms or
+// s) to the bucket named by uri.
+func (c *Client) Write(ctx context.Context, uri, precision string, lines []byte) error {
+	bucket, err := ParseURI(uri)
+	if err != nil {
+		return err
+	}
+	if precision == "" {
+		precision = "ns"
+	}
+	q := url.Values{"org": {c.cfg.Org}, "bucket": {bucket}, "precision": {precision}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.URL+"/api/v2/write?"+q.Encode(), bytes.NewReader(lines))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if c.cfg.Token != "" {
+		req.Header.Set("Authorization", "Token "+c.cfg.Token)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("error writing to InfluxDB: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("error writing to InfluxDB: %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"rpcGoDatatype/jobs"
+	pb "rpcGoDatatype/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func jobError(err error) error {
+	switch {
+	case errors.Is(err, jobs.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, jobs.ErrNotFinished), errors.Is(err, jobs.ErrFinished):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, jobs.ErrKeyReused):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+type jobOwnerKey struct{}
+
+// runJob converts a serialized ParseRequest into a serialized ParseResponse
+// on behalf of the client that submitted it.
+func (s *server) runJob(ctx context.Context, owner string, payload []byte) ([]byte, error) {
+	ctx = context.WithValue(ctx, jobOwnerKey{}, owner)
+	req := &pb.ParseRequest{}
+	if err := proto.Unmarshal(payload, req); err != nil {
+		return nil, err
+	}
+	resp, err := s.parse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(resp)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func jobStatus(job *jobs.Job) *pb.JobStatus {
+	return &pb.JobStatus{
+		JobId:      job.ID,
+		Status:     job.Status,
+		Error:      job.Error,
+		CreatedAt:  formatTime(job.CreatedAt),
+		StartedAt:  formatTime(job.StartedAt),
+		FinishedAt: formatTime(job.FinishedAt),
+	}
+}
+
+func (s *server) SubmitJob(ctx context.Context, req *pb.ParseRequest) (*pb.JobStatus, error) {
+	slog.InfoContext(ctx, "SubmitJob request", "from", req.From, "to", req.To)
+
+	// Deterministic, so that a retried request hashes the same.
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.jobs.Submit(clientIdentity(ctx), payload, req.IdempotencyKey)
+	if errors.Is(err, jobs.ErrKeyReused) {
+		return nil, jobError(err)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return jobStatus(job), nil
+}
+
+// ownJob returns the caller's job with the given id. Other clients' jobs
+// are not found.
+func (s *server) ownJob(ctx context.Context, id string) (*jobs.Job, error) {
+	job, err := s.jobs.Status(id)
+	if err != nil {
+		return nil, jobError(err)
+	}
+	if job.Owner != clientIdentity(ctx) {
+		return nil, jobError(jobs.ErrNotFound)
+	}
+	return job, nil
+}
+
+func (s *server) GetJobStatus(ctx context.Context, req *pb.JobRequest) (*pb.JobStatus, error) {
+	job, err := s.ownJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	return jobStatus(job), nil
+}
+
+func (s *server) GetJobResult(ctx context.Context, req *pb.JobRequest) (*pb.ParseResponse, error) {
+	if _, err := s.ownJob(ctx, req.JobId); err != nil {
+		return nil, err
+	}
+	payload, err := s.jobs.Result(req.JobId)
+	if err != nil {
+		return nil, jobError(err)
+	}
+	resp := &pb.ParseResponse{}
+	if err := proto.Unmarshal(payload, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *server) CancelJob(ctx context.Context, req *pb.JobRequest) (*pb.JobStatus, error) {
+	slog.InfoContext(ctx, "CancelJob request", "job_id", req.JobId)
+
+	if _, err := s.ownJob(ctx, req.JobId); err != nil {
+		return nil, err
+	}
+	job, err := s.jobs.Cancel(req.JobId)
+	if err != nil {
+		return nil, jobError(err)
+	}
+	return jobStatus(job), nil
+}
@@ -0,0 +1,101 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// dirStore keeps each job in files named after its id: the state in
+// <id>.json, the payload of an unfinished job in <id>.payload and the
+// result of a succeeded one in <id>.result.
+type dirStore struct {
+	dir string
+}
+
+// OpenDir returns a Store keeping jobs in dir, which is created if needed.
+func OpenDir(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating jobs directory: %v", err)
+	}
+	return &dirStore{dir: dir}, nil
+}
+
+func (d *dirStore) file(id, kind string) string {
+	return filepath.Join(d.dir, id+"."+kind)
+}
+
+func (d *dirStore) writeFile(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (d *dirStore) Load() ([]Stored, error) {
+	names, err := filepath.Glob(filepath.Join(d.dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("error listing jobs: %v", err)
+	}
+	var stored []Stored
+	for _, name := range names {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return nil, fmt.Errorf("error reading job: %v", err)
+		}
+		var s Stored
+		if err := json.Unmarshal(data, &s.Job); err != nil {
+			return nil, fmt.Errorf("error parsing job %s: %v", filepath.Base(name), err)
+		}
+		if !s.Job.finished() {
+			if s.Payload, err = os.ReadFile(d.file(s.Job.ID, "payload")); err != nil {
+				return nil, fmt.Errorf("error reading job %s: %v", s.Job.ID, err)
+			}
+		}
+		stored = append(stored, s)
+	}
+	return stored, nil
+}
+
+func (d *dirStore) Save(job Job, payload []byte) error {
+	if payload != nil {
+		if err := d.writeFile(d.file(job.ID, "payload"), payload); err != nil {
+			return fmt.Errorf("error writing job: %v", err)
+		}
+	}
+	data, err := json.MarshalIndent(job, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding job: %v", err)
+	}
+	if err := d.writeFile(d.file(job.ID, "json"), data); err != nil {
+		return fmt.Errorf("error writing job: %v", err)
+	}
+	return nil
+}
+
+func (d *dirStore) Finish(job Job, result []byte) error {
+	if job.Status == StatusSucceeded {
+		if err := d.writeFile(d.file(job.ID, "result"), result); err != nil {
+			return fmt.Errorf("error writing job result: %v", err)
+		}
+	}
+	os.Remove(d.file(job.ID, "payload"))
+	return d.Save(job, nil)
+}
+
+func (d *dirStore) Result(id string) ([]byte, error) {
+	data, err := os.ReadFile(d.file(id, "result"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading job result: %v", err)
+	}
+	return data, nil
+}
+
+func (d *dirStore) Remove(id string) error {
+	for _, kind := range []string{"json", "payload", "result"} {
+		os.Remove(d.file(id, kind))
+	}
+	return nil
+}
@@ -0,0 +1,522 @@
+// Package jobs runs long conversions in the background. Jobs are queued in
+// process and, when a Store is configured, persisted so that queued and
+// interrupted jobs resume after a restart.
+package jobs
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Job states.
+const (
+	StatusQueued    = "queued"
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+	StatusCancelled = "cancelled"
+)
+
+var (
+	ErrNotFound    = errors.New("job not found")
+	ErrNotFinished = errors.New("job has not finished")
+	ErrFinished    = errors.New("job already finished")
+	ErrKeyReused   = errors.New("idempotency key was used for a different request")
+	ErrClosed      = errors.New("job manager is shutting down")
+)
+
+// RunFunc performs a job: it receives the job's owner and submitted payload
+// and returns the result payload.
+type RunFunc func(ctx context.Context, owner string, payload []byte) ([]byte, error)
+
+// Job is a submitted conversion.
+type Job struct {
+	ID         string    `json:"id"`
+	Status     string    `json:"status"`
+	Error      string    `json:"error,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	StartedAt  time.Time `json:"started_at,omitempty"`
+	FinishedAt time.Time `json:"finished_at,omitempty"`
+	// Owner identifies the client that submitted the job.
+	Owner string `json:"owner,omitempty"`
+	// IdempotencyKey and PayloadHash identify a keyed submission.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+	PayloadHash    string `json:"payload_hash,omitempty"`
+}
+
+func (j *Job) finished() bool {
+	return j.Status == StatusSucceeded || j.Status == StatusFailed || j.Status == StatusCancelled
+}
+
+type entry struct {
+	job     Job
+	payload []byte
+	result  []byte
+	cancel  context.CancelFunc
+}
+
+// Queue is implemented by Manager, which queues jobs in process, and by
+// RedisQueue, which shares them between server instances.
+type Queue interface {
+	Submit(owner string, payload []byte, key string) (*Job, error)
+	Status(id string) (*Job, error)
+	Result(id string) ([]byte, error)
+	Cancel(id string) (*Job, error)
+	List(status string, limit int) ([]Job, error)
+	Shutdown(ctx context.Context) error
+}
+
+// Store persists jobs. Its methods are called with the Manager's lock
+// held, one at a time.
+type Store interface {
+	// Load returns the stored jobs, with the payloads of unfinished ones.
+	Load() ([]Stored, error)
+	// Save writes a job's state and, when it is not nil, its payload.
+	Save(job Job, payload []byte) error
+	// Finish writes the state of a finished job and the result of a
+	// succeeded one, and drops its payload.
+	Finish(job Job, result []byte) error
+	// Result returns the result of a succeeded job.
+	Result(id string) ([]byte, error)
+	// Remove deletes a job.
+	Remove(id string) error
+}
+
+// Stored is a job as loaded from a Store.
+type Stored struct {
+	Job     Job
+	Payload []byte
+}
+
+// pruneEvery is how often finished jobs past their retention are removed.
+const pruneEvery = time.Minute
+
+// Manager queues jobs and runs them on a fixed number of workers.
+type Manager struct {
+	store  Store
+	run    RunFunc
+	keyTTL time.Duration
+	maxAge time.Duration
+	queue  chan string
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	workers  sync.WaitGroup
+
+	mu   sync.Mutex
+	jobs map[string]*entry
+	keys map[string]string // owner and idempotency key to job id
+}
+
+// ownerKey scopes an idempotency key to the job owner, so that clients
+// cannot reach each other's jobs by reusing a key.
+func ownerKey(owner, key string) string {
+	return owner + "\x00" + key
+}
+
+// Open creates a Manager running jobs with run on the given number of
+// workers. When store is not nil, jobs are stored there and reloaded:
+// finished jobs keep their results, and queued or interrupted jobs run
+// again. Idempotency keys are remembered for keyTTL after their job was
+// created, and finished jobs are removed, with their results, retention
+// after they finished.
+func Open(store Store, workers int, keyTTL, retention time.Duration, run RunFunc) (*Manager, error) {
+	if workers <= 0 {
+		workers = 1
+	}
+	m := &Manager{
+		store:  store,
+		run:    run,
+		keyTTL: keyTTL,
+		maxAge: retention,
+		stop:   make(chan struct{}),
+		jobs:   make(map[string]*entry),
+		keys:   make(map[string]string),
+	}
+
+	var pending []*entry
+	if store != nil {
+		stored, err := store.Load()
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range stored {
+			e := &entry{job: s.Job, payload: s.Payload}
+			m.jobs[e.job.ID] = e
+			if e.job.IdempotencyKey != "" {
+				k := ownerKey(e.job.Owner, e.job.IdempotencyKey)
+				if prev, ok := m.keys[k]; !ok || m.jobs[prev].job.CreatedAt.Before(e.job.CreatedAt) {
+					m.keys[k] = e.job.ID
+				}
+			}
+			if !e.job.finished() {
+				e.job.Status = StatusQueued
+				pending = append(pending, e)
+			}
+		}
+	}
+	sort.Slice(pending, func(i, k int) bool {
+		return pending[i].job.CreatedAt.Before(pending[k].job.CreatedAt)
+	})
+
+	m.queue = make(chan string, len(pending)+1024)
+	for _, e := range pending {
+		m.queue <- e.job.ID
+	}
+	m.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go m.worker()
+	}
+	m.prune(time.Now())
+	go func() {
+		t := time.NewTicker(pruneEvery)
+		defer t.Stop()
+		for {
+			select {
+			case <-m.stop:
+				return
+			case now := <-t.C:
+				m.prune(now)
+			}
+		}
+	}()
+	return m, nil
+}
+
+// prune removes the jobs that finished more than the retention before now,
+// together with their idempotency keys.
+func (m *Manager) prune(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, e := range m.jobs {
+		if !e.job.finished() || now.Sub(e.job.FinishedAt) < m.maxAge {
+			continue
+		}
+		if m.store != nil {
+			if err := m.store.Remove(id); err != nil {
+				slog.Error("error removing job", "job_id", id, "error", err)
+				continue
+			}
+		}
+		delete(m.jobs, id)
+		if e.job.IdempotencyKey != "" {
+			k := ownerKey(e.job.Owner, e.job.IdempotencyKey)
+			if m.keys[k] == id {
+				delete(m.keys, k)
+			}
+		}
+	}
+}
+
+// Submit queues a job for payload on behalf of owner. When key is set and
+// owner submitted a job with the same key within the key TTL, that job is
+// returned instead; reusing a key for a different payload fails with
+// ErrKeyReused.
+func (m *Manager) Submit(owner string, payload []byte, key string) (*Job, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	e := &entry{
+		job:     Job{ID: id, Status: StatusQueued, CreatedAt: time.Now().UTC(), Owner: owner},
+		payload: payload,
+	}
+	if key != "" {
+		sum := sha256.Sum256(payload)
+		e.job.IdempotencyKey = key
+		e.job.PayloadHash = hex.EncodeToString(sum[:])
+		key = ownerKey(owner, key)
+	}
+
+	m.mu.Lock()
+	select {
+	case <-m.stop:
+		m.mu.Unlock()
+		return nil, ErrClosed
+	default:
+	}
+	if key != "" {
+		if prev := m.keyed(key); prev != nil {
+			m.mu.Unlock()
+			if prev.job.PayloadHash != e.job.PayloadHash {
+				return nil, ErrKeyReused
+			}
+			job := prev.job
+			return &job, nil
+		}
+	}
+	if err := m.save(e, payload); err != nil {
+		m.mu.Unlock()
+		return nil, err
+	}
+	m.jobs[id] = e
+	if key != "" {
+		m.keys[key] = id
+	}
+	job := e.job
+	m.mu.Unlock()
+
+	select {
+	case m.queue <- id:
+	default:
+		m.mu.Lock()
+		delete(m.jobs, id)
+		if key != "" {
+			delete(m.keys, key)
+		}
+		if m.store != nil {
+			m.store.Remove(id)
+		}
+		m.mu.Unlock()
+		return nil, fmt.Errorf("job queue is full")
+	}
+	return &job, nil
+}
+
+// keyed returns the job submitted with key within the key TTL, if any.
+// Callers must hold m.mu.
+func (m *Manager) keyed(key string) *entry {
+	id, ok := m.keys[key]
+	if !ok {
+		return nil
+	}
+	e := m.jobs[id]
+	if time.Since(e.job.CreatedAt) > m.keyTTL {
+		delete(m.keys, key)
+		return nil
+	}
+	return e
+}
+
+// Status returns the current state of a job.
+func (m *Manager) Status(id string) (*Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.jobs[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	job := e.job
+	return &job, nil
+}
+
+// List returns the jobs with the given status, or all jobs when status is
+// empty, newest first. A positive limit caps the number returned.
+func (m *Manager) List(status string, limit int) ([]Job, error) {
+	m.mu.Lock()
+	var list []Job
+	for _, e := range m.jobs {
+		if status == "" || e.job.Status == status {
+			list = append(list, e.job)
+		}
+	}
+	m.mu.Unlock()
+	sort.Slice(list, func(i, k int) bool {
+		return list[i].CreatedAt.After(list[k].CreatedAt)
+	})
+	if limit > 0 && len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+// Result returns the result payload of a succeeded job, or the job's error
+// if it failed or was cancelled.
+func (m *Manager) Result(id string) ([]byte, error) {
+	m.mu.Lock()
+	e, ok := m.jobs[id]
+	if !ok {
+		m.mu.Unlock()
+		return nil, ErrNotFound
+	}
+	job, result := e.job, e.result
+	m.mu.Unlock()
+
+	switch job.Status {
+	case StatusSucceeded:
+		if m.store != nil {
+			return m.store.Result(id)
+		}
+		return result, nil
+	case StatusFailed:
+		return nil, fmt.Errorf("job failed: %s", job.Error)
+	case StatusCancelled:
+		return nil, fmt.Errorf("job was cancelled")
+	}
+	return nil, ErrNotFinished
+}
+
+// Cancel stops a queued or running job. A running conversion is abandoned
+// and its result discarded.
+func (m *Manager) Cancel(id string) (*Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.jobs[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if e.job.finished() {
+		return nil, ErrFinished
+	}
+	if e.cancel != nil {
+		e.cancel()
+	}
+	m.finish(e, StatusCancelled, "", nil)
+	job := e.job
+	return &job, nil
+}
+
+// Shutdown stops starting queued jobs and waits for running ones until ctx
+// ends. Jobs still running then are abandoned and stored as queued, so that
+// they run again when the jobs directory is reopened.
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.mu.Lock()
+	m.stopOnce.Do(func() { close(m.stop) })
+	m.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		m.workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, e := range m.jobs {
+		if e.job.Status != StatusRunning {
+			continue
+		}
+		e.cancel()
+		e.cancel = nil
+		e.job.Status = StatusQueued
+		e.job.StartedAt = time.Time{}
+		if err := m.save(e, nil); err != nil {
+			slog.Error("error saving job", "job_id", e.job.ID, "error", err)
+		}
+	}
+	return ctx.Err()
+}
+
+func (m *Manager) worker() {
+	defer m.workers.Done()
+	for {
+		var id string
+		select {
+		case <-m.stop:
+			return
+		case id = <-m.queue:
+		}
+		select {
+		case <-m.stop:
+			// Leave the job queued for the next start.
+			return
+		default:
+		}
+		m.mu.Lock()
+		e, ok := m.jobs[id]
+		if !ok || e.job.Status != StatusQueued {
+			m.mu.Unlock()
+			continue
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		e.cancel = cancel
+		e.job.Status = StatusRunning
+		e.job.StartedAt = time.Now().UTC()
+		if err := m.save(e, nil); err != nil {
+			slog.Error("error saving job", "job_id", id, "error", err)
+		}
+		owner, payload := e.job.Owner, e.payload
+		m.mu.Unlock()
+
+		result, err := runJob(ctx, m.run, owner, payload)
+		cancel()
+
+		m.mu.Lock()
+		if e.job.Status == StatusRunning {
+			if err != nil {
+				m.finish(e, StatusFailed, err.Error(), nil)
+			} else {
+				m.finish(e, StatusSucceeded, "", result)
+			}
+		}
+		m.mu.Unlock()
+	}
+}
+
+// runJob runs a job, returning early when it is cancelled.
+func runJob(ctx context.Context, run RunFunc, owner string, payload []byte) ([]byte, error) {
+	type outcome struct {
+		result []byte
+		err    error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- outcome{err: fmt.Errorf("job panicked: %v", r)}
+			}
+		}()
+		result, err := run(ctx, owner, payload)
+		done <- outcome{result, err}
+	}()
+	select {
+	case o := <-done:
+		return o.result, o.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// finish records the outcome of a job. Callers must hold m.mu.
+func (m *Manager) finish(e *entry, status, reason string, result []byte) {
+	e.job.Status = status
+	e.job.Error = reason
+	e.job.FinishedAt = time.Now().UTC()
+	e.cancel = nil
+	e.payload = nil
+	if m.store == nil {
+		if status == StatusSucceeded {
+			e.result = result
+		}
+		return
+	}
+	err := m.store.Finish(e.job, result)
+	if err != nil && status == StatusSucceeded {
+		// Without its result the job is of no use; record it as failed.
+		e.job.Status = StatusFailed
+		e.job.Error = err.Error()
+		err = m.store.Finish(e.job, nil)
+	}
+	if err != nil {
+		slog.Error("error saving job", "job_id", e.job.ID, "error", err)
+	}
+}
+
+// save stores a job's state and payload. Callers must hold m.mu.
+func (m *Manager) save(e *entry, payload []byte) error {
+	if m.store == nil {
+		return nil
+	}
+	return m.store.Save(e.job, payload)
+}
+
+func newID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating job id: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,195 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// upper is a RunFunc that returns the owner and the upper-cased payload.
+func upper(ctx context.Context, owner string, payload []byte) ([]byte, error) {
+	if string(payload) == "fail" {
+		return nil, errors.New("bad payload")
+	}
+	return []byte(owner + ":" + strings.ToUpper(string(payload))), nil
+}
+
+func open(t *testing.T, store Store) *Manager {
+	t.Helper()
+	m, err := Open(store, 2, time.Hour, time.Hour, upper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { m.Shutdown(context.Background()) })
+	return m
+}
+
+// wait polls a job until it finishes.
+func wait(t *testing.T, m *Manager, id string) *Job {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := m.Status(id)
+		if err != nil {
+			t.Fatalf("job %s: %v", id, err)
+		}
+		if job.finished() {
+			return job
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("job %s did not finish", id)
+	return nil
+}
+
+func TestSubmitRunResult(t *testing.T) {
+	m := open(t, nil)
+	tests := []struct {
+		payload    string
+		wantStatus string
+		want       string
+		wantErr    bool
+	}{
+		{payload: "b12", wantStatus: StatusSucceeded, want: "alice:B12"},
+		{payload: "fail", wantStatus: StatusFailed, wantErr: true},
+	}
+	for _, tt := range tests {
+		job, err := m.Submit("alice", []byte(tt.payload), "")
+		if err != nil {
+			t.Fatalf("%s: %v", tt.payload, err)
+		}
+		if job.Owner != "alice" {
+			t.Errorf("%s: owner %q, want alice", tt.payload, job.Owner)
+		}
+		if got := wait(t, m, job.ID); got.Status != tt.wantStatus {
+			t.Errorf("%s: status %s, want %s", tt.payload, got.Status, tt.wantStatus)
+		}
+		result, err := m.Result(job.ID)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: got error %v, want one: %v", tt.payload, err, tt.wantErr)
+		}
+		if string(result) != tt.want {
+			t.Errorf("%s: result %q, want %q", tt.payload, result, tt.want)
+		}
+	}
+	if _, err := m.Status("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("unknown job: got error %v, want ErrNotFound", err)
+	}
+}
+
+func TestIdempotencyKeys(t *testing.T) {
+	m := open(t, nil)
+	first, err := m.Submit("alice", []byte("b12"), "k1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		owner    string
+		payload  string
+		key      string
+		wantSame bool
+		wantErr  error
+	}{
+		{name: "same request", owner: "alice", payload: "b12", key: "k1", wantSame: true},
+		{name: "different payload", owner: "alice", payload: "b13", key: "k1", wantErr: ErrKeyReused},
+		{name: "different owner", owner: "bob", payload: "b12", key: "k1"},
+		{name: "different key", owner: "alice", payload: "b12", key: "k2"},
+		{name: "no key", owner: "alice", payload: "b12"},
+	}
+	for _, tt := range tests {
+		job, err := m.Submit(tt.owner, []byte(tt.payload), tt.key)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if same := job.ID == first.ID; same != tt.wantSame {
+			t.Errorf("%s: got job %s for %s, want the same job: %v", tt.name, job.ID, first.ID, tt.wantSame)
+		}
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	store, err := OpenDir(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	created := time.Now().UTC().Add(-time.Minute)
+	stored := []struct {
+		job     Job
+		payload string
+		result  string
+	}{
+		{job: Job{ID: "queued", Status: StatusQueued, Owner: "alice"}, payload: "b12"},
+		{job: Job{ID: "running", Status: StatusRunning, Owner: "bob"}, payload: "b13"},
+		{job: Job{ID: "done", Status: StatusSucceeded, Owner: "alice", FinishedAt: created}, result: "alice:B14"},
+	}
+	for _, s := range stored {
+		s.job.CreatedAt = created
+		if err := store.Save(s.job, []byte(s.payload)); err != nil {
+			t.Fatal(err)
+		}
+		if s.job.finished() {
+			if err := store.Finish(s.job, []byte(s.result)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	m := open(t, store)
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{id: "queued", want: "alice:B12"},
+		{id: "running", want: "bob:B13"},
+		{id: "done", want: "alice:B14"},
+	}
+	for _, tt := range tests {
+		if job := wait(t, m, tt.id); job.Status != StatusSucceeded {
+			t.Errorf("%s: status %s, want %s", tt.id, job.Status, StatusSucceeded)
+		}
+		if result, err := m.Result(tt.id); err != nil || string(result) != tt.want {
+			t.Errorf("%s: result %q, error %v, want %q", tt.id, result, err, tt.want)
+		}
+	}
+}
+
+func TestPrune(t *testing.T) {
+	store, err := OpenDir(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := open(t, store)
+	job, err := m.Submit("alice", []byte("b12"), "k1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wait(t, m, job.ID)
+
+	m.prune(time.Now())
+	if _, err := m.Status(job.ID); err != nil {
+		t.Errorf("job pruned before its retention: %v", err)
+	}
+
+	m.prune(time.Now().Add(2 * time.Hour))
+	if _, err := m.Status(job.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("got error %v after the retention, want ErrNotFound", err)
+	}
+	if _, err := store.Result(job.ID); err == nil {
+		t.Errorf("the store still holds the result")
+	}
+	again, err := m.Submit("alice", []byte("b13"), "k1")
+	if err != nil {
+		t.Fatalf("reusing the key of a pruned job: %v", err)
+	}
+	if again.ID == job.ID {
+		t.Errorf("the key still refers to the pruned job")
+	}
+}
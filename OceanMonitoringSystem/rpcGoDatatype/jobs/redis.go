@@ -0,0 +1,559 @@
+package jobs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis keys. Each job is a hash holding its state, its payload until it
+// finishes and its result once it succeeded. Queued job ids wait in the
+// queue list and move to the running list while an instance runs them,
+// holding a lease the instance keeps renewing.
+const (
+	redisPrefix = "oms:jobs:"
+	queueKey    = redisPrefix + "queue"
+	runningKey  = redisPrefix + "running"
+	indexKey    = redisPrefix + "index"
+)
+
+func jobKey(id string) string   { return redisPrefix + "job:" + id }
+func leaseKey(id string) string { return redisPrefix + "lease:" + id }
+func idemKey(key string) string { return redisPrefix + "key:" + key }
+
+const (
+	leaseTTL     = 15 * time.Second
+	renewEvery   = 3 * time.Second
+	reapEvery    = 5 * time.Second
+	popTimeout   = time.Second
+	updateTries  = 10
+	listPageSize = 100
+)
+
+// errSkip aborts an update that no longer applies to a job.
+var errSkip = errors.New("job changed")
+
+// RedisQueue keeps jobs in Redis, so that every server instance sharing
+// it sees the same jobs and any of them runs a queued one. The jobs of an
+// instance that stops without finishing them are queued again once their
+// lease expires.
+type RedisQueue struct {
+	client *redis.Client
+	run    RunFunc
+	keyTTL time.Duration
+	maxAge time.Duration
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	workers  sync.WaitGroup
+
+	mu      sync.Mutex
+	running map[string]context.CancelFunc
+}
+
+// OpenRedis connects to the Redis server at url, e.g.
+// "redis://:password@localhost:6379/0", and runs queued jobs with run on
+// the given number of workers. Idempotency keys are remembered for keyTTL,
+// and finished jobs expire retention after they finished.
+func OpenRedis(url string, workers int, keyTTL, retention time.Duration, run RunFunc) (*RedisQueue, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Redis URL: %v", err)
+	}
+	q := &RedisQueue{
+		client:  redis.NewClient(opts),
+		run:     run,
+		keyTTL:  keyTTL,
+		maxAge:  retention,
+		stop:    make(chan struct{}),
+		running: make(map[string]context.CancelFunc),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := q.Ping(ctx); err != nil {
+		q.client.Close()
+		return nil, err
+	}
+	if workers <= 0 {
+		workers = 1
+	}
+	q.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go q.worker()
+	}
+	go q.reap()
+	return q, nil
+}
+
+// Ping checks that Redis answers.
+func (q *RedisQueue) Ping(ctx context.Context) error {
+	if err := q.client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("redis is unreachable: %v", err)
+	}
+	return nil
+}
+
+// Submit queues a job for payload on behalf of owner, with the same
+// idempotency rules as Manager.Submit.
+func (q *RedisQueue) Submit(owner string, payload []byte, key string) (*Job, error) {
+	select {
+	case <-q.stop:
+		return nil, ErrClosed
+	default:
+	}
+	ctx := context.Background()
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	job := Job{ID: id, Status: StatusQueued, CreatedAt: time.Now().UTC(), Owner: owner}
+	if key != "" {
+		sum := sha256.Sum256(payload)
+		job.IdempotencyKey = key
+		job.PayloadHash = hex.EncodeToString(sum[:])
+		key = ownerKey(owner, key)
+		prev, err := q.claimKey(ctx, key, id)
+		if err != nil {
+			return nil, err
+		}
+		if prev != nil {
+			if prev.PayloadHash != job.PayloadHash {
+				return nil, ErrKeyReused
+			}
+			return prev, nil
+		}
+	}
+	data, err := json.Marshal(job)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding job: %v", err)
+	}
+	_, err = q.client.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HSet(ctx, jobKey(id), "job", data, "payload", payload)
+		p.ZAdd(ctx, indexKey, redis.Z{Score: float64(job.CreatedAt.UnixNano()), Member: id})
+		p.LPush(ctx, queueKey, id)
+		return nil
+	})
+	if err != nil {
+		if key != "" {
+			q.client.Del(ctx, idemKey(key))
+		}
+		return nil, fmt.Errorf("error queuing job: %v", err)
+	}
+	return &job, nil
+}
+
+// claimKey records id as the job of an idempotency key, or returns the job
+// the key already belongs to.
+func (q *RedisQueue) claimKey(ctx context.Context, key, id string) (*Job, error) {
+	for i := 0; i < updateTries; i++ {
+		ok, err := q.client.SetNX(ctx, idemKey(key), id, q.keyTTL).Result()
+		if err != nil {
+			return nil, fmt.Errorf("error reading job: %v", err)
+		}
+		if ok {
+			return nil, nil
+		}
+		prev, err := q.client.Get(ctx, idemKey(key)).Result()
+		if errors.Is(err, redis.Nil) {
+			// The key expired meanwhile.
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading job: %v", err)
+		}
+		job, err := q.Status(prev)
+		if errors.Is(err, ErrNotFound) {
+			// The submission holding the key failed.
+			q.client.Del(ctx, idemKey(key))
+			continue
+		}
+		return job, err
+	}
+	return nil, fmt.Errorf("idempotency key %q is contended", key)
+}
+
+// Status returns the current state of a job.
+func (q *RedisQueue) Status(id string) (*Job, error) {
+	data, err := q.client.HGet(context.Background(), jobKey(id), "job").Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading job: %v", err)
+	}
+	job := &Job{}
+	if err := json.Unmarshal(data, job); err != nil {
+		return nil, fmt.Errorf("error parsing job %s: %v", id, err)
+	}
+	return job, nil
+}
+
+// Result returns the result payload of a succeeded job, or the job's error
+// if it failed or was cancelled.
+func (q *RedisQueue) Result(id string) ([]byte, error) {
+	job, err := q.Status(id)
+	if err != nil {
+		return nil, err
+	}
+	switch job.Status {
+	case StatusSucceeded:
+		result, err := q.client.HGet(context.Background(), jobKey(id), "result").Bytes()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return nil, fmt.Errorf("error reading job result: %v", err)
+		}
+		return result, nil
+	case StatusFailed:
+		return nil, fmt.Errorf("job failed: %s", job.Error)
+	case StatusCancelled:
+		return nil, fmt.Errorf("job was cancelled")
+	}
+	return nil, ErrNotFinished
+}
+
+// Cancel stops a queued or running job. A job running on another instance
+// stops when that instance next renews its lease.
+func (q *RedisQueue) Cancel(id string) (*Job, error) {
+	job, err := q.update(id, func(j *Job) error {
+		if j.finished() {
+			return ErrFinished
+		}
+		j.Status = StatusCancelled
+		j.FinishedAt = time.Now().UTC()
+		return nil
+	}, func(ctx context.Context, p redis.Pipeliner) {
+		p.HDel(ctx, jobKey(id), "payload")
+		p.Expire(ctx, jobKey(id), q.maxAge)
+	})
+	if err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	if cancel, ok := q.running[id]; ok {
+		cancel()
+	}
+	q.mu.Unlock()
+	return job, nil
+}
+
+// List returns the jobs with the given status, or all jobs when status is
+// empty, newest first. A positive limit caps the number returned.
+func (q *RedisQueue) List(status string, limit int) ([]Job, error) {
+	ctx := context.Background()
+	var list []Job
+	// Expired jobs leave their IDs behind in the index; they are removed
+	// once paging through it is done.
+	var expired []interface{}
+	defer func() {
+		if len(expired) > 0 {
+			q.client.ZRem(ctx, indexKey, expired...)
+		}
+	}()
+	for start := int64(0); ; start += listPageSize {
+		ids, err := q.client.ZRevRange(ctx, indexKey, start, start+listPageSize-1).Result()
+		if err != nil {
+			return nil, fmt.Errorf("error listing jobs: %v", err)
+		}
+		if len(ids) == 0 {
+			return list, nil
+		}
+		cmds := make([]*redis.StringCmd, len(ids))
+		_, err = q.client.Pipelined(ctx, func(p redis.Pipeliner) error {
+			for i, id := range ids {
+				cmds[i] = p.HGet(ctx, jobKey(id), "job")
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return nil, fmt.Errorf("error listing jobs: %v", err)
+		}
+		for i, cmd := range cmds {
+			data, err := cmd.Bytes()
+			if errors.Is(err, redis.Nil) {
+				expired = append(expired, ids[i])
+				continue
+			}
+			if err != nil {
+				continue
+			}
+			var job Job
+			if json.Unmarshal(data, &job) != nil || (status != "" && job.Status != status) {
+				continue
+			}
+			list = append(list, job)
+			if limit > 0 && len(list) == limit {
+				return list, nil
+			}
+		}
+	}
+}
+
+// Shutdown stops taking queued jobs and waits for running ones until ctx
+// ends. Jobs still running then are abandoned and queued again.
+func (q *RedisQueue) Shutdown(ctx context.Context) error {
+	q.stopOnce.Do(func() { close(q.stop) })
+
+	done := make(chan struct{})
+	go func() {
+		q.workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return q.client.Close()
+	case <-ctx.Done():
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for id, cancel := range q.running {
+		cancel()
+		delete(q.running, id)
+		q.requeue(id)
+	}
+	return ctx.Err()
+}
+
+// update changes a job's state with fn, retrying when the job changes
+// meanwhile. extra adds commands to the transaction writing the state.
+func (q *RedisQueue) update(id string, fn func(*Job) error, extra func(context.Context, redis.Pipeliner)) (*Job, error) {
+	ctx := context.Background()
+	key := jobKey(id)
+	var job Job
+	txf := func(tx *redis.Tx) error {
+		data, err := tx.HGet(ctx, key, "job").Bytes()
+		if errors.Is(err, redis.Nil) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		job = Job{}
+		if err := json.Unmarshal(data, &job); err != nil {
+			return fmt.Errorf("error parsing job %s: %v", id, err)
+		}
+		if err := fn(&job); err != nil {
+			return err
+		}
+		if data, err = json.Marshal(job); err != nil {
+			return fmt.Errorf("error encoding job: %v", err)
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.HSet(ctx, key, "job", data)
+			if extra != nil {
+				extra(ctx, p)
+			}
+			return nil
+		})
+		return err
+	}
+	for i := 0; i < updateTries; i++ {
+		err := q.client.Watch(ctx, txf, key)
+		if errors.Is(err, redis.TxFailedErr) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &job, nil
+	}
+	return nil, fmt.Errorf("job %s is contended", id)
+}
+
+func (q *RedisQueue) worker() {
+	defer q.workers.Done()
+	ctx := context.Background()
+	for {
+		select {
+		case <-q.stop:
+			return
+		default:
+		}
+		id, err := q.client.BLMove(ctx, queueKey, runningKey, "RIGHT", "LEFT", popTimeout).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			slog.Error("error reading job queue", "error", err)
+			select {
+			case <-q.stop:
+				return
+			case <-time.After(popTimeout):
+			}
+			continue
+		}
+		select {
+		case <-q.stop:
+			// Leave the job queued for another instance.
+			q.mu.Lock()
+			q.requeue(id)
+			q.mu.Unlock()
+			return
+		default:
+		}
+		q.process(id)
+	}
+}
+
+// process runs a job taken from the queue.
+func (q *RedisQueue) process(id string) {
+	ctx := context.Background()
+	q.client.Set(ctx, leaseKey(id), 1, leaseTTL)
+	release := func() {
+		q.client.LRem(ctx, runningKey, 0, id)
+		q.client.Del(ctx, leaseKey(id))
+	}
+	job, err := q.update(id, func(j *Job) error {
+		if j.Status != StatusQueued {
+			return errSkip
+		}
+		j.Status = StatusRunning
+		j.StartedAt = time.Now().UTC()
+		return nil
+	}, nil)
+	if err != nil {
+		if !errors.Is(err, errSkip) && !errors.Is(err, ErrNotFound) {
+			slog.Error("error starting job", "job_id", id, "error", err)
+		}
+		release()
+		return
+	}
+	payload, err := q.client.HGet(ctx, jobKey(id), "payload").Bytes()
+	if err != nil {
+		q.finish(id, nil, fmt.Errorf("error reading job: %v", err))
+		release()
+		return
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	q.mu.Lock()
+	q.running[id] = cancel
+	q.mu.Unlock()
+	go q.renew(runCtx, id, cancel)
+
+	result, err := runJob(runCtx, q.run, job.Owner, payload)
+	cancel()
+
+	q.mu.Lock()
+	_, ours := q.running[id]
+	delete(q.running, id)
+	q.mu.Unlock()
+	if !ours {
+		// Shutdown gave up on the job and queued it again.
+		return
+	}
+	q.finish(id, result, err)
+	release()
+}
+
+// renew keeps a running job's lease until ctx ends, and cancels the job
+// when it was cancelled on another instance.
+func (q *RedisQueue) renew(ctx context.Context, id string, cancel context.CancelFunc) {
+	t := time.NewTicker(renewEvery)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		q.client.Expire(ctx, leaseKey(id), leaseTTL)
+		if job, err := q.Status(id); err == nil && job.Status != StatusRunning {
+			cancel()
+			return
+		}
+	}
+}
+
+// finish records the outcome of a running job.
+func (q *RedisQueue) finish(id string, result []byte, runErr error) {
+	_, err := q.update(id, func(j *Job) error {
+		if j.Status != StatusRunning {
+			return errSkip
+		}
+		j.FinishedAt = time.Now().UTC()
+		if runErr != nil {
+			j.Status = StatusFailed
+			j.Error = runErr.Error()
+		} else {
+			j.Status = StatusSucceeded
+		}
+		return nil
+	}, func(ctx context.Context, p redis.Pipeliner) {
+		p.HDel(ctx, jobKey(id), "payload")
+		if runErr == nil {
+			p.HSet(ctx, jobKey(id), "result", result)
+		}
+		p.Expire(ctx, jobKey(id), q.maxAge)
+	})
+	if err != nil && !errors.Is(err, errSkip) {
+		slog.Error("error saving job", "job_id", id, "error", err)
+	}
+}
+
+// reap queues again the running jobs whose lease expired because their
+// instance stopped. A job is only taken once its lease was found missing
+// twice, so that a job just moved to the running list gets its lease.
+func (q *RedisQueue) reap() {
+	ctx := context.Background()
+	t := time.NewTicker(reapEvery)
+	defer t.Stop()
+	suspects := map[string]bool{}
+	for {
+		select {
+		case <-q.stop:
+			return
+		case <-t.C:
+		}
+		ids, err := q.client.LRange(ctx, runningKey, 0, -1).Result()
+		if err != nil {
+			slog.Error("error reading running jobs", "error", err)
+			continue
+		}
+		next := map[string]bool{}
+		for _, id := range ids {
+			if n, err := q.client.Exists(ctx, leaseKey(id)).Result(); err != nil || n > 0 {
+				continue
+			}
+			if !suspects[id] {
+				next[id] = true
+				continue
+			}
+			slog.Warn("requeuing abandoned job", "job_id", id)
+			q.requeue(id)
+		}
+		suspects = next
+	}
+}
+
+// requeue moves a job from the running list back to the front of the
+// queue, unless it finished. Only the caller removing it from the running
+// list queues it, so that it is not queued twice.
+func (q *RedisQueue) requeue(id string) {
+	ctx := context.Background()
+	n, err := q.client.LRem(ctx, runningKey, 1, id).Result()
+	if err != nil || n == 0 {
+		return
+	}
+	q.client.Del(ctx, leaseKey(id))
+	_, err = q.update(id, func(j *Job) error {
+		if j.finished() {
+			return errSkip
+		}
+		j.Status = StatusQueued
+		j.StartedAt = time.Time{}
+		return nil
+	}, func(ctx context.Context, p redis.Pipeliner) {
+		p.RPush(ctx, queueKey, id)
+	})
+	if err != nil && !errors.Is(err, errSkip) && !errors.Is(err, ErrNotFound) {
+		slog.Error("error requeuing job", "job_id", id, "error", err)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"rpcGoDatatype/jobs"
+	pb "rpcGoDatatype/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestJobOwnership(t *testing.T) {
+	m, err := jobs.Open(nil, 1, time.Hour, time.Hour, func(ctx context.Context, owner string, payload []byte) ([]byte, error) {
+		return proto.Marshal(&pb.ParseResponse{})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Shutdown(context.Background())
+	s := &server{jobs: m}
+
+	job, err := m.Submit("alice", nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(5 * time.Millisecond) {
+		if j, _ := m.Status(job.ID); j.Status == jobs.StatusSucceeded {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("job did not finish")
+		}
+	}
+
+	tests := []struct {
+		owner string
+		want  codes.Code
+	}{
+		{owner: "alice", want: codes.OK},
+		{owner: "bob", want: codes.NotFound},
+		{owner: "", want: codes.NotFound},
+	}
+	req := &pb.JobRequest{JobId: job.ID}
+	for _, tt := range tests {
+		ctx := context.WithValue(context.Background(), jobOwnerKey{}, tt.owner)
+		if _, err := s.GetJobStatus(ctx, req); status.Code(err) != tt.want {
+			t.Errorf("GetJobStatus as %q: got %v, want %s", tt.owner, err, tt.want)
+		}
+		if _, err := s.GetJobResult(ctx, req); status.Code(err) != tt.want {
+			t.Errorf("GetJobResult as %q: got %v, want %s", tt.owner, err, tt.want)
+		}
+		if tt.want == codes.OK {
+			continue
+		}
+		// Cancelling another client's finished job must not reveal that it
+		// exists.
+		if _, err := s.CancelJob(ctx, req); status.Code(err) != tt.want {
+			t.Errorf("CancelJob as %q: got %v, want %s", tt.owner, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package kafka
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// avroType is the Avro type of a field, ordered so that merging two
+// numeric types picks the wider one.
+type avroType int
+
+const (
+	avroNull avroType = iota
+	avroBoolean
+	avroLong
+	avroDouble
+	avroString
+)
+
+var avroTypeNames = [...]string{"null", "boolean", "long", "double", "string"}
+
+// merge returns a type that holds values of both t and u.
+func (t avroType) merge(u avroType) avroType {
+	switch {
+	case t == u || u == avroNull:
+		return t
+	case t == avroNull:
+		return u
+	case (t == avroLong || t == avroDouble) && (u == avroLong || u == avroDouble):
+		return avroDouble
+	}
+	return avroString
+}
+
+// field is a column of the records in one message.
+type field struct {
+	key  string
+	name string
+	typ  avroType
+}
+
+// record is a JSON object with its keys in order.
+type record struct {
+	keys   []string
+	values map[string]json.RawMessage
+}
+
+func decodeRecord(data []byte) (*record, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return nil, fmt.Errorf("record is not a JSON object")
+	}
+	r := &record{values: map[string]json.RawMessage{}}
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key := t.(string)
+		var v json.RawMessage
+		if err := dec.Decode(&v); err != nil {
+			return nil, err
+		}
+		if _, ok := r.values[key]; !ok {
+			r.keys = append(r.keys, key)
+		}
+		r.values[key] = v
+	}
+	return r, nil
+}
+
+// typeOf returns the narrowest Avro type holding v. Arrays and objects
+// are written as their JSON text.
+func typeOf(v json.RawMessage) avroType {
+	switch s := string(v); {
+	case s == "null":
+		return avroNull
+	case s == "true" || s == "false":
+		return avroBoolean
+	case s[0] == '"' || s[0] == '[' || s[0] == '{':
+		return avroString
+	}
+	if _, err := strconv.ParseInt(string(v), 10, 64); err == nil {
+		return avroLong
+	}
+	return avroDouble
+}
+
+// avroName turns a column name into a valid Avro name.
+func avroName(key string) string {
+	var b strings.Builder
+	for i, r := range key {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+			b.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	if b.Len() == 0 {
+		return "_"
+	}
+	return b.String()
+}
+
+// encodeAvro infers a record schema covering every record, each field
+// nullable, and encodes each record as an Avro binary datum of it.
+func encodeAvro(data [][]byte) (schema []byte, values [][]byte, err error) {
+	records := make([]*record, len(data))
+	var fields []*field
+	byKey := map[string]*field{}
+	names := map[string]bool{}
+	for i, d := range data {
+		if records[i], err = decodeRecord(d); err != nil {
+			return nil, nil, err
+		}
+		for _, key := range records[i].keys {
+			f, ok := byKey[key]
+			if !ok {
+				f = &field{key: key, name: avroName(key)}
+				for n := 2; names[f.name]; n++ {
+					f.name = fmt.Sprintf("%s_%d", avroName(key), n)
+				}
+				names[f.name] = true
+				byKey[key] = f
+				fields = append(fields, f)
+			}
+			f.typ = f.typ.merge(typeOf(records[i].values[key]))
+		}
+	}
+
+	type schemaField struct {
+		Name    string   `json:"name"`
+		Type    []string `json:"type"`
+		Default *string  `json:"default"`
+	}
+	sf := make([]schemaField, len(fields))
+	for i, f := range fields {
+		if f.typ == avroNull {
+			f.typ = avroString
+		}
+		sf[i] = schemaField{Name: f.name, Type: []string{"null", avroTypeNames[f.typ]}}
+	}
+	if schema, err = json.Marshal(map[string]interface{}{
+		"type":   "record",
+		"name":   "Record",
+		"fields": sf,
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	values = make([][]byte, len(records))
+	for i, r := range records {
+		var buf []byte
+		for _, f := range fields {
+			v, ok := r.values[f.key]
+			if !ok || typeOf(v) == avroNull {
+				buf = binary.AppendVarint(buf, 0)
+				continue
+			}
+			buf = binary.AppendVarint(buf, 1)
+			if buf, err = appendAvro(buf, f.typ, v); err != nil {
+				return nil, nil, fmt.Errorf("error encoding %s: %v", f.key, err)
+			}
+		}
+		values[i] = buf
+	}
+	return schema, values, nil
+}
+
+// appendAvro appends v in the Avro binary encoding of t. Avro longs and
+// lengths use the same zig-zag varints as encoding/binary.
+func appendAvro(buf []byte, t avroType, v json.RawMessage) ([]byte, error) {
+	switch t {
+	case avroBoolean:
+		if string(v) == "true" {
+			return append(buf, 1), nil
+		}
+		return append(buf, 0), nil
+	case avroLong:
+		n, err := strconv.ParseInt(string(v), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return binary.AppendVarint(buf, n), nil
+	case avroDouble:
+		f, err := strconv.ParseFloat(string(v), 64)
+		if err != nil {
+			return nil, err
+		}
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(f)), nil
+	}
+	s := string(v)
+	if v[0] == '"' {
+		if err := json.Unmarshal(v, &s); err != nil {
+			return nil, err
+		}
+	}
+	buf = binary.AppendVarint(buf, int64(len(s)))
+	return append(buf, s...), nil
+}
@@ -0,0 +1,219 @@
+// Package kafka runs the service as a streaming normalization stage: it
+// consumes raw messages from a Kafka topic, converts each one and produces
+// the converted records to an output topic.
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	kgo "github.com/segmentio/kafka-go"
+)
+
+// Config selects the topics a Stage reads and writes.
+type Config struct {
+	Brokers []string
+	Topic   string
+	GroupID string
+	// OutputTopic receives the converted records. DeadLetterTopic, when
+	// set, receives the messages that could not be converted.
+	OutputTopic     string
+	DeadLetterTopic string
+	// Serialization is "json", writing each record as a JSON object, or
+	// "avro", writing each record as an Avro binary datum whose schema is
+	// in the avro.schema header.
+	Serialization string
+	// DeadLettered, when set, is called with the number of messages sent
+	// to the dead letter topic so far, each time one more is.
+	DeadLettered func(total int64, err error)
+	// WriteFailed, when set, is called when writing to the brokers fails,
+	// before Run returns.
+	WriteFailed func(err error)
+}
+
+// ConvertFunc converts a message value into records, each a JSON object.
+type ConvertFunc func(ctx context.Context, value []byte) ([][]byte, error)
+
+// Stage converts the messages of a topic. Messages are committed once
+// their records are written, so each is converted at least once.
+type Stage struct {
+	cfg     Config
+	convert ConvertFunc
+	reader  *kgo.Reader
+	writer  *kgo.Writer
+
+	consumed     atomic.Int64
+	produced     atomic.Int64
+	failed       atomic.Int64
+	deadLettered atomic.Int64
+}
+
+// New creates a Stage. It connects to the brokers once Run is called.
+func New(cfg Config, convert ConvertFunc) (*Stage, error) {
+	switch strings.ToLower(cfg.Serialization) {
+	case "", "json", "avro":
+	default:
+		return nil, fmt.Errorf("unsupported serialization %q: use json or avro", cfg.Serialization)
+	}
+	if cfg.OutputTopic == "" {
+		return nil, fmt.Errorf("an output topic is required")
+	}
+	rc := kgo.ReaderConfig{
+		Brokers: cfg.Brokers,
+		GroupID: cfg.GroupID,
+		Topic:   cfg.Topic,
+	}
+	if err := rc.Validate(); err != nil {
+		return nil, err
+	}
+	return &Stage{
+		cfg:     cfg,
+		convert: convert,
+		reader:  kgo.NewReader(rc),
+		writer: &kgo.Writer{
+			Addr: kgo.TCP(cfg.Brokers...),
+			// Records keep their message key, so hashing keeps the records
+			// of one station in order.
+			Balancer:     &kgo.Hash{},
+			RequiredAcks: kgo.RequireAll,
+			BatchTimeout: 10 * time.Millisecond,
+		},
+	}, nil
+}
+
+// Run converts messages until ctx is done or reading or writing fails.
+func (s *Stage) Run(ctx context.Context) error {
+	for {
+		msg, err := s.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("error reading from kafka: %v", err)
+		}
+		s.consumed.Add(1)
+
+		out, convErr := s.process(ctx, msg)
+		records := len(out)
+		if convErr != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			s.failed.Add(1)
+			slog.WarnContext(ctx, "kafka message not converted", "topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset, "error", convErr)
+			out = nil
+			if s.cfg.DeadLetterTopic != "" {
+				out = []kgo.Message{deadLetter(s.cfg.DeadLetterTopic, msg, convErr)}
+			}
+		}
+		if err := s.writer.WriteMessages(ctx, out...); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if s.cfg.WriteFailed != nil {
+				s.cfg.WriteFailed(err)
+			}
+			return fmt.Errorf("error writing to kafka: %v", err)
+		}
+		s.produced.Add(int64(records))
+		if convErr != nil && len(out) > 0 {
+			total := s.deadLettered.Add(1)
+			if s.cfg.DeadLettered != nil {
+				s.cfg.DeadLettered(total, convErr)
+			}
+		}
+		if err := s.reader.CommitMessages(ctx, msg); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("error committing kafka offset: %v", err)
+		}
+	}
+}
+
+// process converts a message into the messages for its records.
+func (s *Stage) process(ctx context.Context, msg kgo.Message) ([]kgo.Message, error) {
+	records, err := s.convert(ctx, msg.Value)
+	if err != nil {
+		return nil, err
+	}
+	values, header, err := s.encode(records)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]kgo.Message, len(values))
+	for i, v := range values {
+		out[i] = kgo.Message{
+			Topic:   s.cfg.OutputTopic,
+			Key:     msg.Key,
+			Value:   v,
+			Headers: append(append([]kgo.Header{}, msg.Headers...), header...),
+		}
+	}
+	return out, nil
+}
+
+// encode serializes records in the configured format and returns the
+// headers describing them.
+func (s *Stage) encode(records [][]byte) ([][]byte, []kgo.Header, error) {
+	if strings.EqualFold(s.cfg.Serialization, "avro") {
+		schema, values, err := encodeAvro(records)
+		if err != nil {
+			return nil, nil, err
+		}
+		return values, []kgo.Header{
+			{Key: "content-type", Value: []byte("avro/binary")},
+			{Key: "avro.schema", Value: schema},
+		}, nil
+	}
+	return records, []kgo.Header{{Key: "content-type", Value: []byte("application/json")}}, nil
+}
+
+// deadLetter wraps a message that could not be converted, recording why.
+func deadLetter(topic string, msg kgo.Message, err error) kgo.Message {
+	return kgo.Message{
+		Topic: topic,
+		Key:   msg.Key,
+		Value: msg.Value,
+		Headers: append(append([]kgo.Header{}, msg.Headers...),
+			kgo.Header{Key: "error", Value: []byte(err.Error())},
+			kgo.Header{Key: "source", Value: []byte(fmt.Sprintf("%s/%d/%d", msg.Topic, msg.Partition, msg.Offset))},
+		),
+	}
+}
+
+// Close stops reading and flushes pending writes. Run must have returned.
+func (s *Stage) Close() error {
+	rerr := s.reader.Close()
+	if err := s.writer.Close(); err != nil {
+		return err
+	}
+	return rerr
+}
+
+// Stats counts the messages a Stage handled.
+type Stats struct {
+	Consumed     int64 `json:"consumed"`
+	Produced     int64 `json:"produced"`
+	Failed       int64 `json:"failed"`
+	DeadLettered int64 `json:"dead_lettered"`
+}
+
+// Stats returns the current counts.
+func (s *Stage) Stats() Stats {
+	return Stats{
+		Consumed:     s.consumed.Load(),
+		Produced:     s.produced.Load(),
+		Failed:       s.failed.Load(),
+		DeadLettered: s.deadLettered.Load(),
+	}
+}
+
+// Vars returns the current counts in a form suitable for expvar.
+func (s *Stage) Vars() interface{} {
+	return s.Stats()
+}
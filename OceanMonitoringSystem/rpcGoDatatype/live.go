@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"rpcGoDatatype/auth"
+	"rpcGoDatatype/csvconverter"
+	pb "rpcGoDatatype/proto"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ParseLive converts a feed of input lines as they arrive, sending each row
+// back as soon as it is read. A feed has no set length, so the row and
+// input size limits do not apply and it does not take a pool worker.
+func (s *server) ParseLive(stream pb.DataParser_ParseLiveServer) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "live feed ended before its first message")
+	}
+	if err != nil {
+		return err
+	}
+	from := first.From
+	if from == "" {
+		from = "csv"
+	}
+	slog.InfoContext(ctx, "ParseLive request", "from", from)
+	opts := s.alertOptions(ctx, s.options(first.Options))
+	opts.MaxInputBytes, opts.MaxRows = 0, 0
+
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	go func() {
+		for msg := first; ; {
+			if _, err := io.WriteString(pw, msg.Data); err != nil {
+				return
+			}
+			if msg, err = stream.Recv(); err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				pw.CloseWithError(err)
+				return
+			}
+		}
+	}()
+
+	result, err := csvconverter.ConvertRows(ctx, from, pr, opts, func(row []byte, line int) error {
+		return stream.Send(&pb.LiveResponse{Object: string(row), Line: int64(line)})
+	})
+	if err != nil {
+		return convertError(err)
+	}
+	if len(result.Warnings) > 0 {
+		return stream.Send(&pb.LiveResponse{Warnings: result.Warnings})
+	}
+	return nil
+}
+
+// liveUpgrader accepts connections from any origin: calls authenticate
+// with an API key or bearer token rather than cookies.
+var liveUpgrader = websocket.Upgrader{
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// liveMessage is the JSON text message sent for each LiveResponse.
+type liveMessage struct {
+	Line     int64           `json:"line,omitempty"`
+	Object   json.RawMessage `json:"object,omitempty"`
+	Warnings []string        `json:"warnings,omitempty"`
+}
+
+// liveHandler bridges a WebSocket to ParseLive. The query names the input
+// format ("from", default csv) and the options as ProtoJSON ("options");
+// browsers, which cannot set headers on a WebSocket, may pass their API key
+// as "api_key". Each text message holds one or more input lines and each
+// converted row is pushed back as a liveMessage.
+func liveHandler(client pb.DataParserClient, readLimit int) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		q := r.URL.Query()
+		first := &pb.LiveRequest{From: q.Get("from")}
+		if o := q.Get("options"); o != "" {
+			first.Options = &pb.ConvertOptions{}
+			if err := protojson.Unmarshal([]byte(o), first.Options); err != nil {
+				http.Error(w, "invalid options: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		md := forwardedMetadata(r.Header, r.RemoteAddr)
+		if key := q.Get("api_key"); key != "" && len(md[auth.APIKeyHeader]) == 0 {
+			md.Set(auth.APIKeyHeader, key)
+		}
+
+		ws, err := liveUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer ws.Close()
+		ws.SetReadLimit(int64(readLimit))
+
+		ctx, cancel := context.WithCancel(metadata.NewOutgoingContext(r.Context(), md))
+		defer cancel()
+		stream, err := client.ParseLive(ctx)
+		if err == nil {
+			err = stream.Send(first)
+		}
+		if err != nil {
+			closeLive(ws, err)
+			return
+		}
+
+		go func() {
+			for {
+				_, data, err := ws.ReadMessage()
+				if err != nil {
+					stream.CloseSend()
+					return
+				}
+				lines := string(data)
+				if !strings.HasSuffix(lines, "\n") {
+					lines += "\n"
+				}
+				if err := stream.Send(&pb.LiveRequest{Data: lines}); err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				closeLive(ws, nil)
+				return
+			}
+			if err != nil {
+				closeLive(ws, err)
+				return
+			}
+			msg := liveMessage{Line: resp.Line, Warnings: resp.Warnings}
+			if resp.Object != "" {
+				msg.Object = json.RawMessage(resp.Object)
+			}
+			if err := ws.WriteJSON(msg); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// closeLive ends a WebSocket with a close code matching err.
+func closeLive(ws *websocket.Conn, err error) {
+	code, text := websocket.CloseNormalClosure, ""
+	if err != nil {
+		st := status.Convert(err)
+		switch st.Code() {
+		case codes.Unauthenticated, codes.PermissionDenied:
+			code = websocket.ClosePolicyViolation
+		case codes.InvalidArgument:
+			code = websocket.CloseInvalidFramePayloadData
+		case codes.ResourceExhausted, codes.Unavailable:
+			code = websocket.CloseTryAgainLater
+		default:
+			code = websocket.CloseInternalServerErr
+		}
+		// Close reasons are limited to 123 bytes.
+		if text = st.Message(); len(text) > 123 {
+			text = text[:123]
+		}
+	}
+	ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(time.Second))
+}
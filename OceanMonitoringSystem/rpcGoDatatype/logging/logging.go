@@ -0,0 +1,168 @@
+// Package logging configures structured logging and tags each gRPC call
+// with a request ID that appears in its log lines and error responses.
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDHeader is the metadata key carrying the request ID, both from
+// clients that set their own and back to every client.
+const RequestIDHeader = "x-request-id"
+
+// maxRequestIDLength bounds client supplied request IDs.
+const maxRequestIDLength = 128
+
+// Setup installs the default slog logger. format is "json" or "text" and
+// level one of debug, info, warn or error; empty values select text and
+// info. The standard log package writes through the same logger.
+func Setup(w io.Writer, format, level string) error {
+	var lvl slog.Level
+	if level != "" {
+		if err := lvl.UnmarshalText([]byte(level)); err != nil {
+			return fmt.Errorf("invalid log level %q", level)
+		}
+	}
+	opts := &slog.HandlerOptions{Level: lvl}
+	var h slog.Handler
+	switch strings.ToLower(format) {
+	case "", "text":
+		h = slog.NewTextHandler(w, opts)
+	case "json":
+		h = slog.NewJSONHandler(w, opts)
+	default:
+		return fmt.Errorf("invalid log format %q", format)
+	}
+	slog.SetDefault(slog.New(contextHandler{h}))
+	return nil
+}
+
+// contextHandler adds the request and trace IDs found in the context to
+// each record.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id, ok := RequestID(ctx); ok {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context carrying id.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID carried by ctx.
+func RequestID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok
+}
+
+// NewRequestID generates a random request ID.
+func NewRequestID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// incoming returns the request ID sent by the client, or a new one.
+func incoming(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(RequestIDHeader); len(v) > 0 {
+		id := strings.TrimSpace(v[0])
+		if id != "" && len(id) <= maxRequestIDLength && !strings.ContainsFunc(id, func(r rune) bool { return r < ' ' || r > '~' }) {
+			return id
+		}
+	}
+	return NewRequestID()
+}
+
+// start tags a call with its request ID, returning it to the client in the
+// response header.
+func start(ctx context.Context) (context.Context, string) {
+	id := incoming(ctx)
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id))
+	return WithRequestID(ctx, id), id
+}
+
+// finish logs the outcome of a call and attaches the request ID to its
+// error.
+func finish(ctx context.Context, method, id string, began time.Time, err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		// Keep the CANCELED and DEADLINE_EXCEEDED codes gRPC would report.
+		st = status.FromContextError(err)
+	}
+	attrs := []any{
+		"method", method,
+		"code", st.Code().String(),
+		"duration_ms", float64(time.Since(began)) / float64(time.Millisecond),
+	}
+	if err == nil {
+		slog.InfoContext(ctx, "request finished", attrs...)
+		return nil
+	}
+	slog.WarnContext(ctx, "request failed", append(attrs, "error", st.Message())...)
+	if d, derr := st.WithDetails(&errdetails.RequestInfo{RequestId: id}); derr == nil {
+		st = d
+	}
+	return st.Err()
+}
+
+// UnaryInterceptor assigns request IDs to unary calls and logs them.
+func UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		began := time.Now()
+		ctx, id := start(ctx)
+		resp, err := handler(ctx, req)
+		return resp, finish(ctx, info.FullMethod, id, began, err)
+	}
+}
+
+// StreamInterceptor assigns request IDs to streaming calls and logs them.
+func StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		began := time.Now()
+		ctx, id := start(ss.Context())
+		err := handler(srv, &requestStream{ServerStream: ss, ctx: ctx})
+		return finish(ctx, info.FullMethod, id, began, err)
+	}
+}
+
+type requestStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestStream) Context() context.Context {
+	return s.ctx
+}
@@ -2,58 +2,1528 @@ package main
 
 import (
 	"context"
+	"errors"
+	"expvar"
+	"flag"
 	"fmt"
 	"log"
+	"log/slog"
 	"net"
-	"strings"
+	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
 
+	"rpcGoDatatype/alerting"
+	"rpcGoDatatype/audit"
+	"rpcGoDatatype/auth"
+	"rpcGoDatatype/cache"
+	"rpcGoDatatype/compression"
+	"rpcGoDatatype/config"
+	"rpcGoDatatype/coords"
 	"rpcGoDatatype/csvconverter"
+	"rpcGoDatatype/fetch"
+	"rpcGoDatatype/history"
+	"rpcGoDatatype/hooks"
+	"rpcGoDatatype/idempotency"
+	"rpcGoDatatype/influx"
+	"rpcGoDatatype/jobs"
+	"rpcGoDatatype/kafka"
+	"rpcGoDatatype/logging"
+	"rpcGoDatatype/mtls"
+	"rpcGoDatatype/nats"
+	"rpcGoDatatype/objectstore"
+	"rpcGoDatatype/plugins"
+	"rpcGoDatatype/pool"
+	"rpcGoDatatype/postgres"
 	pb "rpcGoDatatype/proto"
+	pbv2 "rpcGoDatatype/proto/v2"
+	"rpcGoDatatype/ranges"
+	"rpcGoDatatype/ratelimit"
+	"rpcGoDatatype/recovery"
+	"rpcGoDatatype/registration"
+	"rpcGoDatatype/registry"
+	"rpcGoDatatype/tracing"
+	"rpcGoDatatype/usage"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type server struct {
 	pb.UnimplementedDataParserServer
+	hooks      *hooks.Runner
+	fetcher    *fetch.Fetcher
+	objects    *objectstore.Store
+	influx     *influx.Client
+	postgres   *postgres.Sink
+	history    *history.DB
+	audit      *audit.Logger
+	dataRoot   *os.Root
+	maxFetch   int64
+	jobs       jobs.Queue
+	stations   *registration.Store
+	regLimiter *ratelimit.Limiter
+	registry   *registry.Store
+	alerts     *alerting.Service
+	adminToken string
+	cache      cache.Cache
+	idempotent *idempotency.Store
+	usage      *usage.Tracker
+	// Limits applied to every conversion input.
+	maxInput   int64
+	maxRows    int
+	maxColumns int
+	pool       *pool.Pool
+	// workers convert the rows of one input.
+	workers int
+	// Rows held for sorting spill to spillDir past spillThreshold bytes.
+	spillThreshold int64
+	spillDir       string
+	// objectCache keeps downloaded objects, which are read memory-mapped.
+	objectCache string
+	// JSON output defaults for requests that leave them unset.
+	compactJSON bool
+	jsonIndent  int
+	// sensorRanges are the value ranges requests select by sensor type.
+	sensorRanges map[string][]csvconverter.RangeRule
+	// sinkFailures counts the consecutive failed writes to each output sink.
+	sinkMu       sync.Mutex
+	sinkFailures map[string]int
+}
+
+// options converts request options, applies the server's input limits,
+// worker count, spill settings and sensor ranges, and fills in its JSON
+// output defaults.
+func (s *server) options(o *pb.ConvertOptions) csvconverter.Options {
+	opts := converterOptions(o)
+	opts.MaxInputBytes = s.maxInput
+	opts.MaxRows = s.maxRows
+	opts.MaxColumns = s.maxColumns
+	opts.Workers = s.workers
+	opts.SpillThreshold = s.spillThreshold
+	opts.SpillDir = s.spillDir
+	opts.SensorRanges = s.sensorRanges
+	if o == nil || o.CompactJson == nil {
+		opts.CompactJSON = s.compactJSON
+	}
+	if opts.JSONIndent == 0 {
+		opts.JSONIndent = s.jsonIndent
+	}
+	return opts
+}
+
+func converterOptions(o *pb.ConvertOptions) csvconverter.Options {
+	return csvconverter.Options{
+		DisableTypeInference: o.GetDisableTypeInference(),
+		StringColumns:        o.GetStringColumns(),
+		ColumnTypes:          o.GetColumnTypes(),
+		StrictNumbers:        o.GetStrictNumbers(),
+		NonFiniteAs:          o.GetNonFiniteAs(),
+		DetectTimestamps:     o.GetDetectTimestamps(),
+		NormalizeTimestamps:  o.GetNormalizeTimestamps(),
+		SourceTimezone:       o.GetSourceTimezone(),
+		TargetTimezone:       o.GetTargetTimezone(),
+		YearPivot:            int(o.GetYearPivot()),
+		InferBooleans:        o.GetInferBooleans(),
+		NullValues:           o.GetNullValues(),
+		NullOutput:           o.GetNullOutput(),
+		NoHeader:             o.GetNoHeader(),
+		Headers:              o.GetHeaders(),
+		OmitHeader:           o.GetOmitHeader(),
+		Template:             o.GetTemplate(),
+		TemplateHeader:       o.GetTemplateHeader(),
+		TemplateFooter:       o.GetTemplateFooter(),
+		InfluxMeasurement:    o.GetInfluxMeasurement(),
+		InfluxTags:           o.GetInfluxTags(),
+		InfluxFields:         o.GetInfluxFields(),
+		InfluxTimeColumn:     o.GetInfluxTimeColumn(),
+		InfluxPrecision:      o.GetInfluxPrecision(),
+		DuplicateHeaders:     o.GetDuplicateHeaders(),
+		JaggedRows:           o.GetJaggedRows(),
+		InputEncoding:        o.GetInputEncoding(),
+		OutputEncoding:       o.GetOutputEncoding(),
+		SkipLines:            int(o.GetSkipLines()),
+		CommentPrefix:        o.GetCommentPrefix(),
+		CaptureMetadata:      o.GetCaptureMetadata(),
+		Columns:              o.GetColumns(),
+		Rename:               o.GetRename(),
+		ComputedColumns:      o.GetComputedColumns(),
+		Filter:               o.GetFilter(),
+		Units:                o.GetUnits(),
+		Ranges:               rangeRules(o.GetRanges()),
+		OutOfRange:           o.GetOutOfRange(),
+		SensorType:           o.GetSensorType(),
+		SortBy:               o.GetSortBy(),
+		Deduplicate:          o.GetDeduplicate(),
+		DedupKeys:            o.GetDedupKeys(),
+		Reshape:              o.GetReshape(),
+		ReshapeIDColumns:     o.GetReshapeIdColumns(),
+		ReshapeNameColumn:    o.GetReshapeNameColumn(),
+		ReshapeValueColumn:   o.GetReshapeValueColumn(),
+		UnpivotColumns:       o.GetUnpivotColumns(),
+		Schema:               o.GetSchema(),
+		SkipInvalidRows:      o.GetSkipInvalidRows(),
+		Mode:                 o.GetMode(),
+		CompactJSON:          o.GetCompactJson(),
+		JSONIndent:           int(o.GetJsonIndent()),
+	}
+}
+
+func rangeRules(rules []*pb.RangeRule) []csvconverter.RangeRule {
+	var out []csvconverter.RangeRule
+	for _, r := range rules {
+		out = append(out, csvconverter.RangeRule{Column: r.Column, Min: r.Min, Max: r.Max})
+	}
+	return out
 }
 
 func (s *server) Parse(ctx context.Context, req *pb.ParseRequest) (*pb.ParseResponse, error) {
-	log.Printf("Parse request: from: %s, to: %s", req.From, req.To)
+	slog.InfoContext(ctx, "Parse request", "from", req.From, "to", req.To)
+	if req.IdempotencyKey == "" {
+		resp, err := s.parseLimited(ctx, req)
+		return resp, convertError(err)
+	}
 
-	var result string
-	var err error
+	// A retried request with the same key gets the original response. Keys
+	// belong to the client, whose rows the first call evaluated against the
+	// alert rules.
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	key := clientIdentity(ctx) + "\x00" + req.IdempotencyKey
+	data, replayed, err := s.idempotent.Do(ctx, key, cache.Key(payload), func() ([]byte, error) {
+		resp, err := s.parseLimited(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(resp)
+	})
+	if errors.Is(err, idempotency.ErrKeyReused) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if replayed {
+		slog.InfoContext(ctx, "replaying result for idempotency key", "idempotency_key", req.IdempotencyKey)
+	}
+	resp := &pb.ParseResponse{}
+	if err := proto.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
 
+// parseLimited parses a request once the pool has a free worker. Jobs call
+// parse directly, as they have workers of their own.
+func (s *server) parseLimited(ctx context.Context, req *pb.ParseRequest) (*pb.ParseResponse, error) {
+	release, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return s.parse(ctx, req)
+}
+
+// parse converts the request's data, raw data, the document at its URL or
+// the file at its path. With an output URL the result is stored there
+// instead of being returned.
+func (s *server) parse(ctx context.Context, req *pb.ParseRequest) (*pb.ParseResponse, error) {
+	if req.Url != "" && req.Path != "" {
+		return nil, badRequest("url and path are mutually exclusive", "url", "path")
+	}
+	raw := req.RawData
+	release := func() {}
+	var err error
 	switch {
-	case strings.ToLower(req.From) == "csv" && strings.ToLower(req.To) == "json":
-		result, err = csvconverter.ConvertCSVToJSON(req.Data)
-		log.Printf("Converted CSV to JSON: %s", result)
-	case strings.ToLower(req.From) == "json" && strings.ToLower(req.To) == "csv":
-		result, err = csvconverter.ConvertJSONToCSV(req.Data)
-	default:
-		return nil, fmt.Errorf("unsupported conversion: from %s to %s", req.From, req.To)
+	case req.Url != "":
+		raw, release, err = s.download(ctx, req.Url)
+	case req.Path != "":
+		raw, release, err = s.readLocal(req.Path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// Cached results are not used while alert rules are active, as the
+	// rows must be evaluated against them.
+	var key string
+	if s.cache != nil {
+		if key, err = resultKey(req, raw, fmt.Sprintf("compact_json=%t json_indent=%d", s.compactJSON, s.jsonIndent)); err != nil {
+			return nil, err
+		}
+		if !s.alertsActive() {
+			if resp := s.cachedResult(ctx, key); resp != nil {
+				return s.finishParse(ctx, req, raw, resp)
+			}
+		}
 	}
 
+	var result *csvconverter.Result
+	if len(raw) > 0 || req.Url != "" || req.Path != "" {
+		result, err = csvconverter.ConvertBytesContext(ctx, req.From, req.To, raw, s.alertOptions(ctx, s.options(req.Options)))
+	} else {
+		result, err = csvconverter.ConvertContext(ctx, req.From, req.To, req.Data, s.alertOptions(ctx, s.options(req.Options)))
+	}
 	if err != nil {
 		return nil, err
 	}
+	resp := parseResponse(result)
+	if s.cache != nil {
+		s.cacheResult(ctx, key, resp)
+	}
+	return s.finishParse(ctx, req, raw, resp)
+}
 
-	return &pb.ParseResponse{
-		Result: result,
-	}, nil
+// finishParse reports the sizes converted to the call and uploads the
+// result when the request names an output URL.
+func (s *server) finishParse(ctx context.Context, req *pb.ParseRequest, raw []byte, resp *pb.ParseResponse) (*pb.ParseResponse, error) {
+	reportConverted(ctx, int64(len(raw)+len(req.Data)), int64(len(resp.RawResult)+len(resp.Result)))
+	if req.OutputUrl != "" {
+		if err := s.upload(ctx, req, resp); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// ParseBatch converts many small payloads in one call. Each item succeeds
+// or fails on its own; items line up with the requests.
+func (s *server) ParseBatch(ctx context.Context, req *pb.ParseBatchRequest) (*pb.ParseBatchResponse, error) {
+	slog.InfoContext(ctx, "ParseBatch request", "items", len(req.Requests))
+
+	resp := &pb.ParseBatchResponse{Items: make([]*pb.ParseBatchItem, len(req.Requests))}
+	for i, r := range req.Requests {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result, err := s.parseLimited(ctx, r)
+		if err != nil {
+			resp.Items[i] = &pb.ParseBatchItem{Error: err.Error()}
+			resp.Failed++
+			continue
+		}
+		resp.Items[i] = &pb.ParseBatchItem{Response: result}
+	}
+	return resp, nil
+}
+
+func (s *server) Merge(ctx context.Context, req *pb.MergeRequest) (*pb.ParseResponse, error) {
+	slog.InfoContext(ctx, "Merge request", "inputs", len(req.Inputs), "to", req.To, "mode", req.Mode)
+
+	inputs := make([]csvconverter.Input, len(req.Inputs))
+	for i, in := range req.Inputs {
+		inputs[i] = csvconverter.Input{Name: in.Name, Format: in.Format, Data: in.Data}
+	}
+	mopts := csvconverter.MergeOptions{
+		Mode:         req.Mode,
+		Keys:         req.Keys,
+		Join:         req.Join,
+		SourceColumn: req.SourceColumn,
+		TimeColumn:   req.TimeColumn,
+		Tolerance:    req.Tolerance,
+		Method:       req.Method,
+	}
+	release, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	defer release()
+	result, err := csvconverter.MergeContext(ctx, inputs, req.To, mopts, s.alertOptions(ctx, s.options(req.Options)))
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return parseResponse(result), nil
+}
+
+func (s *server) Pipeline(ctx context.Context, req *pb.PipelineRequest) (*pb.ParseResponse, error) {
+	slog.InfoContext(ctx, "Pipeline request", "from", req.From, "to", req.To, "steps", len(req.Steps))
+
+	opts := s.options(req.Options)
+	data := req.Data
+	if len(req.RawData) > 0 {
+		var err error
+		if data, err = csvconverter.Decode(req.RawData, opts.InputEncoding); err != nil {
+			return nil, convertError(err)
+		}
+	}
+	steps := make([]csvconverter.Step, len(req.Steps))
+	for i, st := range req.Steps {
+		steps[i] = csvconverter.Step{
+			Type:       st.Type,
+			Filter:     st.Filter,
+			Rename:     st.Rename,
+			Column:     st.Column,
+			FromUnit:   st.FromUnit,
+			ToUnit:     st.ToUnit,
+			Expression: st.Expression,
+		}
+		if st.QualityControl != nil {
+			steps[i].QualityControl = qualityControl(st.QualityControl)
+		}
+		if st.Anomalies != nil {
+			steps[i].Anomalies = anomalyDetection(st.Anomalies)
+		}
+		if ip := st.Interpolation; ip != nil {
+			steps[i].Interpolation = &csvconverter.Interpolation{
+				TimeColumn:    ip.TimeColumn,
+				StationColumn: ip.StationColumn,
+				Columns:       ip.Columns,
+				Method:        ip.Method,
+				Interval:      ip.Interval,
+				MaxGap:        ip.MaxGap,
+			}
+		}
+		if st.Aggregation != nil {
+			steps[i].Aggregation = aggregation(st.Aggregation)
+		}
+		if sm := st.Smoothing; sm != nil {
+			steps[i].Smoothing = &csvconverter.Smoothing{
+				Columns:       sm.Columns,
+				Method:        sm.Method,
+				Window:        int(sm.Window),
+				Trailing:      sm.Trailing,
+				StationColumn: sm.StationColumn,
+				Suffix:        sm.Suffix,
+			}
+		}
+		if b := st.DepthBinning; b != nil {
+			steps[i].DepthBinning = &csvconverter.DepthBinning{
+				DepthColumn:  b.DepthColumn,
+				BinSize:      b.BinSize,
+				Columns:      b.Columns,
+				GroupColumns: b.GroupColumns,
+			}
+		}
+		if c := st.Coordinates; c != nil {
+			steps[i].Coordinates = &csvconverter.Coordinates{
+				LatitudeColumn:  c.LatitudeColumn,
+				LongitudeColumn: c.LongitudeColumn,
+				From:            c.From,
+				To:              c.To,
+			}
+		}
+		if g := st.Geofence; g != nil {
+			steps[i].Geofence = &csvconverter.Geofence{
+				LatitudeColumn:  g.LatitudeColumn,
+				LongitudeColumn: g.LongitudeColumn,
+				Polygon:         g.Geojson,
+				Exclude:         g.Exclude,
+			}
+			if b := g.BoundingBox; b != nil {
+				steps[i].Geofence.BoundingBox = &coords.BoundingBox{
+					MinLatitude:  b.MinLatitude,
+					MinLongitude: b.MinLongitude,
+					MaxLatitude:  b.MaxLatitude,
+					MaxLongitude: b.MaxLongitude,
+				}
+			}
+		}
+		if t := st.Track; t != nil {
+			steps[i].Track = &csvconverter.Track{
+				LatitudeColumn:  t.LatitudeColumn,
+				LongitudeColumn: t.LongitudeColumn,
+				TimeColumn:      t.TimeColumn,
+				StationColumn:   t.StationColumn,
+				DistanceColumn:  t.DistanceColumn,
+				StepColumn:      t.StepColumn,
+				SpeedColumn:     t.SpeedColumn,
+			}
+			if r := t.Reference; r != nil {
+				steps[i].Track.Reference = &coords.Point{Latitude: r.Latitude, Longitude: r.Longitude}
+			}
+		}
+		if e := st.Enrichment; e != nil {
+			steps[i].Enrichment = &csvconverter.Enrichment{
+				StationColumn:  e.StationColumn,
+				TimeColumn:     e.TimeColumn,
+				Fields:         e.Fields,
+				Prefix:         e.Prefix,
+				RequireStation: e.RequireStation,
+				Lookup:         s.stationInfo,
+			}
+		}
+		if c := st.Calibration; c != nil {
+			steps[i].Calibration = &csvconverter.Calibration{
+				StationColumn: c.StationColumn,
+				TimeColumn:    c.TimeColumn,
+				Columns:       c.Columns,
+				Suffix:        c.Suffix,
+				Lookup:        s.sensorCalibration,
+			}
+			if len(c.Coefficients) > 0 {
+				steps[i].Calibration.Coefficients = make(map[string][]float64, len(c.Coefficients))
+				for column, p := range c.Coefficients {
+					steps[i].Calibration.Coefficients[column] = p.GetCoefficients()
+				}
+			}
+		}
+		if d := st.Drift; d != nil {
+			steps[i].Drift = &csvconverter.DriftCorrection{
+				Columns:    d.Columns,
+				TimeColumn: d.TimeColumn,
+				Suffix:     d.Suffix,
+			}
+			for _, p := range d.Points {
+				t, err := requestTime(p.Time, "time")
+				if err != nil {
+					return nil, err
+				}
+				if t.IsZero() {
+					return nil, badRequest("drift point time is required", "time")
+				}
+				steps[i].Drift.Points = append(steps[i].Drift.Points, csvconverter.DriftPoint{Time: t, Offset: p.Offset})
+			}
+		}
+	}
+	release, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	defer release()
+	result, err := csvconverter.PipelineContext(ctx, req.From, req.To, data, steps, s.alertOptions(ctx, opts))
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return parseResponse(result), nil
+}
+
+func (s *server) QualityControl(ctx context.Context, req *pb.QualityControlRequest) (*pb.ParseResponse, error) {
+	slog.InfoContext(ctx, "QualityControl request", "from", req.From, "to", req.To, "tests", len(req.GetConfig().GetTests()))
+
+	opts := s.options(req.Options)
+	data := req.Data
+	if len(req.RawData) > 0 {
+		var err error
+		if data, err = csvconverter.Decode(req.RawData, opts.InputEncoding); err != nil {
+			return nil, convertError(err)
+		}
+	}
+	release, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	defer release()
+	result, err := csvconverter.QualityControlContext(ctx, req.From, req.To, data, *qualityControl(req.GetConfig()), opts)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return parseResponse(result), nil
+}
+
+// qualityControl converts a quality control configuration.
+func qualityControl(c *pb.QualityControlConfig) *csvconverter.QualityControl {
+	qc := &csvconverter.QualityControl{
+		StationColumn: c.GetStationColumn(),
+		TimeColumn:    c.GetTimeColumn(),
+		FlagSuffix:    c.GetFlagSuffix(),
+	}
+	for _, t := range c.GetTests() {
+		test := csvconverter.QCTest{Station: t.Station, Column: t.Column, RateOfChange: t.RateOfChange}
+		if g := t.GrossRange; g != nil {
+			test.GrossRange = &csvconverter.GrossRange{FailMin: g.FailMin, FailMax: g.FailMax, SuspectMin: g.SuspectMin, SuspectMax: g.SuspectMax}
+		}
+		if sp := t.Spike; sp != nil {
+			test.Spike = &csvconverter.SpikeTest{Suspect: sp.Suspect, Fail: sp.Fail}
+		}
+		if f := t.FlatLine; f != nil {
+			test.FlatLine = &csvconverter.FlatLineTest{Suspect: int(f.Suspect), Fail: int(f.Fail), Tolerance: f.Tolerance}
+		}
+		qc.Tests = append(qc.Tests, test)
+	}
+	for _, l := range c.GetLocations() {
+		qc.Locations = append(qc.Locations, csvconverter.LocationTest{
+			Station:         l.Station,
+			LatitudeColumn:  l.LatitudeColumn,
+			LongitudeColumn: l.LongitudeColumn,
+			MinLatitude:     l.MinLatitude,
+			MaxLatitude:     l.MaxLatitude,
+			MinLongitude:    l.MinLongitude,
+			MaxLongitude:    l.MaxLongitude,
+		})
+	}
+	return qc
+}
+
+func (s *server) Aggregate(ctx context.Context, req *pb.AggregateRequest) (*pb.ParseResponse, error) {
+	slog.InfoContext(ctx, "Aggregate request", "from", req.From, "to", req.To, "window", req.GetConfig().GetWindow())
+
+	opts := s.options(req.Options)
+	data := req.Data
+	if len(req.RawData) > 0 {
+		var err error
+		if data, err = csvconverter.Decode(req.RawData, opts.InputEncoding); err != nil {
+			return nil, convertError(err)
+		}
+	}
+	release, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	defer release()
+	result, err := csvconverter.AggregateContext(ctx, req.From, req.To, data, *aggregation(req.GetConfig()), opts)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return parseResponse(result), nil
+}
+
+// aggregation converts an aggregation configuration.
+func aggregation(c *pb.AggregationConfig) *csvconverter.Aggregation {
+	return &csvconverter.Aggregation{
+		TimeColumn:    c.GetTimeColumn(),
+		StationColumn: c.GetStationColumn(),
+		Window:        c.GetWindow(),
+		Columns:       c.GetColumns(),
+		Functions:     c.GetFunctions(),
+	}
+}
+
+// DetectAnomalies flags spikes in numeric columns. Without a target
+// format only the anomalies are returned, not the annotated data.
+func (s *server) DetectAnomalies(ctx context.Context, req *pb.DetectAnomaliesRequest) (*pb.ParseResponse, error) {
+	slog.InfoContext(ctx, "DetectAnomalies request", "from", req.From, "to", req.To, "columns", len(req.GetConfig().GetColumns()))
+
+	opts := s.options(req.Options)
+	data := req.Data
+	if len(req.RawData) > 0 {
+		var err error
+		if data, err = csvconverter.Decode(req.RawData, opts.InputEncoding); err != nil {
+			return nil, convertError(err)
+		}
+	}
+	to := req.To
+	if to == "" {
+		to = "json"
+	}
+	release, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	defer release()
+	result, err := csvconverter.DetectAnomaliesContext(ctx, req.From, to, data, *anomalyDetection(req.GetConfig()), opts)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	resp := parseResponse(result)
+	if req.To == "" {
+		resp.Result, resp.RawResult = "", nil
+	}
+	return resp, nil
+}
+
+// anomalyDetection converts an anomaly detection configuration.
+func anomalyDetection(c *pb.AnomalyDetectionConfig) *csvconverter.AnomalyDetection {
+	return &csvconverter.AnomalyDetection{
+		Columns:       c.GetColumns(),
+		Method:        c.GetMethod(),
+		Window:        int(c.GetWindow()),
+		Threshold:     c.GetThreshold(),
+		StationColumn: c.GetStationColumn(),
+		FlagSuffix:    c.GetFlagSuffix(),
+	}
+}
+
+func (s *server) Split(ctx context.Context, req *pb.SplitRequest) (*pb.SplitResponse, error) {
+	slog.InfoContext(ctx, "Split request", "from", req.From, "to", req.To, "by", req.By)
+
+	opts := s.options(req.Options)
+	data := req.Data
+	if len(req.RawData) > 0 {
+		var err error
+		if data, err = csvconverter.Decode(req.RawData, opts.InputEncoding); err != nil {
+			return nil, convertError(err)
+		}
+	}
+	sopts := csvconverter.SplitOptions{
+		By:     req.By,
+		Rows:   int(req.Rows),
+		Column: req.Column,
+		Window: req.Window,
+	}
+	release, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	defer release()
+	result, err := csvconverter.SplitContext(ctx, req.From, req.To, data, sopts, opts)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	resp := &pb.SplitResponse{
+		Warnings:          result.Warnings,
+		Preamble:          result.Preamble,
+		Metadata:          result.Metadata,
+		DuplicatesRemoved: int64(result.DuplicatesRemoved),
+		RowErrors:         rowErrors(result.RowErrors),
+	}
+	if req.Archive {
+		if resp.Archive, err = result.Archive(req.To); err != nil {
+			return nil, convertError(err)
+		}
+		return resp, nil
+	}
+	for _, part := range result.Parts {
+		p := &pb.Part{Name: part.Name, Rows: int64(part.Rows)}
+		if part.Encoded != nil {
+			p.RawResult = part.Encoded
+		} else {
+			p.Result = part.Output
+		}
+		resp.Parts = append(resp.Parts, p)
+	}
+	return resp, nil
+}
+
+func (s *server) InferSchema(ctx context.Context, req *pb.InferSchemaRequest) (*pb.InferSchemaResponse, error) {
+	slog.InfoContext(ctx, "InferSchema request", "format", req.Format)
+
+	opts := s.options(req.Options)
+	data := req.Data
+	if len(req.RawData) > 0 {
+		var err error
+		if data, err = csvconverter.Decode(req.RawData, opts.InputEncoding); err != nil {
+			return nil, convertError(err)
+		}
+	}
+	release, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	defer release()
+	schema, err := csvconverter.InferSchema(req.Format, data, int(req.SampleSize), opts)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	resp := &pb.InferSchemaResponse{Rows: int64(schema.Rows), Warnings: schema.Warnings}
+	for _, c := range schema.Columns {
+		resp.Columns = append(resp.Columns, &pb.ColumnSchema{
+			Name:      c.Name,
+			Type:      c.Type,
+			Nullable:  c.Nullable,
+			NullCount: int64(c.NullCount),
+			Min:       c.Min,
+			Max:       c.Max,
+			Samples:   c.Samples,
+		})
+	}
+	return resp, nil
+}
+
+func (s *server) DescribeData(ctx context.Context, req *pb.DescribeDataRequest) (*pb.DescribeDataResponse, error) {
+	slog.InfoContext(ctx, "DescribeData request", "format", req.Format)
+
+	opts := s.options(req.Options)
+	data := req.Data
+	if len(req.RawData) > 0 {
+		var err error
+		if data, err = csvconverter.Decode(req.RawData, opts.InputEncoding); err != nil {
+			return nil, convertError(err)
+		}
+	}
+	release, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	defer release()
+	d, err := csvconverter.Describe(req.Format, data, req.Percentiles, opts)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	resp := &pb.DescribeDataResponse{Rows: int64(d.Rows), Warnings: d.Warnings}
+	for _, c := range d.Columns {
+		summary := &pb.ColumnSummary{
+			Name:      c.Name,
+			Count:     int64(c.Count),
+			NullCount: int64(c.NullCount),
+			Numeric:   int64(c.Numeric),
+			Min:       c.Min,
+			Max:       c.Max,
+			Mean:      c.Mean,
+			Stddev:    c.StdDev,
+		}
+		for _, p := range c.Percentiles {
+			summary.Percentiles = append(summary.Percentiles, &pb.Percentile{P: p.P, Value: p.Value})
+		}
+		resp.Columns = append(resp.Columns, summary)
+	}
+	return resp, nil
+}
+
+func (s *server) Validate(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+	slog.InfoContext(ctx, "Validate request", "format", req.Format)
+
+	opts := s.options(req.Options)
+	data := req.Data
+	if len(req.RawData) > 0 {
+		var err error
+		if data, err = csvconverter.Decode(req.RawData, opts.InputEncoding); err != nil {
+			return nil, convertError(err)
+		}
+	}
+	rules := csvconverter.ValidationRules{
+		JSONSchema:    req.JsonSchema,
+		MaxViolations: int(req.MaxViolations),
+	}
+	for _, c := range req.Columns {
+		rules.Columns = append(rules.Columns, csvconverter.ColumnSchema{
+			Name:     c.Name,
+			Type:     c.Type,
+			Nullable: c.Nullable,
+			Min:      c.Min,
+			Max:      c.Max,
+		})
+	}
+	release, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	defer release()
+	report, err := csvconverter.Validate(req.Format, data, rules, opts)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	resp := &pb.ValidateResponse{
+		Valid:    len(report.Violations) == 0,
+		Rows:     int64(report.Rows),
+		Warnings: report.Warnings,
+	}
+	for _, v := range report.Violations {
+		resp.Violations = append(resp.Violations, &pb.Violation{Row: int64(v.Row), Column: v.Column, Reason: v.Reason})
+	}
+	return resp, nil
+}
+
+func (s *server) DetectGaps(ctx context.Context, req *pb.DetectGapsRequest) (*pb.DetectGapsResponse, error) {
+	slog.InfoContext(ctx, "DetectGaps request", "format", req.Format, "interval", req.Interval)
+
+	opts := s.options(req.Options)
+	data := req.Data
+	if len(req.RawData) > 0 {
+		var err error
+		if data, err = csvconverter.Decode(req.RawData, opts.InputEncoding); err != nil {
+			return nil, convertError(err)
+		}
+	}
+	gopts := csvconverter.GapOptions{
+		TimeColumn:    req.TimeColumn,
+		StationColumn: req.StationColumn,
+		Interval:      req.Interval,
+		Tolerance:     req.Tolerance,
+		Start:         req.Start,
+		End:           req.End,
+	}
+	release, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	defer release()
+	report, err := csvconverter.DetectGaps(req.Format, data, gopts, opts)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	resp := &pb.DetectGapsResponse{Rows: int64(report.Rows), Warnings: report.Warnings}
+	for _, g := range report.Gaps {
+		resp.Gaps = append(resp.Gaps, &pb.Gap{
+			Station:         g.Station,
+			Start:           formatTime(g.Start),
+			End:             formatTime(g.End),
+			DurationSeconds: g.Duration.Seconds(),
+			Missing:         int64(g.Missing),
+		})
+	}
+	for _, st := range report.Series {
+		resp.Series = append(resp.Series, &pb.GapSeries{
+			Station: st.Station,
+			First:   formatTime(st.First),
+			Last:    formatTime(st.Last),
+			Samples: int64(st.Samples),
+			Missing: int64(st.Missing),
+		})
+	}
+	return resp, nil
+}
+
+func (s *server) Correlate(ctx context.Context, req *pb.CorrelateRequest) (*pb.CorrelateResponse, error) {
+	slog.InfoContext(ctx, "Correlate request", "format", req.Format, "columns", req.Columns, "max_lag", req.MaxLag)
+
+	opts := s.options(req.Options)
+	data := req.Data
+	if len(req.RawData) > 0 {
+		var err error
+		if data, err = csvconverter.Decode(req.RawData, opts.InputEncoding); err != nil {
+			return nil, convertError(err)
+		}
+	}
+	c := csvconverter.CorrelationOptions{
+		Columns:       req.Columns,
+		StationColumn: req.StationColumn,
+		MaxLag:        int(req.MaxLag),
+	}
+	release, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	defer release()
+	report, err := csvconverter.Correlate(req.Format, data, c, opts)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	resp := &pb.CorrelateResponse{Rows: int64(report.Rows), Columns: report.Columns, Warnings: report.Warnings}
+	for _, values := range report.Matrix {
+		resp.Matrix = append(resp.Matrix, &pb.CorrelationRow{Values: values})
+	}
+	for _, l := range report.Lagged {
+		resp.Lagged = append(resp.Lagged, &pb.Correlation{X: l.X, Y: l.Y, Lag: int32(l.Lag), R: l.R, N: int64(l.N)})
+	}
+	return resp, nil
+}
+
+func parseResponse(result *csvconverter.Result) *pb.ParseResponse {
+	resp := &pb.ParseResponse{
+		Warnings:          result.Warnings,
+		Preamble:          result.Preamble,
+		Metadata:          result.Metadata,
+		DuplicatesRemoved: int64(result.DuplicatesRemoved),
+		RowErrors:         rowErrors(result.RowErrors),
+		Anomalies:         anomalies(result.Anomalies),
+		Stats: &pb.ConversionStats{
+			RowsRead:    int64(result.Stats.RowsRead),
+			RowsSkipped: int64(result.Stats.RowsSkipped),
+			RowsWritten: int64(result.Stats.RowsWritten),
+			Columns:     result.Stats.Columns,
+			ColumnTypes: result.Stats.ColumnTypes,
+			DurationMs:  float64(result.Stats.Duration) / float64(time.Millisecond),
+		},
+	}
+	if result.Encoded != nil {
+		resp.RawResult = result.Encoded
+	} else {
+		resp.Result = result.Output
+	}
+	return resp
+}
+
+func anomalies(found []csvconverter.Anomaly) []*pb.Anomaly {
+	var out []*pb.Anomaly
+	for _, a := range found {
+		out = append(out, &pb.Anomaly{Index: int64(a.Index), Row: int64(a.Row), Station: a.Station, Column: a.Column, Value: a.Value, Score: a.Score})
+	}
+	return out
+}
+
+func rowErrors(errs []csvconverter.RowError) []*pb.RowError {
+	var out []*pb.RowError
+	for _, e := range errs {
+		out = append(out, &pb.RowError{Row: int64(e.Row), Column: e.Column, Reason: e.Reason})
+	}
+	return out
+}
+
+func (s *server) GetCompatibilityMatrix(ctx context.Context, req *pb.CompatibilityMatrixRequest) (*pb.CompatibilityMatrixResponse, error) {
+	resp := &pb.CompatibilityMatrixResponse{}
+	for _, c := range csvconverter.CompatibilityMatrix() {
+		resp.Entries = append(resp.Entries, &pb.CompatibilityEntry{
+			From:   c.From,
+			To:     c.To,
+			Level:  c.Level,
+			Reason: c.Reason,
+		})
+	}
+	return resp, nil
+}
+
+// clientIdentity names the caller for per-client limits: the authenticated
+// principal, else the client certificate, else the peer address. Calls from
+// the HTTP facades name the HTTP client's address, and jobs the client that
+// submitted them.
+func clientIdentity(ctx context.Context) string {
+	if owner, ok := ctx.Value(jobOwnerKey{}).(string); ok {
+		return owner
+	}
+	if p, ok := auth.FromContext(ctx); ok {
+		return p.Subject
+	}
+	if id, ok := mtls.FromContext(ctx); ok {
+		return id.CommonName
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if _, internal := p.Addr.(internalAddr); internal {
+			md, _ := metadata.FromIncomingContext(ctx)
+			if v := md.Get(clientAddressKey); len(v) > 0 {
+				return v[0]
+			}
+			return ""
+		}
+		return remoteHost(p.Addr.String())
+	}
+	return ""
+}
+
+// listenUnix listens on a unix socket, replacing a socket left behind by a
+// previous run.
+func listenUnix(path string, mode os.FileMode) (net.Listener, error) {
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("error removing stale socket: %v", err)
+		}
+	}
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		lis.Close()
+		return nil, fmt.Errorf("error setting socket permissions: %v", err)
+	}
+	return lis, nil
 }
 
 func main() {
-	lis, err := net.Listen("tcp", ":50051")
+	cfg, flags, err := config.Load(os.Args[0], os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatalf("invalid configuration: %v", err)
+	}
+	if flags.PrintConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			log.Fatalf("failed to print configuration: %v", err)
+		}
+		return
+	}
+	if err := logging.Setup(os.Stderr, cfg.Log.Format, cfg.Log.Level); err != nil {
+		log.Fatalf("failed to configure logging: %v", err)
+	}
+
+	if err := compression.Register(cfg.GRPC.GzipLevel, cfg.GRPC.Zstd); err != nil {
+		log.Fatalf("failed to configure compression: %v", err)
+	}
+
+	var listeners []net.Listener
+	if cfg.Listen != "" {
+		lis, err := net.Listen("tcp", cfg.Listen)
+		if err != nil {
+			log.Fatalf("failed to listen: %v", err)
+		}
+		listeners = append(listeners, lis)
+	}
+	if cfg.UnixSocket != "" {
+		mode, _ := cfg.SocketMode()
+		lis, err := listenUnix(cfg.UnixSocket, mode)
+		if err != nil {
+			log.Fatalf("failed to listen: %v", err)
+		}
+		listeners = append(listeners, lis)
+	}
+
+	stations, err := registration.Open(cfg.Storage.RegistrationStore)
+	if err != nil {
+		log.Fatalf("failed to open registration store: %v", err)
+	}
+	platforms, err := registry.Open(cfg.Storage.StationRegistry)
+	if err != nil {
+		log.Fatalf("failed to open station registry: %v", err)
+	}
+	alerts, err := alerting.Open(cfg.Storage.AlertRules)
+	if err != nil {
+		log.Fatalf("failed to open alert rules: %v", err)
+	}
+
+	srv := &server{
+		stations:   stations,
+		registry:   platforms,
+		alerts:     alerts,
+		adminToken: cfg.Auth.AdminToken,
+		maxFetch:   cfg.Storage.Fetch.MaxBytes,
+		maxInput:   cfg.Limits.MaxInputBytes,
+		maxRows:    cfg.Limits.MaxRows,
+		maxColumns: cfg.Limits.MaxColumns,
+		workers:    cfg.Limits.ConversionWorkers,
+
+		spillThreshold: cfg.Spill.Threshold,
+		spillDir:       cfg.Spill.Dir,
+
+		compactJSON: cfg.Output.CompactJSON,
+		jsonIndent:  cfg.Output.JSONIndent,
+	}
+	if v := cfg.Limits.RegistrationRateLimit; v != "" {
+		limit, err := ratelimit.ParseLimit(v)
+		if err != nil {
+			log.Fatalf("invalid registration rate limit: %v", err)
+		}
+		srv.regLimiter = ratelimit.New(limit, nil, clientIdentity)
+	}
+	if srv.workers == 0 {
+		srv.workers = runtime.GOMAXPROCS(0)
+	}
+	if cfg.Limits.MaxConcurrent > 0 {
+		srv.pool = pool.New(cfg.Limits.MaxConcurrent, cfg.Limits.MaxQueued, time.Duration(cfg.Limits.QueueTimeout))
+		expvar.Publish("conversions", expvar.Func(srv.pool.Vars))
+	}
+	if hosts := cfg.Storage.Fetch.AllowedHosts; len(hosts) > 0 {
+		srv.fetcher = fetch.New(hosts, srv.maxFetch, time.Duration(cfg.Storage.Fetch.Timeout))
+	}
+	if dir := cfg.Storage.DataRoot; dir != "" {
+		if srv.dataRoot, err = os.OpenRoot(dir); err != nil {
+			log.Fatalf("failed to open data root: %v", err)
+		}
+		slog.Info("serving files", "data_root", dir)
+	}
+	if s3 := cfg.Storage.S3; s3.Endpoint != "" {
+		srv.objects, err = objectstore.New(objectstore.Config{
+			Endpoint:  s3.Endpoint,
+			Region:    s3.Region,
+			AccessKey: s3.AccessKey,
+			SecretKey: s3.SecretKey,
+			Insecure:  s3.Insecure,
+		})
+		if err != nil {
+			log.Fatalf("failed to configure object storage: %v", err)
+		}
+		if dir := s3.CacheDir; dir != "" {
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				log.Fatalf("failed to create object cache: %v", err)
+			}
+			srv.objectCache = dir
+		}
+	}
+	if db := cfg.Storage.Influx; db.URL != "" {
+		srv.influx, err = influx.New(influx.Config{URL: db.URL, Token: db.Token, Org: db.Org})
+		if err != nil {
+			log.Fatalf("failed to configure InfluxDB: %v", err)
+		}
+	}
+	if dsn := cfg.Storage.PostgresURL; dsn != "" {
+		if srv.postgres, err = postgres.Open(context.Background(), dsn); err != nil {
+			log.Fatalf("failed to configure PostgreSQL: %v", err)
+		}
+		defer srv.postgres.Close()
+	}
+	if path := cfg.Storage.HistoryDB; path != "" {
+		if srv.history, err = history.Open(path); err != nil {
+			log.Fatalf("failed to open history database: %v", err)
+		}
+		defer srv.history.Close()
+		if keep := time.Duration(cfg.Storage.HistoryRetention); keep > 0 {
+			go srv.pruneHistory(keep)
+		}
+		slog.Info("recording conversion history", "path", path)
+	}
+	if url := cfg.Cache.RedisURL; url != "" {
+		if srv.cache, err = cache.NewRedis(url, time.Duration(cfg.Cache.TTL)); err != nil {
+			log.Fatalf("failed to configure result cache: %v", err)
+		}
+	} else if cfg.Cache.MaxBytes > 0 {
+		srv.cache = cache.NewLRU(cfg.Cache.MaxBytes)
+	}
+	keyTTL := time.Duration(cfg.Jobs.IdempotencyTTL)
+	retention := time.Duration(cfg.Jobs.Retention)
+	srv.idempotent = idempotency.New(keyTTL)
+	if url := cfg.Jobs.RedisURL; url != "" {
+		if srv.jobs, err = jobs.OpenRedis(url, cfg.Jobs.Workers, keyTTL, retention, srv.runJob); err != nil {
+			log.Fatalf("failed to open job queue: %v", err)
+		}
+		slog.Info("sharing jobs in Redis")
+	} else {
+		var jobStore jobs.Store
+		switch {
+		case srv.history != nil:
+			jobStore = srv.history.Jobs()
+		case cfg.Jobs.Dir != "":
+			if jobStore, err = jobs.OpenDir(cfg.Jobs.Dir); err != nil {
+				log.Fatalf("failed to open job store: %v", err)
+			}
+		}
+		if srv.jobs, err = jobs.Open(jobStore, cfg.Jobs.Workers, keyTTL, retention, srv.runJob); err != nil {
+			log.Fatalf("failed to open job store: %v", err)
+		}
+	}
+	var diskWatch *hooks.DiskWatch
+	if path := cfg.HooksConfig; path != "" {
+		cfg, err := hooks.LoadConfig(path)
+		if err != nil {
+			log.Fatalf("failed to load hooks: %v", err)
+		}
+		srv.hooks = hooks.NewRunner(cfg.Hooks)
+		diskWatch = cfg.Disk
+		slog.Info("loaded runbook hooks", "hooks", len(cfg.Hooks), "path", path)
+	}
+	if path := cfg.PluginsConfig; path != "" {
+		cfg, err := plugins.LoadConfig(path)
+		if err != nil {
+			log.Fatalf("failed to load plugins: %v", err)
+		}
+		if err := cfg.Register(); err != nil {
+			log.Fatalf("failed to register plugins: %v", err)
+		}
+		slog.Info("loaded external converters", "converters", len(cfg.Converters), "path", path)
+	}
+	if path := cfg.RangesConfig; path != "" {
+		if srv.sensorRanges, err = ranges.LoadConfig(path); err != nil {
+			log.Fatalf("failed to load ranges: %v", err)
+		}
+		slog.Info("loaded sensor ranges", "sensor_types", len(srv.sensorRanges), "path", path)
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(cfg.GRPC.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(cfg.GRPC.MaxSendMsgSize),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:                  time.Duration(cfg.GRPC.KeepaliveTime),
+			Timeout:               time.Duration(cfg.GRPC.KeepaliveTimeout),
+			MaxConnectionIdle:     time.Duration(cfg.GRPC.MaxConnectionIdle),
+			MaxConnectionAge:      time.Duration(cfg.GRPC.MaxConnectionAge),
+			MaxConnectionAgeGrace: time.Duration(cfg.GRPC.MaxConnectionAgeGrace),
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             time.Duration(cfg.GRPC.KeepaliveMinTime),
+			PermitWithoutStream: cfg.GRPC.KeepalivePermitNoStream,
+		}),
+		grpc.ChainUnaryInterceptor(logging.UnaryInterceptor(), recovery.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(logging.StreamInterceptor(), recovery.StreamInterceptor()),
+	}
+	if tracing.Enabled() {
+		shutdown, err := tracing.Setup(context.Background())
+		if err != nil {
+			log.Fatalf("failed to configure tracing: %v", err)
+		}
+		defer func() {
+			if err := shutdown(context.Background()); err != nil {
+				slog.Error("error flushing traces", "error", err)
+			}
+		}()
+		opts = append(opts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
+		slog.Info("exporting traces over OTLP")
+	}
+	// History and audit go ahead of authentication, rate limits and quotas
+	// so that the calls they reject are recorded too.
+	if srv.history != nil {
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(srv.historyInterceptor),
+			grpc.ChainStreamInterceptor(srv.historyStreamInterceptor),
+		)
+	}
+	if sink := cfg.Audit.Sink; sink != "" {
+		var key []byte
+		if cfg.Audit.Key != "" {
+			key = []byte(cfg.Audit.Key)
+		}
+		if srv.audit, err = audit.Open(sink, key); err != nil {
+			log.Fatalf("failed to open audit log: %v", err)
+		}
+		defer srv.audit.Close()
+		expvar.Publish("audit", expvar.Func(srv.audit.Vars))
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(srv.auditInterceptor),
+			grpc.ChainStreamInterceptor(srv.auditStreamInterceptor),
+		)
+		slog.Info("writing audit log", "sink", sink)
+	}
+
+	// Transport credentials only apply to the public listeners, not to the
+	// gateway's in-memory connection.
+	var tlsOpts []grpc.ServerOption
+	if cfg.TLS.Cert != "" {
+		creds, err := mtls.ServerCredentials(mtls.Config{
+			CertFile:     cfg.TLS.Cert,
+			KeyFile:      cfg.TLS.Key,
+			ClientCAFile: cfg.TLS.ClientCA,
+		})
+		if err != nil {
+			log.Fatalf("failed to configure TLS: %v", err)
+		}
+		tlsOpts = append(tlsOpts, grpc.Creds(creds))
+		if cfg.TLS.ClientCA != "" {
+			opts = append(opts,
+				grpc.ChainUnaryInterceptor(mtls.UnaryInterceptor(srv.authorizeGateway)),
+				grpc.ChainStreamInterceptor(mtls.StreamInterceptor(srv.authorizeGateway)),
+			)
+			slog.Info("requiring client certificates", "client_ca", cfg.TLS.ClientCA)
+		}
+	}
+
+	if cfg.Auth.Required {
+		validators := []auth.Validator{auth.ValidatorFunc(srv.validateAPIKey)}
+		if jwt := cfg.Auth.JWT; jwt.Secret != "" || jwt.PublicKey != "" {
+			v, err := auth.NewJWT(auth.JWTConfig{
+				Secret:        jwt.Secret,
+				PublicKeyFile: jwt.PublicKey,
+				Issuer:        jwt.Issuer,
+				Audience:      jwt.Audience,
+			})
+			if err != nil {
+				log.Fatalf("failed to configure JWT validation: %v", err)
+			}
+			validators = append(validators, v)
+		}
+		public := make([]string, 0, len(openMethods))
+		for m := range openMethods {
+			public = append(public, m)
+		}
+		a := auth.New(validators, public...)
+		a.RequireScopes(methodScopes)
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(a.UnaryInterceptor()),
+			grpc.ChainStreamInterceptor(a.StreamInterceptor()),
+		)
+	}
+
+	if v := cfg.Limits.RateLimit; v != "" {
+		limit, err := ratelimit.ParseLimit(v)
+		if err != nil {
+			log.Fatalf("invalid rate limit: %v", err)
+		}
+		overrides, err := ratelimit.ParseOverrides(cfg.Limits.RateLimitClients)
+		if err != nil {
+			log.Fatalf("invalid rate limit clients: %v", err)
+		}
+		l := ratelimit.New(limit, overrides, clientIdentity)
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(l.UnaryInterceptor()),
+			grpc.ChainStreamInterceptor(l.StreamInterceptor()),
+		)
+	}
+
+	quota := usage.Quota{Bytes: cfg.Limits.QuotaBytes, Requests: cfg.Limits.QuotaRequests}
+	quotas, err := usage.ParseQuotas(cfg.Limits.QuotaClients)
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		log.Fatalf("invalid quota clients: %v", err)
+	}
+	if srv.usage, err = usage.Open(cfg.Storage.UsageStore, quota, quotas); err != nil {
+		log.Fatalf("failed to open usage store: %v", err)
 	}
+	go func() {
+		for range time.Tick(time.Minute) {
+			if err := srv.usage.Flush(); err != nil {
+				slog.Error("error saving usage", "error", err)
+			}
+		}
+	}()
+	expvar.Publish("usage", expvar.Func(srv.usage.Vars))
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(srv.usageInterceptor),
+		grpc.ChainStreamInterceptor(srv.usageStreamInterceptor),
+	)
 
-	s := grpc.NewServer()
-	pb.RegisterDataParserServer(s, &server{})
+	if addr := cfg.MetricsAddr; addr != "" {
+		go func() {
+			slog.Info("metrics listening", "address", addr, "path", "/debug/vars")
+			if err := http.ListenAndServe(addr, metricsHandler()); err != nil {
+				log.Fatalf("failed to serve metrics: %v", err)
+			}
+		}()
+	}
+
+	if addr := cfg.AdminAddr; addr != "" {
+		go func() {
+			slog.Info("admin listening", "address", addr, "paths", []string{"/debug/pprof/", "/debug/vars"})
+			if err := http.ListenAndServe(addr, adminHandler()); err != nil {
+				log.Fatalf("failed to serve admin endpoints: %v", err)
+			}
+		}()
+	}
 
-	log.Printf("server listening at %v", lis.Addr())
+	// The REST gateway and the Connect handler call the service through an
+	// in-memory gRPC connection.
+	var (
+		in        *internal
+		frontends []*http.Server
+	)
+	if cfg.GatewayAddr != "" || cfg.ConnectAddr != "" {
+		if in, err = newInternal(srv, opts, cfg.GRPC); err != nil {
+			log.Fatalf("failed to connect HTTP frontends: %v", err)
+		}
+	}
+	if addr := cfg.GatewayAddr; addr != "" {
+		h, err := gatewayHandler(in.conn, cfg.GRPC)
+		if err != nil {
+			log.Fatalf("failed to configure gateway: %v", err)
+		}
+		frontends = append(frontends, &http.Server{Addr: addr, Handler: h})
+		slog.Info("gateway listening", "address", addr)
+	}
+	if addr := cfg.ConnectAddr; addr != "" {
+		var protocols http.Protocols
+		protocols.SetHTTP1(true)
+		protocols.SetUnencryptedHTTP2(true)
+		frontends = append(frontends, &http.Server{Addr: addr, Handler: connectHandler(in.conn, cfg.GRPC), Protocols: &protocols})
+		slog.Info("connect listening", "address", addr)
+	}
+	var bridge *nats.Bridge
+	if n := cfg.NATS; n.URL != "" {
+		convert, err := srv.recordConverter(n.From, n.Options)
+		if err != nil {
+			log.Fatalf("failed to configure NATS conversion: %v", err)
+		}
+		bridge, err = nats.Connect(context.Background(), nats.Config{
+			URL:            n.URL,
+			CredsFile:      n.CredsFile,
+			QueueGroup:     n.QueueGroup,
+			Workers:        n.Workers,
+			RequestSubject: n.RequestSubject,
+			Subject:        n.Subject,
+			RecordsSubject: n.RecordsSubject,
+			SubjectColumn:  n.SubjectColumn,
+			Stream:         n.Stream,
+		}, srv.Parse, convert)
+		if err != nil {
+			log.Fatalf("failed to configure NATS: %v", err)
+		}
+		expvar.Publish("nats", expvar.Func(bridge.Vars))
+		slog.Info("serving NATS", "request_subject", n.RequestSubject, "subject", n.Subject, "records_subject", n.RecordsSubject)
+	}
 
-	if err := s.Serve(lis); err != nil {
+	for _, hs := range frontends {
+		go func() {
+			if err := hs.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalf("failed to serve %s: %v", hs.Addr, err)
+			}
+		}()
+	}
+
+	s := grpc.NewServer(append(opts, tlsOpts...)...)
+	pb.RegisterDataParserServer(s, srv)
+	pbv2.RegisterDataParserServer(s, v2Server{s: srv})
+	pb.RegisterStationRegistryServer(s, registryServer{s: srv})
+	pb.RegisterAlertingServer(s, alertingServer{s: srv})
+
+	hs := health.NewServer()
+	hs.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	hs.SetServingStatus(pb.DataParser_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	hs.SetServingStatus(pbv2.DataParser_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	hs.SetServingStatus(pb.StationRegistry_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	hs.SetServingStatus(pb.Alerting_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(s, hs)
+
+	grace := time.Duration(cfg.ShutdownGrace)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	go srv.watchHealth(ctx, hs, time.Duration(cfg.HealthInterval))
+	if diskWatch != nil {
+		go func() {
+			if err := srv.hooks.WatchDisk(ctx, *diskWatch); err != nil {
+				slog.Error("disk watch stopped", "error", err)
+			}
+		}()
+	}
+
+	var (
+		stage     *kafka.Stage
+		stageDone = make(chan struct{})
+	)
+	if k := cfg.Kafka; len(k.Brokers) > 0 {
+		convert, err := srv.recordConverter(k.From, k.Options)
+		if err != nil {
+			log.Fatalf("failed to configure Kafka conversion: %v", err)
+		}
+		stage, err = kafka.New(kafka.Config{
+			Brokers:         k.Brokers,
+			Topic:           k.Topic,
+			GroupID:         k.GroupID,
+			OutputTopic:     k.OutputTopic,
+			DeadLetterTopic: k.DeadLetterTopic,
+			Serialization:   k.Serialization,
+			DeadLettered: func(total int64, err error) {
+				srv.hooks.Fire(hooks.Event{
+					Condition: hooks.DeadLetterGrowth,
+					Message:   fmt.Sprintf("%d Kafka messages sent to %s", total, k.DeadLetterTopic),
+					Details:   map[string]string{"topic": k.DeadLetterTopic, "total": strconv.FormatInt(total, 10), "error": err.Error()},
+				})
+			},
+			WriteFailed: func(err error) { srv.sinkFailed("kafka", err) },
+		}, convert)
+		if err != nil {
+			log.Fatalf("failed to configure Kafka: %v", err)
+		}
+		expvar.Publish("kafka", expvar.Func(stage.Vars))
+		slog.Info("converting Kafka messages", "topic", k.Topic, "output_topic", k.OutputTopic, "serialization", k.Serialization)
+		go func() {
+			defer close(stageDone)
+			if err := stage.Run(ctx); err != nil {
+				slog.Error("Kafka stage stopped", "error", err)
+			}
+		}()
+	}
+
+	served := make(chan error, len(listeners))
+	for _, lis := range listeners {
+		slog.Info("server listening", "network", lis.Addr().Network(), "address", lis.Addr().String())
+		go func() { served <- s.Serve(lis) }()
+	}
+	select {
+	case err := <-served:
 		log.Fatalf("failed to serve: %v", err)
+	case <-ctx.Done():
+	}
+	stop()
+
+	// Fail health checks so load balancers move away, let in-flight calls
+	// finish, then save job state for the next start.
+	slog.Info("shutting down", "grace", grace.String())
+	hs.Shutdown()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), grace)
+	defer cancel()
+	for _, hs := range frontends {
+		if err := hs.Shutdown(shutdownCtx); err != nil {
+			slog.Warn("HTTP calls were interrupted", "address", hs.Addr, "error", err)
+		}
+	}
+	if bridge != nil {
+		if err := bridge.Close(shutdownCtx); err != nil {
+			slog.Warn("error draining NATS", "error", err)
+		}
+	}
+	if in != nil {
+		in.Close()
+	}
+	if stage != nil {
+		<-stageDone
+		if err := stage.Close(); err != nil {
+			slog.Warn("error closing Kafka stage", "error", err)
+		}
+	}
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-shutdownCtx.Done():
+		slog.Warn("grace period expired, cancelling in-flight calls")
+		s.Stop()
+	}
+	if err := srv.jobs.Shutdown(shutdownCtx); err != nil {
+		slog.Warn("running jobs were interrupted and will resume on restart")
+	}
+	if err := srv.usage.Flush(); err != nil {
+		slog.Error("error saving usage", "error", err)
 	}
+	slog.Info("server stopped")
 }
@@ -0,0 +1,59 @@
+// Package mmap maps files into memory read-only, so conversions read their
+// input straight from the operating system's page cache instead of copying
+// it onto the heap. Repeated conversions of the same file then cost no
+// disk reads and no heap for the input.
+//
+// A mapped file must not be truncated while mapped: reading past its new
+// end faults the process with SIGBUS, which cannot be recovered. Only map
+// files that no other process writes.
+package mmap
+
+import (
+	"fmt"
+	"os"
+)
+
+// Region is a read-only view of a file's contents.
+type Region struct {
+	data []byte
+	// mapped is false when data was read instead, on platforms without
+	// mmap and for empty files.
+	mapped bool
+}
+
+// Map maps the whole of f. The region stays valid after f is closed, until
+// Close.
+func Map(f *os.File) (*Region, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("error mapping %s: %v", f.Name(), err)
+	}
+	if info.Size() == 0 {
+		return &Region{}, nil
+	}
+	if int64(int(info.Size())) != info.Size() {
+		return nil, fmt.Errorf("error mapping %s: file too large", f.Name())
+	}
+	data, mapped, err := mapFile(f, int(info.Size()))
+	if err != nil {
+		return nil, fmt.Errorf("error mapping %s: %v", f.Name(), err)
+	}
+	return &Region{data: data, mapped: mapped}, nil
+}
+
+// Bytes returns the file's contents. They must not be modified or used
+// after Close.
+func (r *Region) Bytes() []byte {
+	return r.data
+}
+
+// Close unmaps the region.
+func (r *Region) Close() error {
+	data := r.data
+	r.data = nil
+	if !r.mapped || data == nil {
+		return nil
+	}
+	r.mapped = false
+	return unmap(data)
+}
@@ -0,0 +1,154 @@
+// Package mtls configures mutual TLS for the gRPC server and exposes the
+// identity of the verified client certificate to interceptors and handlers.
+package mtls
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Config names the PEM files used for TLS.
+type Config struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile is the bundle of CAs trusted to sign client
+	// certificates. When set, every client must present one.
+	ClientCAFile string
+}
+
+// ServerCredentials builds the server's transport credentials.
+func ServerCredentials(cfg Config) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("error loading server certificate: %v", err)
+	}
+	tc := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading client CA bundle: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in client CA bundle %s", cfg.ClientCAFile)
+		}
+		tc.ClientCAs = pool
+		tc.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return credentials.NewTLS(tc), nil
+}
+
+// Identity describes a verified client certificate.
+type Identity struct {
+	CommonName   string
+	Organization []string
+	DNSNames     []string
+	URIs         []string
+	SerialNumber string
+	// Fingerprint is the hex SHA-256 of the DER certificate.
+	Fingerprint string
+}
+
+func identity(cert *x509.Certificate) *Identity {
+	sum := sha256.Sum256(cert.Raw)
+	id := &Identity{
+		CommonName:   cert.Subject.CommonName,
+		Organization: cert.Subject.Organization,
+		DNSNames:     cert.DNSNames,
+		SerialNumber: cert.SerialNumber.String(),
+		Fingerprint:  hex.EncodeToString(sum[:]),
+	}
+	for _, u := range cert.URIs {
+		id.URIs = append(id.URIs, u.String())
+	}
+	return id
+}
+
+// PeerIdentity extracts the identity of the verified client certificate
+// from a connection's peer information.
+func PeerIdentity(ctx context.Context) (*Identity, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+	return identity(info.State.VerifiedChains[0][0]), true
+}
+
+type identityKey struct{}
+
+// FromContext returns the identity stored by the interceptor.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*Identity)
+	return id, ok
+}
+
+// AuthorizeFunc decides whether a client may call a method. id is nil when
+// the client presented no verified certificate.
+type AuthorizeFunc func(ctx context.Context, method string, id *Identity) error
+
+// UnaryInterceptor stores the client identity in the context, where later
+// interceptors and handlers find it with FromContext, and checks it with
+// authorize.
+func UnaryInterceptor(authorize AuthorizeFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := check(ctx, info.FullMethod, authorize)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor is UnaryInterceptor for streaming calls.
+func StreamInterceptor(authorize AuthorizeFunc) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := check(ss.Context(), info.FullMethod, authorize)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &identityStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func check(ctx context.Context, method string, authorize AuthorizeFunc) (context.Context, error) {
+	id, ok := PeerIdentity(ctx)
+	if ok {
+		ctx = context.WithValue(ctx, identityKey{}, id)
+	}
+	if authorize != nil {
+		if err := authorize(ctx, method, id); err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+	return ctx, nil
+}
+
+type identityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *identityStream) Context() context.Context {
+	return s.ctx
+}
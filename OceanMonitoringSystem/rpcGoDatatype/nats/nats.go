@@ -0,0 +1,265 @@
+// Package nats serves conversion requests over NATS request/reply and
+// publishes the records of raw telemetry messages to JetStream.
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	pb "rpcGoDatatype/proto"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Error replies carry the gRPC status code and message in these headers.
+const (
+	ErrorCodeHeader = "Nats-Service-Error-Code"
+	ErrorHeader     = "Nats-Service-Error"
+)
+
+// Config selects the subjects a Bridge serves.
+type Config struct {
+	URL       string
+	CredsFile string
+	// QueueGroup shares the subjects between replicas; Workers is the
+	// number of requests each replica handles at once.
+	QueueGroup string
+	Workers    int
+	// RequestSubject receives ParseRequests, encoded in protobuf or, with
+	// a Content-Type of application/json, in ProtoJSON. The reply holds
+	// the ParseResponse in the same encoding.
+	RequestSubject string
+	// Subject receives raw input whose records are published as JSON to
+	// RecordsSubject on JetStream. With SubjectColumn, each record goes to
+	// RecordsSubject.<value of the column>, e.g. one subject per station.
+	Subject        string
+	RecordsSubject string
+	SubjectColumn  string
+	// Stream, when set, is created or updated to store RecordsSubject.
+	Stream string
+}
+
+// ParseFunc handles a conversion request.
+type ParseFunc func(ctx context.Context, req *pb.ParseRequest) (*pb.ParseResponse, error)
+
+// ConvertFunc converts raw input into records, each a JSON object.
+type ConvertFunc func(ctx context.Context, data []byte) ([][]byte, error)
+
+// Bridge is a NATS connection serving the configured subjects.
+type Bridge struct {
+	cfg     Config
+	parse   ParseFunc
+	convert ConvertFunc
+	nc      *natsgo.Conn
+	js      jetstream.JetStream
+	closed  chan struct{}
+
+	// ctx ends the calls still running when Close gives up waiting.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	requests  atomic.Int64
+	failed    atomic.Int64
+	published atomic.Int64
+}
+
+// Connect connects to the server and subscribes to the configured subjects.
+func Connect(ctx context.Context, cfg Config, parse ParseFunc, convert ConvertFunc) (*Bridge, error) {
+	b := &Bridge{cfg: cfg, parse: parse, convert: convert, closed: make(chan struct{})}
+	opts := []natsgo.Option{
+		natsgo.Name("rpc-go-datatype"),
+		natsgo.MaxReconnects(-1),
+		natsgo.ClosedHandler(func(*natsgo.Conn) { close(b.closed) }),
+	}
+	if cfg.CredsFile != "" {
+		opts = append(opts, natsgo.UserCredentials(cfg.CredsFile))
+	}
+	nc, err := natsgo.Connect(cfg.URL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to NATS: %v", err)
+	}
+	b.nc = nc
+	b.ctx, b.cancel = context.WithCancel(context.Background())
+
+	if err := b.subscribe(ctx); err != nil {
+		b.cancel()
+		nc.Close()
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *Bridge) subscribe(ctx context.Context) error {
+	if b.cfg.RequestSubject != "" {
+		// Each subscription delivers its messages one at a time, so
+		// Workers subscriptions handle that many requests at once.
+		for i := 0; i < max(b.cfg.Workers, 1); i++ {
+			if _, err := b.nc.QueueSubscribe(b.cfg.RequestSubject, b.cfg.QueueGroup, b.handleRequest); err != nil {
+				return fmt.Errorf("error subscribing to %s: %v", b.cfg.RequestSubject, err)
+			}
+		}
+	}
+	if b.cfg.Subject != "" {
+		var err error
+		if b.js, err = jetstream.New(b.nc); err != nil {
+			return err
+		}
+		if b.cfg.Stream != "" {
+			_, err := b.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+				Name:     b.cfg.Stream,
+				Subjects: []string{b.cfg.RecordsSubject, b.cfg.RecordsSubject + ".>"},
+			})
+			if err != nil {
+				return fmt.Errorf("error creating stream %s: %v", b.cfg.Stream, err)
+			}
+		}
+		// A single subscription keeps the records in arrival order.
+		if _, err := b.nc.QueueSubscribe(b.cfg.Subject, b.cfg.QueueGroup, b.handleRaw); err != nil {
+			return fmt.Errorf("error subscribing to %s: %v", b.cfg.Subject, err)
+		}
+	}
+	return b.nc.Flush()
+}
+
+func (b *Bridge) handleRequest(msg *natsgo.Msg) {
+	if msg.Reply == "" {
+		return
+	}
+	b.requests.Add(1)
+	useJSON := strings.HasPrefix(msg.Header.Get("Content-Type"), "application/json")
+	req := &pb.ParseRequest{}
+	var err error
+	if useJSON {
+		err = protojson.Unmarshal(msg.Data, req)
+	} else {
+		err = proto.Unmarshal(msg.Data, req)
+	}
+	if err != nil {
+		b.respondError(msg, status.Errorf(codes.InvalidArgument, "invalid request: %v", err))
+		return
+	}
+	resp, err := b.parse(b.ctx, req)
+	if err != nil {
+		b.respondError(msg, err)
+		return
+	}
+
+	reply := natsgo.NewMsg(msg.Reply)
+	if useJSON {
+		reply.Header.Set("Content-Type", "application/json")
+		reply.Data, err = protojson.Marshal(resp)
+	} else {
+		reply.Header.Set("Content-Type", "application/protobuf")
+		reply.Data, err = proto.Marshal(resp)
+	}
+	if err != nil {
+		b.respondError(msg, err)
+		return
+	}
+	if err := msg.RespondMsg(reply); err != nil {
+		slog.Warn("error replying over NATS", "subject", msg.Subject, "error", err)
+	}
+}
+
+func (b *Bridge) respondError(msg *natsgo.Msg, err error) {
+	b.failed.Add(1)
+	st := status.Convert(err)
+	reply := natsgo.NewMsg(msg.Reply)
+	reply.Header.Set(ErrorCodeHeader, strconv.Itoa(int(st.Code())))
+	reply.Header.Set(ErrorHeader, st.Message())
+	if err := msg.RespondMsg(reply); err != nil {
+		slog.Warn("error replying over NATS", "subject", msg.Subject, "error", err)
+	}
+}
+
+func (b *Bridge) handleRaw(msg *natsgo.Msg) {
+	records, err := b.convert(b.ctx, msg.Data)
+	if err != nil {
+		b.failed.Add(1)
+		slog.Warn("NATS message not converted", "subject", msg.Subject, "error", err)
+		return
+	}
+	for _, rec := range records {
+		out := natsgo.NewMsg(b.recordSubject(rec))
+		out.Header.Set("Content-Type", "application/json")
+		out.Data = rec
+		if _, err := b.js.PublishMsg(b.ctx, out); err != nil {
+			b.failed.Add(1)
+			slog.Warn("error publishing record", "subject", out.Subject, "error", err)
+			return
+		}
+		b.published.Add(1)
+	}
+}
+
+// recordSubject returns the subject for a record, adding the value of
+// SubjectColumn as a token when set and present.
+func (b *Bridge) recordSubject(rec []byte) string {
+	if b.cfg.SubjectColumn == "" {
+		return b.cfg.RecordsSubject
+	}
+	var fields map[string]interface{}
+	if json.Unmarshal(rec, &fields) != nil {
+		return b.cfg.RecordsSubject
+	}
+	v, ok := fields[b.cfg.SubjectColumn]
+	if !ok || v == nil {
+		return b.cfg.RecordsSubject
+	}
+	token := strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '*', '>', ' ', '\t', '\r', '\n':
+			return '_'
+		}
+		return r
+	}, fmt.Sprint(v))
+	if token == "" {
+		return b.cfg.RecordsSubject
+	}
+	return b.cfg.RecordsSubject + "." + token
+}
+
+// Close stops taking messages and waits for the ones being handled, until
+// ctx is done.
+func (b *Bridge) Close(ctx context.Context) error {
+	err := b.nc.Drain()
+	select {
+	case <-b.closed:
+	case <-ctx.Done():
+		b.cancel()
+		b.nc.Close()
+	}
+	b.cancel()
+	return err
+}
+
+// Stats counts the messages a Bridge handled.
+type Stats struct {
+	Requests  int64 `json:"requests"`
+	Failed    int64 `json:"failed"`
+	Published int64 `json:"published"`
+}
+
+// Stats returns the current counts.
+func (b *Bridge) Stats() Stats {
+	return Stats{
+		Requests:  b.requests.Load(),
+		Failed:    b.failed.Load(),
+		Published: b.published.Load(),
+	}
+}
+
+// Vars returns the current counts in a form suitable for expvar.
+func (b *Bridge) Vars() interface{} {
+	return b.Stats()
+}
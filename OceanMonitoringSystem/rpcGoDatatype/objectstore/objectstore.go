@@ -0,0 +1,204 @@
+// Package objectstore reads and writes conversion data in S3-compatible
+// object storage such as AWS S3 or MinIO, addressed as s3://bucket/key.
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// Config holds the connection settings. Without an access key, credentials
+// are taken from the AWS environment variables, the shared credentials file
+// or the instance role.
+type Config struct {
+	// Endpoint is the host[:port] of the service, e.g. "minio:9000".
+	// It defaults to AWS S3.
+	Endpoint  string
+	Region    string
+	AccessKey string
+	SecretKey string
+	// Insecure connects over plain HTTP.
+	Insecure bool
+}
+
+// Store reads and writes objects.
+type Store struct {
+	client *minio.Client
+}
+
+// IsURI reports whether uri refers to object storage.
+func IsURI(uri string) bool {
+	return strings.HasPrefix(uri, "s3://")
+}
+
+// ParseURI splits s3://bucket/key into its bucket and key.
+func ParseURI(uri string) (bucket, key string, err error) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "s3" {
+		return "", "", fmt.Errorf("invalid object URI %q", uri)
+	}
+	key = strings.TrimPrefix(u.Path, "/")
+	if u.Host == "" || key == "" {
+		return "", "", fmt.Errorf("object URI %q needs a bucket and a key", uri)
+	}
+	return u.Host, key, nil
+}
+
+// New connects to the object store.
+func New(cfg Config) (*Store, error) {
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = "s3.amazonaws.com"
+	}
+	creds := credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.EnvAWS{},
+		&credentials.EnvMinio{},
+		&credentials.FileAWSCredentials{},
+		&credentials.IAM{},
+	})
+	if cfg.AccessKey != "" {
+		creds = credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, "")
+	}
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: !cfg.Insecure,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to object storage: %v", err)
+	}
+	return &Store{client: client}, nil
+}
+
+// Get reads the object at uri, refusing objects larger than maxBytes when
+// maxBytes is positive.
+func (s *Store) Get(ctx context.Context, uri string, maxBytes int64) ([]byte, error) {
+	bucket, key, err := ParseURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := s.client.GetObject(ctx, bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %v", uri, err)
+	}
+	defer obj.Close()
+
+	var r io.Reader = obj
+	if maxBytes > 0 {
+		r = io.LimitReader(obj, maxBytes+1)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %v", uri, err)
+	}
+	if maxBytes > 0 && int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("%s is larger than %d bytes", uri, maxBytes)
+	}
+	return data, nil
+}
+
+// Download copies the object at uri to a file in dir and returns the file's
+// path, refusing objects larger than maxBytes when maxBytes is positive.
+// The file is reused by later downloads until the object's ETag changes,
+// when it is replaced.
+func (s *Store) Download(ctx context.Context, uri, dir string, maxBytes int64) (string, error) {
+	bucket, key, err := ParseURI(uri)
+	if err != nil {
+		return "", err
+	}
+	info, err := s.client.StatObject(ctx, bucket, key, minio.StatObjectOptions{})
+	if err != nil {
+		return "", fmt.Errorf("error reading %s: %v", uri, err)
+	}
+	if maxBytes > 0 && info.Size > maxBytes {
+		return "", fmt.Errorf("%s is larger than %d bytes", uri, maxBytes)
+	}
+	prefix := cacheName(uri)
+	path := filepath.Join(dir, prefix+"-"+cacheName(info.ETag))
+	if st, err := os.Stat(path); err == nil && st.Size() == info.Size {
+		return path, nil
+	}
+
+	// Fetch the version that was checked, so the file matches its name.
+	get := minio.GetObjectOptions{}
+	if err := get.SetMatchETag(info.ETag); err != nil {
+		return "", fmt.Errorf("error reading %s: %v", uri, err)
+	}
+	obj, err := s.client.GetObject(ctx, bucket, key, get)
+	if err != nil {
+		return "", fmt.Errorf("error reading %s: %v", uri, err)
+	}
+	defer obj.Close()
+	f, err := os.CreateTemp(dir, prefix+".*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("error caching %s: %v", uri, err)
+	}
+	defer os.Remove(f.Name())
+	n, err := io.Copy(f, io.LimitReader(obj, info.Size+1))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return "", fmt.Errorf("error reading %s: %v", uri, err)
+	}
+	if n != info.Size {
+		return "", fmt.Errorf("error reading %s: object changed while downloading", uri)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return "", fmt.Errorf("error caching %s: %v", uri, err)
+	}
+	// Drop the files of earlier versions.
+	old, _ := filepath.Glob(filepath.Join(dir, prefix+"-*"))
+	for _, name := range old {
+		if name != path {
+			os.Remove(name)
+		}
+	}
+	return path, nil
+}
+
+// cacheName returns a file name component for s.
+func cacheName(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:16])
+}
+
+// Put writes data to the object at uri.
+func (s *Store) Put(ctx context.Context, uri string, data []byte, contentType string) error {
+	bucket, key, err := ParseURI(uri)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.PutObject(ctx, bucket, key, bytes.NewReader(data), int64(len(data)),
+		minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return fmt.Errorf("error writing %s: %v", uri, err)
+	}
+	return nil
+}
+
+// healthBucket is probed by Ping; it need not exist.
+const healthBucket = "rpcgodatatype-health-probe"
+
+// Ping checks that the service answers. Any response, including access
+// denied, counts as reachable.
+func (s *Store) Ping(ctx context.Context) error {
+	_, err := s.client.BucketExists(ctx, healthBucket)
+	var resp minio.ErrorResponse
+	if err != nil && !errors.As(err, &resp) {
+		return fmt.Errorf("object storage is unreachable: %v", err)
+	}
+	return nil
+}
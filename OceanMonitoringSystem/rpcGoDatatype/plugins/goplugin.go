@@ -0,0 +1,25 @@
+//go:build (linux || darwin || freebsd) && cgo
+
+package plugins
+
+import (
+	"fmt"
+	"plugin"
+)
+
+// openPlugin loads a Go plugin and returns its Convert function.
+func openPlugin(path string) (func(from, to, data string) (string, error), error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening plugin: %v", err)
+	}
+	sym, err := p.Lookup("Convert")
+	if err != nil {
+		return nil, fmt.Errorf("error loading plugin: %v", err)
+	}
+	convert, ok := sym.(func(from, to, data string) (string, error))
+	if !ok {
+		return nil, fmt.Errorf("plugin %s: Convert has type %T, want func(from, to, data string) (string, error)", path, sym)
+	}
+	return convert, nil
+}
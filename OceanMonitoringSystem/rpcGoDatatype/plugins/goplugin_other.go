@@ -0,0 +1,9 @@
+//go:build !((linux || darwin || freebsd) && cgo)
+
+package plugins
+
+import "fmt"
+
+func openPlugin(path string) (func(from, to, data string) (string, error), error) {
+	return nil, fmt.Errorf("Go plugins are not supported on this platform")
+}
@@ -0,0 +1,143 @@
+// Package plugins loads additional converters at startup, so proprietary
+// formats can be handled without changing the service.
+//
+// A converter is either an external command or a Go plugin. A command gets
+// the payload on stdin and writes the converted payload to stdout; the
+// CONVERT_FROM and CONVERT_TO environment variables name the formats. Lines
+// written to stderr starting with "warning:" are reported as conversion
+// warnings, and a non-zero exit status fails the conversion with the rest of
+// stderr as the reason.
+//
+// A Go plugin must export a Convert function of type
+// func(from, to, data string) (string, error).
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"rpcGoDatatype/csvconverter"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// Converter configures one external conversion.
+type Converter struct {
+	From    string   `json:"from"`
+	To      string   `json:"to"`
+	Command []string `json:"command,omitempty"`
+	Plugin  string   `json:"plugin,omitempty"`
+	// Timeout bounds a command run, e.g. "1m".
+	Timeout string `json:"timeout,omitempty"`
+
+	timeout time.Duration
+}
+
+// Config is the on-disk converter configuration.
+type Config struct {
+	Converters []Converter `json:"converters"`
+}
+
+// LoadConfig reads a JSON converter configuration file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading plugins config: %v", err)
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("error parsing plugins config: %v", err)
+	}
+	for i := range cfg.Converters {
+		c := &cfg.Converters[i]
+		if c.From == "" || c.To == "" {
+			return nil, fmt.Errorf("converter %d: needs a from and a to format", i)
+		}
+		if (len(c.Command) == 0) == (c.Plugin == "") {
+			return nil, fmt.Errorf("converter %d: needs either a command or a plugin", i)
+		}
+		c.timeout = defaultTimeout
+		if c.Timeout != "" {
+			if c.timeout, err = time.ParseDuration(c.Timeout); err != nil {
+				return nil, fmt.Errorf("converter %d: invalid timeout: %v", i, err)
+			}
+		}
+	}
+	return &cfg, nil
+}
+
+// Register makes every configured converter available to csvconverter.
+func (cfg *Config) Register() error {
+	for _, c := range cfg.Converters {
+		var fn csvconverter.ConverterFunc
+		if c.Plugin != "" {
+			convert, err := openPlugin(c.Plugin)
+			if err != nil {
+				return fmt.Errorf("converter %s to %s: %v", c.From, c.To, err)
+			}
+			fn = pluginConverter(c.From, c.To, convert)
+		} else {
+			fn = commandConverter(c)
+		}
+		if err := csvconverter.Register(c.From, c.To, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func pluginConverter(from, to string, convert func(from, to, data string) (string, error)) csvconverter.ConverterFunc {
+	return func(data string, opts csvconverter.Options) (*csvconverter.Result, error) {
+		out, err := convert(from, to, data)
+		if err != nil {
+			return nil, fmt.Errorf("%s to %s converter failed: %v", from, to, err)
+		}
+		return &csvconverter.Result{Output: out}, nil
+	}
+}
+
+func commandConverter(c Converter) csvconverter.ConverterFunc {
+	return func(data string, opts csvconverter.Options) (*csvconverter.Result, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+		defer cancel()
+
+		cmd := exec.CommandContext(ctx, c.Command[0], c.Command[1:]...)
+		cmd.Env = append(os.Environ(),
+			"CONVERT_FROM="+c.From,
+			"CONVERT_TO="+c.To,
+		)
+		cmd.Stdin = strings.NewReader(data)
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		err := cmd.Run()
+
+		result := &csvconverter.Result{}
+		var reasons []string
+		for _, line := range strings.Split(stderr.String(), "\n") {
+			line = strings.TrimSpace(line)
+			if rest, ok := strings.CutPrefix(line, "warning:"); ok {
+				result.Warnings = append(result.Warnings, strings.TrimSpace(rest))
+			} else if line != "" {
+				reasons = append(reasons, line)
+			}
+		}
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("%s to %s converter timed out after %v", c.From, c.To, c.timeout)
+		}
+		if err != nil {
+			if len(reasons) > 0 {
+				return nil, fmt.Errorf("%s to %s converter failed: %v: %s", c.From, c.To, err, strings.Join(reasons, "; "))
+			}
+			return nil, fmt.Errorf("%s to %s converter failed: %v", c.From, c.To, err)
+		}
+		result.Output = stdout.String()
+		return result, nil
+	}
+}
@@ -0,0 +1,103 @@
+// Package pool bounds the number of conversions running at once, so a
+// burst of large requests queues instead of exhausting memory.
+package pool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// ErrQueueFull is returned when every worker is busy and the queue is
+	// full.
+	ErrQueueFull = errors.New("too many conversions in progress")
+	// ErrQueueTimeout is returned when no worker became free in time.
+	ErrQueueTimeout = errors.New("timed out waiting for a conversion worker")
+)
+
+// Pool hands out a fixed number of worker slots. Callers beyond that wait
+// in a bounded queue. A nil Pool admits every call.
+type Pool struct {
+	slots    chan struct{}
+	maxQueue int64
+	maxWait  time.Duration
+
+	queued   atomic.Int64
+	rejected atomic.Int64
+}
+
+// New creates a Pool running at most workers calls at once. Up to queue
+// further calls wait for a slot, each for at most maxWait; zero maxWait
+// waits until the call's context ends.
+func New(workers, queue int, maxWait time.Duration) *Pool {
+	return &Pool{
+		slots:    make(chan struct{}, workers),
+		maxQueue: int64(queue),
+		maxWait:  maxWait,
+	}
+}
+
+// Acquire waits for a free slot and returns the function releasing it.
+func (p *Pool) Acquire(ctx context.Context) (release func(), err error) {
+	if p == nil {
+		return func() {}, nil
+	}
+	select {
+	case p.slots <- struct{}{}:
+		return p.releaser(), nil
+	default:
+	}
+
+	if p.queued.Add(1) > p.maxQueue {
+		p.queued.Add(-1)
+		p.rejected.Add(1)
+		return nil, ErrQueueFull
+	}
+	defer p.queued.Add(-1)
+	var timeout <-chan time.Time
+	if p.maxWait > 0 {
+		t := time.NewTimer(p.maxWait)
+		defer t.Stop()
+		timeout = t.C
+	}
+	select {
+	case p.slots <- struct{}{}:
+		return p.releaser(), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timeout:
+		p.rejected.Add(1)
+		return nil, ErrQueueTimeout
+	}
+}
+
+func (p *Pool) releaser() func() {
+	var once sync.Once
+	return func() { once.Do(func() { <-p.slots }) }
+}
+
+// Stats is a snapshot of a Pool's load.
+type Stats struct {
+	Workers  int   `json:"workers"`
+	Running  int   `json:"running"`
+	Queued   int64 `json:"queued"`
+	Rejected int64 `json:"rejected"`
+}
+
+// Stats returns the current load.
+func (p *Pool) Stats() Stats {
+	return Stats{
+		Workers:  cap(p.slots),
+		Running:  len(p.slots),
+		Queued:   p.queued.Load(),
+		Rejected: p.rejected.Load(),
+	}
+}
+
+// Vars returns the current load in a form suitable for expvar.
+func (p *Pool) Vars() interface{} {
+	return p.Stats()
+}
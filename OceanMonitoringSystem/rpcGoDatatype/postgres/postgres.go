@@ -0,0 +1,244 @@
+// Package postgres bulk-inserts conversion results into PostgreSQL or
+// TimescaleDB tables, addressed as pg://table or pg://schema.table.
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Conflict handling for rows that violate a unique constraint.
+const (
+	ConflictError  = "error"
+	ConflictIgnore = "ignore"
+	ConflictUpdate = "update"
+)
+
+// WriteOptions control how rows map onto a table.
+type WriteOptions struct {
+	// Columns maps result columns to table columns. When set, only the
+	// mapped columns are written; otherwise every column is written
+	// under its own name.
+	Columns map[string]string
+	// OnConflict is ConflictError (the default), ConflictIgnore to skip
+	// conflicting rows or ConflictUpdate to overwrite them, which needs
+	// the ConflictColumns of a unique index, e.g. station and time.
+	OnConflict      string
+	ConflictColumns []string
+}
+
+// Sink writes rows over a connection pool.
+type Sink struct {
+	pool *pgxpool.Pool
+}
+
+// Open connects to the database named by a connection string or URL.
+func Open(ctx context.Context, dsn string) (*Sink, error) {
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to PostgreSQL: %v", err)
+	}
+	return &Sink{pool: pool}, nil
+}
+
+// Close closes the connections.
+func (s *Sink) Close() {
+	s.pool.Close()
+}
+
+// IsURI reports whether uri refers to a PostgreSQL table.
+func IsURI(uri string) bool {
+	return strings.HasPrefix(uri, "pg://")
+}
+
+// ParseURI returns the table of pg://table or pg://schema.table.
+func ParseURI(uri string) (pgx.Identifier, error) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "pg" {
+		return nil, fmt.Errorf("invalid PostgreSQL URI %q", uri)
+	}
+	if u.Host == "" || strings.Trim(u.Path, "/") != "" {
+		return nil, fmt.Errorf("PostgreSQL URI %q needs a table and nothing else", uri)
+	}
+	table := pgx.Identifier(strings.Split(u.Host, "."))
+	if len(table) > 2 {
+		return nil, fmt.Errorf("invalid table %q", u.Host)
+	}
+	return table, nil
+}
+
+// Write inserts the rows of a JSON array of objects into the table named
+// by uri in one transaction, and returns the number of rows written. Rows
+// are copied into a temporary table and inserted from there, so the
+// database converts the values to the column types and conflicts are
+// handled per row.
+func (s *Sink) Write(ctx context.Context, uri string, data []byte, opts WriteOptions) (int64, error) {
+	table, err := ParseURI(uri)
+	if err != nil {
+		return 0, err
+	}
+	switch opts.OnConflict {
+	case "", ConflictError, ConflictIgnore:
+	case ConflictUpdate:
+		if len(opts.ConflictColumns) == 0 {
+			return 0, fmt.Errorf("updating conflicting rows needs conflict columns")
+		}
+	default:
+		return 0, fmt.Errorf("unsupported conflict handling %q", opts.OnConflict)
+	}
+	rows, keys, err := decodeRows(data)
+	if err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	source, columns := mapColumns(keys, opts.Columns)
+	if len(columns) == 0 {
+		return 0, fmt.Errorf("no columns to write")
+	}
+	body, err := encodeCSV(rows, source)
+	if err != nil {
+		return 0, err
+	}
+
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = pgx.Identifier{c}.Sanitize()
+	}
+	list := strings.Join(quoted, ", ")
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error starting transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	if _, err := tx.Exec(ctx, fmt.Sprintf("CREATE TEMP TABLE staging ON COMMIT DROP AS SELECT %s FROM %s WITH NO DATA", list, table.Sanitize())); err != nil {
+		return 0, fmt.Errorf("error preparing %s: %v", table.Sanitize(), err)
+	}
+	copySQL := fmt.Sprintf("COPY staging (%s) FROM STDIN WITH (FORMAT csv)", list)
+	if _, err := tx.Conn().PgConn().CopyFrom(ctx, bytes.NewReader(body), copySQL); err != nil {
+		return 0, fmt.Errorf("error copying rows: %v", err)
+	}
+	insert := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM staging", table.Sanitize(), list, list)
+	switch opts.OnConflict {
+	case ConflictIgnore:
+		insert += " ON CONFLICT DO NOTHING"
+	case ConflictUpdate:
+		insert += onConflictUpdate(columns, opts.ConflictColumns)
+	}
+	tag, err := tx.Exec(ctx, insert)
+	if err != nil {
+		return 0, fmt.Errorf("error inserting rows: %v", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("error committing rows: %v", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// onConflictUpdate returns the clause overwriting every non-key column.
+func onConflictUpdate(columns, keys []string) string {
+	isKey := make(map[string]bool, len(keys))
+	quotedKeys := make([]string, len(keys))
+	for i, k := range keys {
+		isKey[k] = true
+		quotedKeys[i] = pgx.Identifier{k}.Sanitize()
+	}
+	var set []string
+	for _, c := range columns {
+		if !isKey[c] {
+			q := pgx.Identifier{c}.Sanitize()
+			set = append(set, q+" = EXCLUDED."+q)
+		}
+	}
+	clause := " ON CONFLICT (" + strings.Join(quotedKeys, ", ") + ")"
+	if len(set) == 0 {
+		return clause + " DO NOTHING"
+	}
+	return clause + " DO UPDATE SET " + strings.Join(set, ", ")
+}
+
+// mapColumns returns the result columns to write and their table columns.
+func mapColumns(keys []string, mapping map[string]string) (source, columns []string) {
+	if len(mapping) == 0 {
+		return keys, keys
+	}
+	for _, k := range keys {
+		if c, ok := mapping[k]; ok {
+			source = append(source, k)
+			columns = append(columns, c)
+		}
+	}
+	return source, columns
+}
+
+// decodeRows decodes a JSON array of objects, returning the keys in order
+// of first appearance.
+func decodeRows(data []byte) ([]map[string]json.RawMessage, []string, error) {
+	var rows []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, nil, fmt.Errorf("PostgreSQL output needs a JSON array of objects: %v", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.Token()
+	var keys []string
+	seen := map[string]bool{}
+	for dec.More() {
+		dec.Token()
+		for dec.More() {
+			t, err := dec.Token()
+			if err != nil {
+				return nil, nil, err
+			}
+			if k := t.(string); !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return nil, nil, err
+			}
+		}
+		dec.Token()
+	}
+	return rows, keys, nil
+}
+
+// encodeCSV writes the rows as CSV for COPY, in which an unquoted empty
+// field is NULL. Empty strings, typically empty cells, are written as NULL
+// too so that they fit numeric and time columns; arrays and objects are
+// written as JSON text.
+func encodeCSV(rows []map[string]json.RawMessage, columns []string) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, row := range rows {
+		for i, c := range columns {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			v, ok := row[c]
+			if !ok || string(v) == "null" || string(v) == `""` {
+				continue
+			}
+			text := string(v)
+			if v[0] == '"' {
+				if err := json.Unmarshal(v, &text); err != nil {
+					return nil, err
+				}
+			}
+			if v[0] == '"' || v[0] == '[' || v[0] == '{' {
+				text = `"` + strings.ReplaceAll(text, `"`, `""`) + `"`
+			}
+			buf.WriteString(text)
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
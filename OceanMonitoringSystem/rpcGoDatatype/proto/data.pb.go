@@ -22,12 +22,18 @@ const (
 )
 
 type ParseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	From           string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To             string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Data           string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Options        *ConvertOptions        `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	RawData        []byte                 `protobuf:"bytes,5,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	Url            string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	OutputUrl      string                 `protobuf:"bytes,7,opt,name=output_url,json=outputUrl,proto3" json:"output_url,omitempty"`
+	Path           string                 `protobuf:"bytes,8,opt,name=path,proto3" json:"path,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,9,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ParseRequest) Reset() {
@@ -81,27 +87,123 @@ func (x *ParseRequest) GetData() string {
 	return ""
 }
 
-type ParseResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ParseRequest) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
 }
 
-func (x *ParseResponse) Reset() {
-	*x = ParseResponse{}
+func (x *ParseRequest) GetRawData() []byte {
+	if x != nil {
+		return x.RawData
+	}
+	return nil
+}
+
+func (x *ParseRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ParseRequest) GetOutputUrl() string {
+	if x != nil {
+		return x.OutputUrl
+	}
+	return ""
+}
+
+func (x *ParseRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ParseRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ConvertOptions struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	DisableTypeInference    bool                   `protobuf:"varint,1,opt,name=disable_type_inference,json=disableTypeInference,proto3" json:"disable_type_inference,omitempty"`
+	StringColumns           []string               `protobuf:"bytes,2,rep,name=string_columns,json=stringColumns,proto3" json:"string_columns,omitempty"`
+	ColumnTypes             map[string]string      `protobuf:"bytes,3,rep,name=column_types,json=columnTypes,proto3" json:"column_types,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StrictNumbers           bool                   `protobuf:"varint,4,opt,name=strict_numbers,json=strictNumbers,proto3" json:"strict_numbers,omitempty"`
+	NonFiniteAs             string                 `protobuf:"bytes,5,opt,name=non_finite_as,json=nonFiniteAs,proto3" json:"non_finite_as,omitempty"`
+	DetectTimestamps        bool                   `protobuf:"varint,6,opt,name=detect_timestamps,json=detectTimestamps,proto3" json:"detect_timestamps,omitempty"`
+	NormalizeTimestamps     bool                   `protobuf:"varint,7,opt,name=normalize_timestamps,json=normalizeTimestamps,proto3" json:"normalize_timestamps,omitempty"`
+	YearPivot               int32                  `protobuf:"varint,8,opt,name=year_pivot,json=yearPivot,proto3" json:"year_pivot,omitempty"`
+	InferBooleans           bool                   `protobuf:"varint,9,opt,name=infer_booleans,json=inferBooleans,proto3" json:"infer_booleans,omitempty"`
+	NullValues              []string               `protobuf:"bytes,10,rep,name=null_values,json=nullValues,proto3" json:"null_values,omitempty"`
+	NullOutput              string                 `protobuf:"bytes,11,opt,name=null_output,json=nullOutput,proto3" json:"null_output,omitempty"`
+	NoHeader                bool                   `protobuf:"varint,12,opt,name=no_header,json=noHeader,proto3" json:"no_header,omitempty"`
+	Headers                 []string               `protobuf:"bytes,13,rep,name=headers,proto3" json:"headers,omitempty"`
+	OmitHeader              bool                   `protobuf:"varint,14,opt,name=omit_header,json=omitHeader,proto3" json:"omit_header,omitempty"`
+	DuplicateHeaders        string                 `protobuf:"bytes,15,opt,name=duplicate_headers,json=duplicateHeaders,proto3" json:"duplicate_headers,omitempty"`
+	JaggedRows              string                 `protobuf:"bytes,16,opt,name=jagged_rows,json=jaggedRows,proto3" json:"jagged_rows,omitempty"`
+	InputEncoding           string                 `protobuf:"bytes,17,opt,name=input_encoding,json=inputEncoding,proto3" json:"input_encoding,omitempty"`
+	OutputEncoding          string                 `protobuf:"bytes,18,opt,name=output_encoding,json=outputEncoding,proto3" json:"output_encoding,omitempty"`
+	SkipLines               int32                  `protobuf:"varint,19,opt,name=skip_lines,json=skipLines,proto3" json:"skip_lines,omitempty"`
+	CommentPrefix           string                 `protobuf:"bytes,20,opt,name=comment_prefix,json=commentPrefix,proto3" json:"comment_prefix,omitempty"`
+	CaptureMetadata         bool                   `protobuf:"varint,21,opt,name=capture_metadata,json=captureMetadata,proto3" json:"capture_metadata,omitempty"`
+	Columns                 []string               `protobuf:"bytes,22,rep,name=columns,proto3" json:"columns,omitempty"`
+	Rename                  map[string]string      `protobuf:"bytes,23,rep,name=rename,proto3" json:"rename,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Filter                  string                 `protobuf:"bytes,24,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortBy                  []string               `protobuf:"bytes,25,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	Deduplicate             bool                   `protobuf:"varint,26,opt,name=deduplicate,proto3" json:"deduplicate,omitempty"`
+	DedupKeys               []string               `protobuf:"bytes,27,rep,name=dedup_keys,json=dedupKeys,proto3" json:"dedup_keys,omitempty"`
+	Reshape                 string                 `protobuf:"bytes,28,opt,name=reshape,proto3" json:"reshape,omitempty"`
+	ReshapeIdColumns        []string               `protobuf:"bytes,29,rep,name=reshape_id_columns,json=reshapeIdColumns,proto3" json:"reshape_id_columns,omitempty"`
+	ReshapeNameColumn       string                 `protobuf:"bytes,30,opt,name=reshape_name_column,json=reshapeNameColumn,proto3" json:"reshape_name_column,omitempty"`
+	ReshapeValueColumn      string                 `protobuf:"bytes,31,opt,name=reshape_value_column,json=reshapeValueColumn,proto3" json:"reshape_value_column,omitempty"`
+	UnpivotColumns          []string               `protobuf:"bytes,32,rep,name=unpivot_columns,json=unpivotColumns,proto3" json:"unpivot_columns,omitempty"`
+	Schema                  string                 `protobuf:"bytes,33,opt,name=schema,proto3" json:"schema,omitempty"`
+	SkipInvalidRows         bool                   `protobuf:"varint,34,opt,name=skip_invalid_rows,json=skipInvalidRows,proto3" json:"skip_invalid_rows,omitempty"`
+	Mode                    string                 `protobuf:"bytes,35,opt,name=mode,proto3" json:"mode,omitempty"`
+	ComputedColumns         []string               `protobuf:"bytes,36,rep,name=computed_columns,json=computedColumns,proto3" json:"computed_columns,omitempty"`
+	Template                string                 `protobuf:"bytes,37,opt,name=template,proto3" json:"template,omitempty"`
+	TemplateHeader          string                 `protobuf:"bytes,38,opt,name=template_header,json=templateHeader,proto3" json:"template_header,omitempty"`
+	TemplateFooter          string                 `protobuf:"bytes,39,opt,name=template_footer,json=templateFooter,proto3" json:"template_footer,omitempty"`
+	InfluxMeasurement       string                 `protobuf:"bytes,40,opt,name=influx_measurement,json=influxMeasurement,proto3" json:"influx_measurement,omitempty"`
+	InfluxTags              []string               `protobuf:"bytes,41,rep,name=influx_tags,json=influxTags,proto3" json:"influx_tags,omitempty"`
+	InfluxFields            []string               `protobuf:"bytes,42,rep,name=influx_fields,json=influxFields,proto3" json:"influx_fields,omitempty"`
+	InfluxTimeColumn        string                 `protobuf:"bytes,43,opt,name=influx_time_column,json=influxTimeColumn,proto3" json:"influx_time_column,omitempty"`
+	InfluxPrecision         string                 `protobuf:"bytes,44,opt,name=influx_precision,json=influxPrecision,proto3" json:"influx_precision,omitempty"`
+	PostgresColumns         map[string]string      `protobuf:"bytes,45,rep,name=postgres_columns,json=postgresColumns,proto3" json:"postgres_columns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PostgresOnConflict      string                 `protobuf:"bytes,46,opt,name=postgres_on_conflict,json=postgresOnConflict,proto3" json:"postgres_on_conflict,omitempty"`
+	PostgresConflictColumns []string               `protobuf:"bytes,47,rep,name=postgres_conflict_columns,json=postgresConflictColumns,proto3" json:"postgres_conflict_columns,omitempty"`
+	CompactJson             *bool                  `protobuf:"varint,48,opt,name=compact_json,json=compactJson,proto3,oneof" json:"compact_json,omitempty"`
+	JsonIndent              int32                  `protobuf:"varint,49,opt,name=json_indent,json=jsonIndent,proto3" json:"json_indent,omitempty"`
+	Units                   []string               `protobuf:"bytes,50,rep,name=units,proto3" json:"units,omitempty"`
+	Ranges                  []*RangeRule           `protobuf:"bytes,51,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	OutOfRange              string                 `protobuf:"bytes,52,opt,name=out_of_range,json=outOfRange,proto3" json:"out_of_range,omitempty"`
+	SensorType              string                 `protobuf:"bytes,53,opt,name=sensor_type,json=sensorType,proto3" json:"sensor_type,omitempty"`
+	SourceTimezone          string                 `protobuf:"bytes,54,opt,name=source_timezone,json=sourceTimezone,proto3" json:"source_timezone,omitempty"`
+	TargetTimezone          string                 `protobuf:"bytes,55,opt,name=target_timezone,json=targetTimezone,proto3" json:"target_timezone,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ConvertOptions) Reset() {
+	*x = ConvertOptions{}
 	mi := &file_proto_data_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ParseResponse) String() string {
+func (x *ConvertOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParseResponse) ProtoMessage() {}
+func (*ConvertOptions) ProtoMessage() {}
 
-func (x *ParseResponse) ProtoReflect() protoreflect.Message {
+func (x *ConvertOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_data_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -113,32 +215,8390 @@ func (x *ParseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParseResponse.ProtoReflect.Descriptor instead.
-func (*ParseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConvertOptions.ProtoReflect.Descriptor instead.
+func (*ConvertOptions) Descriptor() ([]byte, []int) {
 	return file_proto_data_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ParseResponse) GetResult() string {
+func (x *ConvertOptions) GetDisableTypeInference() bool {
 	if x != nil {
-		return x.Result
+		return x.DisableTypeInference
+	}
+	return false
+}
+
+func (x *ConvertOptions) GetStringColumns() []string {
+	if x != nil {
+		return x.StringColumns
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetColumnTypes() map[string]string {
+	if x != nil {
+		return x.ColumnTypes
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetStrictNumbers() bool {
+	if x != nil {
+		return x.StrictNumbers
+	}
+	return false
+}
+
+func (x *ConvertOptions) GetNonFiniteAs() string {
+	if x != nil {
+		return x.NonFiniteAs
 	}
 	return ""
 }
 
-var File_proto_data_proto protoreflect.FileDescriptor
+func (x *ConvertOptions) GetDetectTimestamps() bool {
+	if x != nil {
+		return x.DetectTimestamps
+	}
+	return false
+}
 
-const file_proto_data_proto_rawDesc = "" +
-	"\n" +
-	"\x10proto/data.proto\x12\x04data\"F\n" +
-	"\fParseRequest\x12\x12\n" +
-	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
-	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
-	"\x04data\x18\x03 \x01(\tR\x04data\"'\n" +
-	"\rParseResponse\x12\x16\n" +
-	"\x06result\x18\x01 \x01(\tR\x06result2>\n" +
-	"\n" +
-	"DataParser\x120\n" +
-	"\x05Parse\x12\x12.data.ParseRequest\x1a\x13.data.ParseResponseB\x1bZ\x19rpcGoDatatype/proto;protob\x06proto3"
+func (x *ConvertOptions) GetNormalizeTimestamps() bool {
+	if x != nil {
+		return x.NormalizeTimestamps
+	}
+	return false
+}
+
+func (x *ConvertOptions) GetYearPivot() int32 {
+	if x != nil {
+		return x.YearPivot
+	}
+	return 0
+}
+
+func (x *ConvertOptions) GetInferBooleans() bool {
+	if x != nil {
+		return x.InferBooleans
+	}
+	return false
+}
+
+func (x *ConvertOptions) GetNullValues() []string {
+	if x != nil {
+		return x.NullValues
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetNullOutput() string {
+	if x != nil {
+		return x.NullOutput
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetNoHeader() bool {
+	if x != nil {
+		return x.NoHeader
+	}
+	return false
+}
+
+func (x *ConvertOptions) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetOmitHeader() bool {
+	if x != nil {
+		return x.OmitHeader
+	}
+	return false
+}
+
+func (x *ConvertOptions) GetDuplicateHeaders() string {
+	if x != nil {
+		return x.DuplicateHeaders
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetJaggedRows() string {
+	if x != nil {
+		return x.JaggedRows
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetInputEncoding() string {
+	if x != nil {
+		return x.InputEncoding
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetOutputEncoding() string {
+	if x != nil {
+		return x.OutputEncoding
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetSkipLines() int32 {
+	if x != nil {
+		return x.SkipLines
+	}
+	return 0
+}
+
+func (x *ConvertOptions) GetCommentPrefix() string {
+	if x != nil {
+		return x.CommentPrefix
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetCaptureMetadata() bool {
+	if x != nil {
+		return x.CaptureMetadata
+	}
+	return false
+}
+
+func (x *ConvertOptions) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetRename() map[string]string {
+	if x != nil {
+		return x.Rename
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetSortBy() []string {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetDeduplicate() bool {
+	if x != nil {
+		return x.Deduplicate
+	}
+	return false
+}
+
+func (x *ConvertOptions) GetDedupKeys() []string {
+	if x != nil {
+		return x.DedupKeys
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetReshape() string {
+	if x != nil {
+		return x.Reshape
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetReshapeIdColumns() []string {
+	if x != nil {
+		return x.ReshapeIdColumns
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetReshapeNameColumn() string {
+	if x != nil {
+		return x.ReshapeNameColumn
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetReshapeValueColumn() string {
+	if x != nil {
+		return x.ReshapeValueColumn
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetUnpivotColumns() []string {
+	if x != nil {
+		return x.UnpivotColumns
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetSkipInvalidRows() bool {
+	if x != nil {
+		return x.SkipInvalidRows
+	}
+	return false
+}
+
+func (x *ConvertOptions) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetComputedColumns() []string {
+	if x != nil {
+		return x.ComputedColumns
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetTemplateHeader() string {
+	if x != nil {
+		return x.TemplateHeader
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetTemplateFooter() string {
+	if x != nil {
+		return x.TemplateFooter
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetInfluxMeasurement() string {
+	if x != nil {
+		return x.InfluxMeasurement
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetInfluxTags() []string {
+	if x != nil {
+		return x.InfluxTags
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetInfluxFields() []string {
+	if x != nil {
+		return x.InfluxFields
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetInfluxTimeColumn() string {
+	if x != nil {
+		return x.InfluxTimeColumn
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetInfluxPrecision() string {
+	if x != nil {
+		return x.InfluxPrecision
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetPostgresColumns() map[string]string {
+	if x != nil {
+		return x.PostgresColumns
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetPostgresOnConflict() string {
+	if x != nil {
+		return x.PostgresOnConflict
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetPostgresConflictColumns() []string {
+	if x != nil {
+		return x.PostgresConflictColumns
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetCompactJson() bool {
+	if x != nil && x.CompactJson != nil {
+		return *x.CompactJson
+	}
+	return false
+}
+
+func (x *ConvertOptions) GetJsonIndent() int32 {
+	if x != nil {
+		return x.JsonIndent
+	}
+	return 0
+}
+
+func (x *ConvertOptions) GetUnits() []string {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetRanges() []*RangeRule {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetOutOfRange() string {
+	if x != nil {
+		return x.OutOfRange
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetSensorType() string {
+	if x != nil {
+		return x.SensorType
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetSourceTimezone() string {
+	if x != nil {
+		return x.SourceTimezone
+	}
+	return ""
+}
+
+func (x *ConvertOptions) GetTargetTimezone() string {
+	if x != nil {
+		return x.TargetTimezone
+	}
+	return ""
+}
+
+type RangeRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Column        string                 `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	Min           float64                `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RangeRule) Reset() {
+	*x = RangeRule{}
+	mi := &file_proto_data_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RangeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeRule) ProtoMessage() {}
+
+func (x *RangeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeRule.ProtoReflect.Descriptor instead.
+func (*RangeRule) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RangeRule) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *RangeRule) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *RangeRule) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type ParseResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Result            string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Warnings          []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	RawResult         []byte                 `protobuf:"bytes,3,opt,name=raw_result,json=rawResult,proto3" json:"raw_result,omitempty"`
+	Preamble          []string               `protobuf:"bytes,4,rep,name=preamble,proto3" json:"preamble,omitempty"`
+	Metadata          map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DuplicatesRemoved int64                  `protobuf:"varint,6,opt,name=duplicates_removed,json=duplicatesRemoved,proto3" json:"duplicates_removed,omitempty"`
+	RowErrors         []*RowError            `protobuf:"bytes,7,rep,name=row_errors,json=rowErrors,proto3" json:"row_errors,omitempty"`
+	Stats             *ConversionStats       `protobuf:"bytes,8,opt,name=stats,proto3" json:"stats,omitempty"`
+	OutputUrl         string                 `protobuf:"bytes,9,opt,name=output_url,json=outputUrl,proto3" json:"output_url,omitempty"`
+	CacheHit          bool                   `protobuf:"varint,10,opt,name=cache_hit,json=cacheHit,proto3" json:"cache_hit,omitempty"`
+	Anomalies         []*Anomaly             `protobuf:"bytes,11,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ParseResponse) Reset() {
+	*x = ParseResponse{}
+	mi := &file_proto_data_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseResponse) ProtoMessage() {}
+
+func (x *ParseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseResponse.ProtoReflect.Descriptor instead.
+func (*ParseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ParseResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ParseResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ParseResponse) GetRawResult() []byte {
+	if x != nil {
+		return x.RawResult
+	}
+	return nil
+}
+
+func (x *ParseResponse) GetPreamble() []string {
+	if x != nil {
+		return x.Preamble
+	}
+	return nil
+}
+
+func (x *ParseResponse) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ParseResponse) GetDuplicatesRemoved() int64 {
+	if x != nil {
+		return x.DuplicatesRemoved
+	}
+	return 0
+}
+
+func (x *ParseResponse) GetRowErrors() []*RowError {
+	if x != nil {
+		return x.RowErrors
+	}
+	return nil
+}
+
+func (x *ParseResponse) GetStats() *ConversionStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *ParseResponse) GetOutputUrl() string {
+	if x != nil {
+		return x.OutputUrl
+	}
+	return ""
+}
+
+func (x *ParseResponse) GetCacheHit() bool {
+	if x != nil {
+		return x.CacheHit
+	}
+	return false
+}
+
+func (x *ParseResponse) GetAnomalies() []*Anomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+type Anomaly struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int64                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Row           int64                  `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	Station       string                 `protobuf:"bytes,3,opt,name=station,proto3" json:"station,omitempty"`
+	Column        string                 `protobuf:"bytes,4,opt,name=column,proto3" json:"column,omitempty"`
+	Value         float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	Score         float64                `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Anomaly) Reset() {
+	*x = Anomaly{}
+	mi := &file_proto_data_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Anomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly) ProtoMessage() {}
+
+func (x *Anomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly.ProtoReflect.Descriptor instead.
+func (*Anomaly) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Anomaly) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Anomaly) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *Anomaly) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *Anomaly) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *Anomaly) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Anomaly) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ParseBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*ParseRequest        `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseBatchRequest) Reset() {
+	*x = ParseBatchRequest{}
+	mi := &file_proto_data_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseBatchRequest) ProtoMessage() {}
+
+func (x *ParseBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseBatchRequest.ProtoReflect.Descriptor instead.
+func (*ParseBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ParseBatchRequest) GetRequests() []*ParseRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type ParseBatchItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *ParseResponse         `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseBatchItem) Reset() {
+	*x = ParseBatchItem{}
+	mi := &file_proto_data_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseBatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseBatchItem) ProtoMessage() {}
+
+func (x *ParseBatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseBatchItem.ProtoReflect.Descriptor instead.
+func (*ParseBatchItem) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ParseBatchItem) GetResponse() *ParseResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ParseBatchItem) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ParseBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ParseBatchItem      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Failed        int64                  `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseBatchResponse) Reset() {
+	*x = ParseBatchResponse{}
+	mi := &file_proto_data_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseBatchResponse) ProtoMessage() {}
+
+func (x *ParseBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseBatchResponse.ProtoReflect.Descriptor instead.
+func (*ParseBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ParseBatchResponse) GetItems() []*ParseBatchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ParseBatchResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type LiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Options       *ConvertOptions        `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveRequest) Reset() {
+	*x = LiveRequest{}
+	mi := &file_proto_data_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveRequest) ProtoMessage() {}
+
+func (x *LiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveRequest.ProtoReflect.Descriptor instead.
+func (*LiveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LiveRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *LiveRequest) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *LiveRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type LiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Object        string                 `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Line          int64                  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	Warnings      []string               `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveResponse) Reset() {
+	*x = LiveResponse{}
+	mi := &file_proto_data_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveResponse) ProtoMessage() {}
+
+func (x *LiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveResponse.ProtoReflect.Descriptor instead.
+func (*LiveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LiveResponse) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *LiveResponse) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *LiveResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type JobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobRequest) Reset() {
+	*x = JobRequest{}
+	mi := &file_proto_data_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRequest) ProtoMessage() {}
+
+func (x *JobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRequest.ProtoReflect.Descriptor instead.
+func (*JobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *JobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type JobStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    string                 `protobuf:"bytes,6,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobStatus) Reset() {
+	*x = JobStatus{}
+	mi := &file_proto_data_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobStatus) ProtoMessage() {}
+
+func (x *JobStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobStatus.ProtoReflect.Descriptor instead.
+func (*JobStatus) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *JobStatus) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *JobStatus) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *JobStatus) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *JobStatus) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+type UsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        string                 `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	All           bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageRequest) Reset() {
+	*x = UsageRequest{}
+	mi := &file_proto_data_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRequest) ProtoMessage() {}
+
+func (x *UsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRequest.ProtoReflect.Descriptor instead.
+func (*UsageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UsageRequest) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *UsageRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type ClientUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        string                 `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Requests      int64                  `protobuf:"varint,3,opt,name=requests,proto3" json:"requests,omitempty"`
+	BytesIn       int64                  `protobuf:"varint,4,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	BytesOut      int64                  `protobuf:"varint,5,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	Rejected      int64                  `protobuf:"varint,6,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	QuotaBytes    int64                  `protobuf:"varint,7,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+	QuotaRequests int64                  `protobuf:"varint,8,opt,name=quota_requests,json=quotaRequests,proto3" json:"quota_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientUsage) Reset() {
+	*x = ClientUsage{}
+	mi := &file_proto_data_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientUsage) ProtoMessage() {}
+
+func (x *ClientUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientUsage.ProtoReflect.Descriptor instead.
+func (*ClientUsage) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ClientUsage) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *ClientUsage) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ClientUsage) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *ClientUsage) GetBytesIn() int64 {
+	if x != nil {
+		return x.BytesIn
+	}
+	return 0
+}
+
+func (x *ClientUsage) GetBytesOut() int64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
+}
+
+func (x *ClientUsage) GetRejected() int64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *ClientUsage) GetQuotaBytes() int64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
+}
+
+func (x *ClientUsage) GetQuotaRequests() int64 {
+	if x != nil {
+		return x.QuotaRequests
+	}
+	return 0
+}
+
+type UsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Usage         []*ClientUsage         `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageResponse) Reset() {
+	*x = UsageResponse{}
+	mi := &file_proto_data_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageResponse) ProtoMessage() {}
+
+func (x *UsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageResponse.ProtoReflect.Descriptor instead.
+func (*UsageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UsageResponse) GetUsage() []*ClientUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type ListJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_proto_data_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListJobsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*JobStatus           `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	mi := &file_proto_data_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListJobsResponse) GetJobs() []*JobStatus {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type HistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        string                 `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Since         string                 `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	Until         string                 `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"`
+	FailedOnly    bool                   `protobuf:"varint,5,opt,name=failed_only,json=failedOnly,proto3" json:"failed_only,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryRequest) Reset() {
+	*x = HistoryRequest{}
+	mi := &file_proto_data_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryRequest) ProtoMessage() {}
+
+func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryRequest.ProtoReflect.Descriptor instead.
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HistoryRequest) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *HistoryRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *HistoryRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *HistoryRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *HistoryRequest) GetFailedOnly() bool {
+	if x != nil {
+		return x.FailedOnly
+	}
+	return false
+}
+
+func (x *HistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Conversion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Time          string                 `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Client        string                 `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	From          string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	BytesIn       int64                  `protobuf:"varint,7,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	BytesOut      int64                  `protobuf:"varint,8,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	DurationMs    float64                `protobuf:"fixed64,9,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Code          string                 `protobuf:"bytes,10,opt,name=code,proto3" json:"code,omitempty"`
+	Error         string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Conversion) Reset() {
+	*x = Conversion{}
+	mi := &file_proto_data_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Conversion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conversion) ProtoMessage() {}
+
+func (x *Conversion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conversion.ProtoReflect.Descriptor instead.
+func (*Conversion) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Conversion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Conversion) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *Conversion) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *Conversion) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Conversion) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Conversion) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Conversion) GetBytesIn() int64 {
+	if x != nil {
+		return x.BytesIn
+	}
+	return 0
+}
+
+func (x *Conversion) GetBytesOut() int64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
+}
+
+func (x *Conversion) GetDurationMs() float64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *Conversion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Conversion) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type HistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversions   []*Conversion          `protobuf:"bytes,1,rep,name=conversions,proto3" json:"conversions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryResponse) Reset() {
+	*x = HistoryResponse{}
+	mi := &file_proto_data_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryResponse) ProtoMessage() {}
+
+func (x *HistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryResponse.ProtoReflect.Descriptor instead.
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HistoryResponse) GetConversions() []*Conversion {
+	if x != nil {
+		return x.Conversions
+	}
+	return nil
+}
+
+type ConversionStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RowsRead      int64                  `protobuf:"varint,1,opt,name=rows_read,json=rowsRead,proto3" json:"rows_read,omitempty"`
+	RowsSkipped   int64                  `protobuf:"varint,2,opt,name=rows_skipped,json=rowsSkipped,proto3" json:"rows_skipped,omitempty"`
+	RowsWritten   int64                  `protobuf:"varint,3,opt,name=rows_written,json=rowsWritten,proto3" json:"rows_written,omitempty"`
+	Columns       []string               `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	ColumnTypes   map[string]string      `protobuf:"bytes,5,rep,name=column_types,json=columnTypes,proto3" json:"column_types,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DurationMs    float64                `protobuf:"fixed64,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConversionStats) Reset() {
+	*x = ConversionStats{}
+	mi := &file_proto_data_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversionStats) ProtoMessage() {}
+
+func (x *ConversionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversionStats.ProtoReflect.Descriptor instead.
+func (*ConversionStats) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ConversionStats) GetRowsRead() int64 {
+	if x != nil {
+		return x.RowsRead
+	}
+	return 0
+}
+
+func (x *ConversionStats) GetRowsSkipped() int64 {
+	if x != nil {
+		return x.RowsSkipped
+	}
+	return 0
+}
+
+func (x *ConversionStats) GetRowsWritten() int64 {
+	if x != nil {
+		return x.RowsWritten
+	}
+	return 0
+}
+
+func (x *ConversionStats) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ConversionStats) GetColumnTypes() map[string]string {
+	if x != nil {
+		return x.ColumnTypes
+	}
+	return nil
+}
+
+func (x *ConversionStats) GetDurationMs() float64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type RowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int64                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column        string                 `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowError) Reset() {
+	*x = RowError{}
+	mi := &file_proto_data_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowError) ProtoMessage() {}
+
+func (x *RowError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowError.ProtoReflect.Descriptor instead.
+func (*RowError) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RowError) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *RowError) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *RowError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MergeInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeInput) Reset() {
+	*x = MergeInput{}
+	mi := &file_proto_data_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeInput) ProtoMessage() {}
+
+func (x *MergeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeInput.ProtoReflect.Descriptor instead.
+func (*MergeInput) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MergeInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MergeInput) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *MergeInput) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type MergeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Inputs        []*MergeInput          `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Keys          []string               `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys,omitempty"`
+	Join          string                 `protobuf:"bytes,5,opt,name=join,proto3" json:"join,omitempty"`
+	SourceColumn  string                 `protobuf:"bytes,6,opt,name=source_column,json=sourceColumn,proto3" json:"source_column,omitempty"`
+	Options       *ConvertOptions        `protobuf:"bytes,7,opt,name=options,proto3" json:"options,omitempty"`
+	TimeColumn    string                 `protobuf:"bytes,8,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	Tolerance     string                 `protobuf:"bytes,9,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	Method        string                 `protobuf:"bytes,10,opt,name=method,proto3" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeRequest) Reset() {
+	*x = MergeRequest{}
+	mi := &file_proto_data_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeRequest) ProtoMessage() {}
+
+func (x *MergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeRequest.ProtoReflect.Descriptor instead.
+func (*MergeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MergeRequest) GetInputs() []*MergeInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *MergeRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *MergeRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *MergeRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *MergeRequest) GetJoin() string {
+	if x != nil {
+		return x.Join
+	}
+	return ""
+}
+
+func (x *MergeRequest) GetSourceColumn() string {
+	if x != nil {
+		return x.SourceColumn
+	}
+	return ""
+}
+
+func (x *MergeRequest) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *MergeRequest) GetTimeColumn() string {
+	if x != nil {
+		return x.TimeColumn
+	}
+	return ""
+}
+
+func (x *MergeRequest) GetTolerance() string {
+	if x != nil {
+		return x.Tolerance
+	}
+	return ""
+}
+
+func (x *MergeRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+type PipelineStep struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Type           string                  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Filter         string                  `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Rename         map[string]string       `protobuf:"bytes,3,rep,name=rename,proto3" json:"rename,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Column         string                  `protobuf:"bytes,4,opt,name=column,proto3" json:"column,omitempty"`
+	FromUnit       string                  `protobuf:"bytes,5,opt,name=from_unit,json=fromUnit,proto3" json:"from_unit,omitempty"`
+	ToUnit         string                  `protobuf:"bytes,6,opt,name=to_unit,json=toUnit,proto3" json:"to_unit,omitempty"`
+	Expression     string                  `protobuf:"bytes,7,opt,name=expression,proto3" json:"expression,omitempty"`
+	QualityControl *QualityControlConfig   `protobuf:"bytes,8,opt,name=quality_control,json=qualityControl,proto3" json:"quality_control,omitempty"`
+	Anomalies      *AnomalyDetectionConfig `protobuf:"bytes,9,opt,name=anomalies,proto3" json:"anomalies,omitempty"`
+	Interpolation  *InterpolationConfig    `protobuf:"bytes,10,opt,name=interpolation,proto3" json:"interpolation,omitempty"`
+	Aggregation    *AggregationConfig      `protobuf:"bytes,11,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	Smoothing      *SmoothingConfig        `protobuf:"bytes,12,opt,name=smoothing,proto3" json:"smoothing,omitempty"`
+	DepthBinning   *DepthBinningConfig     `protobuf:"bytes,13,opt,name=depth_binning,json=depthBinning,proto3" json:"depth_binning,omitempty"`
+	Coordinates    *CoordinatesConfig      `protobuf:"bytes,14,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	Geofence       *GeofenceConfig         `protobuf:"bytes,15,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	Track          *TrackConfig            `protobuf:"bytes,16,opt,name=track,proto3" json:"track,omitempty"`
+	Enrichment     *EnrichmentConfig       `protobuf:"bytes,17,opt,name=enrichment,proto3" json:"enrichment,omitempty"`
+	Calibration    *CalibrateConfig        `protobuf:"bytes,18,opt,name=calibration,proto3" json:"calibration,omitempty"`
+	Drift          *DriftConfig            `protobuf:"bytes,19,opt,name=drift,proto3" json:"drift,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PipelineStep) Reset() {
+	*x = PipelineStep{}
+	mi := &file_proto_data_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelineStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineStep) ProtoMessage() {}
+
+func (x *PipelineStep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineStep.ProtoReflect.Descriptor instead.
+func (*PipelineStep) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PipelineStep) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PipelineStep) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *PipelineStep) GetRename() map[string]string {
+	if x != nil {
+		return x.Rename
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *PipelineStep) GetFromUnit() string {
+	if x != nil {
+		return x.FromUnit
+	}
+	return ""
+}
+
+func (x *PipelineStep) GetToUnit() string {
+	if x != nil {
+		return x.ToUnit
+	}
+	return ""
+}
+
+func (x *PipelineStep) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *PipelineStep) GetQualityControl() *QualityControlConfig {
+	if x != nil {
+		return x.QualityControl
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetAnomalies() *AnomalyDetectionConfig {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetInterpolation() *InterpolationConfig {
+	if x != nil {
+		return x.Interpolation
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetAggregation() *AggregationConfig {
+	if x != nil {
+		return x.Aggregation
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetSmoothing() *SmoothingConfig {
+	if x != nil {
+		return x.Smoothing
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetDepthBinning() *DepthBinningConfig {
+	if x != nil {
+		return x.DepthBinning
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetCoordinates() *CoordinatesConfig {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetGeofence() *GeofenceConfig {
+	if x != nil {
+		return x.Geofence
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetTrack() *TrackConfig {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetEnrichment() *EnrichmentConfig {
+	if x != nil {
+		return x.Enrichment
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetCalibration() *CalibrateConfig {
+	if x != nil {
+		return x.Calibration
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetDrift() *DriftConfig {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+type PipelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	RawData       []byte                 `protobuf:"bytes,4,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	Steps         []*PipelineStep        `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
+	Options       *ConvertOptions        `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PipelineRequest) Reset() {
+	*x = PipelineRequest{}
+	mi := &file_proto_data_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineRequest) ProtoMessage() {}
+
+func (x *PipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineRequest.ProtoReflect.Descriptor instead.
+func (*PipelineRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PipelineRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PipelineRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PipelineRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *PipelineRequest) GetRawData() []byte {
+	if x != nil {
+		return x.RawData
+	}
+	return nil
+}
+
+func (x *PipelineRequest) GetSteps() []*PipelineStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *PipelineRequest) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type GrossRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FailMin       float64                `protobuf:"fixed64,1,opt,name=fail_min,json=failMin,proto3" json:"fail_min,omitempty"`
+	FailMax       float64                `protobuf:"fixed64,2,opt,name=fail_max,json=failMax,proto3" json:"fail_max,omitempty"`
+	SuspectMin    float64                `protobuf:"fixed64,3,opt,name=suspect_min,json=suspectMin,proto3" json:"suspect_min,omitempty"`
+	SuspectMax    float64                `protobuf:"fixed64,4,opt,name=suspect_max,json=suspectMax,proto3" json:"suspect_max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrossRange) Reset() {
+	*x = GrossRange{}
+	mi := &file_proto_data_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrossRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrossRange) ProtoMessage() {}
+
+func (x *GrossRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrossRange.ProtoReflect.Descriptor instead.
+func (*GrossRange) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GrossRange) GetFailMin() float64 {
+	if x != nil {
+		return x.FailMin
+	}
+	return 0
+}
+
+func (x *GrossRange) GetFailMax() float64 {
+	if x != nil {
+		return x.FailMax
+	}
+	return 0
+}
+
+func (x *GrossRange) GetSuspectMin() float64 {
+	if x != nil {
+		return x.SuspectMin
+	}
+	return 0
+}
+
+func (x *GrossRange) GetSuspectMax() float64 {
+	if x != nil {
+		return x.SuspectMax
+	}
+	return 0
+}
+
+type SpikeTest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suspect       float64                `protobuf:"fixed64,1,opt,name=suspect,proto3" json:"suspect,omitempty"`
+	Fail          float64                `protobuf:"fixed64,2,opt,name=fail,proto3" json:"fail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpikeTest) Reset() {
+	*x = SpikeTest{}
+	mi := &file_proto_data_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpikeTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpikeTest) ProtoMessage() {}
+
+func (x *SpikeTest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpikeTest.ProtoReflect.Descriptor instead.
+func (*SpikeTest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SpikeTest) GetSuspect() float64 {
+	if x != nil {
+		return x.Suspect
+	}
+	return 0
+}
+
+func (x *SpikeTest) GetFail() float64 {
+	if x != nil {
+		return x.Fail
+	}
+	return 0
+}
+
+type FlatLineTest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suspect       int32                  `protobuf:"varint,1,opt,name=suspect,proto3" json:"suspect,omitempty"`
+	Fail          int32                  `protobuf:"varint,2,opt,name=fail,proto3" json:"fail,omitempty"`
+	Tolerance     float64                `protobuf:"fixed64,3,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlatLineTest) Reset() {
+	*x = FlatLineTest{}
+	mi := &file_proto_data_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlatLineTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlatLineTest) ProtoMessage() {}
+
+func (x *FlatLineTest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlatLineTest.ProtoReflect.Descriptor instead.
+func (*FlatLineTest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FlatLineTest) GetSuspect() int32 {
+	if x != nil {
+		return x.Suspect
+	}
+	return 0
+}
+
+func (x *FlatLineTest) GetFail() int32 {
+	if x != nil {
+		return x.Fail
+	}
+	return 0
+}
+
+func (x *FlatLineTest) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+type QcTest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Station       string                 `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	Column        string                 `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	GrossRange    *GrossRange            `protobuf:"bytes,3,opt,name=gross_range,json=grossRange,proto3" json:"gross_range,omitempty"`
+	Spike         *SpikeTest             `protobuf:"bytes,4,opt,name=spike,proto3" json:"spike,omitempty"`
+	RateOfChange  float64                `protobuf:"fixed64,5,opt,name=rate_of_change,json=rateOfChange,proto3" json:"rate_of_change,omitempty"`
+	FlatLine      *FlatLineTest          `protobuf:"bytes,6,opt,name=flat_line,json=flatLine,proto3" json:"flat_line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QcTest) Reset() {
+	*x = QcTest{}
+	mi := &file_proto_data_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QcTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QcTest) ProtoMessage() {}
+
+func (x *QcTest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QcTest.ProtoReflect.Descriptor instead.
+func (*QcTest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *QcTest) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *QcTest) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *QcTest) GetGrossRange() *GrossRange {
+	if x != nil {
+		return x.GrossRange
+	}
+	return nil
+}
+
+func (x *QcTest) GetSpike() *SpikeTest {
+	if x != nil {
+		return x.Spike
+	}
+	return nil
+}
+
+func (x *QcTest) GetRateOfChange() float64 {
+	if x != nil {
+		return x.RateOfChange
+	}
+	return 0
+}
+
+func (x *QcTest) GetFlatLine() *FlatLineTest {
+	if x != nil {
+		return x.FlatLine
+	}
+	return nil
+}
+
+type LocationTest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Station         string                 `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	LatitudeColumn  string                 `protobuf:"bytes,2,opt,name=latitude_column,json=latitudeColumn,proto3" json:"latitude_column,omitempty"`
+	LongitudeColumn string                 `protobuf:"bytes,3,opt,name=longitude_column,json=longitudeColumn,proto3" json:"longitude_column,omitempty"`
+	MinLatitude     float64                `protobuf:"fixed64,4,opt,name=min_latitude,json=minLatitude,proto3" json:"min_latitude,omitempty"`
+	MaxLatitude     float64                `protobuf:"fixed64,5,opt,name=max_latitude,json=maxLatitude,proto3" json:"max_latitude,omitempty"`
+	MinLongitude    float64                `protobuf:"fixed64,6,opt,name=min_longitude,json=minLongitude,proto3" json:"min_longitude,omitempty"`
+	MaxLongitude    float64                `protobuf:"fixed64,7,opt,name=max_longitude,json=maxLongitude,proto3" json:"max_longitude,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LocationTest) Reset() {
+	*x = LocationTest{}
+	mi := &file_proto_data_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationTest) ProtoMessage() {}
+
+func (x *LocationTest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationTest.ProtoReflect.Descriptor instead.
+func (*LocationTest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LocationTest) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *LocationTest) GetLatitudeColumn() string {
+	if x != nil {
+		return x.LatitudeColumn
+	}
+	return ""
+}
+
+func (x *LocationTest) GetLongitudeColumn() string {
+	if x != nil {
+		return x.LongitudeColumn
+	}
+	return ""
+}
+
+func (x *LocationTest) GetMinLatitude() float64 {
+	if x != nil {
+		return x.MinLatitude
+	}
+	return 0
+}
+
+func (x *LocationTest) GetMaxLatitude() float64 {
+	if x != nil {
+		return x.MaxLatitude
+	}
+	return 0
+}
+
+func (x *LocationTest) GetMinLongitude() float64 {
+	if x != nil {
+		return x.MinLongitude
+	}
+	return 0
+}
+
+func (x *LocationTest) GetMaxLongitude() float64 {
+	if x != nil {
+		return x.MaxLongitude
+	}
+	return 0
+}
+
+type QualityControlConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StationColumn string                 `protobuf:"bytes,1,opt,name=station_column,json=stationColumn,proto3" json:"station_column,omitempty"`
+	TimeColumn    string                 `protobuf:"bytes,2,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	FlagSuffix    string                 `protobuf:"bytes,3,opt,name=flag_suffix,json=flagSuffix,proto3" json:"flag_suffix,omitempty"`
+	Tests         []*QcTest              `protobuf:"bytes,4,rep,name=tests,proto3" json:"tests,omitempty"`
+	Locations     []*LocationTest        `protobuf:"bytes,5,rep,name=locations,proto3" json:"locations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QualityControlConfig) Reset() {
+	*x = QualityControlConfig{}
+	mi := &file_proto_data_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QualityControlConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityControlConfig) ProtoMessage() {}
+
+func (x *QualityControlConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityControlConfig.ProtoReflect.Descriptor instead.
+func (*QualityControlConfig) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QualityControlConfig) GetStationColumn() string {
+	if x != nil {
+		return x.StationColumn
+	}
+	return ""
+}
+
+func (x *QualityControlConfig) GetTimeColumn() string {
+	if x != nil {
+		return x.TimeColumn
+	}
+	return ""
+}
+
+func (x *QualityControlConfig) GetFlagSuffix() string {
+	if x != nil {
+		return x.FlagSuffix
+	}
+	return ""
+}
+
+func (x *QualityControlConfig) GetTests() []*QcTest {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+func (x *QualityControlConfig) GetLocations() []*LocationTest {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type QualityControlRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	RawData       []byte                 `protobuf:"bytes,4,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	Config        *QualityControlConfig  `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	Options       *ConvertOptions        `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QualityControlRequest) Reset() {
+	*x = QualityControlRequest{}
+	mi := &file_proto_data_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QualityControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityControlRequest) ProtoMessage() {}
+
+func (x *QualityControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityControlRequest.ProtoReflect.Descriptor instead.
+func (*QualityControlRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QualityControlRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *QualityControlRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *QualityControlRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *QualityControlRequest) GetRawData() []byte {
+	if x != nil {
+		return x.RawData
+	}
+	return nil
+}
+
+func (x *QualityControlRequest) GetConfig() *QualityControlConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *QualityControlRequest) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type AnomalyDetectionConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []string               `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Window        int32                  `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	StationColumn string                 `protobuf:"bytes,5,opt,name=station_column,json=stationColumn,proto3" json:"station_column,omitempty"`
+	FlagSuffix    string                 `protobuf:"bytes,6,opt,name=flag_suffix,json=flagSuffix,proto3" json:"flag_suffix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomalyDetectionConfig) Reset() {
+	*x = AnomalyDetectionConfig{}
+	mi := &file_proto_data_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalyDetectionConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyDetectionConfig) ProtoMessage() {}
+
+func (x *AnomalyDetectionConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyDetectionConfig.ProtoReflect.Descriptor instead.
+func (*AnomalyDetectionConfig) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AnomalyDetectionConfig) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *AnomalyDetectionConfig) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AnomalyDetectionConfig) GetWindow() int32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *AnomalyDetectionConfig) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AnomalyDetectionConfig) GetStationColumn() string {
+	if x != nil {
+		return x.StationColumn
+	}
+	return ""
+}
+
+func (x *AnomalyDetectionConfig) GetFlagSuffix() string {
+	if x != nil {
+		return x.FlagSuffix
+	}
+	return ""
+}
+
+type InterpolationConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeColumn    string                 `protobuf:"bytes,1,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	StationColumn string                 `protobuf:"bytes,2,opt,name=station_column,json=stationColumn,proto3" json:"station_column,omitempty"`
+	Columns       []string               `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Interval      string                 `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	MaxGap        string                 `protobuf:"bytes,6,opt,name=max_gap,json=maxGap,proto3" json:"max_gap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterpolationConfig) Reset() {
+	*x = InterpolationConfig{}
+	mi := &file_proto_data_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterpolationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterpolationConfig) ProtoMessage() {}
+
+func (x *InterpolationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterpolationConfig.ProtoReflect.Descriptor instead.
+func (*InterpolationConfig) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InterpolationConfig) GetTimeColumn() string {
+	if x != nil {
+		return x.TimeColumn
+	}
+	return ""
+}
+
+func (x *InterpolationConfig) GetStationColumn() string {
+	if x != nil {
+		return x.StationColumn
+	}
+	return ""
+}
+
+func (x *InterpolationConfig) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *InterpolationConfig) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *InterpolationConfig) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *InterpolationConfig) GetMaxGap() string {
+	if x != nil {
+		return x.MaxGap
+	}
+	return ""
+}
+
+type AggregationConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeColumn    string                 `protobuf:"bytes,1,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	StationColumn string                 `protobuf:"bytes,2,opt,name=station_column,json=stationColumn,proto3" json:"station_column,omitempty"`
+	Window        string                 `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	Columns       []string               `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	Functions     []string               `protobuf:"bytes,5,rep,name=functions,proto3" json:"functions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregationConfig) Reset() {
+	*x = AggregationConfig{}
+	mi := &file_proto_data_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregationConfig) ProtoMessage() {}
+
+func (x *AggregationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregationConfig.ProtoReflect.Descriptor instead.
+func (*AggregationConfig) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AggregationConfig) GetTimeColumn() string {
+	if x != nil {
+		return x.TimeColumn
+	}
+	return ""
+}
+
+func (x *AggregationConfig) GetStationColumn() string {
+	if x != nil {
+		return x.StationColumn
+	}
+	return ""
+}
+
+func (x *AggregationConfig) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *AggregationConfig) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *AggregationConfig) GetFunctions() []string {
+	if x != nil {
+		return x.Functions
+	}
+	return nil
+}
+
+type SmoothingConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []string               `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Window        int32                  `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+	Trailing      bool                   `protobuf:"varint,4,opt,name=trailing,proto3" json:"trailing,omitempty"`
+	StationColumn string                 `protobuf:"bytes,5,opt,name=station_column,json=stationColumn,proto3" json:"station_column,omitempty"`
+	Suffix        string                 `protobuf:"bytes,6,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SmoothingConfig) Reset() {
+	*x = SmoothingConfig{}
+	mi := &file_proto_data_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SmoothingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmoothingConfig) ProtoMessage() {}
+
+func (x *SmoothingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmoothingConfig.ProtoReflect.Descriptor instead.
+func (*SmoothingConfig) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SmoothingConfig) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *SmoothingConfig) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SmoothingConfig) GetWindow() int32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *SmoothingConfig) GetTrailing() bool {
+	if x != nil {
+		return x.Trailing
+	}
+	return false
+}
+
+func (x *SmoothingConfig) GetStationColumn() string {
+	if x != nil {
+		return x.StationColumn
+	}
+	return ""
+}
+
+func (x *SmoothingConfig) GetSuffix() string {
+	if x != nil {
+		return x.Suffix
+	}
+	return ""
+}
+
+type DepthBinningConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DepthColumn   string                 `protobuf:"bytes,1,opt,name=depth_column,json=depthColumn,proto3" json:"depth_column,omitempty"`
+	BinSize       float64                `protobuf:"fixed64,2,opt,name=bin_size,json=binSize,proto3" json:"bin_size,omitempty"`
+	Columns       []string               `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	GroupColumns  []string               `protobuf:"bytes,4,rep,name=group_columns,json=groupColumns,proto3" json:"group_columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepthBinningConfig) Reset() {
+	*x = DepthBinningConfig{}
+	mi := &file_proto_data_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepthBinningConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepthBinningConfig) ProtoMessage() {}
+
+func (x *DepthBinningConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepthBinningConfig.ProtoReflect.Descriptor instead.
+func (*DepthBinningConfig) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DepthBinningConfig) GetDepthColumn() string {
+	if x != nil {
+		return x.DepthColumn
+	}
+	return ""
+}
+
+func (x *DepthBinningConfig) GetBinSize() float64 {
+	if x != nil {
+		return x.BinSize
+	}
+	return 0
+}
+
+func (x *DepthBinningConfig) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *DepthBinningConfig) GetGroupColumns() []string {
+	if x != nil {
+		return x.GroupColumns
+	}
+	return nil
+}
+
+type CoordinatesConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LatitudeColumn  string                 `protobuf:"bytes,1,opt,name=latitude_column,json=latitudeColumn,proto3" json:"latitude_column,omitempty"`
+	LongitudeColumn string                 `protobuf:"bytes,2,opt,name=longitude_column,json=longitudeColumn,proto3" json:"longitude_column,omitempty"`
+	From            string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To              string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CoordinatesConfig) Reset() {
+	*x = CoordinatesConfig{}
+	mi := &file_proto_data_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoordinatesConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoordinatesConfig) ProtoMessage() {}
+
+func (x *CoordinatesConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoordinatesConfig.ProtoReflect.Descriptor instead.
+func (*CoordinatesConfig) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CoordinatesConfig) GetLatitudeColumn() string {
+	if x != nil {
+		return x.LatitudeColumn
+	}
+	return ""
+}
+
+func (x *CoordinatesConfig) GetLongitudeColumn() string {
+	if x != nil {
+		return x.LongitudeColumn
+	}
+	return ""
+}
+
+func (x *CoordinatesConfig) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CoordinatesConfig) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type BoundingBox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinLatitude   float64                `protobuf:"fixed64,1,opt,name=min_latitude,json=minLatitude,proto3" json:"min_latitude,omitempty"`
+	MinLongitude  float64                `protobuf:"fixed64,2,opt,name=min_longitude,json=minLongitude,proto3" json:"min_longitude,omitempty"`
+	MaxLatitude   float64                `protobuf:"fixed64,3,opt,name=max_latitude,json=maxLatitude,proto3" json:"max_latitude,omitempty"`
+	MaxLongitude  float64                `protobuf:"fixed64,4,opt,name=max_longitude,json=maxLongitude,proto3" json:"max_longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoundingBox) Reset() {
+	*x = BoundingBox{}
+	mi := &file_proto_data_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoundingBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoundingBox) ProtoMessage() {}
+
+func (x *BoundingBox) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
+func (*BoundingBox) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BoundingBox) GetMinLatitude() float64 {
+	if x != nil {
+		return x.MinLatitude
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMinLongitude() float64 {
+	if x != nil {
+		return x.MinLongitude
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMaxLatitude() float64 {
+	if x != nil {
+		return x.MaxLatitude
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMaxLongitude() float64 {
+	if x != nil {
+		return x.MaxLongitude
+	}
+	return 0
+}
+
+type GeofenceConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LatitudeColumn  string                 `protobuf:"bytes,1,opt,name=latitude_column,json=latitudeColumn,proto3" json:"latitude_column,omitempty"`
+	LongitudeColumn string                 `protobuf:"bytes,2,opt,name=longitude_column,json=longitudeColumn,proto3" json:"longitude_column,omitempty"`
+	BoundingBox     *BoundingBox           `protobuf:"bytes,3,opt,name=bounding_box,json=boundingBox,proto3" json:"bounding_box,omitempty"`
+	Geojson         string                 `protobuf:"bytes,4,opt,name=geojson,proto3" json:"geojson,omitempty"`
+	Exclude         bool                   `protobuf:"varint,5,opt,name=exclude,proto3" json:"exclude,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GeofenceConfig) Reset() {
+	*x = GeofenceConfig{}
+	mi := &file_proto_data_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeofenceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeofenceConfig) ProtoMessage() {}
+
+func (x *GeofenceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeofenceConfig.ProtoReflect.Descriptor instead.
+func (*GeofenceConfig) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GeofenceConfig) GetLatitudeColumn() string {
+	if x != nil {
+		return x.LatitudeColumn
+	}
+	return ""
+}
+
+func (x *GeofenceConfig) GetLongitudeColumn() string {
+	if x != nil {
+		return x.LongitudeColumn
+	}
+	return ""
+}
+
+func (x *GeofenceConfig) GetBoundingBox() *BoundingBox {
+	if x != nil {
+		return x.BoundingBox
+	}
+	return nil
+}
+
+func (x *GeofenceConfig) GetGeojson() string {
+	if x != nil {
+		return x.Geojson
+	}
+	return ""
+}
+
+func (x *GeofenceConfig) GetExclude() bool {
+	if x != nil {
+		return x.Exclude
+	}
+	return false
+}
+
+type Point struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Point) Reset() {
+	*x = Point{}
+	mi := &file_proto_data_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Point) ProtoMessage() {}
+
+func (x *Point) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Point.ProtoReflect.Descriptor instead.
+func (*Point) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Point) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Point) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type TrackConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LatitudeColumn  string                 `protobuf:"bytes,1,opt,name=latitude_column,json=latitudeColumn,proto3" json:"latitude_column,omitempty"`
+	LongitudeColumn string                 `protobuf:"bytes,2,opt,name=longitude_column,json=longitudeColumn,proto3" json:"longitude_column,omitempty"`
+	TimeColumn      string                 `protobuf:"bytes,3,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	StationColumn   string                 `protobuf:"bytes,4,opt,name=station_column,json=stationColumn,proto3" json:"station_column,omitempty"`
+	Reference       *Point                 `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	DistanceColumn  string                 `protobuf:"bytes,6,opt,name=distance_column,json=distanceColumn,proto3" json:"distance_column,omitempty"`
+	StepColumn      string                 `protobuf:"bytes,7,opt,name=step_column,json=stepColumn,proto3" json:"step_column,omitempty"`
+	SpeedColumn     string                 `protobuf:"bytes,8,opt,name=speed_column,json=speedColumn,proto3" json:"speed_column,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TrackConfig) Reset() {
+	*x = TrackConfig{}
+	mi := &file_proto_data_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackConfig) ProtoMessage() {}
+
+func (x *TrackConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackConfig.ProtoReflect.Descriptor instead.
+func (*TrackConfig) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TrackConfig) GetLatitudeColumn() string {
+	if x != nil {
+		return x.LatitudeColumn
+	}
+	return ""
+}
+
+func (x *TrackConfig) GetLongitudeColumn() string {
+	if x != nil {
+		return x.LongitudeColumn
+	}
+	return ""
+}
+
+func (x *TrackConfig) GetTimeColumn() string {
+	if x != nil {
+		return x.TimeColumn
+	}
+	return ""
+}
+
+func (x *TrackConfig) GetStationColumn() string {
+	if x != nil {
+		return x.StationColumn
+	}
+	return ""
+}
+
+func (x *TrackConfig) GetReference() *Point {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *TrackConfig) GetDistanceColumn() string {
+	if x != nil {
+		return x.DistanceColumn
+	}
+	return ""
+}
+
+func (x *TrackConfig) GetStepColumn() string {
+	if x != nil {
+		return x.StepColumn
+	}
+	return ""
+}
+
+func (x *TrackConfig) GetSpeedColumn() string {
+	if x != nil {
+		return x.SpeedColumn
+	}
+	return ""
+}
+
+type EnrichmentConfig struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StationColumn  string                 `protobuf:"bytes,1,opt,name=station_column,json=stationColumn,proto3" json:"station_column,omitempty"`
+	TimeColumn     string                 `protobuf:"bytes,2,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	Fields         []string               `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	Prefix         string                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	RequireStation bool                   `protobuf:"varint,5,opt,name=require_station,json=requireStation,proto3" json:"require_station,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EnrichmentConfig) Reset() {
+	*x = EnrichmentConfig{}
+	mi := &file_proto_data_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrichmentConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrichmentConfig) ProtoMessage() {}
+
+func (x *EnrichmentConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrichmentConfig.ProtoReflect.Descriptor instead.
+func (*EnrichmentConfig) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *EnrichmentConfig) GetStationColumn() string {
+	if x != nil {
+		return x.StationColumn
+	}
+	return ""
+}
+
+func (x *EnrichmentConfig) GetTimeColumn() string {
+	if x != nil {
+		return x.TimeColumn
+	}
+	return ""
+}
+
+func (x *EnrichmentConfig) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *EnrichmentConfig) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *EnrichmentConfig) GetRequireStation() bool {
+	if x != nil {
+		return x.RequireStation
+	}
+	return false
+}
+
+type Polynomial struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coefficients  []float64              `protobuf:"fixed64,1,rep,packed,name=coefficients,proto3" json:"coefficients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Polynomial) Reset() {
+	*x = Polynomial{}
+	mi := &file_proto_data_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Polynomial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Polynomial) ProtoMessage() {}
+
+func (x *Polynomial) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Polynomial.ProtoReflect.Descriptor instead.
+func (*Polynomial) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Polynomial) GetCoefficients() []float64 {
+	if x != nil {
+		return x.Coefficients
+	}
+	return nil
+}
+
+type CalibrateConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coefficients  map[string]*Polynomial `protobuf:"bytes,1,rep,name=coefficients,proto3" json:"coefficients,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StationColumn string                 `protobuf:"bytes,2,opt,name=station_column,json=stationColumn,proto3" json:"station_column,omitempty"`
+	TimeColumn    string                 `protobuf:"bytes,3,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	Columns       []string               `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	Suffix        string                 `protobuf:"bytes,5,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalibrateConfig) Reset() {
+	*x = CalibrateConfig{}
+	mi := &file_proto_data_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalibrateConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrateConfig) ProtoMessage() {}
+
+func (x *CalibrateConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrateConfig.ProtoReflect.Descriptor instead.
+func (*CalibrateConfig) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CalibrateConfig) GetCoefficients() map[string]*Polynomial {
+	if x != nil {
+		return x.Coefficients
+	}
+	return nil
+}
+
+func (x *CalibrateConfig) GetStationColumn() string {
+	if x != nil {
+		return x.StationColumn
+	}
+	return ""
+}
+
+func (x *CalibrateConfig) GetTimeColumn() string {
+	if x != nil {
+		return x.TimeColumn
+	}
+	return ""
+}
+
+func (x *CalibrateConfig) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *CalibrateConfig) GetSuffix() string {
+	if x != nil {
+		return x.Suffix
+	}
+	return ""
+}
+
+type DriftPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Offset        float64                `protobuf:"fixed64,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriftPoint) Reset() {
+	*x = DriftPoint{}
+	mi := &file_proto_data_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriftPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftPoint) ProtoMessage() {}
+
+func (x *DriftPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftPoint.ProtoReflect.Descriptor instead.
+func (*DriftPoint) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DriftPoint) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *DriftPoint) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type DriftConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []string               `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	TimeColumn    string                 `protobuf:"bytes,2,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	Points        []*DriftPoint          `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	Suffix        string                 `protobuf:"bytes,4,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriftConfig) Reset() {
+	*x = DriftConfig{}
+	mi := &file_proto_data_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriftConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftConfig) ProtoMessage() {}
+
+func (x *DriftConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftConfig.ProtoReflect.Descriptor instead.
+func (*DriftConfig) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DriftConfig) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *DriftConfig) GetTimeColumn() string {
+	if x != nil {
+		return x.TimeColumn
+	}
+	return ""
+}
+
+func (x *DriftConfig) GetPoints() []*DriftPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *DriftConfig) GetSuffix() string {
+	if x != nil {
+		return x.Suffix
+	}
+	return ""
+}
+
+type AggregateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	RawData       []byte                 `protobuf:"bytes,4,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	Config        *AggregationConfig     `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	Options       *ConvertOptions        `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateRequest) Reset() {
+	*x = AggregateRequest{}
+	mi := &file_proto_data_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest) ProtoMessage() {}
+
+func (x *AggregateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest.ProtoReflect.Descriptor instead.
+func (*AggregateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AggregateRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *AggregateRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *AggregateRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *AggregateRequest) GetRawData() []byte {
+	if x != nil {
+		return x.RawData
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetConfig() *AggregationConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type DetectAnomaliesRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	From          string                  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                  `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Data          string                  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	RawData       []byte                  `protobuf:"bytes,4,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	Config        *AnomalyDetectionConfig `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	Options       *ConvertOptions         `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectAnomaliesRequest) Reset() {
+	*x = DetectAnomaliesRequest{}
+	mi := &file_proto_data_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectAnomaliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectAnomaliesRequest) ProtoMessage() {}
+
+func (x *DetectAnomaliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectAnomaliesRequest.ProtoReflect.Descriptor instead.
+func (*DetectAnomaliesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DetectAnomaliesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *DetectAnomaliesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *DetectAnomaliesRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *DetectAnomaliesRequest) GetRawData() []byte {
+	if x != nil {
+		return x.RawData
+	}
+	return nil
+}
+
+func (x *DetectAnomaliesRequest) GetConfig() *AnomalyDetectionConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *DetectAnomaliesRequest) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type SplitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	RawData       []byte                 `protobuf:"bytes,4,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	By            string                 `protobuf:"bytes,5,opt,name=by,proto3" json:"by,omitempty"`
+	Rows          int64                  `protobuf:"varint,6,opt,name=rows,proto3" json:"rows,omitempty"`
+	Column        string                 `protobuf:"bytes,7,opt,name=column,proto3" json:"column,omitempty"`
+	Window        string                 `protobuf:"bytes,8,opt,name=window,proto3" json:"window,omitempty"`
+	Archive       bool                   `protobuf:"varint,9,opt,name=archive,proto3" json:"archive,omitempty"`
+	Options       *ConvertOptions        `protobuf:"bytes,10,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitRequest) Reset() {
+	*x = SplitRequest{}
+	mi := &file_proto_data_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitRequest) ProtoMessage() {}
+
+func (x *SplitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitRequest.ProtoReflect.Descriptor instead.
+func (*SplitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SplitRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SplitRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SplitRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *SplitRequest) GetRawData() []byte {
+	if x != nil {
+		return x.RawData
+	}
+	return nil
+}
+
+func (x *SplitRequest) GetBy() string {
+	if x != nil {
+		return x.By
+	}
+	return ""
+}
+
+func (x *SplitRequest) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *SplitRequest) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *SplitRequest) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *SplitRequest) GetArchive() bool {
+	if x != nil {
+		return x.Archive
+	}
+	return false
+}
+
+func (x *SplitRequest) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type Part struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rows          int64                  `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	Result        string                 `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	RawResult     []byte                 `protobuf:"bytes,4,opt,name=raw_result,json=rawResult,proto3" json:"raw_result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Part) Reset() {
+	*x = Part{}
+	mi := &file_proto_data_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Part) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Part) ProtoMessage() {}
+
+func (x *Part) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Part.ProtoReflect.Descriptor instead.
+func (*Part) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Part) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Part) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *Part) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *Part) GetRawResult() []byte {
+	if x != nil {
+		return x.RawResult
+	}
+	return nil
+}
+
+type SplitResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Parts             []*Part                `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	Archive           []byte                 `protobuf:"bytes,2,opt,name=archive,proto3" json:"archive,omitempty"`
+	Warnings          []string               `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Preamble          []string               `protobuf:"bytes,4,rep,name=preamble,proto3" json:"preamble,omitempty"`
+	Metadata          map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DuplicatesRemoved int64                  `protobuf:"varint,6,opt,name=duplicates_removed,json=duplicatesRemoved,proto3" json:"duplicates_removed,omitempty"`
+	RowErrors         []*RowError            `protobuf:"bytes,7,rep,name=row_errors,json=rowErrors,proto3" json:"row_errors,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SplitResponse) Reset() {
+	*x = SplitResponse{}
+	mi := &file_proto_data_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitResponse) ProtoMessage() {}
+
+func (x *SplitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitResponse.ProtoReflect.Descriptor instead.
+func (*SplitResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SplitResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *SplitResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *SplitResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *SplitResponse) GetPreamble() []string {
+	if x != nil {
+		return x.Preamble
+	}
+	return nil
+}
+
+func (x *SplitResponse) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SplitResponse) GetDuplicatesRemoved() int64 {
+	if x != nil {
+		return x.DuplicatesRemoved
+	}
+	return 0
+}
+
+func (x *SplitResponse) GetRowErrors() []*RowError {
+	if x != nil {
+		return x.RowErrors
+	}
+	return nil
+}
+
+type InferSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	RawData       []byte                 `protobuf:"bytes,3,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	SampleSize    int32                  `protobuf:"varint,4,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	Options       *ConvertOptions        `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InferSchemaRequest) Reset() {
+	*x = InferSchemaRequest{}
+	mi := &file_proto_data_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InferSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InferSchemaRequest) ProtoMessage() {}
+
+func (x *InferSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InferSchemaRequest.ProtoReflect.Descriptor instead.
+func (*InferSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *InferSchemaRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *InferSchemaRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *InferSchemaRequest) GetRawData() []byte {
+	if x != nil {
+		return x.RawData
+	}
+	return nil
+}
+
+func (x *InferSchemaRequest) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+func (x *InferSchemaRequest) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ColumnSchema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Nullable      bool                   `protobuf:"varint,3,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	NullCount     int64                  `protobuf:"varint,4,opt,name=null_count,json=nullCount,proto3" json:"null_count,omitempty"`
+	Min           string                 `protobuf:"bytes,5,opt,name=min,proto3" json:"min,omitempty"`
+	Max           string                 `protobuf:"bytes,6,opt,name=max,proto3" json:"max,omitempty"`
+	Samples       []string               `protobuf:"bytes,7,rep,name=samples,proto3" json:"samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColumnSchema) Reset() {
+	*x = ColumnSchema{}
+	mi := &file_proto_data_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColumnSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnSchema) ProtoMessage() {}
+
+func (x *ColumnSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnSchema.ProtoReflect.Descriptor instead.
+func (*ColumnSchema) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ColumnSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ColumnSchema) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ColumnSchema) GetNullable() bool {
+	if x != nil {
+		return x.Nullable
+	}
+	return false
+}
+
+func (x *ColumnSchema) GetNullCount() int64 {
+	if x != nil {
+		return x.NullCount
+	}
+	return 0
+}
+
+func (x *ColumnSchema) GetMin() string {
+	if x != nil {
+		return x.Min
+	}
+	return ""
+}
+
+func (x *ColumnSchema) GetMax() string {
+	if x != nil {
+		return x.Max
+	}
+	return ""
+}
+
+func (x *ColumnSchema) GetSamples() []string {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type InferSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []*ColumnSchema        `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	Rows          int64                  `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	Warnings      []string               `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InferSchemaResponse) Reset() {
+	*x = InferSchemaResponse{}
+	mi := &file_proto_data_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InferSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InferSchemaResponse) ProtoMessage() {}
+
+func (x *InferSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InferSchemaResponse.ProtoReflect.Descriptor instead.
+func (*InferSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *InferSchemaResponse) GetColumns() []*ColumnSchema {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *InferSchemaResponse) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *InferSchemaResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type DescribeDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	RawData       []byte                 `protobuf:"bytes,3,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	Percentiles   []float64              `protobuf:"fixed64,4,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`
+	Options       *ConvertOptions        `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeDataRequest) Reset() {
+	*x = DescribeDataRequest{}
+	mi := &file_proto_data_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeDataRequest) ProtoMessage() {}
+
+func (x *DescribeDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeDataRequest.ProtoReflect.Descriptor instead.
+func (*DescribeDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DescribeDataRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DescribeDataRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *DescribeDataRequest) GetRawData() []byte {
+	if x != nil {
+		return x.RawData
+	}
+	return nil
+}
+
+func (x *DescribeDataRequest) GetPercentiles() []float64 {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+func (x *DescribeDataRequest) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type Percentile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	P             float64                `protobuf:"fixed64,1,opt,name=p,proto3" json:"p,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Percentile) Reset() {
+	*x = Percentile{}
+	mi := &file_proto_data_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Percentile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Percentile) ProtoMessage() {}
+
+func (x *Percentile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Percentile.ProtoReflect.Descriptor instead.
+func (*Percentile) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Percentile) GetP() float64 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
+func (x *Percentile) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type ColumnSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	NullCount     int64                  `protobuf:"varint,3,opt,name=null_count,json=nullCount,proto3" json:"null_count,omitempty"`
+	Numeric       int64                  `protobuf:"varint,4,opt,name=numeric,proto3" json:"numeric,omitempty"`
+	Min           float64                `protobuf:"fixed64,5,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,6,opt,name=max,proto3" json:"max,omitempty"`
+	Mean          float64                `protobuf:"fixed64,7,opt,name=mean,proto3" json:"mean,omitempty"`
+	Stddev        float64                `protobuf:"fixed64,8,opt,name=stddev,proto3" json:"stddev,omitempty"`
+	Percentiles   []*Percentile          `protobuf:"bytes,9,rep,name=percentiles,proto3" json:"percentiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColumnSummary) Reset() {
+	*x = ColumnSummary{}
+	mi := &file_proto_data_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColumnSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnSummary) ProtoMessage() {}
+
+func (x *ColumnSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnSummary.ProtoReflect.Descriptor instead.
+func (*ColumnSummary) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ColumnSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ColumnSummary) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ColumnSummary) GetNullCount() int64 {
+	if x != nil {
+		return x.NullCount
+	}
+	return 0
+}
+
+func (x *ColumnSummary) GetNumeric() int64 {
+	if x != nil {
+		return x.Numeric
+	}
+	return 0
+}
+
+func (x *ColumnSummary) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *ColumnSummary) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ColumnSummary) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *ColumnSummary) GetStddev() float64 {
+	if x != nil {
+		return x.Stddev
+	}
+	return 0
+}
+
+func (x *ColumnSummary) GetPercentiles() []*Percentile {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+type DescribeDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []*ColumnSummary       `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	Rows          int64                  `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	Warnings      []string               `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeDataResponse) Reset() {
+	*x = DescribeDataResponse{}
+	mi := &file_proto_data_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeDataResponse) ProtoMessage() {}
+
+func (x *DescribeDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeDataResponse.ProtoReflect.Descriptor instead.
+func (*DescribeDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DescribeDataResponse) GetColumns() []*ColumnSummary {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *DescribeDataResponse) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *DescribeDataResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type ValidateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	RawData       []byte                 `protobuf:"bytes,3,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	JsonSchema    string                 `protobuf:"bytes,4,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	Columns       []*ColumnSchema        `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"`
+	MaxViolations int32                  `protobuf:"varint,6,opt,name=max_violations,json=maxViolations,proto3" json:"max_violations,omitempty"`
+	Options       *ConvertOptions        `protobuf:"bytes,7,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_proto_data_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ValidateRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetRawData() []byte {
+	if x != nil {
+		return x.RawData
+	}
+	return nil
+}
+
+func (x *ValidateRequest) GetJsonSchema() string {
+	if x != nil {
+		return x.JsonSchema
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetColumns() []*ColumnSchema {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ValidateRequest) GetMaxViolations() int32 {
+	if x != nil {
+		return x.MaxViolations
+	}
+	return 0
+}
+
+func (x *ValidateRequest) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type Violation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int64                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column        string                 `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Violation) Reset() {
+	*x = Violation{}
+	mi := &file_proto_data_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Violation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Violation) ProtoMessage() {}
+
+func (x *Violation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Violation.ProtoReflect.Descriptor instead.
+func (*Violation) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Violation) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *Violation) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *Violation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ValidateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Rows          int64                  `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	Violations    []*Violation           `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations,omitempty"`
+	Warnings      []string               `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_proto_data_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ValidateResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateResponse) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *ValidateResponse) GetViolations() []*Violation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type DetectGapsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	RawData       []byte                 `protobuf:"bytes,3,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	TimeColumn    string                 `protobuf:"bytes,4,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	StationColumn string                 `protobuf:"bytes,5,opt,name=station_column,json=stationColumn,proto3" json:"station_column,omitempty"`
+	Interval      string                 `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	Tolerance     string                 `protobuf:"bytes,7,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	Start         string                 `protobuf:"bytes,8,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,9,opt,name=end,proto3" json:"end,omitempty"`
+	Options       *ConvertOptions        `protobuf:"bytes,10,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectGapsRequest) Reset() {
+	*x = DetectGapsRequest{}
+	mi := &file_proto_data_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectGapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectGapsRequest) ProtoMessage() {}
+
+func (x *DetectGapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectGapsRequest.ProtoReflect.Descriptor instead.
+func (*DetectGapsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DetectGapsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DetectGapsRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *DetectGapsRequest) GetRawData() []byte {
+	if x != nil {
+		return x.RawData
+	}
+	return nil
+}
+
+func (x *DetectGapsRequest) GetTimeColumn() string {
+	if x != nil {
+		return x.TimeColumn
+	}
+	return ""
+}
+
+func (x *DetectGapsRequest) GetStationColumn() string {
+	if x != nil {
+		return x.StationColumn
+	}
+	return ""
+}
+
+func (x *DetectGapsRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *DetectGapsRequest) GetTolerance() string {
+	if x != nil {
+		return x.Tolerance
+	}
+	return ""
+}
+
+func (x *DetectGapsRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *DetectGapsRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *DetectGapsRequest) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type Gap struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Station         string                 `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	Start           string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End             string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	DurationSeconds float64                `protobuf:"fixed64,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Missing         int64                  `protobuf:"varint,5,opt,name=missing,proto3" json:"missing,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Gap) Reset() {
+	*x = Gap{}
+	mi := &file_proto_data_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Gap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gap) ProtoMessage() {}
+
+func (x *Gap) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gap.ProtoReflect.Descriptor instead.
+func (*Gap) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Gap) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *Gap) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *Gap) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *Gap) GetDurationSeconds() float64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *Gap) GetMissing() int64 {
+	if x != nil {
+		return x.Missing
+	}
+	return 0
+}
+
+type GapSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Station       string                 `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	First         string                 `protobuf:"bytes,2,opt,name=first,proto3" json:"first,omitempty"`
+	Last          string                 `protobuf:"bytes,3,opt,name=last,proto3" json:"last,omitempty"`
+	Samples       int64                  `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
+	Missing       int64                  `protobuf:"varint,5,opt,name=missing,proto3" json:"missing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GapSeries) Reset() {
+	*x = GapSeries{}
+	mi := &file_proto_data_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GapSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GapSeries) ProtoMessage() {}
+
+func (x *GapSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GapSeries.ProtoReflect.Descriptor instead.
+func (*GapSeries) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GapSeries) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *GapSeries) GetFirst() string {
+	if x != nil {
+		return x.First
+	}
+	return ""
+}
+
+func (x *GapSeries) GetLast() string {
+	if x != nil {
+		return x.Last
+	}
+	return ""
+}
+
+func (x *GapSeries) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *GapSeries) GetMissing() int64 {
+	if x != nil {
+		return x.Missing
+	}
+	return 0
+}
+
+type DetectGapsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          int64                  `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Gaps          []*Gap                 `protobuf:"bytes,2,rep,name=gaps,proto3" json:"gaps,omitempty"`
+	Series        []*GapSeries           `protobuf:"bytes,3,rep,name=series,proto3" json:"series,omitempty"`
+	Warnings      []string               `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectGapsResponse) Reset() {
+	*x = DetectGapsResponse{}
+	mi := &file_proto_data_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectGapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectGapsResponse) ProtoMessage() {}
+
+func (x *DetectGapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectGapsResponse.ProtoReflect.Descriptor instead.
+func (*DetectGapsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DetectGapsResponse) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *DetectGapsResponse) GetGaps() []*Gap {
+	if x != nil {
+		return x.Gaps
+	}
+	return nil
+}
+
+func (x *DetectGapsResponse) GetSeries() []*GapSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *DetectGapsResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type CorrelateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	RawData       []byte                 `protobuf:"bytes,3,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	Columns       []string               `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	StationColumn string                 `protobuf:"bytes,5,opt,name=station_column,json=stationColumn,proto3" json:"station_column,omitempty"`
+	MaxLag        int32                  `protobuf:"varint,6,opt,name=max_lag,json=maxLag,proto3" json:"max_lag,omitempty"`
+	Options       *ConvertOptions        `protobuf:"bytes,7,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CorrelateRequest) Reset() {
+	*x = CorrelateRequest{}
+	mi := &file_proto_data_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorrelateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrelateRequest) ProtoMessage() {}
+
+func (x *CorrelateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrelateRequest.ProtoReflect.Descriptor instead.
+func (*CorrelateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CorrelateRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *CorrelateRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *CorrelateRequest) GetRawData() []byte {
+	if x != nil {
+		return x.RawData
+	}
+	return nil
+}
+
+func (x *CorrelateRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *CorrelateRequest) GetStationColumn() string {
+	if x != nil {
+		return x.StationColumn
+	}
+	return ""
+}
+
+func (x *CorrelateRequest) GetMaxLag() int32 {
+	if x != nil {
+		return x.MaxLag
+	}
+	return 0
+}
+
+func (x *CorrelateRequest) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type CorrelationRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []float64              `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CorrelationRow) Reset() {
+	*x = CorrelationRow{}
+	mi := &file_proto_data_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorrelationRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrelationRow) ProtoMessage() {}
+
+func (x *CorrelationRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrelationRow.ProtoReflect.Descriptor instead.
+func (*CorrelationRow) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CorrelationRow) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type Correlation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             string                 `protobuf:"bytes,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             string                 `protobuf:"bytes,2,opt,name=y,proto3" json:"y,omitempty"`
+	Lag           int32                  `protobuf:"varint,3,opt,name=lag,proto3" json:"lag,omitempty"`
+	R             float64                `protobuf:"fixed64,4,opt,name=r,proto3" json:"r,omitempty"`
+	N             int64                  `protobuf:"varint,5,opt,name=n,proto3" json:"n,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Correlation) Reset() {
+	*x = Correlation{}
+	mi := &file_proto_data_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Correlation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Correlation) ProtoMessage() {}
+
+func (x *Correlation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Correlation.ProtoReflect.Descriptor instead.
+func (*Correlation) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Correlation) GetX() string {
+	if x != nil {
+		return x.X
+	}
+	return ""
+}
+
+func (x *Correlation) GetY() string {
+	if x != nil {
+		return x.Y
+	}
+	return ""
+}
+
+func (x *Correlation) GetLag() int32 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+func (x *Correlation) GetR() float64 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *Correlation) GetN() int64 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+type CorrelateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          int64                  `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns       []string               `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	Matrix        []*CorrelationRow      `protobuf:"bytes,3,rep,name=matrix,proto3" json:"matrix,omitempty"`
+	Lagged        []*Correlation         `protobuf:"bytes,4,rep,name=lagged,proto3" json:"lagged,omitempty"`
+	Warnings      []string               `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CorrelateResponse) Reset() {
+	*x = CorrelateResponse{}
+	mi := &file_proto_data_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorrelateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrelateResponse) ProtoMessage() {}
+
+func (x *CorrelateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrelateResponse.ProtoReflect.Descriptor instead.
+func (*CorrelateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CorrelateResponse) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *CorrelateResponse) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *CorrelateResponse) GetMatrix() []*CorrelationRow {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *CorrelateResponse) GetLagged() []*Correlation {
+	if x != nil {
+		return x.Lagged
+	}
+	return nil
+}
+
+func (x *CorrelateResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type CompatibilityMatrixRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompatibilityMatrixRequest) Reset() {
+	*x = CompatibilityMatrixRequest{}
+	mi := &file_proto_data_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompatibilityMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompatibilityMatrixRequest) ProtoMessage() {}
+
+func (x *CompatibilityMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompatibilityMatrixRequest.ProtoReflect.Descriptor instead.
+func (*CompatibilityMatrixRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{71}
+}
+
+type CompatibilityEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Level         string                 `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompatibilityEntry) Reset() {
+	*x = CompatibilityEntry{}
+	mi := &file_proto_data_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompatibilityEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompatibilityEntry) ProtoMessage() {}
+
+func (x *CompatibilityEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompatibilityEntry.ProtoReflect.Descriptor instead.
+func (*CompatibilityEntry) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CompatibilityEntry) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CompatibilityEntry) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CompatibilityEntry) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *CompatibilityEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CompatibilityMatrixResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*CompatibilityEntry  `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompatibilityMatrixResponse) Reset() {
+	*x = CompatibilityMatrixResponse{}
+	mi := &file_proto_data_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompatibilityMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompatibilityMatrixResponse) ProtoMessage() {}
+
+func (x *CompatibilityMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompatibilityMatrixResponse.ProtoReflect.Descriptor instead.
+func (*CompatibilityMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CompatibilityMatrixResponse) GetEntries() []*CompatibilityEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type Instrument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Column        string                 `protobuf:"bytes,3,opt,name=column,proto3" json:"column,omitempty"`
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Instrument) Reset() {
+	*x = Instrument{}
+	mi := &file_proto_data_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Instrument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Instrument) ProtoMessage() {}
+
+func (x *Instrument) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Instrument.ProtoReflect.Descriptor instead.
+func (*Instrument) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Instrument) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Instrument) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Instrument) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *Instrument) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type RegisterStationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StationId     string                 `protobuf:"bytes,1,opt,name=station_id,json=stationId,proto3" json:"station_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Contact       string                 `protobuf:"bytes,3,opt,name=contact,proto3" json:"contact,omitempty"`
+	Instruments   []*Instrument          `protobuf:"bytes,4,rep,name=instruments,proto3" json:"instruments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterStationRequest) Reset() {
+	*x = RegisterStationRequest{}
+	mi := &file_proto_data_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterStationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterStationRequest) ProtoMessage() {}
+
+func (x *RegisterStationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterStationRequest.ProtoReflect.Descriptor instead.
+func (*RegisterStationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RegisterStationRequest) GetStationId() string {
+	if x != nil {
+		return x.StationId
+	}
+	return ""
+}
+
+func (x *RegisterStationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterStationRequest) GetContact() string {
+	if x != nil {
+		return x.Contact
+	}
+	return ""
+}
+
+func (x *RegisterStationRequest) GetInstruments() []*Instrument {
+	if x != nil {
+		return x.Instruments
+	}
+	return nil
+}
+
+type RegisterStationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RegistrationId string                 `protobuf:"bytes,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	BootstrapToken string                 `protobuf:"bytes,2,opt,name=bootstrap_token,json=bootstrapToken,proto3" json:"bootstrap_token,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegisterStationResponse) Reset() {
+	*x = RegisterStationResponse{}
+	mi := &file_proto_data_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterStationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterStationResponse) ProtoMessage() {}
+
+func (x *RegisterStationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterStationResponse.ProtoReflect.Descriptor instead.
+func (*RegisterStationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RegisterStationResponse) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+func (x *RegisterStationResponse) GetBootstrapToken() string {
+	if x != nil {
+		return x.BootstrapToken
+	}
+	return ""
+}
+
+func (x *RegisterStationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ApproveStationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RegistrationId string                 `protobuf:"bytes,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	Approve        bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApproveStationRequest) Reset() {
+	*x = ApproveStationRequest{}
+	mi := &file_proto_data_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveStationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveStationRequest) ProtoMessage() {}
+
+func (x *ApproveStationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveStationRequest.ProtoReflect.Descriptor instead.
+func (*ApproveStationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ApproveStationRequest) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+func (x *ApproveStationRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ApproveStationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ApproveStationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveStationResponse) Reset() {
+	*x = ApproveStationResponse{}
+	mi := &file_proto_data_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveStationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveStationResponse) ProtoMessage() {}
+
+func (x *ApproveStationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveStationResponse.ProtoReflect.Descriptor instead.
+func (*ApproveStationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ApproveStationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RegistrationStatusRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RegistrationId string                 `protobuf:"bytes,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	BootstrapToken string                 `protobuf:"bytes,2,opt,name=bootstrap_token,json=bootstrapToken,proto3" json:"bootstrap_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegistrationStatusRequest) Reset() {
+	*x = RegistrationStatusRequest{}
+	mi := &file_proto_data_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistrationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationStatusRequest) ProtoMessage() {}
+
+func (x *RegistrationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationStatusRequest.ProtoReflect.Descriptor instead.
+func (*RegistrationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RegistrationStatusRequest) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+func (x *RegistrationStatusRequest) GetBootstrapToken() string {
+	if x != nil {
+		return x.BootstrapToken
+	}
+	return ""
+}
+
+type RegistrationStatusResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ApiKey         string                 `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Scopes         []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	DefaultProfile *ConvertOptions        `protobuf:"bytes,5,opt,name=default_profile,json=defaultProfile,proto3" json:"default_profile,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegistrationStatusResponse) Reset() {
+	*x = RegistrationStatusResponse{}
+	mi := &file_proto_data_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistrationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationStatusResponse) ProtoMessage() {}
+
+func (x *RegistrationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationStatusResponse.ProtoReflect.Descriptor instead.
+func (*RegistrationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RegistrationStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RegistrationStatusResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RegistrationStatusResponse) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *RegistrationStatusResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *RegistrationStatusResponse) GetDefaultProfile() *ConvertOptions {
+	if x != nil {
+		return x.DefaultProfile
+	}
+	return nil
+}
+
+type Calibration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Coefficients  []float64              `protobuf:"fixed64,2,rep,packed,name=coefficients,proto3" json:"coefficients,omitempty"`
+	Certificate   string                 `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Calibration) Reset() {
+	*x = Calibration{}
+	mi := &file_proto_data_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Calibration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calibration) ProtoMessage() {}
+
+func (x *Calibration) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calibration.ProtoReflect.Descriptor instead.
+func (*Calibration) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Calibration) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Calibration) GetCoefficients() []float64 {
+	if x != nil {
+		return x.Coefficients
+	}
+	return nil
+}
+
+func (x *Calibration) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *Calibration) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type Sensor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Model         string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	SerialNumber  string                 `protobuf:"bytes,4,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	Column        string                 `protobuf:"bytes,5,opt,name=column,proto3" json:"column,omitempty"`
+	Unit          string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+	Calibrations  []*Calibration         `protobuf:"bytes,7,rep,name=calibrations,proto3" json:"calibrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sensor) Reset() {
+	*x = Sensor{}
+	mi := &file_proto_data_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sensor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sensor) ProtoMessage() {}
+
+func (x *Sensor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sensor.ProtoReflect.Descriptor instead.
+func (*Sensor) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Sensor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Sensor) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Sensor) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Sensor) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *Sensor) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *Sensor) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Sensor) GetCalibrations() []*Calibration {
+	if x != nil {
+		return x.Calibrations
+	}
+	return nil
+}
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Depth         float64                `protobuf:"fixed64,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_proto_data_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Location) GetDepth() float64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+type Deployment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Location      *Location              `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Notes         string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Deployment) Reset() {
+	*x = Deployment{}
+	mi := &file_proto_data_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deployment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deployment) ProtoMessage() {}
+
+func (x *Deployment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deployment.ProtoReflect.Descriptor instead.
+func (*Deployment) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Deployment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Deployment) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *Deployment) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *Deployment) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Deployment) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type Platform struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Sensors       []*Sensor              `protobuf:"bytes,5,rep,name=sensors,proto3" json:"sensors,omitempty"`
+	Deployments   []*Deployment          `protobuf:"bytes,6,rep,name=deployments,proto3" json:"deployments,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Institution   string                 `protobuf:"bytes,9,opt,name=institution,proto3" json:"institution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Platform) Reset() {
+	*x = Platform{}
+	mi := &file_proto_data_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Platform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Platform) ProtoMessage() {}
+
+func (x *Platform) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Platform.ProtoReflect.Descriptor instead.
+func (*Platform) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Platform) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Platform) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Platform) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Platform) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Platform) GetSensors() []*Sensor {
+	if x != nil {
+		return x.Sensors
+	}
+	return nil
+}
+
+func (x *Platform) GetDeployments() []*Deployment {
+	if x != nil {
+		return x.Deployments
+	}
+	return nil
+}
+
+func (x *Platform) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Platform) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Platform) GetInstitution() string {
+	if x != nil {
+		return x.Institution
+	}
+	return ""
+}
+
+type CreatePlatformRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Platform      *Platform              `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlatformRequest) Reset() {
+	*x = CreatePlatformRequest{}
+	mi := &file_proto_data_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlatformRequest) ProtoMessage() {}
+
+func (x *CreatePlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlatformRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlatformRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreatePlatformRequest) GetPlatform() *Platform {
+	if x != nil {
+		return x.Platform
+	}
+	return nil
+}
+
+type GetPlatformRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlatformRequest) Reset() {
+	*x = GetPlatformRequest{}
+	mi := &file_proto_data_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformRequest) ProtoMessage() {}
+
+func (x *GetPlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformRequest.ProtoReflect.Descriptor instead.
+func (*GetPlatformRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetPlatformRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListPlatformsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlatformsRequest) Reset() {
+	*x = ListPlatformsRequest{}
+	mi := &file_proto_data_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlatformsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlatformsRequest) ProtoMessage() {}
+
+func (x *ListPlatformsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlatformsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlatformsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListPlatformsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ListPlatformsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Platforms     []*Platform            `protobuf:"bytes,1,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlatformsResponse) Reset() {
+	*x = ListPlatformsResponse{}
+	mi := &file_proto_data_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlatformsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlatformsResponse) ProtoMessage() {}
+
+func (x *ListPlatformsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlatformsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlatformsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListPlatformsResponse) GetPlatforms() []*Platform {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+type UpdatePlatformRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Platform      *Platform              `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlatformRequest) Reset() {
+	*x = UpdatePlatformRequest{}
+	mi := &file_proto_data_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlatformRequest) ProtoMessage() {}
+
+func (x *UpdatePlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlatformRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlatformRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *UpdatePlatformRequest) GetPlatform() *Platform {
+	if x != nil {
+		return x.Platform
+	}
+	return nil
+}
+
+type DeletePlatformRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlatformRequest) Reset() {
+	*x = DeletePlatformRequest{}
+	mi := &file_proto_data_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlatformRequest) ProtoMessage() {}
+
+func (x *DeletePlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlatformRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlatformRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DeletePlatformRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePlatformResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlatformResponse) Reset() {
+	*x = DeletePlatformResponse{}
+	mi := &file_proto_data_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlatformResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlatformResponse) ProtoMessage() {}
+
+func (x *DeletePlatformResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlatformResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlatformResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{92}
+}
+
+type PutSensorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlatformId    string                 `protobuf:"bytes,1,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty"`
+	Sensor        *Sensor                `protobuf:"bytes,2,opt,name=sensor,proto3" json:"sensor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutSensorRequest) Reset() {
+	*x = PutSensorRequest{}
+	mi := &file_proto_data_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutSensorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutSensorRequest) ProtoMessage() {}
+
+func (x *PutSensorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutSensorRequest.ProtoReflect.Descriptor instead.
+func (*PutSensorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *PutSensorRequest) GetPlatformId() string {
+	if x != nil {
+		return x.PlatformId
+	}
+	return ""
+}
+
+func (x *PutSensorRequest) GetSensor() *Sensor {
+	if x != nil {
+		return x.Sensor
+	}
+	return nil
+}
+
+type DeleteSensorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlatformId    string                 `protobuf:"bytes,1,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty"`
+	SensorId      string                 `protobuf:"bytes,2,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSensorRequest) Reset() {
+	*x = DeleteSensorRequest{}
+	mi := &file_proto_data_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSensorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSensorRequest) ProtoMessage() {}
+
+func (x *DeleteSensorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSensorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSensorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DeleteSensorRequest) GetPlatformId() string {
+	if x != nil {
+		return x.PlatformId
+	}
+	return ""
+}
+
+func (x *DeleteSensorRequest) GetSensorId() string {
+	if x != nil {
+		return x.SensorId
+	}
+	return ""
+}
+
+type AddCalibrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlatformId    string                 `protobuf:"bytes,1,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty"`
+	SensorId      string                 `protobuf:"bytes,2,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	Calibration   *Calibration           `protobuf:"bytes,3,opt,name=calibration,proto3" json:"calibration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCalibrationRequest) Reset() {
+	*x = AddCalibrationRequest{}
+	mi := &file_proto_data_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCalibrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCalibrationRequest) ProtoMessage() {}
+
+func (x *AddCalibrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCalibrationRequest.ProtoReflect.Descriptor instead.
+func (*AddCalibrationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *AddCalibrationRequest) GetPlatformId() string {
+	if x != nil {
+		return x.PlatformId
+	}
+	return ""
+}
+
+func (x *AddCalibrationRequest) GetSensorId() string {
+	if x != nil {
+		return x.SensorId
+	}
+	return ""
+}
+
+func (x *AddCalibrationRequest) GetCalibration() *Calibration {
+	if x != nil {
+		return x.Calibration
+	}
+	return nil
+}
+
+type PutDeploymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlatformId    string                 `protobuf:"bytes,1,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty"`
+	Deployment    *Deployment            `protobuf:"bytes,2,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutDeploymentRequest) Reset() {
+	*x = PutDeploymentRequest{}
+	mi := &file_proto_data_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutDeploymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutDeploymentRequest) ProtoMessage() {}
+
+func (x *PutDeploymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*PutDeploymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PutDeploymentRequest) GetPlatformId() string {
+	if x != nil {
+		return x.PlatformId
+	}
+	return ""
+}
+
+func (x *PutDeploymentRequest) GetDeployment() *Deployment {
+	if x != nil {
+		return x.Deployment
+	}
+	return nil
+}
+
+type DeleteDeploymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlatformId    string                 `protobuf:"bytes,1,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty"`
+	DeploymentId  string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDeploymentRequest) Reset() {
+	*x = DeleteDeploymentRequest{}
+	mi := &file_proto_data_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDeploymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeploymentRequest) ProtoMessage() {}
+
+func (x *DeleteDeploymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDeploymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *DeleteDeploymentRequest) GetPlatformId() string {
+	if x != nil {
+		return x.PlatformId
+	}
+	return ""
+}
+
+func (x *DeleteDeploymentRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+type AlertRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Parameter     string                 `protobuf:"bytes,2,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	Operator      string                 `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Station       string                 `protobuf:"bytes,5,opt,name=station,proto3" json:"station,omitempty"`
+	StationColumn string                 `protobuf:"bytes,6,opt,name=station_column,json=stationColumn,proto3" json:"station_column,omitempty"`
+	TimeColumn    string                 `protobuf:"bytes,7,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	Duration      string                 `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	Webhook       string                 `protobuf:"bytes,9,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_proto_data_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AlertRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AlertRule) GetParameter() string {
+	if x != nil {
+		return x.Parameter
+	}
+	return ""
+}
+
+func (x *AlertRule) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *AlertRule) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AlertRule) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *AlertRule) GetStationColumn() string {
+	if x != nil {
+		return x.StationColumn
+	}
+	return ""
+}
+
+func (x *AlertRule) GetTimeColumn() string {
+	if x != nil {
+		return x.TimeColumn
+	}
+	return ""
+}
+
+func (x *AlertRule) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *AlertRule) GetWebhook() string {
+	if x != nil {
+		return x.Webhook
+	}
+	return ""
+}
+
+func (x *AlertRule) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleRequest) Reset() {
+	*x = CreateAlertRuleRequest{}
+	mi := &file_proto_data_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleRequest) ProtoMessage() {}
+
+func (x *CreateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CreateAlertRuleRequest) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type ListAlertRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesRequest) Reset() {
+	*x = ListAlertRulesRequest{}
+	mi := &file_proto_data_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesRequest) ProtoMessage() {}
+
+func (x *ListAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{100}
+}
+
+type ListAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*AlertRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesResponse) Reset() {
+	*x = ListAlertRulesResponse{}
+	mi := &file_proto_data_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesResponse) ProtoMessage() {}
+
+func (x *ListAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListAlertRulesResponse) GetRules() []*AlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type DeleteAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleRequest) Reset() {
+	*x = DeleteAlertRuleRequest{}
+	mi := &file_proto_data_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleRequest) ProtoMessage() {}
+
+func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DeleteAlertRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleResponse) Reset() {
+	*x = DeleteAlertRuleResponse{}
+	mi := &file_proto_data_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleResponse) ProtoMessage() {}
+
+func (x *DeleteAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{103}
+}
+
+type WatchAlertsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Station       string                 `protobuf:"bytes,2,opt,name=station,proto3" json:"station,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAlertsRequest) Reset() {
+	*x = WatchAlertsRequest{}
+	mi := &file_proto_data_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAlertsRequest) ProtoMessage() {}
+
+func (x *WatchAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAlertsRequest.ProtoReflect.Descriptor instead.
+func (*WatchAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *WatchAlertsRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *WatchAlertsRequest) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+type AlertEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Station       string                 `protobuf:"bytes,2,opt,name=station,proto3" json:"station,omitempty"`
+	Parameter     string                 `protobuf:"bytes,3,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Operator      string                 `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Value         float64                `protobuf:"fixed64,7,opt,name=value,proto3" json:"value,omitempty"`
+	Since         string                 `protobuf:"bytes,8,opt,name=since,proto3" json:"since,omitempty"`
+	Time          string                 `protobuf:"bytes,9,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertEvent) Reset() {
+	*x = AlertEvent{}
+	mi := &file_proto_data_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertEvent) ProtoMessage() {}
+
+func (x *AlertEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertEvent.ProtoReflect.Descriptor instead.
+func (*AlertEvent) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AlertEvent) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetParameter() string {
+	if x != nil {
+		return x.Parameter
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+var File_proto_data_proto protoreflect.FileDescriptor
+
+const file_proto_data_proto_rawDesc = "" +
+	"\n" +
+	"\x10proto/data.proto\x12\x04data\"\xff\x01\n" +
+	"\fParseRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\tR\x04data\x12.\n" +
+	"\aoptions\x18\x04 \x01(\v2\x14.data.ConvertOptionsR\aoptions\x12\x19\n" +
+	"\braw_data\x18\x05 \x01(\fR\arawData\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"output_url\x18\a \x01(\tR\toutputUrl\x12\x12\n" +
+	"\x04path\x18\b \x01(\tR\x04path\x12'\n" +
+	"\x0fidempotency_key\x18\t \x01(\tR\x0eidempotencyKey\"\xda\x12\n" +
+	"\x0eConvertOptions\x124\n" +
+	"\x16disable_type_inference\x18\x01 \x01(\bR\x14disableTypeInference\x12%\n" +
+	"\x0estring_columns\x18\x02 \x03(\tR\rstringColumns\x12H\n" +
+	"\fcolumn_types\x18\x03 \x03(\v2%.data.ConvertOptions.ColumnTypesEntryR\vcolumnTypes\x12%\n" +
+	"\x0estrict_numbers\x18\x04 \x01(\bR\rstrictNumbers\x12\"\n" +
+	"\rnon_finite_as\x18\x05 \x01(\tR\vnonFiniteAs\x12+\n" +
+	"\x11detect_timestamps\x18\x06 \x01(\bR\x10detectTimestamps\x121\n" +
+	"\x14normalize_timestamps\x18\a \x01(\bR\x13normalizeTimestamps\x12\x1d\n" +
+	"\n" +
+	"year_pivot\x18\b \x01(\x05R\tyearPivot\x12%\n" +
+	"\x0einfer_booleans\x18\t \x01(\bR\rinferBooleans\x12\x1f\n" +
+	"\vnull_values\x18\n" +
+	" \x03(\tR\n" +
+	"nullValues\x12\x1f\n" +
+	"\vnull_output\x18\v \x01(\tR\n" +
+	"nullOutput\x12\x1b\n" +
+	"\tno_header\x18\f \x01(\bR\bnoHeader\x12\x18\n" +
+	"\aheaders\x18\r \x03(\tR\aheaders\x12\x1f\n" +
+	"\vomit_header\x18\x0e \x01(\bR\n" +
+	"omitHeader\x12+\n" +
+	"\x11duplicate_headers\x18\x0f \x01(\tR\x10duplicateHeaders\x12\x1f\n" +
+	"\vjagged_rows\x18\x10 \x01(\tR\n" +
+	"jaggedRows\x12%\n" +
+	"\x0einput_encoding\x18\x11 \x01(\tR\rinputEncoding\x12'\n" +
+	"\x0foutput_encoding\x18\x12 \x01(\tR\x0eoutputEncoding\x12\x1d\n" +
+	"\n" +
+	"skip_lines\x18\x13 \x01(\x05R\tskipLines\x12%\n" +
+	"\x0ecomment_prefix\x18\x14 \x01(\tR\rcommentPrefix\x12)\n" +
+	"\x10capture_metadata\x18\x15 \x01(\bR\x0fcaptureMetadata\x12\x18\n" +
+	"\acolumns\x18\x16 \x03(\tR\acolumns\x128\n" +
+	"\x06rename\x18\x17 \x03(\v2 .data.ConvertOptions.RenameEntryR\x06rename\x12\x16\n" +
+	"\x06filter\x18\x18 \x01(\tR\x06filter\x12\x17\n" +
+	"\asort_by\x18\x19 \x03(\tR\x06sortBy\x12 \n" +
+	"\vdeduplicate\x18\x1a \x01(\bR\vdeduplicate\x12\x1d\n" +
+	"\n" +
+	"dedup_keys\x18\x1b \x03(\tR\tdedupKeys\x12\x18\n" +
+	"\areshape\x18\x1c \x01(\tR\areshape\x12,\n" +
+	"\x12reshape_id_columns\x18\x1d \x03(\tR\x10reshapeIdColumns\x12.\n" +
+	"\x13reshape_name_column\x18\x1e \x01(\tR\x11reshapeNameColumn\x120\n" +
+	"\x14reshape_value_column\x18\x1f \x01(\tR\x12reshapeValueColumn\x12'\n" +
+	"\x0funpivot_columns\x18  \x03(\tR\x0eunpivotColumns\x12\x16\n" +
+	"\x06schema\x18! \x01(\tR\x06schema\x12*\n" +
+	"\x11skip_invalid_rows\x18\" \x01(\bR\x0fskipInvalidRows\x12\x12\n" +
+	"\x04mode\x18# \x01(\tR\x04mode\x12)\n" +
+	"\x10computed_columns\x18$ \x03(\tR\x0fcomputedColumns\x12\x1a\n" +
+	"\btemplate\x18% \x01(\tR\btemplate\x12'\n" +
+	"\x0ftemplate_header\x18& \x01(\tR\x0etemplateHeader\x12'\n" +
+	"\x0ftemplate_footer\x18' \x01(\tR\x0etemplateFooter\x12-\n" +
+	"\x12influx_measurement\x18( \x01(\tR\x11influxMeasurement\x12\x1f\n" +
+	"\vinflux_tags\x18) \x03(\tR\n" +
+	"influxTags\x12#\n" +
+	"\rinflux_fields\x18* \x03(\tR\finfluxFields\x12,\n" +
+	"\x12influx_time_column\x18+ \x01(\tR\x10influxTimeColumn\x12)\n" +
+	"\x10influx_precision\x18, \x01(\tR\x0finfluxPrecision\x12T\n" +
+	"\x10postgres_columns\x18- \x03(\v2).data.ConvertOptions.PostgresColumnsEntryR\x0fpostgresColumns\x120\n" +
+	"\x14postgres_on_conflict\x18. \x01(\tR\x12postgresOnConflict\x12:\n" +
+	"\x19postgres_conflict_columns\x18/ \x03(\tR\x17postgresConflictColumns\x12&\n" +
+	"\fcompact_json\x180 \x01(\bH\x00R\vcompactJson\x88\x01\x01\x12\x1f\n" +
+	"\vjson_indent\x181 \x01(\x05R\n" +
+	"jsonIndent\x12\x14\n" +
+	"\x05units\x182 \x03(\tR\x05units\x12'\n" +
+	"\x06ranges\x183 \x03(\v2\x0f.data.RangeRuleR\x06ranges\x12 \n" +
+	"\fout_of_range\x184 \x01(\tR\n" +
+	"outOfRange\x12\x1f\n" +
+	"\vsensor_type\x185 \x01(\tR\n" +
+	"sensorType\x12'\n" +
+	"\x0fsource_timezone\x186 \x01(\tR\x0esourceTimezone\x12'\n" +
+	"\x0ftarget_timezone\x187 \x01(\tR\x0etargetTimezone\x1a>\n" +
+	"\x10ColumnTypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vRenameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aB\n" +
+	"\x14PostgresColumnsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0f\n" +
+	"\r_compact_json\"G\n" +
+	"\tRangeRule\x12\x16\n" +
+	"\x06column\x18\x01 \x01(\tR\x06column\x12\x10\n" +
+	"\x03min\x18\x02 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x03 \x01(\x01R\x03max\"\xee\x03\n" +
+	"\rParseResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\x12\x1d\n" +
+	"\n" +
+	"raw_result\x18\x03 \x01(\fR\trawResult\x12\x1a\n" +
+	"\bpreamble\x18\x04 \x03(\tR\bpreamble\x12=\n" +
+	"\bmetadata\x18\x05 \x03(\v2!.data.ParseResponse.MetadataEntryR\bmetadata\x12-\n" +
+	"\x12duplicates_removed\x18\x06 \x01(\x03R\x11duplicatesRemoved\x12-\n" +
+	"\n" +
+	"row_errors\x18\a \x03(\v2\x0e.data.RowErrorR\trowErrors\x12+\n" +
+	"\x05stats\x18\b \x01(\v2\x15.data.ConversionStatsR\x05stats\x12\x1d\n" +
+	"\n" +
+	"output_url\x18\t \x01(\tR\toutputUrl\x12\x1b\n" +
+	"\tcache_hit\x18\n" +
+	" \x01(\bR\bcacheHit\x12+\n" +
+	"\tanomalies\x18\v \x03(\v2\r.data.AnomalyR\tanomalies\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8f\x01\n" +
+	"\aAnomaly\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x03R\x05index\x12\x10\n" +
+	"\x03row\x18\x02 \x01(\x03R\x03row\x12\x18\n" +
+	"\astation\x18\x03 \x01(\tR\astation\x12\x16\n" +
+	"\x06column\x18\x04 \x01(\tR\x06column\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x01R\x05value\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score\"C\n" +
+	"\x11ParseBatchRequest\x12.\n" +
+	"\brequests\x18\x01 \x03(\v2\x12.data.ParseRequestR\brequests\"W\n" +
+	"\x0eParseBatchItem\x12/\n" +
+	"\bresponse\x18\x01 \x01(\v2\x13.data.ParseResponseR\bresponse\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"X\n" +
+	"\x12ParseBatchResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.data.ParseBatchItemR\x05items\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x03R\x06failed\"e\n" +
+	"\vLiveRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12.\n" +
+	"\aoptions\x18\x02 \x01(\v2\x14.data.ConvertOptionsR\aoptions\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\tR\x04data\"V\n" +
+	"\fLiveResponse\x12\x16\n" +
+	"\x06object\x18\x01 \x01(\tR\x06object\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\x03R\x04line\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\"#\n" +
+	"\n" +
+	"JobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xaf\x01\n" +
+	"\tJobStatus\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x06 \x01(\tR\n" +
+	"finishedAt\"8\n" +
+	"\fUsageRequest\x12\x16\n" +
+	"\x06client\x18\x01 \x01(\tR\x06client\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\"\xf5\x01\n" +
+	"\vClientUsage\x12\x16\n" +
+	"\x06client\x18\x01 \x01(\tR\x06client\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x1a\n" +
+	"\brequests\x18\x03 \x01(\x03R\brequests\x12\x19\n" +
+	"\bbytes_in\x18\x04 \x01(\x03R\abytesIn\x12\x1b\n" +
+	"\tbytes_out\x18\x05 \x01(\x03R\bbytesOut\x12\x1a\n" +
+	"\brejected\x18\x06 \x01(\x03R\brejected\x12\x1f\n" +
+	"\vquota_bytes\x18\a \x01(\x03R\n" +
+	"quotaBytes\x12%\n" +
+	"\x0equota_requests\x18\b \x01(\x03R\rquotaRequests\"8\n" +
+	"\rUsageResponse\x12'\n" +
+	"\x05usage\x18\x01 \x03(\v2\x11.data.ClientUsageR\x05usage\"?\n" +
+	"\x0fListJobsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"7\n" +
+	"\x10ListJobsResponse\x12#\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x0f.data.JobStatusR\x04jobs\"\xa3\x01\n" +
+	"\x0eHistoryRequest\x12\x16\n" +
+	"\x06client\x18\x01 \x01(\tR\x06client\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\tR\x05since\x12\x14\n" +
+	"\x05until\x18\x04 \x01(\tR\x05until\x12\x1f\n" +
+	"\vfailed_only\x18\x05 \x01(\bR\n" +
+	"failedOnly\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"\x87\x02\n" +
+	"\n" +
+	"Conversion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\tR\x04time\x12\x16\n" +
+	"\x06client\x18\x03 \x01(\tR\x06client\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x06 \x01(\tR\x02to\x12\x19\n" +
+	"\bbytes_in\x18\a \x01(\x03R\abytesIn\x12\x1b\n" +
+	"\tbytes_out\x18\b \x01(\x03R\bbytesOut\x12\x1f\n" +
+	"\vduration_ms\x18\t \x01(\x01R\n" +
+	"durationMs\x12\x12\n" +
+	"\x04code\x18\n" +
+	" \x01(\tR\x04code\x12\x14\n" +
+	"\x05error\x18\v \x01(\tR\x05error\"E\n" +
+	"\x0fHistoryResponse\x122\n" +
+	"\vconversions\x18\x01 \x03(\v2\x10.data.ConversionR\vconversions\"\xba\x02\n" +
+	"\x0fConversionStats\x12\x1b\n" +
+	"\trows_read\x18\x01 \x01(\x03R\browsRead\x12!\n" +
+	"\frows_skipped\x18\x02 \x01(\x03R\vrowsSkipped\x12!\n" +
+	"\frows_written\x18\x03 \x01(\x03R\vrowsWritten\x12\x18\n" +
+	"\acolumns\x18\x04 \x03(\tR\acolumns\x12I\n" +
+	"\fcolumn_types\x18\x05 \x03(\v2&.data.ConversionStats.ColumnTypesEntryR\vcolumnTypes\x12\x1f\n" +
+	"\vduration_ms\x18\x06 \x01(\x01R\n" +
+	"durationMs\x1a>\n" +
+	"\x10ColumnTypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"L\n" +
+	"\bRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x03R\x03row\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\tR\x06column\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"L\n" +
+	"\n" +
+	"MergeInput\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\tR\x04data\"\xb0\x02\n" +
+	"\fMergeRequest\x12(\n" +
+	"\x06inputs\x18\x01 \x03(\v2\x10.data.MergeInputR\x06inputs\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12\x12\n" +
+	"\x04keys\x18\x04 \x03(\tR\x04keys\x12\x12\n" +
+	"\x04join\x18\x05 \x01(\tR\x04join\x12#\n" +
+	"\rsource_column\x18\x06 \x01(\tR\fsourceColumn\x12.\n" +
+	"\aoptions\x18\a \x01(\v2\x14.data.ConvertOptionsR\aoptions\x12\x1f\n" +
+	"\vtime_column\x18\b \x01(\tR\n" +
+	"timeColumn\x12\x1c\n" +
+	"\ttolerance\x18\t \x01(\tR\ttolerance\x12\x16\n" +
+	"\x06method\x18\n" +
+	" \x01(\tR\x06method\"\xbc\a\n" +
+	"\fPipelineStep\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06filter\x18\x02 \x01(\tR\x06filter\x126\n" +
+	"\x06rename\x18\x03 \x03(\v2\x1e.data.PipelineStep.RenameEntryR\x06rename\x12\x16\n" +
+	"\x06column\x18\x04 \x01(\tR\x06column\x12\x1b\n" +
+	"\tfrom_unit\x18\x05 \x01(\tR\bfromUnit\x12\x17\n" +
+	"\ato_unit\x18\x06 \x01(\tR\x06toUnit\x12\x1e\n" +
+	"\n" +
+	"expression\x18\a \x01(\tR\n" +
+	"expression\x12C\n" +
+	"\x0fquality_control\x18\b \x01(\v2\x1a.data.QualityControlConfigR\x0equalityControl\x12:\n" +
+	"\tanomalies\x18\t \x01(\v2\x1c.data.AnomalyDetectionConfigR\tanomalies\x12?\n" +
+	"\rinterpolation\x18\n" +
+	" \x01(\v2\x19.data.InterpolationConfigR\rinterpolation\x129\n" +
+	"\vaggregation\x18\v \x01(\v2\x17.data.AggregationConfigR\vaggregation\x123\n" +
+	"\tsmoothing\x18\f \x01(\v2\x15.data.SmoothingConfigR\tsmoothing\x12=\n" +
+	"\rdepth_binning\x18\r \x01(\v2\x18.data.DepthBinningConfigR\fdepthBinning\x129\n" +
+	"\vcoordinates\x18\x0e \x01(\v2\x17.data.CoordinatesConfigR\vcoordinates\x120\n" +
+	"\bgeofence\x18\x0f \x01(\v2\x14.data.GeofenceConfigR\bgeofence\x12'\n" +
+	"\x05track\x18\x10 \x01(\v2\x11.data.TrackConfigR\x05track\x126\n" +
+	"\n" +
+	"enrichment\x18\x11 \x01(\v2\x16.data.EnrichmentConfigR\n" +
+	"enrichment\x127\n" +
+	"\vcalibration\x18\x12 \x01(\v2\x15.data.CalibrateConfigR\vcalibration\x12'\n" +
+	"\x05drift\x18\x13 \x01(\v2\x11.data.DriftConfigR\x05drift\x1a9\n" +
+	"\vRenameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbe\x01\n" +
+	"\x0fPipelineRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\tR\x04data\x12\x19\n" +
+	"\braw_data\x18\x04 \x01(\fR\arawData\x12(\n" +
+	"\x05steps\x18\x05 \x03(\v2\x12.data.PipelineStepR\x05steps\x12.\n" +
+	"\aoptions\x18\x06 \x01(\v2\x14.data.ConvertOptionsR\aoptions\"\x84\x01\n" +
+	"\n" +
+	"GrossRange\x12\x19\n" +
+	"\bfail_min\x18\x01 \x01(\x01R\afailMin\x12\x19\n" +
+	"\bfail_max\x18\x02 \x01(\x01R\afailMax\x12\x1f\n" +
+	"\vsuspect_min\x18\x03 \x01(\x01R\n" +
+	"suspectMin\x12\x1f\n" +
+	"\vsuspect_max\x18\x04 \x01(\x01R\n" +
+	"suspectMax\"9\n" +
+	"\tSpikeTest\x12\x18\n" +
+	"\asuspect\x18\x01 \x01(\x01R\asuspect\x12\x12\n" +
+	"\x04fail\x18\x02 \x01(\x01R\x04fail\"Z\n" +
+	"\fFlatLineTest\x12\x18\n" +
+	"\asuspect\x18\x01 \x01(\x05R\asuspect\x12\x12\n" +
+	"\x04fail\x18\x02 \x01(\x05R\x04fail\x12\x1c\n" +
+	"\ttolerance\x18\x03 \x01(\x01R\ttolerance\"\xeb\x01\n" +
+	"\x06QcTest\x12\x18\n" +
+	"\astation\x18\x01 \x01(\tR\astation\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\tR\x06column\x121\n" +
+	"\vgross_range\x18\x03 \x01(\v2\x10.data.GrossRangeR\n" +
+	"grossRange\x12%\n" +
+	"\x05spike\x18\x04 \x01(\v2\x0f.data.SpikeTestR\x05spike\x12$\n" +
+	"\x0erate_of_change\x18\x05 \x01(\x01R\frateOfChange\x12/\n" +
+	"\tflat_line\x18\x06 \x01(\v2\x12.data.FlatLineTestR\bflatLine\"\x8c\x02\n" +
+	"\fLocationTest\x12\x18\n" +
+	"\astation\x18\x01 \x01(\tR\astation\x12'\n" +
+	"\x0flatitude_column\x18\x02 \x01(\tR\x0elatitudeColumn\x12)\n" +
+	"\x10longitude_column\x18\x03 \x01(\tR\x0flongitudeColumn\x12!\n" +
+	"\fmin_latitude\x18\x04 \x01(\x01R\vminLatitude\x12!\n" +
+	"\fmax_latitude\x18\x05 \x01(\x01R\vmaxLatitude\x12#\n" +
+	"\rmin_longitude\x18\x06 \x01(\x01R\fminLongitude\x12#\n" +
+	"\rmax_longitude\x18\a \x01(\x01R\fmaxLongitude\"\xd5\x01\n" +
+	"\x14QualityControlConfig\x12%\n" +
+	"\x0estation_column\x18\x01 \x01(\tR\rstationColumn\x12\x1f\n" +
+	"\vtime_column\x18\x02 \x01(\tR\n" +
+	"timeColumn\x12\x1f\n" +
+	"\vflag_suffix\x18\x03 \x01(\tR\n" +
+	"flagSuffix\x12\"\n" +
+	"\x05tests\x18\x04 \x03(\v2\f.data.QcTestR\x05tests\x120\n" +
+	"\tlocations\x18\x05 \x03(\v2\x12.data.LocationTestR\tlocations\"\xce\x01\n" +
+	"\x15QualityControlRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\tR\x04data\x12\x19\n" +
+	"\braw_data\x18\x04 \x01(\fR\arawData\x122\n" +
+	"\x06config\x18\x05 \x01(\v2\x1a.data.QualityControlConfigR\x06config\x12.\n" +
+	"\aoptions\x18\x06 \x01(\v2\x14.data.ConvertOptionsR\aoptions\"\xc8\x01\n" +
+	"\x16AnomalyDetectionConfig\x12\x18\n" +
+	"\acolumns\x18\x01 \x03(\tR\acolumns\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x16\n" +
+	"\x06window\x18\x03 \x01(\x05R\x06window\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x01R\tthreshold\x12%\n" +
+	"\x0estation_column\x18\x05 \x01(\tR\rstationColumn\x12\x1f\n" +
+	"\vflag_suffix\x18\x06 \x01(\tR\n" +
+	"flagSuffix\"\xc4\x01\n" +
+	"\x13InterpolationConfig\x12\x1f\n" +
+	"\vtime_column\x18\x01 \x01(\tR\n" +
+	"timeColumn\x12%\n" +
+	"\x0estation_column\x18\x02 \x01(\tR\rstationColumn\x12\x18\n" +
+	"\acolumns\x18\x03 \x03(\tR\acolumns\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x1a\n" +
+	"\binterval\x18\x05 \x01(\tR\binterval\x12\x17\n" +
+	"\amax_gap\x18\x06 \x01(\tR\x06maxGap\"\xab\x01\n" +
+	"\x11AggregationConfig\x12\x1f\n" +
+	"\vtime_column\x18\x01 \x01(\tR\n" +
+	"timeColumn\x12%\n" +
+	"\x0estation_column\x18\x02 \x01(\tR\rstationColumn\x12\x16\n" +
+	"\x06window\x18\x03 \x01(\tR\x06window\x12\x18\n" +
+	"\acolumns\x18\x04 \x03(\tR\acolumns\x12\x1c\n" +
+	"\tfunctions\x18\x05 \x03(\tR\tfunctions\"\xb6\x01\n" +
+	"\x0fSmoothingConfig\x12\x18\n" +
+	"\acolumns\x18\x01 \x03(\tR\acolumns\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x16\n" +
+	"\x06window\x18\x03 \x01(\x05R\x06window\x12\x1a\n" +
+	"\btrailing\x18\x04 \x01(\bR\btrailing\x12%\n" +
+	"\x0estation_column\x18\x05 \x01(\tR\rstationColumn\x12\x16\n" +
+	"\x06suffix\x18\x06 \x01(\tR\x06suffix\"\x91\x01\n" +
+	"\x12DepthBinningConfig\x12!\n" +
+	"\fdepth_column\x18\x01 \x01(\tR\vdepthColumn\x12\x19\n" +
+	"\bbin_size\x18\x02 \x01(\x01R\abinSize\x12\x18\n" +
+	"\acolumns\x18\x03 \x03(\tR\acolumns\x12#\n" +
+	"\rgroup_columns\x18\x04 \x03(\tR\fgroupColumns\"\x8b\x01\n" +
+	"\x11CoordinatesConfig\x12'\n" +
+	"\x0flatitude_column\x18\x01 \x01(\tR\x0elatitudeColumn\x12)\n" +
+	"\x10longitude_column\x18\x02 \x01(\tR\x0flongitudeColumn\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\"\x9d\x01\n" +
+	"\vBoundingBox\x12!\n" +
+	"\fmin_latitude\x18\x01 \x01(\x01R\vminLatitude\x12#\n" +
+	"\rmin_longitude\x18\x02 \x01(\x01R\fminLongitude\x12!\n" +
+	"\fmax_latitude\x18\x03 \x01(\x01R\vmaxLatitude\x12#\n" +
+	"\rmax_longitude\x18\x04 \x01(\x01R\fmaxLongitude\"\xce\x01\n" +
+	"\x0eGeofenceConfig\x12'\n" +
+	"\x0flatitude_column\x18\x01 \x01(\tR\x0elatitudeColumn\x12)\n" +
+	"\x10longitude_column\x18\x02 \x01(\tR\x0flongitudeColumn\x124\n" +
+	"\fbounding_box\x18\x03 \x01(\v2\x11.data.BoundingBoxR\vboundingBox\x12\x18\n" +
+	"\ageojson\x18\x04 \x01(\tR\ageojson\x12\x18\n" +
+	"\aexclude\x18\x05 \x01(\bR\aexclude\"A\n" +
+	"\x05Point\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\xc1\x02\n" +
+	"\vTrackConfig\x12'\n" +
+	"\x0flatitude_column\x18\x01 \x01(\tR\x0elatitudeColumn\x12)\n" +
+	"\x10longitude_column\x18\x02 \x01(\tR\x0flongitudeColumn\x12\x1f\n" +
+	"\vtime_column\x18\x03 \x01(\tR\n" +
+	"timeColumn\x12%\n" +
+	"\x0estation_column\x18\x04 \x01(\tR\rstationColumn\x12)\n" +
+	"\treference\x18\x05 \x01(\v2\v.data.PointR\treference\x12'\n" +
+	"\x0fdistance_column\x18\x06 \x01(\tR\x0edistanceColumn\x12\x1f\n" +
+	"\vstep_column\x18\a \x01(\tR\n" +
+	"stepColumn\x12!\n" +
+	"\fspeed_column\x18\b \x01(\tR\vspeedColumn\"\xb3\x01\n" +
+	"\x10EnrichmentConfig\x12%\n" +
+	"\x0estation_column\x18\x01 \x01(\tR\rstationColumn\x12\x1f\n" +
+	"\vtime_column\x18\x02 \x01(\tR\n" +
+	"timeColumn\x12\x16\n" +
+	"\x06fields\x18\x03 \x03(\tR\x06fields\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12'\n" +
+	"\x0frequire_station\x18\x05 \x01(\bR\x0erequireStation\"0\n" +
+	"\n" +
+	"Polynomial\x12\"\n" +
+	"\fcoefficients\x18\x01 \x03(\x01R\fcoefficients\"\xab\x02\n" +
+	"\x0fCalibrateConfig\x12K\n" +
+	"\fcoefficients\x18\x01 \x03(\v2'.data.CalibrateConfig.CoefficientsEntryR\fcoefficients\x12%\n" +
+	"\x0estation_column\x18\x02 \x01(\tR\rstationColumn\x12\x1f\n" +
+	"\vtime_column\x18\x03 \x01(\tR\n" +
+	"timeColumn\x12\x18\n" +
+	"\acolumns\x18\x04 \x03(\tR\acolumns\x12\x16\n" +
+	"\x06suffix\x18\x05 \x01(\tR\x06suffix\x1aQ\n" +
+	"\x11CoefficientsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12&\n" +
+	"\x05value\x18\x02 \x01(\v2\x10.data.PolynomialR\x05value:\x028\x01\"8\n" +
+	"\n" +
+	"DriftPoint\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\tR\x04time\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x01R\x06offset\"\x8a\x01\n" +
+	"\vDriftConfig\x12\x18\n" +
+	"\acolumns\x18\x01 \x03(\tR\acolumns\x12\x1f\n" +
+	"\vtime_column\x18\x02 \x01(\tR\n" +
+	"timeColumn\x12(\n" +
+	"\x06points\x18\x03 \x03(\v2\x10.data.DriftPointR\x06points\x12\x16\n" +
+	"\x06suffix\x18\x04 \x01(\tR\x06suffix\"\xc6\x01\n" +
+	"\x10AggregateRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\tR\x04data\x12\x19\n" +
+	"\braw_data\x18\x04 \x01(\fR\arawData\x12/\n" +
+	"\x06config\x18\x05 \x01(\v2\x17.data.AggregationConfigR\x06config\x12.\n" +
+	"\aoptions\x18\x06 \x01(\v2\x14.data.ConvertOptionsR\aoptions\"\xd1\x01\n" +
+	"\x16DetectAnomaliesRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\tR\x04data\x12\x19\n" +
+	"\braw_data\x18\x04 \x01(\fR\arawData\x124\n" +
+	"\x06config\x18\x05 \x01(\v2\x1c.data.AnomalyDetectionConfigR\x06config\x12.\n" +
+	"\aoptions\x18\x06 \x01(\v2\x14.data.ConvertOptionsR\aoptions\"\xff\x01\n" +
+	"\fSplitRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\tR\x04data\x12\x19\n" +
+	"\braw_data\x18\x04 \x01(\fR\arawData\x12\x0e\n" +
+	"\x02by\x18\x05 \x01(\tR\x02by\x12\x12\n" +
+	"\x04rows\x18\x06 \x01(\x03R\x04rows\x12\x16\n" +
+	"\x06column\x18\a \x01(\tR\x06column\x12\x16\n" +
+	"\x06window\x18\b \x01(\tR\x06window\x12\x18\n" +
+	"\aarchive\x18\t \x01(\bR\aarchive\x12.\n" +
+	"\aoptions\x18\n" +
+	" \x01(\v2\x14.data.ConvertOptionsR\aoptions\"e\n" +
+	"\x04Part\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04rows\x18\x02 \x01(\x03R\x04rows\x12\x16\n" +
+	"\x06result\x18\x03 \x01(\tR\x06result\x12\x1d\n" +
+	"\n" +
+	"raw_result\x18\x04 \x01(\fR\trawResult\"\xdd\x02\n" +
+	"\rSplitResponse\x12 \n" +
+	"\x05parts\x18\x01 \x03(\v2\n" +
+	".data.PartR\x05parts\x12\x18\n" +
+	"\aarchive\x18\x02 \x01(\fR\aarchive\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\x12\x1a\n" +
+	"\bpreamble\x18\x04 \x03(\tR\bpreamble\x12=\n" +
+	"\bmetadata\x18\x05 \x03(\v2!.data.SplitResponse.MetadataEntryR\bmetadata\x12-\n" +
+	"\x12duplicates_removed\x18\x06 \x01(\x03R\x11duplicatesRemoved\x12-\n" +
+	"\n" +
+	"row_errors\x18\a \x03(\v2\x0e.data.RowErrorR\trowErrors\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xac\x01\n" +
+	"\x12InferSchemaRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x12\x19\n" +
+	"\braw_data\x18\x03 \x01(\fR\arawData\x12\x1f\n" +
+	"\vsample_size\x18\x04 \x01(\x05R\n" +
+	"sampleSize\x12.\n" +
+	"\aoptions\x18\x05 \x01(\v2\x14.data.ConvertOptionsR\aoptions\"\xaf\x01\n" +
+	"\fColumnSchema\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\bnullable\x18\x03 \x01(\bR\bnullable\x12\x1d\n" +
+	"\n" +
+	"null_count\x18\x04 \x01(\x03R\tnullCount\x12\x10\n" +
+	"\x03min\x18\x05 \x01(\tR\x03min\x12\x10\n" +
+	"\x03max\x18\x06 \x01(\tR\x03max\x12\x18\n" +
+	"\asamples\x18\a \x03(\tR\asamples\"s\n" +
+	"\x13InferSchemaResponse\x12,\n" +
+	"\acolumns\x18\x01 \x03(\v2\x12.data.ColumnSchemaR\acolumns\x12\x12\n" +
+	"\x04rows\x18\x02 \x01(\x03R\x04rows\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\"\xae\x01\n" +
+	"\x13DescribeDataRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x12\x19\n" +
+	"\braw_data\x18\x03 \x01(\fR\arawData\x12 \n" +
+	"\vpercentiles\x18\x04 \x03(\x01R\vpercentiles\x12.\n" +
+	"\aoptions\x18\x05 \x01(\v2\x14.data.ConvertOptionsR\aoptions\"0\n" +
+	"\n" +
+	"Percentile\x12\f\n" +
+	"\x01p\x18\x01 \x01(\x01R\x01p\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"\xf6\x01\n" +
+	"\rColumnSummary\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x1d\n" +
+	"\n" +
+	"null_count\x18\x03 \x01(\x03R\tnullCount\x12\x18\n" +
+	"\anumeric\x18\x04 \x01(\x03R\anumeric\x12\x10\n" +
+	"\x03min\x18\x05 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x06 \x01(\x01R\x03max\x12\x12\n" +
+	"\x04mean\x18\a \x01(\x01R\x04mean\x12\x16\n" +
+	"\x06stddev\x18\b \x01(\x01R\x06stddev\x122\n" +
+	"\vpercentiles\x18\t \x03(\v2\x10.data.PercentileR\vpercentiles\"u\n" +
+	"\x14DescribeDataResponse\x12-\n" +
+	"\acolumns\x18\x01 \x03(\v2\x13.data.ColumnSummaryR\acolumns\x12\x12\n" +
+	"\x04rows\x18\x02 \x01(\x03R\x04rows\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\"\xfe\x01\n" +
+	"\x0fValidateRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x12\x19\n" +
+	"\braw_data\x18\x03 \x01(\fR\arawData\x12\x1f\n" +
+	"\vjson_schema\x18\x04 \x01(\tR\n" +
+	"jsonSchema\x12,\n" +
+	"\acolumns\x18\x05 \x03(\v2\x12.data.ColumnSchemaR\acolumns\x12%\n" +
+	"\x0emax_violations\x18\x06 \x01(\x05R\rmaxViolations\x12.\n" +
+	"\aoptions\x18\a \x01(\v2\x14.data.ConvertOptionsR\aoptions\"M\n" +
+	"\tViolation\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x03R\x03row\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\tR\x06column\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x89\x01\n" +
+	"\x10ValidateResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x12\n" +
+	"\x04rows\x18\x02 \x01(\x03R\x04rows\x12/\n" +
+	"\n" +
+	"violations\x18\x03 \x03(\v2\x0f.data.ViolationR\n" +
+	"violations\x12\x1a\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\"\xb4\x02\n" +
+	"\x11DetectGapsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x12\x19\n" +
+	"\braw_data\x18\x03 \x01(\fR\arawData\x12\x1f\n" +
+	"\vtime_column\x18\x04 \x01(\tR\n" +
+	"timeColumn\x12%\n" +
+	"\x0estation_column\x18\x05 \x01(\tR\rstationColumn\x12\x1a\n" +
+	"\binterval\x18\x06 \x01(\tR\binterval\x12\x1c\n" +
+	"\ttolerance\x18\a \x01(\tR\ttolerance\x12\x14\n" +
+	"\x05start\x18\b \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\t \x01(\tR\x03end\x12.\n" +
+	"\aoptions\x18\n" +
+	" \x01(\v2\x14.data.ConvertOptionsR\aoptions\"\x8c\x01\n" +
+	"\x03Gap\x12\x18\n" +
+	"\astation\x18\x01 \x01(\tR\astation\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12)\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x01R\x0fdurationSeconds\x12\x18\n" +
+	"\amissing\x18\x05 \x01(\x03R\amissing\"\x83\x01\n" +
+	"\tGapSeries\x12\x18\n" +
+	"\astation\x18\x01 \x01(\tR\astation\x12\x14\n" +
+	"\x05first\x18\x02 \x01(\tR\x05first\x12\x12\n" +
+	"\x04last\x18\x03 \x01(\tR\x04last\x12\x18\n" +
+	"\asamples\x18\x04 \x01(\x03R\asamples\x12\x18\n" +
+	"\amissing\x18\x05 \x01(\x03R\amissing\"\x8c\x01\n" +
+	"\x12DetectGapsResponse\x12\x12\n" +
+	"\x04rows\x18\x01 \x01(\x03R\x04rows\x12\x1d\n" +
+	"\x04gaps\x18\x02 \x03(\v2\t.data.GapR\x04gaps\x12'\n" +
+	"\x06series\x18\x03 \x03(\v2\x0f.data.GapSeriesR\x06series\x12\x1a\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\"\xe3\x01\n" +
+	"\x10CorrelateRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x12\x19\n" +
+	"\braw_data\x18\x03 \x01(\fR\arawData\x12\x18\n" +
+	"\acolumns\x18\x04 \x03(\tR\acolumns\x12%\n" +
+	"\x0estation_column\x18\x05 \x01(\tR\rstationColumn\x12\x17\n" +
+	"\amax_lag\x18\x06 \x01(\x05R\x06maxLag\x12.\n" +
+	"\aoptions\x18\a \x01(\v2\x14.data.ConvertOptionsR\aoptions\"(\n" +
+	"\x0eCorrelationRow\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\x01R\x06values\"W\n" +
+	"\vCorrelation\x12\f\n" +
+	"\x01x\x18\x01 \x01(\tR\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\tR\x01y\x12\x10\n" +
+	"\x03lag\x18\x03 \x01(\x05R\x03lag\x12\f\n" +
+	"\x01r\x18\x04 \x01(\x01R\x01r\x12\f\n" +
+	"\x01n\x18\x05 \x01(\x03R\x01n\"\xb6\x01\n" +
+	"\x11CorrelateResponse\x12\x12\n" +
+	"\x04rows\x18\x01 \x01(\x03R\x04rows\x12\x18\n" +
+	"\acolumns\x18\x02 \x03(\tR\acolumns\x12,\n" +
+	"\x06matrix\x18\x03 \x03(\v2\x14.data.CorrelationRowR\x06matrix\x12)\n" +
+	"\x06lagged\x18\x04 \x03(\v2\x11.data.CorrelationR\x06lagged\x12\x1a\n" +
+	"\bwarnings\x18\x05 \x03(\tR\bwarnings\"\x1c\n" +
+	"\x1aCompatibilityMatrixRequest\"f\n" +
+	"\x12CompatibilityEntry\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\tR\x05level\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"Q\n" +
+	"\x1bCompatibilityMatrixResponse\x122\n" +
+	"\aentries\x18\x01 \x03(\v2\x18.data.CompatibilityEntryR\aentries\"\\\n" +
+	"\n" +
+	"Instrument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06column\x18\x03 \x01(\tR\x06column\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\"\x99\x01\n" +
+	"\x16RegisterStationRequest\x12\x1d\n" +
+	"\n" +
+	"station_id\x18\x01 \x01(\tR\tstationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acontact\x18\x03 \x01(\tR\acontact\x122\n" +
+	"\vinstruments\x18\x04 \x03(\v2\x10.data.InstrumentR\vinstruments\"\x83\x01\n" +
+	"\x17RegisterStationResponse\x12'\n" +
+	"\x0fregistration_id\x18\x01 \x01(\tR\x0eregistrationId\x12'\n" +
+	"\x0fbootstrap_token\x18\x02 \x01(\tR\x0ebootstrapToken\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"r\n" +
+	"\x15ApproveStationRequest\x12'\n" +
+	"\x0fregistration_id\x18\x01 \x01(\tR\x0eregistrationId\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"0\n" +
+	"\x16ApproveStationResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"m\n" +
+	"\x19RegistrationStatusRequest\x12'\n" +
+	"\x0fregistration_id\x18\x01 \x01(\tR\x0eregistrationId\x12'\n" +
+	"\x0fbootstrap_token\x18\x02 \x01(\tR\x0ebootstrapToken\"\xbc\x01\n" +
+	"\x1aRegistrationStatusResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x17\n" +
+	"\aapi_key\x18\x03 \x01(\tR\x06apiKey\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12=\n" +
+	"\x0fdefault_profile\x18\x05 \x01(\v2\x14.data.ConvertOptionsR\x0edefaultProfile\"}\n" +
+	"\vCalibration\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\"\n" +
+	"\fcoefficients\x18\x02 \x03(\x01R\fcoefficients\x12 \n" +
+	"\vcertificate\x18\x03 \x01(\tR\vcertificate\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\"\xca\x01\n" +
+	"\x06Sensor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12#\n" +
+	"\rserial_number\x18\x04 \x01(\tR\fserialNumber\x12\x16\n" +
+	"\x06column\x18\x05 \x01(\tR\x06column\x12\x12\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\x125\n" +
+	"\fcalibrations\x18\a \x03(\v2\x11.data.CalibrationR\fcalibrations\"Z\n" +
+	"\bLocation\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x14\n" +
+	"\x05depth\x18\x03 \x01(\x01R\x05depth\"\x86\x01\n" +
+	"\n" +
+	"Deployment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12*\n" +
+	"\blocation\x18\x04 \x01(\v2\x0e.data.LocationR\blocation\x12\x14\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\"\xa0\x02\n" +
+	"\bPlatform\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12&\n" +
+	"\asensors\x18\x05 \x03(\v2\f.data.SensorR\asensors\x122\n" +
+	"\vdeployments\x18\x06 \x03(\v2\x10.data.DeploymentR\vdeployments\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12 \n" +
+	"\vinstitution\x18\t \x01(\tR\vinstitution\"C\n" +
+	"\x15CreatePlatformRequest\x12*\n" +
+	"\bplatform\x18\x01 \x01(\v2\x0e.data.PlatformR\bplatform\"$\n" +
+	"\x12GetPlatformRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
+	"\x14ListPlatformsRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\"E\n" +
+	"\x15ListPlatformsResponse\x12,\n" +
+	"\tplatforms\x18\x01 \x03(\v2\x0e.data.PlatformR\tplatforms\"C\n" +
+	"\x15UpdatePlatformRequest\x12*\n" +
+	"\bplatform\x18\x01 \x01(\v2\x0e.data.PlatformR\bplatform\"'\n" +
+	"\x15DeletePlatformRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeletePlatformResponse\"Y\n" +
+	"\x10PutSensorRequest\x12\x1f\n" +
+	"\vplatform_id\x18\x01 \x01(\tR\n" +
+	"platformId\x12$\n" +
+	"\x06sensor\x18\x02 \x01(\v2\f.data.SensorR\x06sensor\"S\n" +
+	"\x13DeleteSensorRequest\x12\x1f\n" +
+	"\vplatform_id\x18\x01 \x01(\tR\n" +
+	"platformId\x12\x1b\n" +
+	"\tsensor_id\x18\x02 \x01(\tR\bsensorId\"\x8a\x01\n" +
+	"\x15AddCalibrationRequest\x12\x1f\n" +
+	"\vplatform_id\x18\x01 \x01(\tR\n" +
+	"platformId\x12\x1b\n" +
+	"\tsensor_id\x18\x02 \x01(\tR\bsensorId\x123\n" +
+	"\vcalibration\x18\x03 \x01(\v2\x11.data.CalibrationR\vcalibration\"i\n" +
+	"\x14PutDeploymentRequest\x12\x1f\n" +
+	"\vplatform_id\x18\x01 \x01(\tR\n" +
+	"platformId\x120\n" +
+	"\n" +
+	"deployment\x18\x02 \x01(\v2\x10.data.DeploymentR\n" +
+	"deployment\"_\n" +
+	"\x17DeleteDeploymentRequest\x12\x1f\n" +
+	"\vplatform_id\x18\x01 \x01(\tR\n" +
+	"platformId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\"\xaa\x02\n" +
+	"\tAlertRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tparameter\x18\x02 \x01(\tR\tparameter\x12\x1a\n" +
+	"\boperator\x18\x03 \x01(\tR\boperator\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x01R\tthreshold\x12\x18\n" +
+	"\astation\x18\x05 \x01(\tR\astation\x12%\n" +
+	"\x0estation_column\x18\x06 \x01(\tR\rstationColumn\x12\x1f\n" +
+	"\vtime_column\x18\a \x01(\tR\n" +
+	"timeColumn\x12\x1a\n" +
+	"\bduration\x18\b \x01(\tR\bduration\x12\x18\n" +
+	"\awebhook\x18\t \x01(\tR\awebhook\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"=\n" +
+	"\x16CreateAlertRuleRequest\x12#\n" +
+	"\x04rule\x18\x01 \x01(\v2\x0f.data.AlertRuleR\x04rule\"\x17\n" +
+	"\x15ListAlertRulesRequest\"?\n" +
+	"\x16ListAlertRulesResponse\x12%\n" +
+	"\x05rules\x18\x01 \x03(\v2\x0f.data.AlertRuleR\x05rules\"(\n" +
+	"\x16DeleteAlertRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x19\n" +
+	"\x17DeleteAlertRuleResponse\"G\n" +
+	"\x12WatchAlertsRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x18\n" +
+	"\astation\x18\x02 \x01(\tR\astation\"\xed\x01\n" +
+	"\n" +
+	"AlertEvent\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x18\n" +
+	"\astation\x18\x02 \x01(\tR\astation\x12\x1c\n" +
+	"\tparameter\x18\x03 \x01(\tR\tparameter\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x1a\n" +
+	"\boperator\x18\x05 \x01(\tR\boperator\x12\x1c\n" +
+	"\tthreshold\x18\x06 \x01(\x01R\tthreshold\x12\x14\n" +
+	"\x05value\x18\a \x01(\x01R\x05value\x12\x14\n" +
+	"\x05since\x18\b \x01(\tR\x05since\x12\x12\n" +
+	"\x04time\x18\t \x01(\tR\x04time2\xab\f\n" +
+	"\n" +
+	"DataParser\x120\n" +
+	"\x05Parse\x12\x12.data.ParseRequest\x1a\x13.data.ParseResponse\x12]\n" +
+	"\x16GetCompatibilityMatrix\x12 .data.CompatibilityMatrixRequest\x1a!.data.CompatibilityMatrixResponse\x12N\n" +
+	"\x0fRegisterStation\x12\x1c.data.RegisterStationRequest\x1a\x1d.data.RegisterStationResponse\x12K\n" +
+	"\x0eApproveStation\x12\x1b.data.ApproveStationRequest\x1a\x1c.data.ApproveStationResponse\x12Z\n" +
+	"\x15GetRegistrationStatus\x12\x1f.data.RegistrationStatusRequest\x1a .data.RegistrationStatusResponse\x120\n" +
+	"\x05Merge\x12\x12.data.MergeRequest\x1a\x13.data.ParseResponse\x120\n" +
+	"\x05Split\x12\x12.data.SplitRequest\x1a\x13.data.SplitResponse\x12B\n" +
+	"\vInferSchema\x12\x18.data.InferSchemaRequest\x1a\x19.data.InferSchemaResponse\x12E\n" +
+	"\fDescribeData\x12\x19.data.DescribeDataRequest\x1a\x1a.data.DescribeDataResponse\x129\n" +
+	"\bValidate\x12\x15.data.ValidateRequest\x1a\x16.data.ValidateResponse\x126\n" +
+	"\bPipeline\x12\x15.data.PipelineRequest\x1a\x13.data.ParseResponse\x12B\n" +
+	"\x0eQualityControl\x12\x1b.data.QualityControlRequest\x1a\x13.data.ParseResponse\x12D\n" +
+	"\x0fDetectAnomalies\x12\x1c.data.DetectAnomaliesRequest\x1a\x13.data.ParseResponse\x12?\n" +
+	"\n" +
+	"DetectGaps\x12\x17.data.DetectGapsRequest\x1a\x18.data.DetectGapsResponse\x12<\n" +
+	"\tCorrelate\x12\x16.data.CorrelateRequest\x1a\x17.data.CorrelateResponse\x128\n" +
+	"\tAggregate\x12\x16.data.AggregateRequest\x1a\x13.data.ParseResponse\x12?\n" +
+	"\n" +
+	"ParseBatch\x12\x17.data.ParseBatchRequest\x1a\x18.data.ParseBatchResponse\x120\n" +
+	"\tSubmitJob\x12\x12.data.ParseRequest\x1a\x0f.data.JobStatus\x121\n" +
+	"\fGetJobStatus\x12\x10.data.JobRequest\x1a\x0f.data.JobStatus\x125\n" +
+	"\fGetJobResult\x12\x10.data.JobRequest\x1a\x13.data.ParseResponse\x12.\n" +
+	"\tCancelJob\x12\x10.data.JobRequest\x1a\x0f.data.JobStatus\x123\n" +
+	"\bGetUsage\x12\x12.data.UsageRequest\x1a\x13.data.UsageResponse\x126\n" +
+	"\tParseLive\x12\x11.data.LiveRequest\x1a\x12.data.LiveResponse(\x010\x01\x129\n" +
+	"\bListJobs\x12\x15.data.ListJobsRequest\x1a\x16.data.ListJobsResponse\x129\n" +
+	"\n" +
+	"GetHistory\x12\x14.data.HistoryRequest\x1a\x15.data.HistoryResponse2\x8e\x05\n" +
+	"\x0fStationRegistry\x12=\n" +
+	"\x0eCreatePlatform\x12\x1b.data.CreatePlatformRequest\x1a\x0e.data.Platform\x127\n" +
+	"\vGetPlatform\x12\x18.data.GetPlatformRequest\x1a\x0e.data.Platform\x12H\n" +
+	"\rListPlatforms\x12\x1a.data.ListPlatformsRequest\x1a\x1b.data.ListPlatformsResponse\x12=\n" +
+	"\x0eUpdatePlatform\x12\x1b.data.UpdatePlatformRequest\x1a\x0e.data.Platform\x12K\n" +
+	"\x0eDeletePlatform\x12\x1b.data.DeletePlatformRequest\x1a\x1c.data.DeletePlatformResponse\x123\n" +
+	"\tPutSensor\x12\x16.data.PutSensorRequest\x1a\x0e.data.Platform\x129\n" +
+	"\fDeleteSensor\x12\x19.data.DeleteSensorRequest\x1a\x0e.data.Platform\x12=\n" +
+	"\x0eAddCalibration\x12\x1b.data.AddCalibrationRequest\x1a\x0e.data.Platform\x12;\n" +
+	"\rPutDeployment\x12\x1a.data.PutDeploymentRequest\x1a\x0e.data.Platform\x12A\n" +
+	"\x10DeleteDeployment\x12\x1d.data.DeleteDeploymentRequest\x1a\x0e.data.Platform2\xa6\x02\n" +
+	"\bAlerting\x12@\n" +
+	"\x0fCreateAlertRule\x12\x1c.data.CreateAlertRuleRequest\x1a\x0f.data.AlertRule\x12K\n" +
+	"\x0eListAlertRules\x12\x1b.data.ListAlertRulesRequest\x1a\x1c.data.ListAlertRulesResponse\x12N\n" +
+	"\x0fDeleteAlertRule\x12\x1c.data.DeleteAlertRuleRequest\x1a\x1d.data.DeleteAlertRuleResponse\x12;\n" +
+	"\vWatchAlerts\x12\x18.data.WatchAlertsRequest\x1a\x10.data.AlertEvent0\x01B\x1bZ\x19rpcGoDatatype/proto;protob\x06proto3"
 
 var (
 	file_proto_data_proto_rawDescOnce sync.Once
@@ -152,19 +8612,290 @@ func file_proto_data_proto_rawDescGZIP() []byte {
 	return file_proto_data_proto_rawDescData
 }
 
-var file_proto_data_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_data_proto_msgTypes = make([]protoimpl.MessageInfo, 114)
 var file_proto_data_proto_goTypes = []any{
-	(*ParseRequest)(nil),  // 0: data.ParseRequest
-	(*ParseResponse)(nil), // 1: data.ParseResponse
+	(*ParseRequest)(nil),                // 0: data.ParseRequest
+	(*ConvertOptions)(nil),              // 1: data.ConvertOptions
+	(*RangeRule)(nil),                   // 2: data.RangeRule
+	(*ParseResponse)(nil),               // 3: data.ParseResponse
+	(*Anomaly)(nil),                     // 4: data.Anomaly
+	(*ParseBatchRequest)(nil),           // 5: data.ParseBatchRequest
+	(*ParseBatchItem)(nil),              // 6: data.ParseBatchItem
+	(*ParseBatchResponse)(nil),          // 7: data.ParseBatchResponse
+	(*LiveRequest)(nil),                 // 8: data.LiveRequest
+	(*LiveResponse)(nil),                // 9: data.LiveResponse
+	(*JobRequest)(nil),                  // 10: data.JobRequest
+	(*JobStatus)(nil),                   // 11: data.JobStatus
+	(*UsageRequest)(nil),                // 12: data.UsageRequest
+	(*ClientUsage)(nil),                 // 13: data.ClientUsage
+	(*UsageResponse)(nil),               // 14: data.UsageResponse
+	(*ListJobsRequest)(nil),             // 15: data.ListJobsRequest
+	(*ListJobsResponse)(nil),            // 16: data.ListJobsResponse
+	(*HistoryRequest)(nil),              // 17: data.HistoryRequest
+	(*Conversion)(nil),                  // 18: data.Conversion
+	(*HistoryResponse)(nil),             // 19: data.HistoryResponse
+	(*ConversionStats)(nil),             // 20: data.ConversionStats
+	(*RowError)(nil),                    // 21: data.RowError
+	(*MergeInput)(nil),                  // 22: data.MergeInput
+	(*MergeRequest)(nil),                // 23: data.MergeRequest
+	(*PipelineStep)(nil),                // 24: data.PipelineStep
+	(*PipelineRequest)(nil),             // 25: data.PipelineRequest
+	(*GrossRange)(nil),                  // 26: data.GrossRange
+	(*SpikeTest)(nil),                   // 27: data.SpikeTest
+	(*FlatLineTest)(nil),                // 28: data.FlatLineTest
+	(*QcTest)(nil),                      // 29: data.QcTest
+	(*LocationTest)(nil),                // 30: data.LocationTest
+	(*QualityControlConfig)(nil),        // 31: data.QualityControlConfig
+	(*QualityControlRequest)(nil),       // 32: data.QualityControlRequest
+	(*AnomalyDetectionConfig)(nil),      // 33: data.AnomalyDetectionConfig
+	(*InterpolationConfig)(nil),         // 34: data.InterpolationConfig
+	(*AggregationConfig)(nil),           // 35: data.AggregationConfig
+	(*SmoothingConfig)(nil),             // 36: data.SmoothingConfig
+	(*DepthBinningConfig)(nil),          // 37: data.DepthBinningConfig
+	(*CoordinatesConfig)(nil),           // 38: data.CoordinatesConfig
+	(*BoundingBox)(nil),                 // 39: data.BoundingBox
+	(*GeofenceConfig)(nil),              // 40: data.GeofenceConfig
+	(*Point)(nil),                       // 41: data.Point
+	(*TrackConfig)(nil),                 // 42: data.TrackConfig
+	(*EnrichmentConfig)(nil),            // 43: data.EnrichmentConfig
+	(*Polynomial)(nil),                  // 44: data.Polynomial
+	(*CalibrateConfig)(nil),             // 45: data.CalibrateConfig
+	(*DriftPoint)(nil),                  // 46: data.DriftPoint
+	(*DriftConfig)(nil),                 // 47: data.DriftConfig
+	(*AggregateRequest)(nil),            // 48: data.AggregateRequest
+	(*DetectAnomaliesRequest)(nil),      // 49: data.DetectAnomaliesRequest
+	(*SplitRequest)(nil),                // 50: data.SplitRequest
+	(*Part)(nil),                        // 51: data.Part
+	(*SplitResponse)(nil),               // 52: data.SplitResponse
+	(*InferSchemaRequest)(nil),          // 53: data.InferSchemaRequest
+	(*ColumnSchema)(nil),                // 54: data.ColumnSchema
+	(*InferSchemaResponse)(nil),         // 55: data.InferSchemaResponse
+	(*DescribeDataRequest)(nil),         // 56: data.DescribeDataRequest
+	(*Percentile)(nil),                  // 57: data.Percentile
+	(*ColumnSummary)(nil),               // 58: data.ColumnSummary
+	(*DescribeDataResponse)(nil),        // 59: data.DescribeDataResponse
+	(*ValidateRequest)(nil),             // 60: data.ValidateRequest
+	(*Violation)(nil),                   // 61: data.Violation
+	(*ValidateResponse)(nil),            // 62: data.ValidateResponse
+	(*DetectGapsRequest)(nil),           // 63: data.DetectGapsRequest
+	(*Gap)(nil),                         // 64: data.Gap
+	(*GapSeries)(nil),                   // 65: data.GapSeries
+	(*DetectGapsResponse)(nil),          // 66: data.DetectGapsResponse
+	(*CorrelateRequest)(nil),            // 67: data.CorrelateRequest
+	(*CorrelationRow)(nil),              // 68: data.CorrelationRow
+	(*Correlation)(nil),                 // 69: data.Correlation
+	(*CorrelateResponse)(nil),           // 70: data.CorrelateResponse
+	(*CompatibilityMatrixRequest)(nil),  // 71: data.CompatibilityMatrixRequest
+	(*CompatibilityEntry)(nil),          // 72: data.CompatibilityEntry
+	(*CompatibilityMatrixResponse)(nil), // 73: data.CompatibilityMatrixResponse
+	(*Instrument)(nil),                  // 74: data.Instrument
+	(*RegisterStationRequest)(nil),      // 75: data.RegisterStationRequest
+	(*RegisterStationResponse)(nil),     // 76: data.RegisterStationResponse
+	(*ApproveStationRequest)(nil),       // 77: data.ApproveStationRequest
+	(*ApproveStationResponse)(nil),      // 78: data.ApproveStationResponse
+	(*RegistrationStatusRequest)(nil),   // 79: data.RegistrationStatusRequest
+	(*RegistrationStatusResponse)(nil),  // 80: data.RegistrationStatusResponse
+	(*Calibration)(nil),                 // 81: data.Calibration
+	(*Sensor)(nil),                      // 82: data.Sensor
+	(*Location)(nil),                    // 83: data.Location
+	(*Deployment)(nil),                  // 84: data.Deployment
+	(*Platform)(nil),                    // 85: data.Platform
+	(*CreatePlatformRequest)(nil),       // 86: data.CreatePlatformRequest
+	(*GetPlatformRequest)(nil),          // 87: data.GetPlatformRequest
+	(*ListPlatformsRequest)(nil),        // 88: data.ListPlatformsRequest
+	(*ListPlatformsResponse)(nil),       // 89: data.ListPlatformsResponse
+	(*UpdatePlatformRequest)(nil),       // 90: data.UpdatePlatformRequest
+	(*DeletePlatformRequest)(nil),       // 91: data.DeletePlatformRequest
+	(*DeletePlatformResponse)(nil),      // 92: data.DeletePlatformResponse
+	(*PutSensorRequest)(nil),            // 93: data.PutSensorRequest
+	(*DeleteSensorRequest)(nil),         // 94: data.DeleteSensorRequest
+	(*AddCalibrationRequest)(nil),       // 95: data.AddCalibrationRequest
+	(*PutDeploymentRequest)(nil),        // 96: data.PutDeploymentRequest
+	(*DeleteDeploymentRequest)(nil),     // 97: data.DeleteDeploymentRequest
+	(*AlertRule)(nil),                   // 98: data.AlertRule
+	(*CreateAlertRuleRequest)(nil),      // 99: data.CreateAlertRuleRequest
+	(*ListAlertRulesRequest)(nil),       // 100: data.ListAlertRulesRequest
+	(*ListAlertRulesResponse)(nil),      // 101: data.ListAlertRulesResponse
+	(*DeleteAlertRuleRequest)(nil),      // 102: data.DeleteAlertRuleRequest
+	(*DeleteAlertRuleResponse)(nil),     // 103: data.DeleteAlertRuleResponse
+	(*WatchAlertsRequest)(nil),          // 104: data.WatchAlertsRequest
+	(*AlertEvent)(nil),                  // 105: data.AlertEvent
+	nil,                                 // 106: data.ConvertOptions.ColumnTypesEntry
+	nil,                                 // 107: data.ConvertOptions.RenameEntry
+	nil,                                 // 108: data.ConvertOptions.PostgresColumnsEntry
+	nil,                                 // 109: data.ParseResponse.MetadataEntry
+	nil,                                 // 110: data.ConversionStats.ColumnTypesEntry
+	nil,                                 // 111: data.PipelineStep.RenameEntry
+	nil,                                 // 112: data.CalibrateConfig.CoefficientsEntry
+	nil,                                 // 113: data.SplitResponse.MetadataEntry
 }
 var file_proto_data_proto_depIdxs = []int32{
-	0, // 0: data.DataParser.Parse:input_type -> data.ParseRequest
-	1, // 1: data.DataParser.Parse:output_type -> data.ParseResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1,   // 0: data.ParseRequest.options:type_name -> data.ConvertOptions
+	106, // 1: data.ConvertOptions.column_types:type_name -> data.ConvertOptions.ColumnTypesEntry
+	107, // 2: data.ConvertOptions.rename:type_name -> data.ConvertOptions.RenameEntry
+	108, // 3: data.ConvertOptions.postgres_columns:type_name -> data.ConvertOptions.PostgresColumnsEntry
+	2,   // 4: data.ConvertOptions.ranges:type_name -> data.RangeRule
+	109, // 5: data.ParseResponse.metadata:type_name -> data.ParseResponse.MetadataEntry
+	21,  // 6: data.ParseResponse.row_errors:type_name -> data.RowError
+	20,  // 7: data.ParseResponse.stats:type_name -> data.ConversionStats
+	4,   // 8: data.ParseResponse.anomalies:type_name -> data.Anomaly
+	0,   // 9: data.ParseBatchRequest.requests:type_name -> data.ParseRequest
+	3,   // 10: data.ParseBatchItem.response:type_name -> data.ParseResponse
+	6,   // 11: data.ParseBatchResponse.items:type_name -> data.ParseBatchItem
+	1,   // 12: data.LiveRequest.options:type_name -> data.ConvertOptions
+	13,  // 13: data.UsageResponse.usage:type_name -> data.ClientUsage
+	11,  // 14: data.ListJobsResponse.jobs:type_name -> data.JobStatus
+	18,  // 15: data.HistoryResponse.conversions:type_name -> data.Conversion
+	110, // 16: data.ConversionStats.column_types:type_name -> data.ConversionStats.ColumnTypesEntry
+	22,  // 17: data.MergeRequest.inputs:type_name -> data.MergeInput
+	1,   // 18: data.MergeRequest.options:type_name -> data.ConvertOptions
+	111, // 19: data.PipelineStep.rename:type_name -> data.PipelineStep.RenameEntry
+	31,  // 20: data.PipelineStep.quality_control:type_name -> data.QualityControlConfig
+	33,  // 21: data.PipelineStep.anomalies:type_name -> data.AnomalyDetectionConfig
+	34,  // 22: data.PipelineStep.interpolation:type_name -> data.InterpolationConfig
+	35,  // 23: data.PipelineStep.aggregation:type_name -> data.AggregationConfig
+	36,  // 24: data.PipelineStep.smoothing:type_name -> data.SmoothingConfig
+	37,  // 25: data.PipelineStep.depth_binning:type_name -> data.DepthBinningConfig
+	38,  // 26: data.PipelineStep.coordinates:type_name -> data.CoordinatesConfig
+	40,  // 27: data.PipelineStep.geofence:type_name -> data.GeofenceConfig
+	42,  // 28: data.PipelineStep.track:type_name -> data.TrackConfig
+	43,  // 29: data.PipelineStep.enrichment:type_name -> data.EnrichmentConfig
+	45,  // 30: data.PipelineStep.calibration:type_name -> data.CalibrateConfig
+	47,  // 31: data.PipelineStep.drift:type_name -> data.DriftConfig
+	24,  // 32: data.PipelineRequest.steps:type_name -> data.PipelineStep
+	1,   // 33: data.PipelineRequest.options:type_name -> data.ConvertOptions
+	26,  // 34: data.QcTest.gross_range:type_name -> data.GrossRange
+	27,  // 35: data.QcTest.spike:type_name -> data.SpikeTest
+	28,  // 36: data.QcTest.flat_line:type_name -> data.FlatLineTest
+	29,  // 37: data.QualityControlConfig.tests:type_name -> data.QcTest
+	30,  // 38: data.QualityControlConfig.locations:type_name -> data.LocationTest
+	31,  // 39: data.QualityControlRequest.config:type_name -> data.QualityControlConfig
+	1,   // 40: data.QualityControlRequest.options:type_name -> data.ConvertOptions
+	39,  // 41: data.GeofenceConfig.bounding_box:type_name -> data.BoundingBox
+	41,  // 42: data.TrackConfig.reference:type_name -> data.Point
+	112, // 43: data.CalibrateConfig.coefficients:type_name -> data.CalibrateConfig.CoefficientsEntry
+	46,  // 44: data.DriftConfig.points:type_name -> data.DriftPoint
+	35,  // 45: data.AggregateRequest.config:type_name -> data.AggregationConfig
+	1,   // 46: data.AggregateRequest.options:type_name -> data.ConvertOptions
+	33,  // 47: data.DetectAnomaliesRequest.config:type_name -> data.AnomalyDetectionConfig
+	1,   // 48: data.DetectAnomaliesRequest.options:type_name -> data.ConvertOptions
+	1,   // 49: data.SplitRequest.options:type_name -> data.ConvertOptions
+	51,  // 50: data.SplitResponse.parts:type_name -> data.Part
+	113, // 51: data.SplitResponse.metadata:type_name -> data.SplitResponse.MetadataEntry
+	21,  // 52: data.SplitResponse.row_errors:type_name -> data.RowError
+	1,   // 53: data.InferSchemaRequest.options:type_name -> data.ConvertOptions
+	54,  // 54: data.InferSchemaResponse.columns:type_name -> data.ColumnSchema
+	1,   // 55: data.DescribeDataRequest.options:type_name -> data.ConvertOptions
+	57,  // 56: data.ColumnSummary.percentiles:type_name -> data.Percentile
+	58,  // 57: data.DescribeDataResponse.columns:type_name -> data.ColumnSummary
+	54,  // 58: data.ValidateRequest.columns:type_name -> data.ColumnSchema
+	1,   // 59: data.ValidateRequest.options:type_name -> data.ConvertOptions
+	61,  // 60: data.ValidateResponse.violations:type_name -> data.Violation
+	1,   // 61: data.DetectGapsRequest.options:type_name -> data.ConvertOptions
+	64,  // 62: data.DetectGapsResponse.gaps:type_name -> data.Gap
+	65,  // 63: data.DetectGapsResponse.series:type_name -> data.GapSeries
+	1,   // 64: data.CorrelateRequest.options:type_name -> data.ConvertOptions
+	68,  // 65: data.CorrelateResponse.matrix:type_name -> data.CorrelationRow
+	69,  // 66: data.CorrelateResponse.lagged:type_name -> data.Correlation
+	72,  // 67: data.CompatibilityMatrixResponse.entries:type_name -> data.CompatibilityEntry
+	74,  // 68: data.RegisterStationRequest.instruments:type_name -> data.Instrument
+	1,   // 69: data.RegistrationStatusResponse.default_profile:type_name -> data.ConvertOptions
+	81,  // 70: data.Sensor.calibrations:type_name -> data.Calibration
+	83,  // 71: data.Deployment.location:type_name -> data.Location
+	82,  // 72: data.Platform.sensors:type_name -> data.Sensor
+	84,  // 73: data.Platform.deployments:type_name -> data.Deployment
+	85,  // 74: data.CreatePlatformRequest.platform:type_name -> data.Platform
+	85,  // 75: data.ListPlatformsResponse.platforms:type_name -> data.Platform
+	85,  // 76: data.UpdatePlatformRequest.platform:type_name -> data.Platform
+	82,  // 77: data.PutSensorRequest.sensor:type_name -> data.Sensor
+	81,  // 78: data.AddCalibrationRequest.calibration:type_name -> data.Calibration
+	84,  // 79: data.PutDeploymentRequest.deployment:type_name -> data.Deployment
+	98,  // 80: data.CreateAlertRuleRequest.rule:type_name -> data.AlertRule
+	98,  // 81: data.ListAlertRulesResponse.rules:type_name -> data.AlertRule
+	44,  // 82: data.CalibrateConfig.CoefficientsEntry.value:type_name -> data.Polynomial
+	0,   // 83: data.DataParser.Parse:input_type -> data.ParseRequest
+	71,  // 84: data.DataParser.GetCompatibilityMatrix:input_type -> data.CompatibilityMatrixRequest
+	75,  // 85: data.DataParser.RegisterStation:input_type -> data.RegisterStationRequest
+	77,  // 86: data.DataParser.ApproveStation:input_type -> data.ApproveStationRequest
+	79,  // 87: data.DataParser.GetRegistrationStatus:input_type -> data.RegistrationStatusRequest
+	23,  // 88: data.DataParser.Merge:input_type -> data.MergeRequest
+	50,  // 89: data.DataParser.Split:input_type -> data.SplitRequest
+	53,  // 90: data.DataParser.InferSchema:input_type -> data.InferSchemaRequest
+	56,  // 91: data.DataParser.DescribeData:input_type -> data.DescribeDataRequest
+	60,  // 92: data.DataParser.Validate:input_type -> data.ValidateRequest
+	25,  // 93: data.DataParser.Pipeline:input_type -> data.PipelineRequest
+	32,  // 94: data.DataParser.QualityControl:input_type -> data.QualityControlRequest
+	49,  // 95: data.DataParser.DetectAnomalies:input_type -> data.DetectAnomaliesRequest
+	63,  // 96: data.DataParser.DetectGaps:input_type -> data.DetectGapsRequest
+	67,  // 97: data.DataParser.Correlate:input_type -> data.CorrelateRequest
+	48,  // 98: data.DataParser.Aggregate:input_type -> data.AggregateRequest
+	5,   // 99: data.DataParser.ParseBatch:input_type -> data.ParseBatchRequest
+	0,   // 100: data.DataParser.SubmitJob:input_type -> data.ParseRequest
+	10,  // 101: data.DataParser.GetJobStatus:input_type -> data.JobRequest
+	10,  // 102: data.DataParser.GetJobResult:input_type -> data.JobRequest
+	10,  // 103: data.DataParser.CancelJob:input_type -> data.JobRequest
+	12,  // 104: data.DataParser.GetUsage:input_type -> data.UsageRequest
+	8,   // 105: data.DataParser.ParseLive:input_type -> data.LiveRequest
+	15,  // 106: data.DataParser.ListJobs:input_type -> data.ListJobsRequest
+	17,  // 107: data.DataParser.GetHistory:input_type -> data.HistoryRequest
+	86,  // 108: data.StationRegistry.CreatePlatform:input_type -> data.CreatePlatformRequest
+	87,  // 109: data.StationRegistry.GetPlatform:input_type -> data.GetPlatformRequest
+	88,  // 110: data.StationRegistry.ListPlatforms:input_type -> data.ListPlatformsRequest
+	90,  // 111: data.StationRegistry.UpdatePlatform:input_type -> data.UpdatePlatformRequest
+	91,  // 112: data.StationRegistry.DeletePlatform:input_type -> data.DeletePlatformRequest
+	93,  // 113: data.StationRegistry.PutSensor:input_type -> data.PutSensorRequest
+	94,  // 114: data.StationRegistry.DeleteSensor:input_type -> data.DeleteSensorRequest
+	95,  // 115: data.StationRegistry.AddCalibration:input_type -> data.AddCalibrationRequest
+	96,  // 116: data.StationRegistry.PutDeployment:input_type -> data.PutDeploymentRequest
+	97,  // 117: data.StationRegistry.DeleteDeployment:input_type -> data.DeleteDeploymentRequest
+	99,  // 118: data.Alerting.CreateAlertRule:input_type -> data.CreateAlertRuleRequest
+	100, // 119: data.Alerting.ListAlertRules:input_type -> data.ListAlertRulesRequest
+	102, // 120: data.Alerting.DeleteAlertRule:input_type -> data.DeleteAlertRuleRequest
+	104, // 121: data.Alerting.WatchAlerts:input_type -> data.WatchAlertsRequest
+	3,   // 122: data.DataParser.Parse:output_type -> data.ParseResponse
+	73,  // 123: data.DataParser.GetCompatibilityMatrix:output_type -> data.CompatibilityMatrixResponse
+	76,  // 124: data.DataParser.RegisterStation:output_type -> data.RegisterStationResponse
+	78,  // 125: data.DataParser.ApproveStation:output_type -> data.ApproveStationResponse
+	80,  // 126: data.DataParser.GetRegistrationStatus:output_type -> data.RegistrationStatusResponse
+	3,   // 127: data.DataParser.Merge:output_type -> data.ParseResponse
+	52,  // 128: data.DataParser.Split:output_type -> data.SplitResponse
+	55,  // 129: data.DataParser.InferSchema:output_type -> data.InferSchemaResponse
+	59,  // 130: data.DataParser.DescribeData:output_type -> data.DescribeDataResponse
+	62,  // 131: data.DataParser.Validate:output_type -> data.ValidateResponse
+	3,   // 132: data.DataParser.Pipeline:output_type -> data.ParseResponse
+	3,   // 133: data.DataParser.QualityControl:output_type -> data.ParseResponse
+	3,   // 134: data.DataParser.DetectAnomalies:output_type -> data.ParseResponse
+	66,  // 135: data.DataParser.DetectGaps:output_type -> data.DetectGapsResponse
+	70,  // 136: data.DataParser.Correlate:output_type -> data.CorrelateResponse
+	3,   // 137: data.DataParser.Aggregate:output_type -> data.ParseResponse
+	7,   // 138: data.DataParser.ParseBatch:output_type -> data.ParseBatchResponse
+	11,  // 139: data.DataParser.SubmitJob:output_type -> data.JobStatus
+	11,  // 140: data.DataParser.GetJobStatus:output_type -> data.JobStatus
+	3,   // 141: data.DataParser.GetJobResult:output_type -> data.ParseResponse
+	11,  // 142: data.DataParser.CancelJob:output_type -> data.JobStatus
+	14,  // 143: data.DataParser.GetUsage:output_type -> data.UsageResponse
+	9,   // 144: data.DataParser.ParseLive:output_type -> data.LiveResponse
+	16,  // 145: data.DataParser.ListJobs:output_type -> data.ListJobsResponse
+	19,  // 146: data.DataParser.GetHistory:output_type -> data.HistoryResponse
+	85,  // 147: data.StationRegistry.CreatePlatform:output_type -> data.Platform
+	85,  // 148: data.StationRegistry.GetPlatform:output_type -> data.Platform
+	89,  // 149: data.StationRegistry.ListPlatforms:output_type -> data.ListPlatformsResponse
+	85,  // 150: data.StationRegistry.UpdatePlatform:output_type -> data.Platform
+	92,  // 151: data.StationRegistry.DeletePlatform:output_type -> data.DeletePlatformResponse
+	85,  // 152: data.StationRegistry.PutSensor:output_type -> data.Platform
+	85,  // 153: data.StationRegistry.DeleteSensor:output_type -> data.Platform
+	85,  // 154: data.StationRegistry.AddCalibration:output_type -> data.Platform
+	85,  // 155: data.StationRegistry.PutDeployment:output_type -> data.Platform
+	85,  // 156: data.StationRegistry.DeleteDeployment:output_type -> data.Platform
+	98,  // 157: data.Alerting.CreateAlertRule:output_type -> data.AlertRule
+	101, // 158: data.Alerting.ListAlertRules:output_type -> data.ListAlertRulesResponse
+	103, // 159: data.Alerting.DeleteAlertRule:output_type -> data.DeleteAlertRuleResponse
+	105, // 160: data.Alerting.WatchAlerts:output_type -> data.AlertEvent
+	122, // [122:161] is the sub-list for method output_type
+	83,  // [83:122] is the sub-list for method input_type
+	83,  // [83:83] is the sub-list for extension type_name
+	83,  // [83:83] is the sub-list for extension extendee
+	0,   // [0:83] is the sub-list for field type_name
 }
 
 func init() { file_proto_data_proto_init() }
@@ -172,15 +8903,16 @@ func file_proto_data_proto_init() {
 	if File_proto_data_proto != nil {
 		return
 	}
+	file_proto_data_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_data_proto_rawDesc), len(file_proto_data_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   114,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_proto_data_proto_goTypes,
 		DependencyIndexes: file_proto_data_proto_depIdxs,
@@ -19,7 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DataParser_Parse_FullMethodName = "/data.DataParser/Parse"
+	DataParser_Parse_FullMethodName                  = "/data.DataParser/Parse"
+	DataParser_GetCompatibilityMatrix_FullMethodName = "/data.DataParser/GetCompatibilityMatrix"
+	DataParser_RegisterStation_FullMethodName        = "/data.DataParser/RegisterStation"
+	DataParser_ApproveStation_FullMethodName         = "/data.DataParser/ApproveStation"
+	DataParser_GetRegistrationStatus_FullMethodName  = "/data.DataParser/GetRegistrationStatus"
+	DataParser_Merge_FullMethodName                  = "/data.DataParser/Merge"
+	DataParser_Split_FullMethodName                  = "/data.DataParser/Split"
+	DataParser_InferSchema_FullMethodName            = "/data.DataParser/InferSchema"
+	DataParser_DescribeData_FullMethodName           = "/data.DataParser/DescribeData"
+	DataParser_Validate_FullMethodName               = "/data.DataParser/Validate"
+	DataParser_Pipeline_FullMethodName               = "/data.DataParser/Pipeline"
+	DataParser_QualityControl_FullMethodName         = "/data.DataParser/QualityControl"
+	DataParser_DetectAnomalies_FullMethodName        = "/data.DataParser/DetectAnomalies"
+	DataParser_DetectGaps_FullMethodName             = "/data.DataParser/DetectGaps"
+	DataParser_Correlate_FullMethodName              = "/data.DataParser/Correlate"
+	DataParser_Aggregate_FullMethodName              = "/data.DataParser/Aggregate"
+	DataParser_ParseBatch_FullMethodName             = "/data.DataParser/ParseBatch"
+	DataParser_SubmitJob_FullMethodName              = "/data.DataParser/SubmitJob"
+	DataParser_GetJobStatus_FullMethodName           = "/data.DataParser/GetJobStatus"
+	DataParser_GetJobResult_FullMethodName           = "/data.DataParser/GetJobResult"
+	DataParser_CancelJob_FullMethodName              = "/data.DataParser/CancelJob"
+	DataParser_GetUsage_FullMethodName               = "/data.DataParser/GetUsage"
+	DataParser_ParseLive_FullMethodName              = "/data.DataParser/ParseLive"
+	DataParser_ListJobs_FullMethodName               = "/data.DataParser/ListJobs"
+	DataParser_GetHistory_FullMethodName             = "/data.DataParser/GetHistory"
 )
 
 // DataParserClient is the client API for DataParser service.
@@ -27,6 +51,30 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DataParserClient interface {
 	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	GetCompatibilityMatrix(ctx context.Context, in *CompatibilityMatrixRequest, opts ...grpc.CallOption) (*CompatibilityMatrixResponse, error)
+	RegisterStation(ctx context.Context, in *RegisterStationRequest, opts ...grpc.CallOption) (*RegisterStationResponse, error)
+	ApproveStation(ctx context.Context, in *ApproveStationRequest, opts ...grpc.CallOption) (*ApproveStationResponse, error)
+	GetRegistrationStatus(ctx context.Context, in *RegistrationStatusRequest, opts ...grpc.CallOption) (*RegistrationStatusResponse, error)
+	Merge(ctx context.Context, in *MergeRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	Split(ctx context.Context, in *SplitRequest, opts ...grpc.CallOption) (*SplitResponse, error)
+	InferSchema(ctx context.Context, in *InferSchemaRequest, opts ...grpc.CallOption) (*InferSchemaResponse, error)
+	DescribeData(ctx context.Context, in *DescribeDataRequest, opts ...grpc.CallOption) (*DescribeDataResponse, error)
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	Pipeline(ctx context.Context, in *PipelineRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	QualityControl(ctx context.Context, in *QualityControlRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	DetectAnomalies(ctx context.Context, in *DetectAnomaliesRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	DetectGaps(ctx context.Context, in *DetectGapsRequest, opts ...grpc.CallOption) (*DetectGapsResponse, error)
+	Correlate(ctx context.Context, in *CorrelateRequest, opts ...grpc.CallOption) (*CorrelateResponse, error)
+	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	ParseBatch(ctx context.Context, in *ParseBatchRequest, opts ...grpc.CallOption) (*ParseBatchResponse, error)
+	SubmitJob(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*JobStatus, error)
+	GetJobStatus(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobStatus, error)
+	GetJobResult(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	CancelJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobStatus, error)
+	GetUsage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error)
+	ParseLive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[LiveRequest, LiveResponse], error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	GetHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
 }
 
 type dataParserClient struct {
@@ -47,11 +95,278 @@ func (c *dataParserClient) Parse(ctx context.Context, in *ParseRequest, opts ...
 	return out, nil
 }
 
+func (c *dataParserClient) GetCompatibilityMatrix(ctx context.Context, in *CompatibilityMatrixRequest, opts ...grpc.CallOption) (*CompatibilityMatrixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompatibilityMatrixResponse)
+	err := c.cc.Invoke(ctx, DataParser_GetCompatibilityMatrix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) RegisterStation(ctx context.Context, in *RegisterStationRequest, opts ...grpc.CallOption) (*RegisterStationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterStationResponse)
+	err := c.cc.Invoke(ctx, DataParser_RegisterStation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) ApproveStation(ctx context.Context, in *ApproveStationRequest, opts ...grpc.CallOption) (*ApproveStationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveStationResponse)
+	err := c.cc.Invoke(ctx, DataParser_ApproveStation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) GetRegistrationStatus(ctx context.Context, in *RegistrationStatusRequest, opts ...grpc.CallOption) (*RegistrationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegistrationStatusResponse)
+	err := c.cc.Invoke(ctx, DataParser_GetRegistrationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) Merge(ctx context.Context, in *MergeRequest, opts ...grpc.CallOption) (*ParseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseResponse)
+	err := c.cc.Invoke(ctx, DataParser_Merge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) Split(ctx context.Context, in *SplitRequest, opts ...grpc.CallOption) (*SplitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SplitResponse)
+	err := c.cc.Invoke(ctx, DataParser_Split_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) InferSchema(ctx context.Context, in *InferSchemaRequest, opts ...grpc.CallOption) (*InferSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InferSchemaResponse)
+	err := c.cc.Invoke(ctx, DataParser_InferSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) DescribeData(ctx context.Context, in *DescribeDataRequest, opts ...grpc.CallOption) (*DescribeDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeDataResponse)
+	err := c.cc.Invoke(ctx, DataParser_DescribeData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, DataParser_Validate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) Pipeline(ctx context.Context, in *PipelineRequest, opts ...grpc.CallOption) (*ParseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseResponse)
+	err := c.cc.Invoke(ctx, DataParser_Pipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) QualityControl(ctx context.Context, in *QualityControlRequest, opts ...grpc.CallOption) (*ParseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseResponse)
+	err := c.cc.Invoke(ctx, DataParser_QualityControl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) DetectAnomalies(ctx context.Context, in *DetectAnomaliesRequest, opts ...grpc.CallOption) (*ParseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseResponse)
+	err := c.cc.Invoke(ctx, DataParser_DetectAnomalies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) DetectGaps(ctx context.Context, in *DetectGapsRequest, opts ...grpc.CallOption) (*DetectGapsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetectGapsResponse)
+	err := c.cc.Invoke(ctx, DataParser_DetectGaps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) Correlate(ctx context.Context, in *CorrelateRequest, opts ...grpc.CallOption) (*CorrelateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CorrelateResponse)
+	err := c.cc.Invoke(ctx, DataParser_Correlate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*ParseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseResponse)
+	err := c.cc.Invoke(ctx, DataParser_Aggregate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) ParseBatch(ctx context.Context, in *ParseBatchRequest, opts ...grpc.CallOption) (*ParseBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseBatchResponse)
+	err := c.cc.Invoke(ctx, DataParser_ParseBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) SubmitJob(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*JobStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, DataParser_SubmitJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) GetJobStatus(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, DataParser_GetJobStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) GetJobResult(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*ParseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseResponse)
+	err := c.cc.Invoke(ctx, DataParser_GetJobResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) CancelJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, DataParser_CancelJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) GetUsage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UsageResponse)
+	err := c.cc.Invoke(ctx, DataParser_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) ParseLive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[LiveRequest, LiveResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DataParser_ServiceDesc.Streams[0], DataParser_ParseLive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LiveRequest, LiveResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataParser_ParseLiveClient = grpc.BidiStreamingClient[LiveRequest, LiveResponse]
+
+func (c *dataParserClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, DataParser_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) GetHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, DataParser_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataParserServer is the server API for DataParser service.
 // All implementations must embed UnimplementedDataParserServer
 // for forward compatibility.
 type DataParserServer interface {
 	Parse(context.Context, *ParseRequest) (*ParseResponse, error)
+	GetCompatibilityMatrix(context.Context, *CompatibilityMatrixRequest) (*CompatibilityMatrixResponse, error)
+	RegisterStation(context.Context, *RegisterStationRequest) (*RegisterStationResponse, error)
+	ApproveStation(context.Context, *ApproveStationRequest) (*ApproveStationResponse, error)
+	GetRegistrationStatus(context.Context, *RegistrationStatusRequest) (*RegistrationStatusResponse, error)
+	Merge(context.Context, *MergeRequest) (*ParseResponse, error)
+	Split(context.Context, *SplitRequest) (*SplitResponse, error)
+	InferSchema(context.Context, *InferSchemaRequest) (*InferSchemaResponse, error)
+	DescribeData(context.Context, *DescribeDataRequest) (*DescribeDataResponse, error)
+	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	Pipeline(context.Context, *PipelineRequest) (*ParseResponse, error)
+	QualityControl(context.Context, *QualityControlRequest) (*ParseResponse, error)
+	DetectAnomalies(context.Context, *DetectAnomaliesRequest) (*ParseResponse, error)
+	DetectGaps(context.Context, *DetectGapsRequest) (*DetectGapsResponse, error)
+	Correlate(context.Context, *CorrelateRequest) (*CorrelateResponse, error)
+	Aggregate(context.Context, *AggregateRequest) (*ParseResponse, error)
+	ParseBatch(context.Context, *ParseBatchRequest) (*ParseBatchResponse, error)
+	SubmitJob(context.Context, *ParseRequest) (*JobStatus, error)
+	GetJobStatus(context.Context, *JobRequest) (*JobStatus, error)
+	GetJobResult(context.Context, *JobRequest) (*ParseResponse, error)
+	CancelJob(context.Context, *JobRequest) (*JobStatus, error)
+	GetUsage(context.Context, *UsageRequest) (*UsageResponse, error)
+	ParseLive(grpc.BidiStreamingServer[LiveRequest, LiveResponse]) error
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	GetHistory(context.Context, *HistoryRequest) (*HistoryResponse, error)
 	mustEmbedUnimplementedDataParserServer()
 }
 
@@ -65,6 +380,78 @@ type UnimplementedDataParserServer struct{}
 func (UnimplementedDataParserServer) Parse(context.Context, *ParseRequest) (*ParseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Parse not implemented")
 }
+func (UnimplementedDataParserServer) GetCompatibilityMatrix(context.Context, *CompatibilityMatrixRequest) (*CompatibilityMatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompatibilityMatrix not implemented")
+}
+func (UnimplementedDataParserServer) RegisterStation(context.Context, *RegisterStationRequest) (*RegisterStationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterStation not implemented")
+}
+func (UnimplementedDataParserServer) ApproveStation(context.Context, *ApproveStationRequest) (*ApproveStationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveStation not implemented")
+}
+func (UnimplementedDataParserServer) GetRegistrationStatus(context.Context, *RegistrationStatusRequest) (*RegistrationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistrationStatus not implemented")
+}
+func (UnimplementedDataParserServer) Merge(context.Context, *MergeRequest) (*ParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Merge not implemented")
+}
+func (UnimplementedDataParserServer) Split(context.Context, *SplitRequest) (*SplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Split not implemented")
+}
+func (UnimplementedDataParserServer) InferSchema(context.Context, *InferSchemaRequest) (*InferSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InferSchema not implemented")
+}
+func (UnimplementedDataParserServer) DescribeData(context.Context, *DescribeDataRequest) (*DescribeDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeData not implemented")
+}
+func (UnimplementedDataParserServer) Validate(context.Context, *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedDataParserServer) Pipeline(context.Context, *PipelineRequest) (*ParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pipeline not implemented")
+}
+func (UnimplementedDataParserServer) QualityControl(context.Context, *QualityControlRequest) (*ParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QualityControl not implemented")
+}
+func (UnimplementedDataParserServer) DetectAnomalies(context.Context, *DetectAnomaliesRequest) (*ParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectAnomalies not implemented")
+}
+func (UnimplementedDataParserServer) DetectGaps(context.Context, *DetectGapsRequest) (*DetectGapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectGaps not implemented")
+}
+func (UnimplementedDataParserServer) Correlate(context.Context, *CorrelateRequest) (*CorrelateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Correlate not implemented")
+}
+func (UnimplementedDataParserServer) Aggregate(context.Context, *AggregateRequest) (*ParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
+}
+func (UnimplementedDataParserServer) ParseBatch(context.Context, *ParseBatchRequest) (*ParseBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseBatch not implemented")
+}
+func (UnimplementedDataParserServer) SubmitJob(context.Context, *ParseRequest) (*JobStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitJob not implemented")
+}
+func (UnimplementedDataParserServer) GetJobStatus(context.Context, *JobRequest) (*JobStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobStatus not implemented")
+}
+func (UnimplementedDataParserServer) GetJobResult(context.Context, *JobRequest) (*ParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobResult not implemented")
+}
+func (UnimplementedDataParserServer) CancelJob(context.Context, *JobRequest) (*JobStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedDataParserServer) GetUsage(context.Context, *UsageRequest) (*UsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedDataParserServer) ParseLive(grpc.BidiStreamingServer[LiveRequest, LiveResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ParseLive not implemented")
+}
+func (UnimplementedDataParserServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedDataParserServer) GetHistory(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
 func (UnimplementedDataParserServer) mustEmbedUnimplementedDataParserServer() {}
 func (UnimplementedDataParserServer) testEmbeddedByValue()                    {}
 
@@ -104,18 +491,1202 @@ func _DataParser_Parse_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-// DataParser_ServiceDesc is the grpc.ServiceDesc for DataParser service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var DataParser_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "data.DataParser",
-	HandlerType: (*DataParserServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Parse",
-			Handler:    _DataParser_Parse_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
+func _DataParser_GetCompatibilityMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompatibilityMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).GetCompatibilityMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_GetCompatibilityMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).GetCompatibilityMatrix(ctx, req.(*CompatibilityMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_RegisterStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).RegisterStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_RegisterStation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).RegisterStation(ctx, req.(*RegisterStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_ApproveStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).ApproveStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_ApproveStation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).ApproveStation(ctx, req.(*ApproveStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_GetRegistrationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).GetRegistrationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_GetRegistrationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).GetRegistrationStatus(ctx, req.(*RegistrationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_Merge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).Merge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_Merge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).Merge(ctx, req.(*MergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_Split_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).Split(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_Split_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).Split(ctx, req.(*SplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_InferSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InferSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).InferSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_InferSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).InferSchema(ctx, req.(*InferSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_DescribeData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).DescribeData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_DescribeData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).DescribeData(ctx, req.(*DescribeDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_Validate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_Pipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).Pipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_Pipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).Pipeline(ctx, req.(*PipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_QualityControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QualityControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).QualityControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_QualityControl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).QualityControl(ctx, req.(*QualityControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_DetectAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectAnomaliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).DetectAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_DetectAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).DetectAnomalies(ctx, req.(*DetectAnomaliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_DetectGaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectGapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).DetectGaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_DetectGaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).DetectGaps(ctx, req.(*DetectGapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_Correlate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorrelateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).Correlate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_Correlate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).Correlate(ctx, req.(*CorrelateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).Aggregate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_Aggregate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).Aggregate(ctx, req.(*AggregateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_ParseBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).ParseBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_ParseBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).ParseBatch(ctx, req.(*ParseBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_SubmitJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).SubmitJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_SubmitJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).SubmitJob(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_GetJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).GetJobStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_GetJobStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).GetJobStatus(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_GetJobResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).GetJobResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_GetJobResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).GetJobResult(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_CancelJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).CancelJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).GetUsage(ctx, req.(*UsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_ParseLive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DataParserServer).ParseLive(&grpc.GenericServerStream[LiveRequest, LiveResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataParser_ParseLiveServer = grpc.BidiStreamingServer[LiveRequest, LiveResponse]
+
+func _DataParser_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).GetHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DataParser_ServiceDesc is the grpc.ServiceDesc for DataParser service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DataParser_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "data.DataParser",
+	HandlerType: (*DataParserServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Parse",
+			Handler:    _DataParser_Parse_Handler,
+		},
+		{
+			MethodName: "GetCompatibilityMatrix",
+			Handler:    _DataParser_GetCompatibilityMatrix_Handler,
+		},
+		{
+			MethodName: "RegisterStation",
+			Handler:    _DataParser_RegisterStation_Handler,
+		},
+		{
+			MethodName: "ApproveStation",
+			Handler:    _DataParser_ApproveStation_Handler,
+		},
+		{
+			MethodName: "GetRegistrationStatus",
+			Handler:    _DataParser_GetRegistrationStatus_Handler,
+		},
+		{
+			MethodName: "Merge",
+			Handler:    _DataParser_Merge_Handler,
+		},
+		{
+			MethodName: "Split",
+			Handler:    _DataParser_Split_Handler,
+		},
+		{
+			MethodName: "InferSchema",
+			Handler:    _DataParser_InferSchema_Handler,
+		},
+		{
+			MethodName: "DescribeData",
+			Handler:    _DataParser_DescribeData_Handler,
+		},
+		{
+			MethodName: "Validate",
+			Handler:    _DataParser_Validate_Handler,
+		},
+		{
+			MethodName: "Pipeline",
+			Handler:    _DataParser_Pipeline_Handler,
+		},
+		{
+			MethodName: "QualityControl",
+			Handler:    _DataParser_QualityControl_Handler,
+		},
+		{
+			MethodName: "DetectAnomalies",
+			Handler:    _DataParser_DetectAnomalies_Handler,
+		},
+		{
+			MethodName: "DetectGaps",
+			Handler:    _DataParser_DetectGaps_Handler,
+		},
+		{
+			MethodName: "Correlate",
+			Handler:    _DataParser_Correlate_Handler,
+		},
+		{
+			MethodName: "Aggregate",
+			Handler:    _DataParser_Aggregate_Handler,
+		},
+		{
+			MethodName: "ParseBatch",
+			Handler:    _DataParser_ParseBatch_Handler,
+		},
+		{
+			MethodName: "SubmitJob",
+			Handler:    _DataParser_SubmitJob_Handler,
+		},
+		{
+			MethodName: "GetJobStatus",
+			Handler:    _DataParser_GetJobStatus_Handler,
+		},
+		{
+			MethodName: "GetJobResult",
+			Handler:    _DataParser_GetJobResult_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _DataParser_CancelJob_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _DataParser_GetUsage_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _DataParser_ListJobs_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _DataParser_GetHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ParseLive",
+			Handler:       _DataParser_ParseLive_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/data.proto",
+}
+
+const (
+	StationRegistry_CreatePlatform_FullMethodName   = "/data.StationRegistry/CreatePlatform"
+	StationRegistry_GetPlatform_FullMethodName      = "/data.StationRegistry/GetPlatform"
+	StationRegistry_ListPlatforms_FullMethodName    = "/data.StationRegistry/ListPlatforms"
+	StationRegistry_UpdatePlatform_FullMethodName   = "/data.StationRegistry/UpdatePlatform"
+	StationRegistry_DeletePlatform_FullMethodName   = "/data.StationRegistry/DeletePlatform"
+	StationRegistry_PutSensor_FullMethodName        = "/data.StationRegistry/PutSensor"
+	StationRegistry_DeleteSensor_FullMethodName     = "/data.StationRegistry/DeleteSensor"
+	StationRegistry_AddCalibration_FullMethodName   = "/data.StationRegistry/AddCalibration"
+	StationRegistry_PutDeployment_FullMethodName    = "/data.StationRegistry/PutDeployment"
+	StationRegistry_DeleteDeployment_FullMethodName = "/data.StationRegistry/DeleteDeployment"
+)
+
+// StationRegistryClient is the client API for StationRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StationRegistryClient interface {
+	CreatePlatform(ctx context.Context, in *CreatePlatformRequest, opts ...grpc.CallOption) (*Platform, error)
+	GetPlatform(ctx context.Context, in *GetPlatformRequest, opts ...grpc.CallOption) (*Platform, error)
+	ListPlatforms(ctx context.Context, in *ListPlatformsRequest, opts ...grpc.CallOption) (*ListPlatformsResponse, error)
+	UpdatePlatform(ctx context.Context, in *UpdatePlatformRequest, opts ...grpc.CallOption) (*Platform, error)
+	DeletePlatform(ctx context.Context, in *DeletePlatformRequest, opts ...grpc.CallOption) (*DeletePlatformResponse, error)
+	PutSensor(ctx context.Context, in *PutSensorRequest, opts ...grpc.CallOption) (*Platform, error)
+	DeleteSensor(ctx context.Context, in *DeleteSensorRequest, opts ...grpc.CallOption) (*Platform, error)
+	AddCalibration(ctx context.Context, in *AddCalibrationRequest, opts ...grpc.CallOption) (*Platform, error)
+	PutDeployment(ctx context.Context, in *PutDeploymentRequest, opts ...grpc.CallOption) (*Platform, error)
+	DeleteDeployment(ctx context.Context, in *DeleteDeploymentRequest, opts ...grpc.CallOption) (*Platform, error)
+}
+
+type stationRegistryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStationRegistryClient(cc grpc.ClientConnInterface) StationRegistryClient {
+	return &stationRegistryClient{cc}
+}
+
+func (c *stationRegistryClient) CreatePlatform(ctx context.Context, in *CreatePlatformRequest, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, StationRegistry_CreatePlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationRegistryClient) GetPlatform(ctx context.Context, in *GetPlatformRequest, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, StationRegistry_GetPlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationRegistryClient) ListPlatforms(ctx context.Context, in *ListPlatformsRequest, opts ...grpc.CallOption) (*ListPlatformsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlatformsResponse)
+	err := c.cc.Invoke(ctx, StationRegistry_ListPlatforms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationRegistryClient) UpdatePlatform(ctx context.Context, in *UpdatePlatformRequest, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, StationRegistry_UpdatePlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationRegistryClient) DeletePlatform(ctx context.Context, in *DeletePlatformRequest, opts ...grpc.CallOption) (*DeletePlatformResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePlatformResponse)
+	err := c.cc.Invoke(ctx, StationRegistry_DeletePlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationRegistryClient) PutSensor(ctx context.Context, in *PutSensorRequest, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, StationRegistry_PutSensor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationRegistryClient) DeleteSensor(ctx context.Context, in *DeleteSensorRequest, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, StationRegistry_DeleteSensor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationRegistryClient) AddCalibration(ctx context.Context, in *AddCalibrationRequest, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, StationRegistry_AddCalibration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationRegistryClient) PutDeployment(ctx context.Context, in *PutDeploymentRequest, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, StationRegistry_PutDeployment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationRegistryClient) DeleteDeployment(ctx context.Context, in *DeleteDeploymentRequest, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, StationRegistry_DeleteDeployment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StationRegistryServer is the server API for StationRegistry service.
+// All implementations must embed UnimplementedStationRegistryServer
+// for forward compatibility.
+type StationRegistryServer interface {
+	CreatePlatform(context.Context, *CreatePlatformRequest) (*Platform, error)
+	GetPlatform(context.Context, *GetPlatformRequest) (*Platform, error)
+	ListPlatforms(context.Context, *ListPlatformsRequest) (*ListPlatformsResponse, error)
+	UpdatePlatform(context.Context, *UpdatePlatformRequest) (*Platform, error)
+	DeletePlatform(context.Context, *DeletePlatformRequest) (*DeletePlatformResponse, error)
+	PutSensor(context.Context, *PutSensorRequest) (*Platform, error)
+	DeleteSensor(context.Context, *DeleteSensorRequest) (*Platform, error)
+	AddCalibration(context.Context, *AddCalibrationRequest) (*Platform, error)
+	PutDeployment(context.Context, *PutDeploymentRequest) (*Platform, error)
+	DeleteDeployment(context.Context, *DeleteDeploymentRequest) (*Platform, error)
+	mustEmbedUnimplementedStationRegistryServer()
+}
+
+// UnimplementedStationRegistryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStationRegistryServer struct{}
+
+func (UnimplementedStationRegistryServer) CreatePlatform(context.Context, *CreatePlatformRequest) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlatform not implemented")
+}
+func (UnimplementedStationRegistryServer) GetPlatform(context.Context, *GetPlatformRequest) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlatform not implemented")
+}
+func (UnimplementedStationRegistryServer) ListPlatforms(context.Context, *ListPlatformsRequest) (*ListPlatformsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlatforms not implemented")
+}
+func (UnimplementedStationRegistryServer) UpdatePlatform(context.Context, *UpdatePlatformRequest) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlatform not implemented")
+}
+func (UnimplementedStationRegistryServer) DeletePlatform(context.Context, *DeletePlatformRequest) (*DeletePlatformResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlatform not implemented")
+}
+func (UnimplementedStationRegistryServer) PutSensor(context.Context, *PutSensorRequest) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutSensor not implemented")
+}
+func (UnimplementedStationRegistryServer) DeleteSensor(context.Context, *DeleteSensorRequest) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSensor not implemented")
+}
+func (UnimplementedStationRegistryServer) AddCalibration(context.Context, *AddCalibrationRequest) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCalibration not implemented")
+}
+func (UnimplementedStationRegistryServer) PutDeployment(context.Context, *PutDeploymentRequest) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutDeployment not implemented")
+}
+func (UnimplementedStationRegistryServer) DeleteDeployment(context.Context, *DeleteDeploymentRequest) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeployment not implemented")
+}
+func (UnimplementedStationRegistryServer) mustEmbedUnimplementedStationRegistryServer() {}
+func (UnimplementedStationRegistryServer) testEmbeddedByValue()                         {}
+
+// UnsafeStationRegistryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StationRegistryServer will
+// result in compilation errors.
+type UnsafeStationRegistryServer interface {
+	mustEmbedUnimplementedStationRegistryServer()
+}
+
+func RegisterStationRegistryServer(s grpc.ServiceRegistrar, srv StationRegistryServer) {
+	// If the following call pancis, it indicates UnimplementedStationRegistryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StationRegistry_ServiceDesc, srv)
+}
+
+func _StationRegistry_CreatePlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlatformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServer).CreatePlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistry_CreatePlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServer).CreatePlatform(ctx, req.(*CreatePlatformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StationRegistry_GetPlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlatformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServer).GetPlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistry_GetPlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServer).GetPlatform(ctx, req.(*GetPlatformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StationRegistry_ListPlatforms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlatformsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServer).ListPlatforms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistry_ListPlatforms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServer).ListPlatforms(ctx, req.(*ListPlatformsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StationRegistry_UpdatePlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlatformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServer).UpdatePlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistry_UpdatePlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServer).UpdatePlatform(ctx, req.(*UpdatePlatformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StationRegistry_DeletePlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlatformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServer).DeletePlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistry_DeletePlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServer).DeletePlatform(ctx, req.(*DeletePlatformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StationRegistry_PutSensor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutSensorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServer).PutSensor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistry_PutSensor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServer).PutSensor(ctx, req.(*PutSensorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StationRegistry_DeleteSensor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSensorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServer).DeleteSensor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistry_DeleteSensor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServer).DeleteSensor(ctx, req.(*DeleteSensorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StationRegistry_AddCalibration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCalibrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServer).AddCalibration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistry_AddCalibration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServer).AddCalibration(ctx, req.(*AddCalibrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StationRegistry_PutDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServer).PutDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistry_PutDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServer).PutDeployment(ctx, req.(*PutDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StationRegistry_DeleteDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServer).DeleteDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistry_DeleteDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServer).DeleteDeployment(ctx, req.(*DeleteDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StationRegistry_ServiceDesc is the grpc.ServiceDesc for StationRegistry service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StationRegistry_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "data.StationRegistry",
+	HandlerType: (*StationRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePlatform",
+			Handler:    _StationRegistry_CreatePlatform_Handler,
+		},
+		{
+			MethodName: "GetPlatform",
+			Handler:    _StationRegistry_GetPlatform_Handler,
+		},
+		{
+			MethodName: "ListPlatforms",
+			Handler:    _StationRegistry_ListPlatforms_Handler,
+		},
+		{
+			MethodName: "UpdatePlatform",
+			Handler:    _StationRegistry_UpdatePlatform_Handler,
+		},
+		{
+			MethodName: "DeletePlatform",
+			Handler:    _StationRegistry_DeletePlatform_Handler,
+		},
+		{
+			MethodName: "PutSensor",
+			Handler:    _StationRegistry_PutSensor_Handler,
+		},
+		{
+			MethodName: "DeleteSensor",
+			Handler:    _StationRegistry_DeleteSensor_Handler,
+		},
+		{
+			MethodName: "AddCalibration",
+			Handler:    _StationRegistry_AddCalibration_Handler,
+		},
+		{
+			MethodName: "PutDeployment",
+			Handler:    _StationRegistry_PutDeployment_Handler,
+		},
+		{
+			MethodName: "DeleteDeployment",
+			Handler:    _StationRegistry_DeleteDeployment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/data.proto",
+}
+
+const (
+	Alerting_CreateAlertRule_FullMethodName = "/data.Alerting/CreateAlertRule"
+	Alerting_ListAlertRules_FullMethodName  = "/data.Alerting/ListAlertRules"
+	Alerting_DeleteAlertRule_FullMethodName = "/data.Alerting/DeleteAlertRule"
+	Alerting_WatchAlerts_FullMethodName     = "/data.Alerting/WatchAlerts"
+)
+
+// AlertingClient is the client API for Alerting service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AlertingClient interface {
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*AlertRule, error)
+	ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error)
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error)
+	WatchAlerts(ctx context.Context, in *WatchAlertsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AlertEvent], error)
+}
+
+type alertingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAlertingClient(cc grpc.ClientConnInterface) AlertingClient {
+	return &alertingClient{cc}
+}
+
+func (c *alertingClient) CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*AlertRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertRule)
+	err := c.cc.Invoke(ctx, Alerting_CreateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertingClient) ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertRulesResponse)
+	err := c.cc.Invoke(ctx, Alerting_ListAlertRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertingClient) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertRuleResponse)
+	err := c.cc.Invoke(ctx, Alerting_DeleteAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertingClient) WatchAlerts(ctx context.Context, in *WatchAlertsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AlertEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Alerting_ServiceDesc.Streams[0], Alerting_WatchAlerts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchAlertsRequest, AlertEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Alerting_WatchAlertsClient = grpc.ServerStreamingClient[AlertEvent]
+
+// AlertingServer is the server API for Alerting service.
+// All implementations must embed UnimplementedAlertingServer
+// for forward compatibility.
+type AlertingServer interface {
+	CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*AlertRule, error)
+	ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error)
+	DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error)
+	WatchAlerts(*WatchAlertsRequest, grpc.ServerStreamingServer[AlertEvent]) error
+	mustEmbedUnimplementedAlertingServer()
+}
+
+// UnimplementedAlertingServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAlertingServer struct{}
+
+func (UnimplementedAlertingServer) CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*AlertRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedAlertingServer) ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedAlertingServer) DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedAlertingServer) WatchAlerts(*WatchAlertsRequest, grpc.ServerStreamingServer[AlertEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAlerts not implemented")
+}
+func (UnimplementedAlertingServer) mustEmbedUnimplementedAlertingServer() {}
+func (UnimplementedAlertingServer) testEmbeddedByValue()                  {}
+
+// UnsafeAlertingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AlertingServer will
+// result in compilation errors.
+type UnsafeAlertingServer interface {
+	mustEmbedUnimplementedAlertingServer()
+}
+
+func RegisterAlertingServer(s grpc.ServiceRegistrar, srv AlertingServer) {
+	// If the following call pancis, it indicates UnimplementedAlertingServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Alerting_ServiceDesc, srv)
+}
+
+func _Alerting_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertingServer).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Alerting_CreateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertingServer).CreateAlertRule(ctx, req.(*CreateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Alerting_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertingServer).ListAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Alerting_ListAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertingServer).ListAlertRules(ctx, req.(*ListAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Alerting_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertingServer).DeleteAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Alerting_DeleteAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertingServer).DeleteAlertRule(ctx, req.(*DeleteAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Alerting_WatchAlerts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAlertsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AlertingServer).WatchAlerts(m, &grpc.GenericServerStream[WatchAlertsRequest, AlertEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Alerting_WatchAlertsServer = grpc.ServerStreamingServer[AlertEvent]
+
+// Alerting_ServiceDesc is the grpc.ServiceDesc for Alerting service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Alerting_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "data.Alerting",
+	HandlerType: (*AlertingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _Alerting_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _Alerting_ListAlertRules_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _Alerting_DeleteAlertRule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAlerts",
+			Handler:       _Alerting_WatchAlerts_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "proto/data.proto",
 }
@@ -0,0 +1,1282 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/data.proto
+
+package protoconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	proto "rpcGoDatatype/proto"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// DataParserName is the fully-qualified name of the DataParser service.
+	DataParserName = "data.DataParser"
+	// StationRegistryName is the fully-qualified name of the StationRegistry service.
+	StationRegistryName = "data.StationRegistry"
+	// AlertingName is the fully-qualified name of the Alerting service.
+	AlertingName = "data.Alerting"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DataParserParseProcedure is the fully-qualified name of the DataParser's Parse RPC.
+	DataParserParseProcedure = "/data.DataParser/Parse"
+	// DataParserGetCompatibilityMatrixProcedure is the fully-qualified name of the DataParser's
+	// GetCompatibilityMatrix RPC.
+	DataParserGetCompatibilityMatrixProcedure = "/data.DataParser/GetCompatibilityMatrix"
+	// DataParserRegisterStationProcedure is the fully-qualified name of the DataParser's
+	// RegisterStation RPC.
+	DataParserRegisterStationProcedure = "/data.DataParser/RegisterStation"
+	// DataParserApproveStationProcedure is the fully-qualified name of the DataParser's ApproveStation
+	// RPC.
+	DataParserApproveStationProcedure = "/data.DataParser/ApproveStation"
+	// DataParserGetRegistrationStatusProcedure is the fully-qualified name of the DataParser's
+	// GetRegistrationStatus RPC.
+	DataParserGetRegistrationStatusProcedure = "/data.DataParser/GetRegistrationStatus"
+	// DataParserMergeProcedure is the fully-qualified name of the DataParser's Merge RPC.
+	DataParserMergeProcedure = "/data.DataParser/Merge"
+	// DataParserSplitProcedure is the fully-qualified name of the DataParser's Split RPC.
+	DataParserSplitProcedure = "/data.DataParser/Split"
+	// DataParserInferSchemaProcedure is the fully-qualified name of the DataParser's InferSchema RPC.
+	DataParserInferSchemaProcedure = "/data.DataParser/InferSchema"
+	// DataParserDescribeDataProcedure is the fully-qualified name of the DataParser's DescribeData RPC.
+	DataParserDescribeDataProcedure = "/data.DataParser/DescribeData"
+	// DataParserValidateProcedure is the fully-qualified name of the DataParser's Validate RPC.
+	DataParserValidateProcedure = "/data.DataParser/Validate"
+	// DataParserPipelineProcedure is the fully-qualified name of the DataParser's Pipeline RPC.
+	DataParserPipelineProcedure = "/data.DataParser/Pipeline"
+	// DataParserQualityControlProcedure is the fully-qualified name of the DataParser's QualityControl
+	// RPC.
+	DataParserQualityControlProcedure = "/data.DataParser/QualityControl"
+	// DataParserDetectAnomaliesProcedure is the fully-qualified name of the DataParser's
+	// DetectAnomalies RPC.
+	DataParserDetectAnomaliesProcedure = "/data.DataParser/DetectAnomalies"
+	// DataParserDetectGapsProcedure is the fully-qualified name of the DataParser's DetectGaps RPC.
+	DataParserDetectGapsProcedure = "/data.DataParser/DetectGaps"
+	// DataParserCorrelateProcedure is the fully-qualified name of the DataParser's Correlate RPC.
+	DataParserCorrelateProcedure = "/data.DataParser/Correlate"
+	// DataParserAggregateProcedure is the fully-qualified name of the DataParser's Aggregate RPC.
+	DataParserAggregateProcedure = "/data.DataParser/Aggregate"
+	// DataParserParseBatchProcedure is the fully-qualified name of the DataParser's ParseBatch RPC.
+	DataParserParseBatchProcedure = "/data.DataParser/ParseBatch"
+	// DataParserSubmitJobProcedure is the fully-qualified name of the DataParser's SubmitJob RPC.
+	DataParserSubmitJobProcedure = "/data.DataParser/SubmitJob"
+	// DataParserGetJobStatusProcedure is the fully-qualified name of the DataParser's GetJobStatus RPC.
+	DataParserGetJobStatusProcedure = "/data.DataParser/GetJobStatus"
+	// DataParserGetJobResultProcedure is the fully-qualified name of the DataParser's GetJobResult RPC.
+	DataParserGetJobResultProcedure = "/data.DataParser/GetJobResult"
+	// DataParserCancelJobProcedure is the fully-qualified name of the DataParser's CancelJob RPC.
+	DataParserCancelJobProcedure = "/data.DataParser/CancelJob"
+	// DataParserGetUsageProcedure is the fully-qualified name of the DataParser's GetUsage RPC.
+	DataParserGetUsageProcedure = "/data.DataParser/GetUsage"
+	// DataParserParseLiveProcedure is the fully-qualified name of the DataParser's ParseLive RPC.
+	DataParserParseLiveProcedure = "/data.DataParser/ParseLive"
+	// DataParserListJobsProcedure is the fully-qualified name of the DataParser's ListJobs RPC.
+	DataParserListJobsProcedure = "/data.DataParser/ListJobs"
+	// DataParserGetHistoryProcedure is the fully-qualified name of the DataParser's GetHistory RPC.
+	DataParserGetHistoryProcedure = "/data.DataParser/GetHistory"
+	// StationRegistryCreatePlatformProcedure is the fully-qualified name of the StationRegistry's
+	// CreatePlatform RPC.
+	StationRegistryCreatePlatformProcedure = "/data.StationRegistry/CreatePlatform"
+	// StationRegistryGetPlatformProcedure is the fully-qualified name of the StationRegistry's
+	// GetPlatform RPC.
+	StationRegistryGetPlatformProcedure = "/data.StationRegistry/GetPlatform"
+	// StationRegistryListPlatformsProcedure is the fully-qualified name of the StationRegistry's
+	// ListPlatforms RPC.
+	StationRegistryListPlatformsProcedure = "/data.StationRegistry/ListPlatforms"
+	// StationRegistryUpdatePlatformProcedure is the fully-qualified name of the StationRegistry's
+	// UpdatePlatform RPC.
+	StationRegistryUpdatePlatformProcedure = "/data.StationRegistry/UpdatePlatform"
+	// StationRegistryDeletePlatformProcedure is the fully-qualified name of the StationRegistry's
+	// DeletePlatform RPC.
+	StationRegistryDeletePlatformProcedure = "/data.StationRegistry/DeletePlatform"
+	// StationRegistryPutSensorProcedure is the fully-qualified name of the StationRegistry's PutSensor
+	// RPC.
+	StationRegistryPutSensorProcedure = "/data.StationRegistry/PutSensor"
+	// StationRegistryDeleteSensorProcedure is the fully-qualified name of the StationRegistry's
+	// DeleteSensor RPC.
+	StationRegistryDeleteSensorProcedure = "/data.StationRegistry/DeleteSensor"
+	// StationRegistryAddCalibrationProcedure is the fully-qualified name of the StationRegistry's
+	// AddCalibration RPC.
+	StationRegistryAddCalibrationProcedure = "/data.StationRegistry/AddCalibration"
+	// StationRegistryPutDeploymentProcedure is the fully-qualified name of the StationRegistry's
+	// PutDeployment RPC.
+	StationRegistryPutDeploymentProcedure = "/data.StationRegistry/PutDeployment"
+	// StationRegistryDeleteDeploymentProcedure is the fully-qualified name of the StationRegistry's
+	// DeleteDeployment RPC.
+	StationRegistryDeleteDeploymentProcedure = "/data.StationRegistry/DeleteDeployment"
+	// AlertingCreateAlertRuleProcedure is the fully-qualified name of the Alerting's CreateAlertRule
+	// RPC.
+	AlertingCreateAlertRuleProcedure = "/data.Alerting/CreateAlertRule"
+	// AlertingListAlertRulesProcedure is the fully-qualified name of the Alerting's ListAlertRules RPC.
+	AlertingListAlertRulesProcedure = "/data.Alerting/ListAlertRules"
+	// AlertingDeleteAlertRuleProcedure is the fully-qualified name of the Alerting's DeleteAlertRule
+	// RPC.
+	AlertingDeleteAlertRuleProcedure = "/data.Alerting/DeleteAlertRule"
+	// AlertingWatchAlertsProcedure is the fully-qualified name of the Alerting's WatchAlerts RPC.
+	AlertingWatchAlertsProcedure = "/data.Alerting/WatchAlerts"
+)
+
+// DataParserClient is a client for the data.DataParser service.
+type DataParserClient interface {
+	Parse(context.Context, *connect.Request[proto.ParseRequest]) (*connect.Response[proto.ParseResponse], error)
+	GetCompatibilityMatrix(context.Context, *connect.Request[proto.CompatibilityMatrixRequest]) (*connect.Response[proto.CompatibilityMatrixResponse], error)
+	RegisterStation(context.Context, *connect.Request[proto.RegisterStationRequest]) (*connect.Response[proto.RegisterStationResponse], error)
+	ApproveStation(context.Context, *connect.Request[proto.ApproveStationRequest]) (*connect.Response[proto.ApproveStationResponse], error)
+	GetRegistrationStatus(context.Context, *connect.Request[proto.RegistrationStatusRequest]) (*connect.Response[proto.RegistrationStatusResponse], error)
+	Merge(context.Context, *connect.Request[proto.MergeRequest]) (*connect.Response[proto.ParseResponse], error)
+	Split(context.Context, *connect.Request[proto.SplitRequest]) (*connect.Response[proto.SplitResponse], error)
+	InferSchema(context.Context, *connect.Request[proto.InferSchemaRequest]) (*connect.Response[proto.InferSchemaResponse], error)
+	DescribeData(context.Context, *connect.Request[proto.DescribeDataRequest]) (*connect.Response[proto.DescribeDataResponse], error)
+	Validate(context.Context, *connect.Request[proto.ValidateRequest]) (*connect.Response[proto.ValidateResponse], error)
+	Pipeline(context.Context, *connect.Request[proto.PipelineRequest]) (*connect.Response[proto.ParseResponse], error)
+	QualityControl(context.Context, *connect.Request[proto.QualityControlRequest]) (*connect.Response[proto.ParseResponse], error)
+	DetectAnomalies(context.Context, *connect.Request[proto.DetectAnomaliesRequest]) (*connect.Response[proto.ParseResponse], error)
+	DetectGaps(context.Context, *connect.Request[proto.DetectGapsRequest]) (*connect.Response[proto.DetectGapsResponse], error)
+	Correlate(context.Context, *connect.Request[proto.CorrelateRequest]) (*connect.Response[proto.CorrelateResponse], error)
+	Aggregate(context.Context, *connect.Request[proto.AggregateRequest]) (*connect.Response[proto.ParseResponse], error)
+	ParseBatch(context.Context, *connect.Request[proto.ParseBatchRequest]) (*connect.Response[proto.ParseBatchResponse], error)
+	SubmitJob(context.Context, *connect.Request[proto.ParseRequest]) (*connect.Response[proto.JobStatus], error)
+	GetJobStatus(context.Context, *connect.Request[proto.JobRequest]) (*connect.Response[proto.JobStatus], error)
+	GetJobResult(context.Context, *connect.Request[proto.JobRequest]) (*connect.Response[proto.ParseResponse], error)
+	CancelJob(context.Context, *connect.Request[proto.JobRequest]) (*connect.Response[proto.JobStatus], error)
+	GetUsage(context.Context, *connect.Request[proto.UsageRequest]) (*connect.Response[proto.UsageResponse], error)
+	ParseLive(context.Context) *connect.BidiStreamForClient[proto.LiveRequest, proto.LiveResponse]
+	ListJobs(context.Context, *connect.Request[proto.ListJobsRequest]) (*connect.Response[proto.ListJobsResponse], error)
+	GetHistory(context.Context, *connect.Request[proto.HistoryRequest]) (*connect.Response[proto.HistoryResponse], error)
+}
+
+// NewDataParserClient constructs a client for the data.DataParser service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDataParserClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DataParserClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	dataParserMethods := proto.File_proto_data_proto.Services().ByName("DataParser").Methods()
+	return &dataParserClient{
+		parse: connect.NewClient[proto.ParseRequest, proto.ParseResponse](
+			httpClient,
+			baseURL+DataParserParseProcedure,
+			connect.WithSchema(dataParserMethods.ByName("Parse")),
+			connect.WithClientOptions(opts...),
+		),
+		getCompatibilityMatrix: connect.NewClient[proto.CompatibilityMatrixRequest, proto.CompatibilityMatrixResponse](
+			httpClient,
+			baseURL+DataParserGetCompatibilityMatrixProcedure,
+			connect.WithSchema(dataParserMethods.ByName("GetCompatibilityMatrix")),
+			connect.WithClientOptions(opts...),
+		),
+		registerStation: connect.NewClient[proto.RegisterStationRequest, proto.RegisterStationResponse](
+			httpClient,
+			baseURL+DataParserRegisterStationProcedure,
+			connect.WithSchema(dataParserMethods.ByName("RegisterStation")),
+			connect.WithClientOptions(opts...),
+		),
+		approveStation: connect.NewClient[proto.ApproveStationRequest, proto.ApproveStationResponse](
+			httpClient,
+			baseURL+DataParserApproveStationProcedure,
+			connect.WithSchema(dataParserMethods.ByName("ApproveStation")),
+			connect.WithClientOptions(opts...),
+		),
+		getRegistrationStatus: connect.NewClient[proto.RegistrationStatusRequest, proto.RegistrationStatusResponse](
+			httpClient,
+			baseURL+DataParserGetRegistrationStatusProcedure,
+			connect.WithSchema(dataParserMethods.ByName("GetRegistrationStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		merge: connect.NewClient[proto.MergeRequest, proto.ParseResponse](
+			httpClient,
+			baseURL+DataParserMergeProcedure,
+			connect.WithSchema(dataParserMethods.ByName("Merge")),
+			connect.WithClientOptions(opts...),
+		),
+		split: connect.NewClient[proto.SplitRequest, proto.SplitResponse](
+			httpClient,
+			baseURL+DataParserSplitProcedure,
+			connect.WithSchema(dataParserMethods.ByName("Split")),
+			connect.WithClientOptions(opts...),
+		),
+		inferSchema: connect.NewClient[proto.InferSchemaRequest, proto.InferSchemaResponse](
+			httpClient,
+			baseURL+DataParserInferSchemaProcedure,
+			connect.WithSchema(dataParserMethods.ByName("InferSchema")),
+			connect.WithClientOptions(opts...),
+		),
+		describeData: connect.NewClient[proto.DescribeDataRequest, proto.DescribeDataResponse](
+			httpClient,
+			baseURL+DataParserDescribeDataProcedure,
+			connect.WithSchema(dataParserMethods.ByName("DescribeData")),
+			connect.WithClientOptions(opts...),
+		),
+		validate: connect.NewClient[proto.ValidateRequest, proto.ValidateResponse](
+			httpClient,
+			baseURL+DataParserValidateProcedure,
+			connect.WithSchema(dataParserMethods.ByName("Validate")),
+			connect.WithClientOptions(opts...),
+		),
+		pipeline: connect.NewClient[proto.PipelineRequest, proto.ParseResponse](
+			httpClient,
+			baseURL+DataParserPipelineProcedure,
+			connect.WithSchema(dataParserMethods.ByName("Pipeline")),
+			connect.WithClientOptions(opts...),
+		),
+		qualityControl: connect.NewClient[proto.QualityControlRequest, proto.ParseResponse](
+			httpClient,
+			baseURL+DataParserQualityControlProcedure,
+			connect.WithSchema(dataParserMethods.ByName("QualityControl")),
+			connect.WithClientOptions(opts...),
+		),
+		detectAnomalies: connect.NewClient[proto.DetectAnomaliesRequest, proto.ParseResponse](
+			httpClient,
+			baseURL+DataParserDetectAnomaliesProcedure,
+			connect.WithSchema(dataParserMethods.ByName("DetectAnomalies")),
+			connect.WithClientOptions(opts...),
+		),
+		detectGaps: connect.NewClient[proto.DetectGapsRequest, proto.DetectGapsResponse](
+			httpClient,
+			baseURL+DataParserDetectGapsProcedure,
+			connect.WithSchema(dataParserMethods.ByName("DetectGaps")),
+			connect.WithClientOptions(opts...),
+		),
+		correlate: connect.NewClient[proto.CorrelateRequest, proto.CorrelateResponse](
+			httpClient,
+			baseURL+DataParserCorrelateProcedure,
+			connect.WithSchema(dataParserMethods.ByName("Correlate")),
+			connect.WithClientOptions(opts...),
+		),
+		aggregate: connect.NewClient[proto.AggregateRequest, proto.ParseResponse](
+			httpClient,
+			baseURL+DataParserAggregateProcedure,
+			connect.WithSchema(dataParserMethods.ByName("Aggregate")),
+			connect.WithClientOptions(opts...),
+		),
+		parseBatch: connect.NewClient[proto.ParseBatchRequest, proto.ParseBatchResponse](
+			httpClient,
+			baseURL+DataParserParseBatchProcedure,
+			connect.WithSchema(dataParserMethods.ByName("ParseBatch")),
+			connect.WithClientOptions(opts...),
+		),
+		submitJob: connect.NewClient[proto.ParseRequest, proto.JobStatus](
+			httpClient,
+			baseURL+DataParserSubmitJobProcedure,
+			connect.WithSchema(dataParserMethods.ByName("SubmitJob")),
+			connect.WithClientOptions(opts...),
+		),
+		getJobStatus: connect.NewClient[proto.JobRequest, proto.JobStatus](
+			httpClient,
+			baseURL+DataParserGetJobStatusProcedure,
+			connect.WithSchema(dataParserMethods.ByName("GetJobStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		getJobResult: connect.NewClient[proto.JobRequest, proto.ParseResponse](
+			httpClient,
+			baseURL+DataParserGetJobResultProcedure,
+			connect.WithSchema(dataParserMethods.ByName("GetJobResult")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelJob: connect.NewClient[proto.JobRequest, proto.JobStatus](
+			httpClient,
+			baseURL+DataParserCancelJobProcedure,
+			connect.WithSchema(dataParserMethods.ByName("CancelJob")),
+			connect.WithClientOptions(opts...),
+		),
+		getUsage: connect.NewClient[proto.UsageRequest, proto.UsageResponse](
+			httpClient,
+			baseURL+DataParserGetUsageProcedure,
+			connect.WithSchema(dataParserMethods.ByName("GetUsage")),
+			connect.WithClientOptions(opts...),
+		),
+		parseLive: connect.NewClient[proto.LiveRequest, proto.LiveResponse](
+			httpClient,
+			baseURL+DataParserParseLiveProcedure,
+			connect.WithSchema(dataParserMethods.ByName("ParseLive")),
+			connect.WithClientOptions(opts...),
+		),
+		listJobs: connect.NewClient[proto.ListJobsRequest, proto.ListJobsResponse](
+			httpClient,
+			baseURL+DataParserListJobsProcedure,
+			connect.WithSchema(dataParserMethods.ByName("ListJobs")),
+			connect.WithClientOptions(opts...),
+		),
+		getHistory: connect.NewClient[proto.HistoryRequest, proto.HistoryResponse](
+			httpClient,
+			baseURL+DataParserGetHistoryProcedure,
+			connect.WithSchema(dataParserMethods.ByName("GetHistory")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// dataParserClient implements DataParserClient.
+type dataParserClient struct {
+	parse                  *connect.Client[proto.ParseRequest, proto.ParseResponse]
+	getCompatibilityMatrix *connect.Client[proto.CompatibilityMatrixRequest, proto.CompatibilityMatrixResponse]
+	registerStation        *connect.Client[proto.RegisterStationRequest, proto.RegisterStationResponse]
+	approveStation         *connect.Client[proto.ApproveStationRequest, proto.ApproveStationResponse]
+	getRegistrationStatus  *connect.Client[proto.RegistrationStatusRequest, proto.RegistrationStatusResponse]
+	merge                  *connect.Client[proto.MergeRequest, proto.ParseResponse]
+	split                  *connect.Client[proto.SplitRequest, proto.SplitResponse]
+	inferSchema            *connect.Client[proto.InferSchemaRequest, proto.InferSchemaResponse]
+	describeData           *connect.Client[proto.DescribeDataRequest, proto.DescribeDataResponse]
+	validate               *connect.Client[proto.ValidateRequest, proto.ValidateResponse]
+	pipeline               *connect.Client[proto.PipelineRequest, proto.ParseResponse]
+	qualityControl         *connect.Client[proto.QualityControlRequest, proto.ParseResponse]
+	detectAnomalies        *connect.Client[proto.DetectAnomaliesRequest, proto.ParseResponse]
+	detectGaps             *connect.Client[proto.DetectGapsRequest, proto.DetectGapsResponse]
+	correlate              *connect.Client[proto.CorrelateRequest, proto.CorrelateResponse]
+	aggregate              *connect.Client[proto.AggregateRequest, proto.ParseResponse]
+	parseBatch             *connect.Client[proto.ParseBatchRequest, proto.ParseBatchResponse]
+	submitJob              *connect.Client[proto.ParseRequest, proto.JobStatus]
+	getJobStatus           *connect.Client[proto.JobRequest, proto.JobStatus]
+	getJobResult           *connect.Client[proto.JobRequest, proto.ParseResponse]
+	cancelJob              *connect.Client[proto.JobRequest, proto.JobStatus]
+	getUsage               *connect.Client[proto.UsageRequest, proto.UsageResponse]
+	parseLive              *connect.Client[proto.LiveRequest, proto.LiveResponse]
+	listJobs               *connect.Client[proto.ListJobsRequest, proto.ListJobsResponse]
+	getHistory             *connect.Client[proto.HistoryRequest, proto.HistoryResponse]
+}
+
+// Parse calls data.DataParser.Parse.
+func (c *dataParserClient) Parse(ctx context.Context, req *connect.Request[proto.ParseRequest]) (*connect.Response[proto.ParseResponse], error) {
+	return c.parse.CallUnary(ctx, req)
+}
+
+// GetCompatibilityMatrix calls data.DataParser.GetCompatibilityMatrix.
+func (c *dataParserClient) GetCompatibilityMatrix(ctx context.Context, req *connect.Request[proto.CompatibilityMatrixRequest]) (*connect.Response[proto.CompatibilityMatrixResponse], error) {
+	return c.getCompatibilityMatrix.CallUnary(ctx, req)
+}
+
+// RegisterStation calls data.DataParser.RegisterStation.
+func (c *dataParserClient) RegisterStation(ctx context.Context, req *connect.Request[proto.RegisterStationRequest]) (*connect.Response[proto.RegisterStationResponse], error) {
+	return c.registerStation.CallUnary(ctx, req)
+}
+
+// ApproveStation calls data.DataParser.ApproveStation.
+func (c *dataParserClient) ApproveStation(ctx context.Context, req *connect.Request[proto.ApproveStationRequest]) (*connect.Response[proto.ApproveStationResponse], error) {
+	return c.approveStation.CallUnary(ctx, req)
+}
+
+// GetRegistrationStatus calls data.DataParser.GetRegistrationStatus.
+func (c *dataParserClient) GetRegistrationStatus(ctx context.Context, req *connect.Request[proto.RegistrationStatusRequest]) (*connect.Response[proto.RegistrationStatusResponse], error) {
+	return c.getRegistrationStatus.CallUnary(ctx, req)
+}
+
+// Merge calls data.DataParser.Merge.
+func (c *dataParserClient) Merge(ctx context.Context, req *connect.Request[proto.MergeRequest]) (*connect.Response[proto.ParseResponse], error) {
+	return c.merge.CallUnary(ctx, req)
+}
+
+// Split calls data.DataParser.Split.
+func (c *dataParserClient) Split(ctx context.Context, req *connect.Request[proto.SplitRequest]) (*connect.Response[proto.SplitResponse], error) {
+	return c.split.CallUnary(ctx, req)
+}
+
+// InferSchema calls data.DataParser.InferSchema.
+func (c *dataParserClient) InferSchema(ctx context.Context, req *connect.Request[proto.InferSchemaRequest]) (*connect.Response[proto.InferSchemaResponse], error) {
+	return c.inferSchema.CallUnary(ctx, req)
+}
+
+// DescribeData calls data.DataParser.DescribeData.
+func (c *dataParserClient) DescribeData(ctx context.Context, req *connect.Request[proto.DescribeDataRequest]) (*connect.Response[proto.DescribeDataResponse], error) {
+	return c.describeData.CallUnary(ctx, req)
+}
+
+// Validate calls data.DataParser.Validate.
+func (c *dataParserClient) Validate(ctx context.Context, req *connect.Request[proto.ValidateRequest]) (*connect.Response[proto.ValidateResponse], error) {
+	return c.validate.CallUnary(ctx, req)
+}
+
+// Pipeline calls data.DataParser.Pipeline.
+func (c *dataParserClient) Pipeline(ctx context.Context, req *connect.Request[proto.PipelineRequest]) (*connect.Response[proto.ParseResponse], error) {
+	return c.pipeline.CallUnary(ctx, req)
+}
+
+// QualityControl calls data.DataParser.QualityControl.
+func (c *dataParserClient) QualityControl(ctx context.Context, req *connect.Request[proto.QualityControlRequest]) (*connect.Response[proto.ParseResponse], error) {
+	return c.qualityControl.CallUnary(ctx, req)
+}
+
+// DetectAnomalies calls data.DataParser.DetectAnomalies.
+func (c *dataParserClient) DetectAnomalies(ctx context.Context, req *connect.Request[proto.DetectAnomaliesRequest]) (*connect.Response[proto.ParseResponse], error) {
+	return c.detectAnomalies.CallUnary(ctx, req)
+}
+
+// DetectGaps calls data.DataParser.DetectGaps.
+func (c *dataParserClient) DetectGaps(ctx context.Context, req *connect.Request[proto.DetectGapsRequest]) (*connect.Response[proto.DetectGapsResponse], error) {
+	return c.detectGaps.CallUnary(ctx, req)
+}
+
+// Correlate calls data.DataParser.Correlate.
+func (c *dataParserClient) Correlate(ctx context.Context, req *connect.Request[proto.CorrelateRequest]) (*connect.Response[proto.CorrelateResponse], error) {
+	return c.correlate.CallUnary(ctx, req)
+}
+
+// Aggregate calls data.DataParser.Aggregate.
+func (c *dataParserClient) Aggregate(ctx context.Context, req *connect.Request[proto.AggregateRequest]) (*connect.Response[proto.ParseResponse], error) {
+	return c.aggregate.CallUnary(ctx, req)
+}
+
+// ParseBatch calls data.DataParser.ParseBatch.
+func (c *dataParserClient) ParseBatch(ctx context.Context, req *connect.Request[proto.ParseBatchRequest]) (*connect.Response[proto.ParseBatchResponse], error) {
+	return c.parseBatch.CallUnary(ctx, req)
+}
+
+// SubmitJob calls data.DataParser.SubmitJob.
+func (c *dataParserClient) SubmitJob(ctx context.Context, req *connect.Request[proto.ParseRequest]) (*connect.Response[proto.JobStatus], error) {
+	return c.submitJob.CallUnary(ctx, req)
+}
+
+// GetJobStatus calls data.DataParser.GetJobStatus.
+func (c *dataParserClient) GetJobStatus(ctx context.Context, req *connect.Request[proto.JobRequest]) (*connect.Response[proto.JobStatus], error) {
+	return c.getJobStatus.CallUnary(ctx, req)
+}
+
+// GetJobResult calls data.DataParser.GetJobResult.
+func (c *dataParserClient) GetJobResult(ctx context.Context, req *connect.Request[proto.JobRequest]) (*connect.Response[proto.ParseResponse], error) {
+	return c.getJobResult.CallUnary(ctx, req)
+}
+
+// CancelJob calls data.DataParser.CancelJob.
+func (c *dataParserClient) CancelJob(ctx context.Context, req *connect.Request[proto.JobRequest]) (*connect.Response[proto.JobStatus], error) {
+	return c.cancelJob.CallUnary(ctx, req)
+}
+
+// GetUsage calls data.DataParser.GetUsage.
+func (c *dataParserClient) GetUsage(ctx context.Context, req *connect.Request[proto.UsageRequest]) (*connect.Response[proto.UsageResponse], error) {
+	return c.getUsage.CallUnary(ctx, req)
+}
+
+// ParseLive calls data.DataParser.ParseLive.
+func (c *dataParserClient) ParseLive(ctx context.Context) *connect.BidiStreamForClient[proto.LiveRequest, proto.LiveResponse] {
+	return c.parseLive.CallBidiStream(ctx)
+}
+
+// ListJobs calls data.DataParser.ListJobs.
+func (c *dataParserClient) ListJobs(ctx context.Context, req *connect.Request[proto.ListJobsRequest]) (*connect.Response[proto.ListJobsResponse], error) {
+	return c.listJobs.CallUnary(ctx, req)
+}
+
+// GetHistory calls data.DataParser.GetHistory.
+func (c *dataParserClient) GetHistory(ctx context.Context, req *connect.Request[proto.HistoryRequest]) (*connect.Response[proto.HistoryResponse], error) {
+	return c.getHistory.CallUnary(ctx, req)
+}
+
+// DataParserHandler is an implementation of the data.DataParser service.
+type DataParserHandler interface {
+	Parse(context.Context, *connect.Request[proto.ParseRequest]) (*connect.Response[proto.ParseResponse], error)
+	GetCompatibilityMatrix(context.Context, *connect.Request[proto.CompatibilityMatrixRequest]) (*connect.Response[proto.CompatibilityMatrixResponse], error)
+	RegisterStation(context.Context, *connect.Request[proto.RegisterStationRequest]) (*connect.Response[proto.RegisterStationResponse], error)
+	ApproveStation(context.Context, *connect.Request[proto.ApproveStationRequest]) (*connect.Response[proto.ApproveStationResponse], error)
+	GetRegistrationStatus(context.Context, *connect.Request[proto.RegistrationStatusRequest]) (*connect.Response[proto.RegistrationStatusResponse], error)
+	Merge(context.Context, *connect.Request[proto.MergeRequest]) (*connect.Response[proto.ParseResponse], error)
+	Split(context.Context, *connect.Request[proto.SplitRequest]) (*connect.Response[proto.SplitResponse], error)
+	InferSchema(context.Context, *connect.Request[proto.InferSchemaRequest]) (*connect.Response[proto.InferSchemaResponse], error)
+	DescribeData(context.Context, *connect.Request[proto.DescribeDataRequest]) (*connect.Response[proto.DescribeDataResponse], error)
+	Validate(context.Context, *connect.Request[proto.ValidateRequest]) (*connect.Response[proto.ValidateResponse], error)
+	Pipeline(context.Context, *connect.Request[proto.PipelineRequest]) (*connect.Response[proto.ParseResponse], error)
+	QualityControl(context.Context, *connect.Request[proto.QualityControlRequest]) (*connect.Response[proto.ParseResponse], error)
+	DetectAnomalies(context.Context, *connect.Request[proto.DetectAnomaliesRequest]) (*connect.Response[proto.ParseResponse], error)
+	DetectGaps(context.Context, *connect.Request[proto.DetectGapsRequest]) (*connect.Response[proto.DetectGapsResponse], error)
+	Correlate(context.Context, *connect.Request[proto.CorrelateRequest]) (*connect.Response[proto.CorrelateResponse], error)
+	Aggregate(context.Context, *connect.Request[proto.AggregateRequest]) (*connect.Response[proto.ParseResponse], error)
+	ParseBatch(context.Context, *connect.Request[proto.ParseBatchRequest]) (*connect.Response[proto.ParseBatchResponse], error)
+	SubmitJob(context.Context, *connect.Request[proto.ParseRequest]) (*connect.Response[proto.JobStatus], error)
+	GetJobStatus(context.Context, *connect.Request[proto.JobRequest]) (*connect.Response[proto.JobStatus], error)
+	GetJobResult(context.Context, *connect.Request[proto.JobRequest]) (*connect.Response[proto.ParseResponse], error)
+	CancelJob(context.Context, *connect.Request[proto.JobRequest]) (*connect.Response[proto.JobStatus], error)
+	GetUsage(context.Context, *connect.Request[proto.UsageRequest]) (*connect.Response[proto.UsageResponse], error)
+	ParseLive(context.Context, *connect.BidiStream[proto.LiveRequest, proto.LiveResponse]) error
+	ListJobs(context.Context, *connect.Request[proto.ListJobsRequest]) (*connect.Response[proto.ListJobsResponse], error)
+	GetHistory(context.Context, *connect.Request[proto.HistoryRequest]) (*connect.Response[proto.HistoryResponse], error)
+}
+
+// NewDataParserHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDataParserHandler(svc DataParserHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	dataParserMethods := proto.File_proto_data_proto.Services().ByName("DataParser").Methods()
+	dataParserParseHandler := connect.NewUnaryHandler(
+		DataParserParseProcedure,
+		svc.Parse,
+		connect.WithSchema(dataParserMethods.ByName("Parse")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserGetCompatibilityMatrixHandler := connect.NewUnaryHandler(
+		DataParserGetCompatibilityMatrixProcedure,
+		svc.GetCompatibilityMatrix,
+		connect.WithSchema(dataParserMethods.ByName("GetCompatibilityMatrix")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserRegisterStationHandler := connect.NewUnaryHandler(
+		DataParserRegisterStationProcedure,
+		svc.RegisterStation,
+		connect.WithSchema(dataParserMethods.ByName("RegisterStation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserApproveStationHandler := connect.NewUnaryHandler(
+		DataParserApproveStationProcedure,
+		svc.ApproveStation,
+		connect.WithSchema(dataParserMethods.ByName("ApproveStation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserGetRegistrationStatusHandler := connect.NewUnaryHandler(
+		DataParserGetRegistrationStatusProcedure,
+		svc.GetRegistrationStatus,
+		connect.WithSchema(dataParserMethods.ByName("GetRegistrationStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserMergeHandler := connect.NewUnaryHandler(
+		DataParserMergeProcedure,
+		svc.Merge,
+		connect.WithSchema(dataParserMethods.ByName("Merge")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserSplitHandler := connect.NewUnaryHandler(
+		DataParserSplitProcedure,
+		svc.Split,
+		connect.WithSchema(dataParserMethods.ByName("Split")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserInferSchemaHandler := connect.NewUnaryHandler(
+		DataParserInferSchemaProcedure,
+		svc.InferSchema,
+		connect.WithSchema(dataParserMethods.ByName("InferSchema")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserDescribeDataHandler := connect.NewUnaryHandler(
+		DataParserDescribeDataProcedure,
+		svc.DescribeData,
+		connect.WithSchema(dataParserMethods.ByName("DescribeData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserValidateHandler := connect.NewUnaryHandler(
+		DataParserValidateProcedure,
+		svc.Validate,
+		connect.WithSchema(dataParserMethods.ByName("Validate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserPipelineHandler := connect.NewUnaryHandler(
+		DataParserPipelineProcedure,
+		svc.Pipeline,
+		connect.WithSchema(dataParserMethods.ByName("Pipeline")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserQualityControlHandler := connect.NewUnaryHandler(
+		DataParserQualityControlProcedure,
+		svc.QualityControl,
+		connect.WithSchema(dataParserMethods.ByName("QualityControl")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserDetectAnomaliesHandler := connect.NewUnaryHandler(
+		DataParserDetectAnomaliesProcedure,
+		svc.DetectAnomalies,
+		connect.WithSchema(dataParserMethods.ByName("DetectAnomalies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserDetectGapsHandler := connect.NewUnaryHandler(
+		DataParserDetectGapsProcedure,
+		svc.DetectGaps,
+		connect.WithSchema(dataParserMethods.ByName("DetectGaps")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserCorrelateHandler := connect.NewUnaryHandler(
+		DataParserCorrelateProcedure,
+		svc.Correlate,
+		connect.WithSchema(dataParserMethods.ByName("Correlate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserAggregateHandler := connect.NewUnaryHandler(
+		DataParserAggregateProcedure,
+		svc.Aggregate,
+		connect.WithSchema(dataParserMethods.ByName("Aggregate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserParseBatchHandler := connect.NewUnaryHandler(
+		DataParserParseBatchProcedure,
+		svc.ParseBatch,
+		connect.WithSchema(dataParserMethods.ByName("ParseBatch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserSubmitJobHandler := connect.NewUnaryHandler(
+		DataParserSubmitJobProcedure,
+		svc.SubmitJob,
+		connect.WithSchema(dataParserMethods.ByName("SubmitJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserGetJobStatusHandler := connect.NewUnaryHandler(
+		DataParserGetJobStatusProcedure,
+		svc.GetJobStatus,
+		connect.WithSchema(dataParserMethods.ByName("GetJobStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserGetJobResultHandler := connect.NewUnaryHandler(
+		DataParserGetJobResultProcedure,
+		svc.GetJobResult,
+		connect.WithSchema(dataParserMethods.ByName("GetJobResult")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserCancelJobHandler := connect.NewUnaryHandler(
+		DataParserCancelJobProcedure,
+		svc.CancelJob,
+		connect.WithSchema(dataParserMethods.ByName("CancelJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserGetUsageHandler := connect.NewUnaryHandler(
+		DataParserGetUsageProcedure,
+		svc.GetUsage,
+		connect.WithSchema(dataParserMethods.ByName("GetUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserParseLiveHandler := connect.NewBidiStreamHandler(
+		DataParserParseLiveProcedure,
+		svc.ParseLive,
+		connect.WithSchema(dataParserMethods.ByName("ParseLive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserListJobsHandler := connect.NewUnaryHandler(
+		DataParserListJobsProcedure,
+		svc.ListJobs,
+		connect.WithSchema(dataParserMethods.ByName("ListJobs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataParserGetHistoryHandler := connect.NewUnaryHandler(
+		DataParserGetHistoryProcedure,
+		svc.GetHistory,
+		connect.WithSchema(dataParserMethods.ByName("GetHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/data.DataParser/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DataParserParseProcedure:
+			dataParserParseHandler.ServeHTTP(w, r)
+		case DataParserGetCompatibilityMatrixProcedure:
+			dataParserGetCompatibilityMatrixHandler.ServeHTTP(w, r)
+		case DataParserRegisterStationProcedure:
+			dataParserRegisterStationHandler.ServeHTTP(w, r)
+		case DataParserApproveStationProcedure:
+			dataParserApproveStationHandler.ServeHTTP(w, r)
+		case DataParserGetRegistrationStatusProcedure:
+			dataParserGetRegistrationStatusHandler.ServeHTTP(w, r)
+		case DataParserMergeProcedure:
+			dataParserMergeHandler.ServeHTTP(w, r)
+		case DataParserSplitProcedure:
+			dataParserSplitHandler.ServeHTTP(w, r)
+		case DataParserInferSchemaProcedure:
+			dataParserInferSchemaHandler.ServeHTTP(w, r)
+		case DataParserDescribeDataProcedure:
+			dataParserDescribeDataHandler.ServeHTTP(w, r)
+		case DataParserValidateProcedure:
+			dataParserValidateHandler.ServeHTTP(w, r)
+		case DataParserPipelineProcedure:
+			dataParserPipelineHandler.ServeHTTP(w, r)
+		case DataParserQualityControlProcedure:
+			dataParserQualityControlHandler.ServeHTTP(w, r)
+		case DataParserDetectAnomaliesProcedure:
+			dataParserDetectAnomaliesHandler.ServeHTTP(w, r)
+		case DataParserDetectGapsProcedure:
+			dataParserDetectGapsHandler.ServeHTTP(w, r)
+		case DataParserCorrelateProcedure:
+			dataParserCorrelateHandler.ServeHTTP(w, r)
+		case DataParserAggregateProcedure:
+			dataParserAggregateHandler.ServeHTTP(w, r)
+		case DataParserParseBatchProcedure:
+			dataParserParseBatchHandler.ServeHTTP(w, r)
+		case DataParserSubmitJobProcedure:
+			dataParserSubmitJobHandler.ServeHTTP(w, r)
+		case DataParserGetJobStatusProcedure:
+			dataParserGetJobStatusHandler.ServeHTTP(w, r)
+		case DataParserGetJobResultProcedure:
+			dataParserGetJobResultHandler.ServeHTTP(w, r)
+		case DataParserCancelJobProcedure:
+			dataParserCancelJobHandler.ServeHTTP(w, r)
+		case DataParserGetUsageProcedure:
+			dataParserGetUsageHandler.ServeHTTP(w, r)
+		case DataParserParseLiveProcedure:
+			dataParserParseLiveHandler.ServeHTTP(w, r)
+		case DataParserListJobsProcedure:
+			dataParserListJobsHandler.ServeHTTP(w, r)
+		case DataParserGetHistoryProcedure:
+			dataParserGetHistoryHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDataParserHandler returns CodeUnimplemented from all methods.
+type UnimplementedDataParserHandler struct{}
+
+func (UnimplementedDataParserHandler) Parse(context.Context, *connect.Request[proto.ParseRequest]) (*connect.Response[proto.ParseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.Parse is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) GetCompatibilityMatrix(context.Context, *connect.Request[proto.CompatibilityMatrixRequest]) (*connect.Response[proto.CompatibilityMatrixResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.GetCompatibilityMatrix is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) RegisterStation(context.Context, *connect.Request[proto.RegisterStationRequest]) (*connect.Response[proto.RegisterStationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.RegisterStation is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) ApproveStation(context.Context, *connect.Request[proto.ApproveStationRequest]) (*connect.Response[proto.ApproveStationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.ApproveStation is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) GetRegistrationStatus(context.Context, *connect.Request[proto.RegistrationStatusRequest]) (*connect.Response[proto.RegistrationStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.GetRegistrationStatus is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) Merge(context.Context, *connect.Request[proto.MergeRequest]) (*connect.Response[proto.ParseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.Merge is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) Split(context.Context, *connect.Request[proto.SplitRequest]) (*connect.Response[proto.SplitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.Split is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) InferSchema(context.Context, *connect.Request[proto.InferSchemaRequest]) (*connect.Response[proto.InferSchemaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.InferSchema is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) DescribeData(context.Context, *connect.Request[proto.DescribeDataRequest]) (*connect.Response[proto.DescribeDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.DescribeData is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) Validate(context.Context, *connect.Request[proto.ValidateRequest]) (*connect.Response[proto.ValidateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.Validate is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) Pipeline(context.Context, *connect.Request[proto.PipelineRequest]) (*connect.Response[proto.ParseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.Pipeline is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) QualityControl(context.Context, *connect.Request[proto.QualityControlRequest]) (*connect.Response[proto.ParseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.QualityControl is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) DetectAnomalies(context.Context, *connect.Request[proto.DetectAnomaliesRequest]) (*connect.Response[proto.ParseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.DetectAnomalies is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) DetectGaps(context.Context, *connect.Request[proto.DetectGapsRequest]) (*connect.Response[proto.DetectGapsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.DetectGaps is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) Correlate(context.Context, *connect.Request[proto.CorrelateRequest]) (*connect.Response[proto.CorrelateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.Correlate is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) Aggregate(context.Context, *connect.Request[proto.AggregateRequest]) (*connect.Response[proto.ParseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.Aggregate is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) ParseBatch(context.Context, *connect.Request[proto.ParseBatchRequest]) (*connect.Response[proto.ParseBatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.ParseBatch is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) SubmitJob(context.Context, *connect.Request[proto.ParseRequest]) (*connect.Response[proto.JobStatus], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.SubmitJob is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) GetJobStatus(context.Context, *connect.Request[proto.JobRequest]) (*connect.Response[proto.JobStatus], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.GetJobStatus is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) GetJobResult(context.Context, *connect.Request[proto.JobRequest]) (*connect.Response[proto.ParseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.GetJobResult is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) CancelJob(context.Context, *connect.Request[proto.JobRequest]) (*connect.Response[proto.JobStatus], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.CancelJob is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) GetUsage(context.Context, *connect.Request[proto.UsageRequest]) (*connect.Response[proto.UsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.GetUsage is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) ParseLive(context.Context, *connect.BidiStream[proto.LiveRequest, proto.LiveResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.ParseLive is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) ListJobs(context.Context, *connect.Request[proto.ListJobsRequest]) (*connect.Response[proto.ListJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.ListJobs is not implemented"))
+}
+
+func (UnimplementedDataParserHandler) GetHistory(context.Context, *connect.Request[proto.HistoryRequest]) (*connect.Response[proto.HistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.DataParser.GetHistory is not implemented"))
+}
+
+// StationRegistryClient is a client for the data.StationRegistry service.
+type StationRegistryClient interface {
+	CreatePlatform(context.Context, *connect.Request[proto.CreatePlatformRequest]) (*connect.Response[proto.Platform], error)
+	GetPlatform(context.Context, *connect.Request[proto.GetPlatformRequest]) (*connect.Response[proto.Platform], error)
+	ListPlatforms(context.Context, *connect.Request[proto.ListPlatformsRequest]) (*connect.Response[proto.ListPlatformsResponse], error)
+	UpdatePlatform(context.Context, *connect.Request[proto.UpdatePlatformRequest]) (*connect.Response[proto.Platform], error)
+	DeletePlatform(context.Context, *connect.Request[proto.DeletePlatformRequest]) (*connect.Response[proto.DeletePlatformResponse], error)
+	PutSensor(context.Context, *connect.Request[proto.PutSensorRequest]) (*connect.Response[proto.Platform], error)
+	DeleteSensor(context.Context, *connect.Request[proto.DeleteSensorRequest]) (*connect.Response[proto.Platform], error)
+	AddCalibration(context.Context, *connect.Request[proto.AddCalibrationRequest]) (*connect.Response[proto.Platform], error)
+	PutDeployment(context.Context, *connect.Request[proto.PutDeploymentRequest]) (*connect.Response[proto.Platform], error)
+	DeleteDeployment(context.Context, *connect.Request[proto.DeleteDeploymentRequest]) (*connect.Response[proto.Platform], error)
+}
+
+// NewStationRegistryClient constructs a client for the data.StationRegistry service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStationRegistryClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StationRegistryClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	stationRegistryMethods := proto.File_proto_data_proto.Services().ByName("StationRegistry").Methods()
+	return &stationRegistryClient{
+		createPlatform: connect.NewClient[proto.CreatePlatformRequest, proto.Platform](
+			httpClient,
+			baseURL+StationRegistryCreatePlatformProcedure,
+			connect.WithSchema(stationRegistryMethods.ByName("CreatePlatform")),
+			connect.WithClientOptions(opts...),
+		),
+		getPlatform: connect.NewClient[proto.GetPlatformRequest, proto.Platform](
+			httpClient,
+			baseURL+StationRegistryGetPlatformProcedure,
+			connect.WithSchema(stationRegistryMethods.ByName("GetPlatform")),
+			connect.WithClientOptions(opts...),
+		),
+		listPlatforms: connect.NewClient[proto.ListPlatformsRequest, proto.ListPlatformsResponse](
+			httpClient,
+			baseURL+StationRegistryListPlatformsProcedure,
+			connect.WithSchema(stationRegistryMethods.ByName("ListPlatforms")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePlatform: connect.NewClient[proto.UpdatePlatformRequest, proto.Platform](
+			httpClient,
+			baseURL+StationRegistryUpdatePlatformProcedure,
+			connect.WithSchema(stationRegistryMethods.ByName("UpdatePlatform")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePlatform: connect.NewClient[proto.DeletePlatformRequest, proto.DeletePlatformResponse](
+			httpClient,
+			baseURL+StationRegistryDeletePlatformProcedure,
+			connect.WithSchema(stationRegistryMethods.ByName("DeletePlatform")),
+			connect.WithClientOptions(opts...),
+		),
+		putSensor: connect.NewClient[proto.PutSensorRequest, proto.Platform](
+			httpClient,
+			baseURL+StationRegistryPutSensorProcedure,
+			connect.WithSchema(stationRegistryMethods.ByName("PutSensor")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSensor: connect.NewClient[proto.DeleteSensorRequest, proto.Platform](
+			httpClient,
+			baseURL+StationRegistryDeleteSensorProcedure,
+			connect.WithSchema(stationRegistryMethods.ByName("DeleteSensor")),
+			connect.WithClientOptions(opts...),
+		),
+		addCalibration: connect.NewClient[proto.AddCalibrationRequest, proto.Platform](
+			httpClient,
+			baseURL+StationRegistryAddCalibrationProcedure,
+			connect.WithSchema(stationRegistryMethods.ByName("AddCalibration")),
+			connect.WithClientOptions(opts...),
+		),
+		putDeployment: connect.NewClient[proto.PutDeploymentRequest, proto.Platform](
+			httpClient,
+			baseURL+StationRegistryPutDeploymentProcedure,
+			connect.WithSchema(stationRegistryMethods.ByName("PutDeployment")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteDeployment: connect.NewClient[proto.DeleteDeploymentRequest, proto.Platform](
+			httpClient,
+			baseURL+StationRegistryDeleteDeploymentProcedure,
+			connect.WithSchema(stationRegistryMethods.ByName("DeleteDeployment")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// stationRegistryClient implements StationRegistryClient.
+type stationRegistryClient struct {
+	createPlatform   *connect.Client[proto.CreatePlatformRequest, proto.Platform]
+	getPlatform      *connect.Client[proto.GetPlatformRequest, proto.Platform]
+	listPlatforms    *connect.Client[proto.ListPlatformsRequest, proto.ListPlatformsResponse]
+	updatePlatform   *connect.Client[proto.UpdatePlatformRequest, proto.Platform]
+	deletePlatform   *connect.Client[proto.DeletePlatformRequest, proto.DeletePlatformResponse]
+	putSensor        *connect.Client[proto.PutSensorRequest, proto.Platform]
+	deleteSensor     *connect.Client[proto.DeleteSensorRequest, proto.Platform]
+	addCalibration   *connect.Client[proto.AddCalibrationRequest, proto.Platform]
+	putDeployment    *connect.Client[proto.PutDeploymentRequest, proto.Platform]
+	deleteDeployment *connect.Client[proto.DeleteDeploymentRequest, proto.Platform]
+}
+
+// CreatePlatform calls data.StationRegistry.CreatePlatform.
+func (c *stationRegistryClient) CreatePlatform(ctx context.Context, req *connect.Request[proto.CreatePlatformRequest]) (*connect.Response[proto.Platform], error) {
+	return c.createPlatform.CallUnary(ctx, req)
+}
+
+// GetPlatform calls data.StationRegistry.GetPlatform.
+func (c *stationRegistryClient) GetPlatform(ctx context.Context, req *connect.Request[proto.GetPlatformRequest]) (*connect.Response[proto.Platform], error) {
+	return c.getPlatform.CallUnary(ctx, req)
+}
+
+// ListPlatforms calls data.StationRegistry.ListPlatforms.
+func (c *stationRegistryClient) ListPlatforms(ctx context.Context, req *connect.Request[proto.ListPlatformsRequest]) (*connect.Response[proto.ListPlatformsResponse], error) {
+	return c.listPlatforms.CallUnary(ctx, req)
+}
+
+// UpdatePlatform calls data.StationRegistry.UpdatePlatform.
+func (c *stationRegistryClient) UpdatePlatform(ctx context.Context, req *connect.Request[proto.UpdatePlatformRequest]) (*connect.Response[proto.Platform], error) {
+	return c.updatePlatform.CallUnary(ctx, req)
+}
+
+// DeletePlatform calls data.StationRegistry.DeletePlatform.
+func (c *stationRegistryClient) DeletePlatform(ctx context.Context, req *connect.Request[proto.DeletePlatformRequest]) (*connect.Response[proto.DeletePlatformResponse], error) {
+	return c.deletePlatform.CallUnary(ctx, req)
+}
+
+// PutSensor calls data.StationRegistry.PutSensor.
+func (c *stationRegistryClient) PutSensor(ctx context.Context, req *connect.Request[proto.PutSensorRequest]) (*connect.Response[proto.Platform], error) {
+	return c.putSensor.CallUnary(ctx, req)
+}
+
+// DeleteSensor calls data.StationRegistry.DeleteSensor.
+func (c *stationRegistryClient) DeleteSensor(ctx context.Context, req *connect.Request[proto.DeleteSensorRequest]) (*connect.Response[proto.Platform], error) {
+	return c.deleteSensor.CallUnary(ctx, req)
+}
+
+// AddCalibration calls data.StationRegistry.AddCalibration.
+func (c *stationRegistryClient) AddCalibration(ctx context.Context, req *connect.Request[proto.AddCalibrationRequest]) (*connect.Response[proto.Platform], error) {
+	return c.addCalibration.CallUnary(ctx, req)
+}
+
+// PutDeployment calls data.StationRegistry.PutDeployment.
+func (c *stationRegistryClient) PutDeployment(ctx context.Context, req *connect.Request[proto.PutDeploymentRequest]) (*connect.Response[proto.Platform], error) {
+	return c.putDeployment.CallUnary(ctx, req)
+}
+
+// DeleteDeployment calls data.StationRegistry.DeleteDeployment.
+func (c *stationRegistryClient) DeleteDeployment(ctx context.Context, req *connect.Request[proto.DeleteDeploymentRequest]) (*connect.Response[proto.Platform], error) {
+	return c.deleteDeployment.CallUnary(ctx, req)
+}
+
+// StationRegistryHandler is an implementation of the data.StationRegistry service.
+type StationRegistryHandler interface {
+	CreatePlatform(context.Context, *connect.Request[proto.CreatePlatformRequest]) (*connect.Response[proto.Platform], error)
+	GetPlatform(context.Context, *connect.Request[proto.GetPlatformRequest]) (*connect.Response[proto.Platform], error)
+	ListPlatforms(context.Context, *connect.Request[proto.ListPlatformsRequest]) (*connect.Response[proto.ListPlatformsResponse], error)
+	UpdatePlatform(context.Context, *connect.Request[proto.UpdatePlatformRequest]) (*connect.Response[proto.Platform], error)
+	DeletePlatform(context.Context, *connect.Request[proto.DeletePlatformRequest]) (*connect.Response[proto.DeletePlatformResponse], error)
+	PutSensor(context.Context, *connect.Request[proto.PutSensorRequest]) (*connect.Response[proto.Platform], error)
+	DeleteSensor(context.Context, *connect.Request[proto.DeleteSensorRequest]) (*connect.Response[proto.Platform], error)
+	AddCalibration(context.Context, *connect.Request[proto.AddCalibrationRequest]) (*connect.Response[proto.Platform], error)
+	PutDeployment(context.Context, *connect.Request[proto.PutDeploymentRequest]) (*connect.Response[proto.Platform], error)
+	DeleteDeployment(context.Context, *connect.Request[proto.DeleteDeploymentRequest]) (*connect.Response[proto.Platform], error)
+}
+
+// NewStationRegistryHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStationRegistryHandler(svc StationRegistryHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	stationRegistryMethods := proto.File_proto_data_proto.Services().ByName("StationRegistry").Methods()
+	stationRegistryCreatePlatformHandler := connect.NewUnaryHandler(
+		StationRegistryCreatePlatformProcedure,
+		svc.CreatePlatform,
+		connect.WithSchema(stationRegistryMethods.ByName("CreatePlatform")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stationRegistryGetPlatformHandler := connect.NewUnaryHandler(
+		StationRegistryGetPlatformProcedure,
+		svc.GetPlatform,
+		connect.WithSchema(stationRegistryMethods.ByName("GetPlatform")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stationRegistryListPlatformsHandler := connect.NewUnaryHandler(
+		StationRegistryListPlatformsProcedure,
+		svc.ListPlatforms,
+		connect.WithSchema(stationRegistryMethods.ByName("ListPlatforms")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stationRegistryUpdatePlatformHandler := connect.NewUnaryHandler(
+		StationRegistryUpdatePlatformProcedure,
+		svc.UpdatePlatform,
+		connect.WithSchema(stationRegistryMethods.ByName("UpdatePlatform")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stationRegistryDeletePlatformHandler := connect.NewUnaryHandler(
+		StationRegistryDeletePlatformProcedure,
+		svc.DeletePlatform,
+		connect.WithSchema(stationRegistryMethods.ByName("DeletePlatform")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stationRegistryPutSensorHandler := connect.NewUnaryHandler(
+		StationRegistryPutSensorProcedure,
+		svc.PutSensor,
+		connect.WithSchema(stationRegistryMethods.ByName("PutSensor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stationRegistryDeleteSensorHandler := connect.NewUnaryHandler(
+		StationRegistryDeleteSensorProcedure,
+		svc.DeleteSensor,
+		connect.WithSchema(stationRegistryMethods.ByName("DeleteSensor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stationRegistryAddCalibrationHandler := connect.NewUnaryHandler(
+		StationRegistryAddCalibrationProcedure,
+		svc.AddCalibration,
+		connect.WithSchema(stationRegistryMethods.ByName("AddCalibration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stationRegistryPutDeploymentHandler := connect.NewUnaryHandler(
+		StationRegistryPutDeploymentProcedure,
+		svc.PutDeployment,
+		connect.WithSchema(stationRegistryMethods.ByName("PutDeployment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stationRegistryDeleteDeploymentHandler := connect.NewUnaryHandler(
+		StationRegistryDeleteDeploymentProcedure,
+		svc.DeleteDeployment,
+		connect.WithSchema(stationRegistryMethods.ByName("DeleteDeployment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/data.StationRegistry/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StationRegistryCreatePlatformProcedure:
+			stationRegistryCreatePlatformHandler.ServeHTTP(w, r)
+		case StationRegistryGetPlatformProcedure:
+			stationRegistryGetPlatformHandler.ServeHTTP(w, r)
+		case StationRegistryListPlatformsProcedure:
+			stationRegistryListPlatformsHandler.ServeHTTP(w, r)
+		case StationRegistryUpdatePlatformProcedure:
+			stationRegistryUpdatePlatformHandler.ServeHTTP(w, r)
+		case StationRegistryDeletePlatformProcedure:
+			stationRegistryDeletePlatformHandler.ServeHTTP(w, r)
+		case StationRegistryPutSensorProcedure:
+			stationRegistryPutSensorHandler.ServeHTTP(w, r)
+		case StationRegistryDeleteSensorProcedure:
+			stationRegistryDeleteSensorHandler.ServeHTTP(w, r)
+		case StationRegistryAddCalibrationProcedure:
+			stationRegistryAddCalibrationHandler.ServeHTTP(w, r)
+		case StationRegistryPutDeploymentProcedure:
+			stationRegistryPutDeploymentHandler.ServeHTTP(w, r)
+		case StationRegistryDeleteDeploymentProcedure:
+			stationRegistryDeleteDeploymentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStationRegistryHandler returns CodeUnimplemented from all methods.
+type UnimplementedStationRegistryHandler struct{}
+
+func (UnimplementedStationRegistryHandler) CreatePlatform(context.Context, *connect.Request[proto.CreatePlatformRequest]) (*connect.Response[proto.Platform], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.StationRegistry.CreatePlatform is not implemented"))
+}
+
+func (UnimplementedStationRegistryHandler) GetPlatform(context.Context, *connect.Request[proto.GetPlatformRequest]) (*connect.Response[proto.Platform], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.StationRegistry.GetPlatform is not implemented"))
+}
+
+func (UnimplementedStationRegistryHandler) ListPlatforms(context.Context, *connect.Request[proto.ListPlatformsRequest]) (*connect.Response[proto.ListPlatformsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.StationRegistry.ListPlatforms is not implemented"))
+}
+
+func (UnimplementedStationRegistryHandler) UpdatePlatform(context.Context, *connect.Request[proto.UpdatePlatformRequest]) (*connect.Response[proto.Platform], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.StationRegistry.UpdatePlatform is not implemented"))
+}
+
+func (UnimplementedStationRegistryHandler) DeletePlatform(context.Context, *connect.Request[proto.DeletePlatformRequest]) (*connect.Response[proto.DeletePlatformResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.StationRegistry.DeletePlatform is not implemented"))
+}
+
+func (UnimplementedStationRegistryHandler) PutSensor(context.Context, *connect.Request[proto.PutSensorRequest]) (*connect.Response[proto.Platform], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.StationRegistry.PutSensor is not implemented"))
+}
+
+func (UnimplementedStationRegistryHandler) DeleteSensor(context.Context, *connect.Request[proto.DeleteSensorRequest]) (*connect.Response[proto.Platform], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.StationRegistry.DeleteSensor is not implemented"))
+}
+
+func (UnimplementedStationRegistryHandler) AddCalibration(context.Context, *connect.Request[proto.AddCalibrationRequest]) (*connect.Response[proto.Platform], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.StationRegistry.AddCalibration is not implemented"))
+}
+
+func (UnimplementedStationRegistryHandler) PutDeployment(context.Context, *connect.Request[proto.PutDeploymentRequest]) (*connect.Response[proto.Platform], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.StationRegistry.PutDeployment is not implemented"))
+}
+
+func (UnimplementedStationRegistryHandler) DeleteDeployment(context.Context, *connect.Request[proto.DeleteDeploymentRequest]) (*connect.Response[proto.Platform], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.StationRegistry.DeleteDeployment is not implemented"))
+}
+
+// AlertingClient is a client for the data.Alerting service.
+type AlertingClient interface {
+	CreateAlertRule(context.Context, *connect.Request[proto.CreateAlertRuleRequest]) (*connect.Response[proto.AlertRule], error)
+	ListAlertRules(context.Context, *connect.Request[proto.ListAlertRulesRequest]) (*connect.Response[proto.ListAlertRulesResponse], error)
+	DeleteAlertRule(context.Context, *connect.Request[proto.DeleteAlertRuleRequest]) (*connect.Response[proto.DeleteAlertRuleResponse], error)
+	WatchAlerts(context.Context, *connect.Request[proto.WatchAlertsRequest]) (*connect.ServerStreamForClient[proto.AlertEvent], error)
+}
+
+// NewAlertingClient constructs a client for the data.Alerting service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAlertingClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AlertingClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	alertingMethods := proto.File_proto_data_proto.Services().ByName("Alerting").Methods()
+	return &alertingClient{
+		createAlertRule: connect.NewClient[proto.CreateAlertRuleRequest, proto.AlertRule](
+			httpClient,
+			baseURL+AlertingCreateAlertRuleProcedure,
+			connect.WithSchema(alertingMethods.ByName("CreateAlertRule")),
+			connect.WithClientOptions(opts...),
+		),
+		listAlertRules: connect.NewClient[proto.ListAlertRulesRequest, proto.ListAlertRulesResponse](
+			httpClient,
+			baseURL+AlertingListAlertRulesProcedure,
+			connect.WithSchema(alertingMethods.ByName("ListAlertRules")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAlertRule: connect.NewClient[proto.DeleteAlertRuleRequest, proto.DeleteAlertRuleResponse](
+			httpClient,
+			baseURL+AlertingDeleteAlertRuleProcedure,
+			connect.WithSchema(alertingMethods.ByName("DeleteAlertRule")),
+			connect.WithClientOptions(opts...),
+		),
+		watchAlerts: connect.NewClient[proto.WatchAlertsRequest, proto.AlertEvent](
+			httpClient,
+			baseURL+AlertingWatchAlertsProcedure,
+			connect.WithSchema(alertingMethods.ByName("WatchAlerts")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// alertingClient implements AlertingClient.
+type alertingClient struct {
+	createAlertRule *connect.Client[proto.CreateAlertRuleRequest, proto.AlertRule]
+	listAlertRules  *connect.Client[proto.ListAlertRulesRequest, proto.ListAlertRulesResponse]
+	deleteAlertRule *connect.Client[proto.DeleteAlertRuleRequest, proto.DeleteAlertRuleResponse]
+	watchAlerts     *connect.Client[proto.WatchAlertsRequest, proto.AlertEvent]
+}
+
+// CreateAlertRule calls data.Alerting.CreateAlertRule.
+func (c *alertingClient) CreateAlertRule(ctx context.Context, req *connect.Request[proto.CreateAlertRuleRequest]) (*connect.Response[proto.AlertRule], error) {
+	return c.createAlertRule.CallUnary(ctx, req)
+}
+
+// ListAlertRules calls data.Alerting.ListAlertRules.
+func (c *alertingClient) ListAlertRules(ctx context.Context, req *connect.Request[proto.ListAlertRulesRequest]) (*connect.Response[proto.ListAlertRulesResponse], error) {
+	return c.listAlertRules.CallUnary(ctx, req)
+}
+
+// DeleteAlertRule calls data.Alerting.DeleteAlertRule.
+func (c *alertingClient) DeleteAlertRule(ctx context.Context, req *connect.Request[proto.DeleteAlertRuleRequest]) (*connect.Response[proto.DeleteAlertRuleResponse], error) {
+	return c.deleteAlertRule.CallUnary(ctx, req)
+}
+
+// WatchAlerts calls data.Alerting.WatchAlerts.
+func (c *alertingClient) WatchAlerts(ctx context.Context, req *connect.Request[proto.WatchAlertsRequest]) (*connect.ServerStreamForClient[proto.AlertEvent], error) {
+	return c.watchAlerts.CallServerStream(ctx, req)
+}
+
+// AlertingHandler is an implementation of the data.Alerting service.
+type AlertingHandler interface {
+	CreateAlertRule(context.Context, *connect.Request[proto.CreateAlertRuleRequest]) (*connect.Response[proto.AlertRule], error)
+	ListAlertRules(context.Context, *connect.Request[proto.ListAlertRulesRequest]) (*connect.Response[proto.ListAlertRulesResponse], error)
+	DeleteAlertRule(context.Context, *connect.Request[proto.DeleteAlertRuleRequest]) (*connect.Response[proto.DeleteAlertRuleResponse], error)
+	WatchAlerts(context.Context, *connect.Request[proto.WatchAlertsRequest], *connect.ServerStream[proto.AlertEvent]) error
+}
+
+// NewAlertingHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAlertingHandler(svc AlertingHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	alertingMethods := proto.File_proto_data_proto.Services().ByName("Alerting").Methods()
+	alertingCreateAlertRuleHandler := connect.NewUnaryHandler(
+		AlertingCreateAlertRuleProcedure,
+		svc.CreateAlertRule,
+		connect.WithSchema(alertingMethods.ByName("CreateAlertRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	alertingListAlertRulesHandler := connect.NewUnaryHandler(
+		AlertingListAlertRulesProcedure,
+		svc.ListAlertRules,
+		connect.WithSchema(alertingMethods.ByName("ListAlertRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	alertingDeleteAlertRuleHandler := connect.NewUnaryHandler(
+		AlertingDeleteAlertRuleProcedure,
+		svc.DeleteAlertRule,
+		connect.WithSchema(alertingMethods.ByName("DeleteAlertRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	alertingWatchAlertsHandler := connect.NewServerStreamHandler(
+		AlertingWatchAlertsProcedure,
+		svc.WatchAlerts,
+		connect.WithSchema(alertingMethods.ByName("WatchAlerts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/data.Alerting/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AlertingCreateAlertRuleProcedure:
+			alertingCreateAlertRuleHandler.ServeHTTP(w, r)
+		case AlertingListAlertRulesProcedure:
+			alertingListAlertRulesHandler.ServeHTTP(w, r)
+		case AlertingDeleteAlertRuleProcedure:
+			alertingDeleteAlertRuleHandler.ServeHTTP(w, r)
+		case AlertingWatchAlertsProcedure:
+			alertingWatchAlertsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAlertingHandler returns CodeUnimplemented from all methods.
+type UnimplementedAlertingHandler struct{}
+
+func (UnimplementedAlertingHandler) CreateAlertRule(context.Context, *connect.Request[proto.CreateAlertRuleRequest]) (*connect.Response[proto.AlertRule], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.Alerting.CreateAlertRule is not implemented"))
+}
+
+func (UnimplementedAlertingHandler) ListAlertRules(context.Context, *connect.Request[proto.ListAlertRulesRequest]) (*connect.Response[proto.ListAlertRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.Alerting.ListAlertRules is not implemented"))
+}
+
+func (UnimplementedAlertingHandler) DeleteAlertRule(context.Context, *connect.Request[proto.DeleteAlertRuleRequest]) (*connect.Response[proto.DeleteAlertRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data.Alerting.DeleteAlertRule is not implemented"))
+}
+
+func (UnimplementedAlertingHandler) WatchAlerts(context.Context, *connect.Request[proto.WatchAlertsRequest], *connect.ServerStream[proto.AlertEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("data.Alerting.WatchAlerts is not implemented"))
+}
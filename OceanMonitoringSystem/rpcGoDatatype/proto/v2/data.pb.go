@@ -0,0 +1,2321 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: proto/v2/data.proto
+
+package datav2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConvertRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*ConvertRequest_Data
+	//	*ConvertRequest_Url
+	//	*ConvertRequest_Path
+	Source         isConvertRequest_Source `protobuf_oneof:"source"`
+	Options        *ConvertOptions         `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
+	OutputUrl      string                  `protobuf:"bytes,7,opt,name=output_url,json=outputUrl,proto3" json:"output_url,omitempty"`
+	IdempotencyKey string                  `protobuf:"bytes,8,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConvertRequest) Reset() {
+	*x = ConvertRequest{}
+	mi := &file_proto_v2_data_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertRequest) ProtoMessage() {}
+
+func (x *ConvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertRequest.ProtoReflect.Descriptor instead.
+func (*ConvertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConvertRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetSource() isConvertRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *ConvertRequest) GetData() []byte {
+	if x != nil {
+		if x, ok := x.Source.(*ConvertRequest_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+func (x *ConvertRequest) GetUrl() string {
+	if x != nil {
+		if x, ok := x.Source.(*ConvertRequest_Url); ok {
+			return x.Url
+		}
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetPath() string {
+	if x != nil {
+		if x, ok := x.Source.(*ConvertRequest_Path); ok {
+			return x.Path
+		}
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ConvertRequest) GetOutputUrl() string {
+	if x != nil {
+		return x.OutputUrl
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type isConvertRequest_Source interface {
+	isConvertRequest_Source()
+}
+
+type ConvertRequest_Data struct {
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3,oneof"`
+}
+
+type ConvertRequest_Url struct {
+	Url string `protobuf:"bytes,4,opt,name=url,proto3,oneof"`
+}
+
+type ConvertRequest_Path struct {
+	Path string `protobuf:"bytes,5,opt,name=path,proto3,oneof"`
+}
+
+func (*ConvertRequest_Data) isConvertRequest_Source() {}
+
+func (*ConvertRequest_Url) isConvertRequest_Source() {}
+
+func (*ConvertRequest_Path) isConvertRequest_Source() {}
+
+type ConvertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        []byte                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	OutputUrl     string                 `protobuf:"bytes,3,opt,name=output_url,json=outputUrl,proto3" json:"output_url,omitempty"`
+	Metadata      *ConversionMetadata    `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertResponse) Reset() {
+	*x = ConvertResponse{}
+	mi := &file_proto_v2_data_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertResponse) ProtoMessage() {}
+
+func (x *ConvertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertResponse.ProtoReflect.Descriptor instead.
+func (*ConvertResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConvertResponse) GetResult() []byte {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ConvertResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ConvertResponse) GetOutputUrl() string {
+	if x != nil {
+		return x.OutputUrl
+	}
+	return ""
+}
+
+func (x *ConvertResponse) GetMetadata() *ConversionMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ConversionMetadata struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Source            map[string]string      `protobuf:"bytes,1,rep,name=source,proto3" json:"source,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Preamble          []string               `protobuf:"bytes,2,rep,name=preamble,proto3" json:"preamble,omitempty"`
+	Warnings          []string               `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	RowErrors         []*RowError            `protobuf:"bytes,4,rep,name=row_errors,json=rowErrors,proto3" json:"row_errors,omitempty"`
+	DuplicatesRemoved int64                  `protobuf:"varint,5,opt,name=duplicates_removed,json=duplicatesRemoved,proto3" json:"duplicates_removed,omitempty"`
+	Stats             *Stats                 `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+	CacheHit          bool                   `protobuf:"varint,7,opt,name=cache_hit,json=cacheHit,proto3" json:"cache_hit,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConversionMetadata) Reset() {
+	*x = ConversionMetadata{}
+	mi := &file_proto_v2_data_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversionMetadata) ProtoMessage() {}
+
+func (x *ConversionMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversionMetadata.ProtoReflect.Descriptor instead.
+func (*ConversionMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConversionMetadata) GetSource() map[string]string {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *ConversionMetadata) GetPreamble() []string {
+	if x != nil {
+		return x.Preamble
+	}
+	return nil
+}
+
+func (x *ConversionMetadata) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ConversionMetadata) GetRowErrors() []*RowError {
+	if x != nil {
+		return x.RowErrors
+	}
+	return nil
+}
+
+func (x *ConversionMetadata) GetDuplicatesRemoved() int64 {
+	if x != nil {
+		return x.DuplicatesRemoved
+	}
+	return 0
+}
+
+func (x *ConversionMetadata) GetStats() *Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *ConversionMetadata) GetCacheHit() bool {
+	if x != nil {
+		return x.CacheHit
+	}
+	return false
+}
+
+type Stats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RowsRead      int64                  `protobuf:"varint,1,opt,name=rows_read,json=rowsRead,proto3" json:"rows_read,omitempty"`
+	RowsSkipped   int64                  `protobuf:"varint,2,opt,name=rows_skipped,json=rowsSkipped,proto3" json:"rows_skipped,omitempty"`
+	RowsWritten   int64                  `protobuf:"varint,3,opt,name=rows_written,json=rowsWritten,proto3" json:"rows_written,omitempty"`
+	Columns       []string               `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	ColumnTypes   map[string]string      `protobuf:"bytes,5,rep,name=column_types,json=columnTypes,proto3" json:"column_types,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DurationMs    float64                `protobuf:"fixed64,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stats) Reset() {
+	*x = Stats{}
+	mi := &file_proto_v2_data_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats) ProtoMessage() {}
+
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Stats) GetRowsRead() int64 {
+	if x != nil {
+		return x.RowsRead
+	}
+	return 0
+}
+
+func (x *Stats) GetRowsSkipped() int64 {
+	if x != nil {
+		return x.RowsSkipped
+	}
+	return 0
+}
+
+func (x *Stats) GetRowsWritten() int64 {
+	if x != nil {
+		return x.RowsWritten
+	}
+	return 0
+}
+
+func (x *Stats) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *Stats) GetColumnTypes() map[string]string {
+	if x != nil {
+		return x.ColumnTypes
+	}
+	return nil
+}
+
+func (x *Stats) GetDurationMs() float64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type RowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int64                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column        string                 `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowError) Reset() {
+	*x = RowError{}
+	mi := &file_proto_v2_data_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowError) ProtoMessage() {}
+
+func (x *RowError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowError.ProtoReflect.Descriptor instead.
+func (*RowError) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RowError) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *RowError) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *RowError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type StreamHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Options       *ConvertOptions        `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamHeader) Reset() {
+	*x = StreamHeader{}
+	mi := &file_proto_v2_data_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamHeader) ProtoMessage() {}
+
+func (x *StreamHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamHeader.ProtoReflect.Descriptor instead.
+func (*StreamHeader) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamHeader) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *StreamHeader) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *StreamHeader) GetOptions() *ConvertOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ConvertStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*ConvertStreamRequest_Header
+	//	*ConvertStreamRequest_Chunk
+	Message       isConvertStreamRequest_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertStreamRequest) Reset() {
+	*x = ConvertStreamRequest{}
+	mi := &file_proto_v2_data_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertStreamRequest) ProtoMessage() {}
+
+func (x *ConvertStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertStreamRequest.ProtoReflect.Descriptor instead.
+func (*ConvertStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConvertStreamRequest) GetMessage() isConvertStreamRequest_Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ConvertStreamRequest) GetHeader() *StreamHeader {
+	if x != nil {
+		if x, ok := x.Message.(*ConvertStreamRequest_Header); ok {
+			return x.Header
+		}
+	}
+	return nil
+}
+
+func (x *ConvertStreamRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Message.(*ConvertStreamRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isConvertStreamRequest_Message interface {
+	isConvertStreamRequest_Message()
+}
+
+type ConvertStreamRequest_Header struct {
+	Header *StreamHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type ConvertStreamRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ConvertStreamRequest_Header) isConvertStreamRequest_Message() {}
+
+func (*ConvertStreamRequest_Chunk) isConvertStreamRequest_Message() {}
+
+type ConvertStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*ConvertStreamResponse_Chunk
+	//	*ConvertStreamResponse_Metadata
+	Message       isConvertStreamResponse_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertStreamResponse) Reset() {
+	*x = ConvertStreamResponse{}
+	mi := &file_proto_v2_data_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertStreamResponse) ProtoMessage() {}
+
+func (x *ConvertStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertStreamResponse.ProtoReflect.Descriptor instead.
+func (*ConvertStreamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConvertStreamResponse) GetMessage() isConvertStreamResponse_Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ConvertStreamResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Message.(*ConvertStreamResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+func (x *ConvertStreamResponse) GetMetadata() *ConversionMetadata {
+	if x != nil {
+		if x, ok := x.Message.(*ConvertStreamResponse_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+type isConvertStreamResponse_Message interface {
+	isConvertStreamResponse_Message()
+}
+
+type ConvertStreamResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3,oneof"`
+}
+
+type ConvertStreamResponse_Metadata struct {
+	Metadata *ConversionMetadata `protobuf:"bytes,2,opt,name=metadata,proto3,oneof"`
+}
+
+func (*ConvertStreamResponse_Chunk) isConvertStreamResponse_Message() {}
+
+func (*ConvertStreamResponse_Metadata) isConvertStreamResponse_Message() {}
+
+type StreamRecordsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*StreamRecordsRequest_Header
+	//	*StreamRecordsRequest_Chunk
+	Message       isStreamRecordsRequest_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamRecordsRequest) Reset() {
+	*x = StreamRecordsRequest{}
+	mi := &file_proto_v2_data_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRecordsRequest) ProtoMessage() {}
+
+func (x *StreamRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRecordsRequest.ProtoReflect.Descriptor instead.
+func (*StreamRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StreamRecordsRequest) GetMessage() isStreamRecordsRequest_Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *StreamRecordsRequest) GetHeader() *StreamHeader {
+	if x != nil {
+		if x, ok := x.Message.(*StreamRecordsRequest_Header); ok {
+			return x.Header
+		}
+	}
+	return nil
+}
+
+func (x *StreamRecordsRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Message.(*StreamRecordsRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isStreamRecordsRequest_Message interface {
+	isStreamRecordsRequest_Message()
+}
+
+type StreamRecordsRequest_Header struct {
+	Header *StreamHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type StreamRecordsRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*StreamRecordsRequest_Header) isStreamRecordsRequest_Message() {}
+
+func (*StreamRecordsRequest_Chunk) isStreamRecordsRequest_Message() {}
+
+type Record struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int64                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Json          []byte                 `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_proto_v2_data_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Record) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *Record) GetJson() []byte {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+type StreamRecordsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*StreamRecordsResponse_Record
+	//	*StreamRecordsResponse_Metadata
+	Message       isStreamRecordsResponse_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamRecordsResponse) Reset() {
+	*x = StreamRecordsResponse{}
+	mi := &file_proto_v2_data_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRecordsResponse) ProtoMessage() {}
+
+func (x *StreamRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRecordsResponse.ProtoReflect.Descriptor instead.
+func (*StreamRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StreamRecordsResponse) GetMessage() isStreamRecordsResponse_Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *StreamRecordsResponse) GetRecord() *Record {
+	if x != nil {
+		if x, ok := x.Message.(*StreamRecordsResponse_Record); ok {
+			return x.Record
+		}
+	}
+	return nil
+}
+
+func (x *StreamRecordsResponse) GetMetadata() *ConversionMetadata {
+	if x != nil {
+		if x, ok := x.Message.(*StreamRecordsResponse_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+type isStreamRecordsResponse_Message interface {
+	isStreamRecordsResponse_Message()
+}
+
+type StreamRecordsResponse_Record struct {
+	Record *Record `protobuf:"bytes,1,opt,name=record,proto3,oneof"`
+}
+
+type StreamRecordsResponse_Metadata struct {
+	Metadata *ConversionMetadata `protobuf:"bytes,2,opt,name=metadata,proto3,oneof"`
+}
+
+func (*StreamRecordsResponse_Record) isStreamRecordsResponse_Message() {}
+
+func (*StreamRecordsResponse_Metadata) isStreamRecordsResponse_Message() {}
+
+type ListFormatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFormatsRequest) Reset() {
+	*x = ListFormatsRequest{}
+	mi := &file_proto_v2_data_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFormatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFormatsRequest) ProtoMessage() {}
+
+func (x *ListFormatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFormatsRequest.ProtoReflect.Descriptor instead.
+func (*ListFormatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{11}
+}
+
+type ListFormatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Formats       []string               `protobuf:"bytes,1,rep,name=formats,proto3" json:"formats,omitempty"`
+	Conversions   []*FormatPair          `protobuf:"bytes,2,rep,name=conversions,proto3" json:"conversions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFormatsResponse) Reset() {
+	*x = ListFormatsResponse{}
+	mi := &file_proto_v2_data_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFormatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFormatsResponse) ProtoMessage() {}
+
+func (x *ListFormatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFormatsResponse.ProtoReflect.Descriptor instead.
+func (*ListFormatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListFormatsResponse) GetFormats() []string {
+	if x != nil {
+		return x.Formats
+	}
+	return nil
+}
+
+func (x *ListFormatsResponse) GetConversions() []*FormatPair {
+	if x != nil {
+		return x.Conversions
+	}
+	return nil
+}
+
+type FormatPair struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Level         string                 `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormatPair) Reset() {
+	*x = FormatPair{}
+	mi := &file_proto_v2_data_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormatPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatPair) ProtoMessage() {}
+
+func (x *FormatPair) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatPair.ProtoReflect.Descriptor instead.
+func (*FormatPair) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FormatPair) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *FormatPair) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *FormatPair) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *FormatPair) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ConvertOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Csv           *CsvOptions            `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+	Types         *TypeOptions           `protobuf:"bytes,2,opt,name=types,proto3" json:"types,omitempty"`
+	Timestamps    *TimestampOptions      `protobuf:"bytes,3,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
+	Transform     *TransformOptions      `protobuf:"bytes,4,opt,name=transform,proto3" json:"transform,omitempty"`
+	Validation    *ValidationOptions     `protobuf:"bytes,5,opt,name=validation,proto3" json:"validation,omitempty"`
+	Template      *TemplateOptions       `protobuf:"bytes,6,opt,name=template,proto3" json:"template,omitempty"`
+	Influx        *InfluxOptions         `protobuf:"bytes,7,opt,name=influx,proto3" json:"influx,omitempty"`
+	Postgres      *PostgresOptions       `protobuf:"bytes,8,opt,name=postgres,proto3" json:"postgres,omitempty"`
+	Json          *JsonOptions           `protobuf:"bytes,9,opt,name=json,proto3" json:"json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertOptions) Reset() {
+	*x = ConvertOptions{}
+	mi := &file_proto_v2_data_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertOptions) ProtoMessage() {}
+
+func (x *ConvertOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertOptions.ProtoReflect.Descriptor instead.
+func (*ConvertOptions) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ConvertOptions) GetCsv() *CsvOptions {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetTypes() *TypeOptions {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetTimestamps() *TimestampOptions {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetTransform() *TransformOptions {
+	if x != nil {
+		return x.Transform
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetValidation() *ValidationOptions {
+	if x != nil {
+		return x.Validation
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetTemplate() *TemplateOptions {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetInflux() *InfluxOptions {
+	if x != nil {
+		return x.Influx
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetPostgres() *PostgresOptions {
+	if x != nil {
+		return x.Postgres
+	}
+	return nil
+}
+
+func (x *ConvertOptions) GetJson() *JsonOptions {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+type CsvOptions struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	NoHeader         bool                   `protobuf:"varint,1,opt,name=no_header,json=noHeader,proto3" json:"no_header,omitempty"`
+	Headers          []string               `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+	OmitHeader       bool                   `protobuf:"varint,3,opt,name=omit_header,json=omitHeader,proto3" json:"omit_header,omitempty"`
+	DuplicateHeaders string                 `protobuf:"bytes,4,opt,name=duplicate_headers,json=duplicateHeaders,proto3" json:"duplicate_headers,omitempty"`
+	JaggedRows       string                 `protobuf:"bytes,5,opt,name=jagged_rows,json=jaggedRows,proto3" json:"jagged_rows,omitempty"`
+	SkipLines        int32                  `protobuf:"varint,6,opt,name=skip_lines,json=skipLines,proto3" json:"skip_lines,omitempty"`
+	CommentPrefix    string                 `protobuf:"bytes,7,opt,name=comment_prefix,json=commentPrefix,proto3" json:"comment_prefix,omitempty"`
+	CaptureMetadata  bool                   `protobuf:"varint,8,opt,name=capture_metadata,json=captureMetadata,proto3" json:"capture_metadata,omitempty"`
+	InputEncoding    string                 `protobuf:"bytes,9,opt,name=input_encoding,json=inputEncoding,proto3" json:"input_encoding,omitempty"`
+	OutputEncoding   string                 `protobuf:"bytes,10,opt,name=output_encoding,json=outputEncoding,proto3" json:"output_encoding,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CsvOptions) Reset() {
+	*x = CsvOptions{}
+	mi := &file_proto_v2_data_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsvOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsvOptions) ProtoMessage() {}
+
+func (x *CsvOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsvOptions.ProtoReflect.Descriptor instead.
+func (*CsvOptions) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CsvOptions) GetNoHeader() bool {
+	if x != nil {
+		return x.NoHeader
+	}
+	return false
+}
+
+func (x *CsvOptions) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *CsvOptions) GetOmitHeader() bool {
+	if x != nil {
+		return x.OmitHeader
+	}
+	return false
+}
+
+func (x *CsvOptions) GetDuplicateHeaders() string {
+	if x != nil {
+		return x.DuplicateHeaders
+	}
+	return ""
+}
+
+func (x *CsvOptions) GetJaggedRows() string {
+	if x != nil {
+		return x.JaggedRows
+	}
+	return ""
+}
+
+func (x *CsvOptions) GetSkipLines() int32 {
+	if x != nil {
+		return x.SkipLines
+	}
+	return 0
+}
+
+func (x *CsvOptions) GetCommentPrefix() string {
+	if x != nil {
+		return x.CommentPrefix
+	}
+	return ""
+}
+
+func (x *CsvOptions) GetCaptureMetadata() bool {
+	if x != nil {
+		return x.CaptureMetadata
+	}
+	return false
+}
+
+func (x *CsvOptions) GetInputEncoding() string {
+	if x != nil {
+		return x.InputEncoding
+	}
+	return ""
+}
+
+func (x *CsvOptions) GetOutputEncoding() string {
+	if x != nil {
+		return x.OutputEncoding
+	}
+	return ""
+}
+
+type TypeOptions struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DisableInference bool                   `protobuf:"varint,1,opt,name=disable_inference,json=disableInference,proto3" json:"disable_inference,omitempty"`
+	StringColumns    []string               `protobuf:"bytes,2,rep,name=string_columns,json=stringColumns,proto3" json:"string_columns,omitempty"`
+	ColumnTypes      map[string]string      `protobuf:"bytes,3,rep,name=column_types,json=columnTypes,proto3" json:"column_types,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StrictNumbers    bool                   `protobuf:"varint,4,opt,name=strict_numbers,json=strictNumbers,proto3" json:"strict_numbers,omitempty"`
+	NonFiniteAs      string                 `protobuf:"bytes,5,opt,name=non_finite_as,json=nonFiniteAs,proto3" json:"non_finite_as,omitempty"`
+	InferBooleans    bool                   `protobuf:"varint,6,opt,name=infer_booleans,json=inferBooleans,proto3" json:"infer_booleans,omitempty"`
+	NullValues       []string               `protobuf:"bytes,7,rep,name=null_values,json=nullValues,proto3" json:"null_values,omitempty"`
+	NullOutput       string                 `protobuf:"bytes,8,opt,name=null_output,json=nullOutput,proto3" json:"null_output,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TypeOptions) Reset() {
+	*x = TypeOptions{}
+	mi := &file_proto_v2_data_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypeOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeOptions) ProtoMessage() {}
+
+func (x *TypeOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeOptions.ProtoReflect.Descriptor instead.
+func (*TypeOptions) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TypeOptions) GetDisableInference() bool {
+	if x != nil {
+		return x.DisableInference
+	}
+	return false
+}
+
+func (x *TypeOptions) GetStringColumns() []string {
+	if x != nil {
+		return x.StringColumns
+	}
+	return nil
+}
+
+func (x *TypeOptions) GetColumnTypes() map[string]string {
+	if x != nil {
+		return x.ColumnTypes
+	}
+	return nil
+}
+
+func (x *TypeOptions) GetStrictNumbers() bool {
+	if x != nil {
+		return x.StrictNumbers
+	}
+	return false
+}
+
+func (x *TypeOptions) GetNonFiniteAs() string {
+	if x != nil {
+		return x.NonFiniteAs
+	}
+	return ""
+}
+
+func (x *TypeOptions) GetInferBooleans() bool {
+	if x != nil {
+		return x.InferBooleans
+	}
+	return false
+}
+
+func (x *TypeOptions) GetNullValues() []string {
+	if x != nil {
+		return x.NullValues
+	}
+	return nil
+}
+
+func (x *TypeOptions) GetNullOutput() string {
+	if x != nil {
+		return x.NullOutput
+	}
+	return ""
+}
+
+type TimestampOptions struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Detect         bool                   `protobuf:"varint,1,opt,name=detect,proto3" json:"detect,omitempty"`
+	Normalize      bool                   `protobuf:"varint,2,opt,name=normalize,proto3" json:"normalize,omitempty"`
+	YearPivot      int32                  `protobuf:"varint,3,opt,name=year_pivot,json=yearPivot,proto3" json:"year_pivot,omitempty"`
+	SourceTimezone string                 `protobuf:"bytes,4,opt,name=source_timezone,json=sourceTimezone,proto3" json:"source_timezone,omitempty"`
+	TargetTimezone string                 `protobuf:"bytes,5,opt,name=target_timezone,json=targetTimezone,proto3" json:"target_timezone,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TimestampOptions) Reset() {
+	*x = TimestampOptions{}
+	mi := &file_proto_v2_data_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimestampOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimestampOptions) ProtoMessage() {}
+
+func (x *TimestampOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimestampOptions.ProtoReflect.Descriptor instead.
+func (*TimestampOptions) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TimestampOptions) GetDetect() bool {
+	if x != nil {
+		return x.Detect
+	}
+	return false
+}
+
+func (x *TimestampOptions) GetNormalize() bool {
+	if x != nil {
+		return x.Normalize
+	}
+	return false
+}
+
+func (x *TimestampOptions) GetYearPivot() int32 {
+	if x != nil {
+		return x.YearPivot
+	}
+	return 0
+}
+
+func (x *TimestampOptions) GetSourceTimezone() string {
+	if x != nil {
+		return x.SourceTimezone
+	}
+	return ""
+}
+
+func (x *TimestampOptions) GetTargetTimezone() string {
+	if x != nil {
+		return x.TargetTimezone
+	}
+	return ""
+}
+
+type TransformOptions struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Columns            []string               `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	Rename             map[string]string      `protobuf:"bytes,2,rep,name=rename,proto3" json:"rename,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ComputedColumns    []string               `protobuf:"bytes,3,rep,name=computed_columns,json=computedColumns,proto3" json:"computed_columns,omitempty"`
+	Filter             string                 `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortBy             []string               `protobuf:"bytes,5,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	Deduplicate        bool                   `protobuf:"varint,6,opt,name=deduplicate,proto3" json:"deduplicate,omitempty"`
+	DedupKeys          []string               `protobuf:"bytes,7,rep,name=dedup_keys,json=dedupKeys,proto3" json:"dedup_keys,omitempty"`
+	Reshape            string                 `protobuf:"bytes,8,opt,name=reshape,proto3" json:"reshape,omitempty"`
+	ReshapeIdColumns   []string               `protobuf:"bytes,9,rep,name=reshape_id_columns,json=reshapeIdColumns,proto3" json:"reshape_id_columns,omitempty"`
+	ReshapeNameColumn  string                 `protobuf:"bytes,10,opt,name=reshape_name_column,json=reshapeNameColumn,proto3" json:"reshape_name_column,omitempty"`
+	ReshapeValueColumn string                 `protobuf:"bytes,11,opt,name=reshape_value_column,json=reshapeValueColumn,proto3" json:"reshape_value_column,omitempty"`
+	UnpivotColumns     []string               `protobuf:"bytes,12,rep,name=unpivot_columns,json=unpivotColumns,proto3" json:"unpivot_columns,omitempty"`
+	Units              []string               `protobuf:"bytes,13,rep,name=units,proto3" json:"units,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TransformOptions) Reset() {
+	*x = TransformOptions{}
+	mi := &file_proto_v2_data_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformOptions) ProtoMessage() {}
+
+func (x *TransformOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformOptions.ProtoReflect.Descriptor instead.
+func (*TransformOptions) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TransformOptions) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *TransformOptions) GetRename() map[string]string {
+	if x != nil {
+		return x.Rename
+	}
+	return nil
+}
+
+func (x *TransformOptions) GetComputedColumns() []string {
+	if x != nil {
+		return x.ComputedColumns
+	}
+	return nil
+}
+
+func (x *TransformOptions) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *TransformOptions) GetSortBy() []string {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *TransformOptions) GetDeduplicate() bool {
+	if x != nil {
+		return x.Deduplicate
+	}
+	return false
+}
+
+func (x *TransformOptions) GetDedupKeys() []string {
+	if x != nil {
+		return x.DedupKeys
+	}
+	return nil
+}
+
+func (x *TransformOptions) GetReshape() string {
+	if x != nil {
+		return x.Reshape
+	}
+	return ""
+}
+
+func (x *TransformOptions) GetReshapeIdColumns() []string {
+	if x != nil {
+		return x.ReshapeIdColumns
+	}
+	return nil
+}
+
+func (x *TransformOptions) GetReshapeNameColumn() string {
+	if x != nil {
+		return x.ReshapeNameColumn
+	}
+	return ""
+}
+
+func (x *TransformOptions) GetReshapeValueColumn() string {
+	if x != nil {
+		return x.ReshapeValueColumn
+	}
+	return ""
+}
+
+func (x *TransformOptions) GetUnpivotColumns() []string {
+	if x != nil {
+		return x.UnpivotColumns
+	}
+	return nil
+}
+
+func (x *TransformOptions) GetUnits() []string {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+type ValidationOptions struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Schema          string                 `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	SkipInvalidRows bool                   `protobuf:"varint,2,opt,name=skip_invalid_rows,json=skipInvalidRows,proto3" json:"skip_invalid_rows,omitempty"`
+	Mode            string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Ranges          []*RangeRule           `protobuf:"bytes,4,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	OutOfRange      string                 `protobuf:"bytes,5,opt,name=out_of_range,json=outOfRange,proto3" json:"out_of_range,omitempty"`
+	SensorType      string                 `protobuf:"bytes,6,opt,name=sensor_type,json=sensorType,proto3" json:"sensor_type,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ValidationOptions) Reset() {
+	*x = ValidationOptions{}
+	mi := &file_proto_v2_data_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationOptions) ProtoMessage() {}
+
+func (x *ValidationOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationOptions.ProtoReflect.Descriptor instead.
+func (*ValidationOptions) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ValidationOptions) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *ValidationOptions) GetSkipInvalidRows() bool {
+	if x != nil {
+		return x.SkipInvalidRows
+	}
+	return false
+}
+
+func (x *ValidationOptions) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ValidationOptions) GetRanges() []*RangeRule {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+func (x *ValidationOptions) GetOutOfRange() string {
+	if x != nil {
+		return x.OutOfRange
+	}
+	return ""
+}
+
+func (x *ValidationOptions) GetSensorType() string {
+	if x != nil {
+		return x.SensorType
+	}
+	return ""
+}
+
+type RangeRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Column        string                 `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	Min           float64                `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RangeRule) Reset() {
+	*x = RangeRule{}
+	mi := &file_proto_v2_data_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RangeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeRule) ProtoMessage() {}
+
+func (x *RangeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeRule.ProtoReflect.Descriptor instead.
+func (*RangeRule) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RangeRule) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *RangeRule) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *RangeRule) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type TemplateOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	Header        string                 `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	Footer        string                 `protobuf:"bytes,3,opt,name=footer,proto3" json:"footer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateOptions) Reset() {
+	*x = TemplateOptions{}
+	mi := &file_proto_v2_data_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateOptions) ProtoMessage() {}
+
+func (x *TemplateOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateOptions.ProtoReflect.Descriptor instead.
+func (*TemplateOptions) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TemplateOptions) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *TemplateOptions) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *TemplateOptions) GetFooter() string {
+	if x != nil {
+		return x.Footer
+	}
+	return ""
+}
+
+type InfluxOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Measurement   string                 `protobuf:"bytes,1,opt,name=measurement,proto3" json:"measurement,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Fields        []string               `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	TimeColumn    string                 `protobuf:"bytes,4,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	Precision     string                 `protobuf:"bytes,5,opt,name=precision,proto3" json:"precision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InfluxOptions) Reset() {
+	*x = InfluxOptions{}
+	mi := &file_proto_v2_data_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InfluxOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InfluxOptions) ProtoMessage() {}
+
+func (x *InfluxOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InfluxOptions.ProtoReflect.Descriptor instead.
+func (*InfluxOptions) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InfluxOptions) GetMeasurement() string {
+	if x != nil {
+		return x.Measurement
+	}
+	return ""
+}
+
+func (x *InfluxOptions) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *InfluxOptions) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *InfluxOptions) GetTimeColumn() string {
+	if x != nil {
+		return x.TimeColumn
+	}
+	return ""
+}
+
+func (x *InfluxOptions) GetPrecision() string {
+	if x != nil {
+		return x.Precision
+	}
+	return ""
+}
+
+type JsonOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Compact       *bool                  `protobuf:"varint,1,opt,name=compact,proto3,oneof" json:"compact,omitempty"`
+	Indent        int32                  `protobuf:"varint,2,opt,name=indent,proto3" json:"indent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JsonOptions) Reset() {
+	*x = JsonOptions{}
+	mi := &file_proto_v2_data_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonOptions) ProtoMessage() {}
+
+func (x *JsonOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonOptions.ProtoReflect.Descriptor instead.
+func (*JsonOptions) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *JsonOptions) GetCompact() bool {
+	if x != nil && x.Compact != nil {
+		return *x.Compact
+	}
+	return false
+}
+
+func (x *JsonOptions) GetIndent() int32 {
+	if x != nil {
+		return x.Indent
+	}
+	return 0
+}
+
+type PostgresOptions struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Columns         map[string]string      `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	OnConflict      string                 `protobuf:"bytes,2,opt,name=on_conflict,json=onConflict,proto3" json:"on_conflict,omitempty"`
+	ConflictColumns []string               `protobuf:"bytes,3,rep,name=conflict_columns,json=conflictColumns,proto3" json:"conflict_columns,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PostgresOptions) Reset() {
+	*x = PostgresOptions{}
+	mi := &file_proto_v2_data_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostgresOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostgresOptions) ProtoMessage() {}
+
+func (x *PostgresOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_data_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostgresOptions.ProtoReflect.Descriptor instead.
+func (*PostgresOptions) Descriptor() ([]byte, []int) {
+	return file_proto_v2_data_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PostgresOptions) GetColumns() map[string]string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *PostgresOptions) GetOnConflict() string {
+	if x != nil {
+		return x.OnConflict
+	}
+	return ""
+}
+
+func (x *PostgresOptions) GetConflictColumns() []string {
+	if x != nil {
+		return x.ConflictColumns
+	}
+	return nil
+}
+
+var File_proto_v2_data_proto protoreflect.FileDescriptor
+
+const file_proto_v2_data_proto_rawDesc = "" +
+	"\n" +
+	"\x13proto/v2/data.proto\x12\adata.v2\"\xf9\x01\n" +
+	"\x0eConvertRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x14\n" +
+	"\x04data\x18\x03 \x01(\fH\x00R\x04data\x12\x12\n" +
+	"\x03url\x18\x04 \x01(\tH\x00R\x03url\x12\x14\n" +
+	"\x04path\x18\x05 \x01(\tH\x00R\x04path\x121\n" +
+	"\aoptions\x18\x06 \x01(\v2\x17.data.v2.ConvertOptionsR\aoptions\x12\x1d\n" +
+	"\n" +
+	"output_url\x18\a \x01(\tR\toutputUrl\x12'\n" +
+	"\x0fidempotency_key\x18\b \x01(\tR\x0eidempotencyKeyB\b\n" +
+	"\x06source\"\xa4\x01\n" +
+	"\x0fConvertResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\fR\x06result\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"output_url\x18\x03 \x01(\tR\toutputUrl\x127\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x1b.data.v2.ConversionMetadataR\bmetadata\"\xec\x02\n" +
+	"\x12ConversionMetadata\x12?\n" +
+	"\x06source\x18\x01 \x03(\v2'.data.v2.ConversionMetadata.SourceEntryR\x06source\x12\x1a\n" +
+	"\bpreamble\x18\x02 \x03(\tR\bpreamble\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\x120\n" +
+	"\n" +
+	"row_errors\x18\x04 \x03(\v2\x11.data.v2.RowErrorR\trowErrors\x12-\n" +
+	"\x12duplicates_removed\x18\x05 \x01(\x03R\x11duplicatesRemoved\x12$\n" +
+	"\x05stats\x18\x06 \x01(\v2\x0e.data.v2.StatsR\x05stats\x12\x1b\n" +
+	"\tcache_hit\x18\a \x01(\bR\bcacheHit\x1a9\n" +
+	"\vSourceEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa9\x02\n" +
+	"\x05Stats\x12\x1b\n" +
+	"\trows_read\x18\x01 \x01(\x03R\browsRead\x12!\n" +
+	"\frows_skipped\x18\x02 \x01(\x03R\vrowsSkipped\x12!\n" +
+	"\frows_written\x18\x03 \x01(\x03R\vrowsWritten\x12\x18\n" +
+	"\acolumns\x18\x04 \x03(\tR\acolumns\x12B\n" +
+	"\fcolumn_types\x18\x05 \x03(\v2\x1f.data.v2.Stats.ColumnTypesEntryR\vcolumnTypes\x12\x1f\n" +
+	"\vduration_ms\x18\x06 \x01(\x01R\n" +
+	"durationMs\x1a>\n" +
+	"\x10ColumnTypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"L\n" +
+	"\bRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x03R\x03row\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\tR\x06column\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"e\n" +
+	"\fStreamHeader\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x121\n" +
+	"\aoptions\x18\x03 \x01(\v2\x17.data.v2.ConvertOptionsR\aoptions\"j\n" +
+	"\x14ConvertStreamRequest\x12/\n" +
+	"\x06header\x18\x01 \x01(\v2\x15.data.v2.StreamHeaderH\x00R\x06header\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\amessage\"u\n" +
+	"\x15ConvertStreamResponse\x12\x16\n" +
+	"\x05chunk\x18\x01 \x01(\fH\x00R\x05chunk\x129\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x1b.data.v2.ConversionMetadataH\x00R\bmetadataB\t\n" +
+	"\amessage\"j\n" +
+	"\x14StreamRecordsRequest\x12/\n" +
+	"\x06header\x18\x01 \x01(\v2\x15.data.v2.StreamHeaderH\x00R\x06header\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\amessage\"0\n" +
+	"\x06Record\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x03R\x04line\x12\x12\n" +
+	"\x04json\x18\x02 \x01(\fR\x04json\"\x88\x01\n" +
+	"\x15StreamRecordsResponse\x12)\n" +
+	"\x06record\x18\x01 \x01(\v2\x0f.data.v2.RecordH\x00R\x06record\x129\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x1b.data.v2.ConversionMetadataH\x00R\bmetadataB\t\n" +
+	"\amessage\"\x14\n" +
+	"\x12ListFormatsRequest\"f\n" +
+	"\x13ListFormatsResponse\x12\x18\n" +
+	"\aformats\x18\x01 \x03(\tR\aformats\x125\n" +
+	"\vconversions\x18\x02 \x03(\v2\x13.data.v2.FormatPairR\vconversions\"^\n" +
+	"\n" +
+	"FormatPair\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\tR\x05level\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xd9\x03\n" +
+	"\x0eConvertOptions\x12%\n" +
+	"\x03csv\x18\x01 \x01(\v2\x13.data.v2.CsvOptionsR\x03csv\x12*\n" +
+	"\x05types\x18\x02 \x01(\v2\x14.data.v2.TypeOptionsR\x05types\x129\n" +
+	"\n" +
+	"timestamps\x18\x03 \x01(\v2\x19.data.v2.TimestampOptionsR\n" +
+	"timestamps\x127\n" +
+	"\ttransform\x18\x04 \x01(\v2\x19.data.v2.TransformOptionsR\ttransform\x12:\n" +
+	"\n" +
+	"validation\x18\x05 \x01(\v2\x1a.data.v2.ValidationOptionsR\n" +
+	"validation\x124\n" +
+	"\btemplate\x18\x06 \x01(\v2\x18.data.v2.TemplateOptionsR\btemplate\x12.\n" +
+	"\x06influx\x18\a \x01(\v2\x16.data.v2.InfluxOptionsR\x06influx\x124\n" +
+	"\bpostgres\x18\b \x01(\v2\x18.data.v2.PostgresOptionsR\bpostgres\x12(\n" +
+	"\x04json\x18\t \x01(\v2\x14.data.v2.JsonOptionsR\x04json\"\xf3\x02\n" +
+	"\n" +
+	"CsvOptions\x12\x1b\n" +
+	"\tno_header\x18\x01 \x01(\bR\bnoHeader\x12\x18\n" +
+	"\aheaders\x18\x02 \x03(\tR\aheaders\x12\x1f\n" +
+	"\vomit_header\x18\x03 \x01(\bR\n" +
+	"omitHeader\x12+\n" +
+	"\x11duplicate_headers\x18\x04 \x01(\tR\x10duplicateHeaders\x12\x1f\n" +
+	"\vjagged_rows\x18\x05 \x01(\tR\n" +
+	"jaggedRows\x12\x1d\n" +
+	"\n" +
+	"skip_lines\x18\x06 \x01(\x05R\tskipLines\x12%\n" +
+	"\x0ecomment_prefix\x18\a \x01(\tR\rcommentPrefix\x12)\n" +
+	"\x10capture_metadata\x18\b \x01(\bR\x0fcaptureMetadata\x12%\n" +
+	"\x0einput_encoding\x18\t \x01(\tR\rinputEncoding\x12'\n" +
+	"\x0foutput_encoding\x18\n" +
+	" \x01(\tR\x0eoutputEncoding\"\x9f\x03\n" +
+	"\vTypeOptions\x12+\n" +
+	"\x11disable_inference\x18\x01 \x01(\bR\x10disableInference\x12%\n" +
+	"\x0estring_columns\x18\x02 \x03(\tR\rstringColumns\x12H\n" +
+	"\fcolumn_types\x18\x03 \x03(\v2%.data.v2.TypeOptions.ColumnTypesEntryR\vcolumnTypes\x12%\n" +
+	"\x0estrict_numbers\x18\x04 \x01(\bR\rstrictNumbers\x12\"\n" +
+	"\rnon_finite_as\x18\x05 \x01(\tR\vnonFiniteAs\x12%\n" +
+	"\x0einfer_booleans\x18\x06 \x01(\bR\rinferBooleans\x12\x1f\n" +
+	"\vnull_values\x18\a \x03(\tR\n" +
+	"nullValues\x12\x1f\n" +
+	"\vnull_output\x18\b \x01(\tR\n" +
+	"nullOutput\x1a>\n" +
+	"\x10ColumnTypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb9\x01\n" +
+	"\x10TimestampOptions\x12\x16\n" +
+	"\x06detect\x18\x01 \x01(\bR\x06detect\x12\x1c\n" +
+	"\tnormalize\x18\x02 \x01(\bR\tnormalize\x12\x1d\n" +
+	"\n" +
+	"year_pivot\x18\x03 \x01(\x05R\tyearPivot\x12'\n" +
+	"\x0fsource_timezone\x18\x04 \x01(\tR\x0esourceTimezone\x12'\n" +
+	"\x0ftarget_timezone\x18\x05 \x01(\tR\x0etargetTimezone\"\xac\x04\n" +
+	"\x10TransformOptions\x12\x18\n" +
+	"\acolumns\x18\x01 \x03(\tR\acolumns\x12=\n" +
+	"\x06rename\x18\x02 \x03(\v2%.data.v2.TransformOptions.RenameEntryR\x06rename\x12)\n" +
+	"\x10computed_columns\x18\x03 \x03(\tR\x0fcomputedColumns\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x17\n" +
+	"\asort_by\x18\x05 \x03(\tR\x06sortBy\x12 \n" +
+	"\vdeduplicate\x18\x06 \x01(\bR\vdeduplicate\x12\x1d\n" +
+	"\n" +
+	"dedup_keys\x18\a \x03(\tR\tdedupKeys\x12\x18\n" +
+	"\areshape\x18\b \x01(\tR\areshape\x12,\n" +
+	"\x12reshape_id_columns\x18\t \x03(\tR\x10reshapeIdColumns\x12.\n" +
+	"\x13reshape_name_column\x18\n" +
+	" \x01(\tR\x11reshapeNameColumn\x120\n" +
+	"\x14reshape_value_column\x18\v \x01(\tR\x12reshapeValueColumn\x12'\n" +
+	"\x0funpivot_columns\x18\f \x03(\tR\x0eunpivotColumns\x12\x14\n" +
+	"\x05units\x18\r \x03(\tR\x05units\x1a9\n" +
+	"\vRenameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xda\x01\n" +
+	"\x11ValidationOptions\x12\x16\n" +
+	"\x06schema\x18\x01 \x01(\tR\x06schema\x12*\n" +
+	"\x11skip_invalid_rows\x18\x02 \x01(\bR\x0fskipInvalidRows\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12*\n" +
+	"\x06ranges\x18\x04 \x03(\v2\x12.data.v2.RangeRuleR\x06ranges\x12 \n" +
+	"\fout_of_range\x18\x05 \x01(\tR\n" +
+	"outOfRange\x12\x1f\n" +
+	"\vsensor_type\x18\x06 \x01(\tR\n" +
+	"sensorType\"G\n" +
+	"\tRangeRule\x12\x16\n" +
+	"\x06column\x18\x01 \x01(\tR\x06column\x12\x10\n" +
+	"\x03min\x18\x02 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x03 \x01(\x01R\x03max\"U\n" +
+	"\x0fTemplateOptions\x12\x12\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\x12\x16\n" +
+	"\x06header\x18\x02 \x01(\tR\x06header\x12\x16\n" +
+	"\x06footer\x18\x03 \x01(\tR\x06footer\"\x9c\x01\n" +
+	"\rInfluxOptions\x12 \n" +
+	"\vmeasurement\x18\x01 \x01(\tR\vmeasurement\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\x16\n" +
+	"\x06fields\x18\x03 \x03(\tR\x06fields\x12\x1f\n" +
+	"\vtime_column\x18\x04 \x01(\tR\n" +
+	"timeColumn\x12\x1c\n" +
+	"\tprecision\x18\x05 \x01(\tR\tprecision\"P\n" +
+	"\vJsonOptions\x12\x1d\n" +
+	"\acompact\x18\x01 \x01(\bH\x00R\acompact\x88\x01\x01\x12\x16\n" +
+	"\x06indent\x18\x02 \x01(\x05R\x06indentB\n" +
+	"\n" +
+	"\b_compact\"\xda\x01\n" +
+	"\x0fPostgresOptions\x12?\n" +
+	"\acolumns\x18\x01 \x03(\v2%.data.v2.PostgresOptions.ColumnsEntryR\acolumns\x12\x1f\n" +
+	"\von_conflict\x18\x02 \x01(\tR\n" +
+	"onConflict\x12)\n" +
+	"\x10conflict_columns\x18\x03 \x03(\tR\x0fconflictColumns\x1a:\n" +
+	"\fColumnsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xbc\x02\n" +
+	"\n" +
+	"DataParser\x12<\n" +
+	"\aConvert\x12\x17.data.v2.ConvertRequest\x1a\x18.data.v2.ConvertResponse\x12R\n" +
+	"\rConvertStream\x12\x1d.data.v2.ConvertStreamRequest\x1a\x1e.data.v2.ConvertStreamResponse(\x010\x01\x12R\n" +
+	"\rStreamRecords\x12\x1d.data.v2.StreamRecordsRequest\x1a\x1e.data.v2.StreamRecordsResponse(\x010\x01\x12H\n" +
+	"\vListFormats\x12\x1b.data.v2.ListFormatsRequest\x1a\x1c.data.v2.ListFormatsResponseB\x1fZ\x1drpcGoDatatype/proto/v2;datav2b\x06proto3"
+
+var (
+	file_proto_v2_data_proto_rawDescOnce sync.Once
+	file_proto_v2_data_proto_rawDescData []byte
+)
+
+func file_proto_v2_data_proto_rawDescGZIP() []byte {
+	file_proto_v2_data_proto_rawDescOnce.Do(func() {
+		file_proto_v2_data_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v2_data_proto_rawDesc), len(file_proto_v2_data_proto_rawDesc)))
+	})
+	return file_proto_v2_data_proto_rawDescData
+}
+
+var file_proto_v2_data_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_proto_v2_data_proto_goTypes = []any{
+	(*ConvertRequest)(nil),        // 0: data.v2.ConvertRequest
+	(*ConvertResponse)(nil),       // 1: data.v2.ConvertResponse
+	(*ConversionMetadata)(nil),    // 2: data.v2.ConversionMetadata
+	(*Stats)(nil),                 // 3: data.v2.Stats
+	(*RowError)(nil),              // 4: data.v2.RowError
+	(*StreamHeader)(nil),          // 5: data.v2.StreamHeader
+	(*ConvertStreamRequest)(nil),  // 6: data.v2.ConvertStreamRequest
+	(*ConvertStreamResponse)(nil), // 7: data.v2.ConvertStreamResponse
+	(*StreamRecordsRequest)(nil),  // 8: data.v2.StreamRecordsRequest
+	(*Record)(nil),                // 9: data.v2.Record
+	(*StreamRecordsResponse)(nil), // 10: data.v2.StreamRecordsResponse
+	(*ListFormatsRequest)(nil),    // 11: data.v2.ListFormatsRequest
+	(*ListFormatsResponse)(nil),   // 12: data.v2.ListFormatsResponse
+	(*FormatPair)(nil),            // 13: data.v2.FormatPair
+	(*ConvertOptions)(nil),        // 14: data.v2.ConvertOptions
+	(*CsvOptions)(nil),            // 15: data.v2.CsvOptions
+	(*TypeOptions)(nil),           // 16: data.v2.TypeOptions
+	(*TimestampOptions)(nil),      // 17: data.v2.TimestampOptions
+	(*TransformOptions)(nil),      // 18: data.v2.TransformOptions
+	(*ValidationOptions)(nil),     // 19: data.v2.ValidationOptions
+	(*RangeRule)(nil),             // 20: data.v2.RangeRule
+	(*TemplateOptions)(nil),       // 21: data.v2.TemplateOptions
+	(*InfluxOptions)(nil),         // 22: data.v2.InfluxOptions
+	(*JsonOptions)(nil),           // 23: data.v2.JsonOptions
+	(*PostgresOptions)(nil),       // 24: data.v2.PostgresOptions
+	nil,                           // 25: data.v2.ConversionMetadata.SourceEntry
+	nil,                           // 26: data.v2.Stats.ColumnTypesEntry
+	nil,                           // 27: data.v2.TypeOptions.ColumnTypesEntry
+	nil,                           // 28: data.v2.TransformOptions.RenameEntry
+	nil,                           // 29: data.v2.PostgresOptions.ColumnsEntry
+}
+var file_proto_v2_data_proto_depIdxs = []int32{
+	14, // 0: data.v2.ConvertRequest.options:type_name -> data.v2.ConvertOptions
+	2,  // 1: data.v2.ConvertResponse.metadata:type_name -> data.v2.ConversionMetadata
+	25, // 2: data.v2.ConversionMetadata.source:type_name -> data.v2.ConversionMetadata.SourceEntry
+	4,  // 3: data.v2.ConversionMetadata.row_errors:type_name -> data.v2.RowError
+	3,  // 4: data.v2.ConversionMetadata.stats:type_name -> data.v2.Stats
+	26, // 5: data.v2.Stats.column_types:type_name -> data.v2.Stats.ColumnTypesEntry
+	14, // 6: data.v2.StreamHeader.options:type_name -> data.v2.ConvertOptions
+	5,  // 7: data.v2.ConvertStreamRequest.header:type_name -> data.v2.StreamHeader
+	2,  // 8: data.v2.ConvertStreamResponse.metadata:type_name -> data.v2.ConversionMetadata
+	5,  // 9: data.v2.StreamRecordsRequest.header:type_name -> data.v2.StreamHeader
+	9,  // 10: data.v2.StreamRecordsResponse.record:type_name -> data.v2.Record
+	2,  // 11: data.v2.StreamRecordsResponse.metadata:type_name -> data.v2.ConversionMetadata
+	13, // 12: data.v2.ListFormatsResponse.conversions:type_name -> data.v2.FormatPair
+	15, // 13: data.v2.ConvertOptions.csv:type_name -> data.v2.CsvOptions
+	16, // 14: data.v2.ConvertOptions.types:type_name -> data.v2.TypeOptions
+	17, // 15: data.v2.ConvertOptions.timestamps:type_name -> data.v2.TimestampOptions
+	18, // 16: data.v2.ConvertOptions.transform:type_name -> data.v2.TransformOptions
+	19, // 17: data.v2.ConvertOptions.validation:type_name -> data.v2.ValidationOptions
+	21, // 18: data.v2.ConvertOptions.template:type_name -> data.v2.TemplateOptions
+	22, // 19: data.v2.ConvertOptions.influx:type_name -> data.v2.InfluxOptions
+	24, // 20: data.v2.ConvertOptions.postgres:type_name -> data.v2.PostgresOptions
+	23, // 21: data.v2.ConvertOptions.json:type_name -> data.v2.JsonOptions
+	27, // 22: data.v2.TypeOptions.column_types:type_name -> data.v2.TypeOptions.ColumnTypesEntry
+	28, // 23: data.v2.TransformOptions.rename:type_name -> data.v2.TransformOptions.RenameEntry
+	20, // 24: data.v2.ValidationOptions.ranges:type_name -> data.v2.RangeRule
+	29, // 25: data.v2.PostgresOptions.columns:type_name -> data.v2.PostgresOptions.ColumnsEntry
+	0,  // 26: data.v2.DataParser.Convert:input_type -> data.v2.ConvertRequest
+	6,  // 27: data.v2.DataParser.ConvertStream:input_type -> data.v2.ConvertStreamRequest
+	8,  // 28: data.v2.DataParser.StreamRecords:input_type -> data.v2.StreamRecordsRequest
+	11, // 29: data.v2.DataParser.ListFormats:input_type -> data.v2.ListFormatsRequest
+	1,  // 30: data.v2.DataParser.Convert:output_type -> data.v2.ConvertResponse
+	7,  // 31: data.v2.DataParser.ConvertStream:output_type -> data.v2.ConvertStreamResponse
+	10, // 32: data.v2.DataParser.StreamRecords:output_type -> data.v2.StreamRecordsResponse
+	12, // 33: data.v2.DataParser.ListFormats:output_type -> data.v2.ListFormatsResponse
+	30, // [30:34] is the sub-list for method output_type
+	26, // [26:30] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_proto_v2_data_proto_init() }
+func file_proto_v2_data_proto_init() {
+	if File_proto_v2_data_proto != nil {
+		return
+	}
+	file_proto_v2_data_proto_msgTypes[0].OneofWrappers = []any{
+		(*ConvertRequest_Data)(nil),
+		(*ConvertRequest_Url)(nil),
+		(*ConvertRequest_Path)(nil),
+	}
+	file_proto_v2_data_proto_msgTypes[6].OneofWrappers = []any{
+		(*ConvertStreamRequest_Header)(nil),
+		(*ConvertStreamRequest_Chunk)(nil),
+	}
+	file_proto_v2_data_proto_msgTypes[7].OneofWrappers = []any{
+		(*ConvertStreamResponse_Chunk)(nil),
+		(*ConvertStreamResponse_Metadata)(nil),
+	}
+	file_proto_v2_data_proto_msgTypes[8].OneofWrappers = []any{
+		(*StreamRecordsRequest_Header)(nil),
+		(*StreamRecordsRequest_Chunk)(nil),
+	}
+	file_proto_v2_data_proto_msgTypes[10].OneofWrappers = []any{
+		(*StreamRecordsResponse_Record)(nil),
+		(*StreamRecordsResponse_Metadata)(nil),
+	}
+	file_proto_v2_data_proto_msgTypes[23].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v2_data_proto_rawDesc), len(file_proto_v2_data_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   30,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v2_data_proto_goTypes,
+		DependencyIndexes: file_proto_v2_data_proto_depIdxs,
+		MessageInfos:      file_proto_v2_data_proto_msgTypes,
+	}.Build()
+	File_proto_v2_data_proto = out.File
+	file_proto_v2_data_proto_goTypes = nil
+	file_proto_v2_data_proto_depIdxs = nil
+}
@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: proto/v2/data.proto
+
+package datav2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DataParser_Convert_FullMethodName       = "/data.v2.DataParser/Convert"
+	DataParser_ConvertStream_FullMethodName = "/data.v2.DataParser/ConvertStream"
+	DataParser_StreamRecords_FullMethodName = "/data.v2.DataParser/StreamRecords"
+	DataParser_ListFormats_FullMethodName   = "/data.v2.DataParser/ListFormats"
+)
+
+// DataParserClient is the client API for DataParser service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DataParserClient interface {
+	Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*ConvertResponse, error)
+	ConvertStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConvertStreamRequest, ConvertStreamResponse], error)
+	StreamRecords(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamRecordsRequest, StreamRecordsResponse], error)
+	ListFormats(ctx context.Context, in *ListFormatsRequest, opts ...grpc.CallOption) (*ListFormatsResponse, error)
+}
+
+type dataParserClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDataParserClient(cc grpc.ClientConnInterface) DataParserClient {
+	return &dataParserClient{cc}
+}
+
+func (c *dataParserClient) Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*ConvertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertResponse)
+	err := c.cc.Invoke(ctx, DataParser_Convert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataParserClient) ConvertStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConvertStreamRequest, ConvertStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DataParser_ServiceDesc.Streams[0], DataParser_ConvertStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ConvertStreamRequest, ConvertStreamResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataParser_ConvertStreamClient = grpc.BidiStreamingClient[ConvertStreamRequest, ConvertStreamResponse]
+
+func (c *dataParserClient) StreamRecords(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamRecordsRequest, StreamRecordsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DataParser_ServiceDesc.Streams[1], DataParser_StreamRecords_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamRecordsRequest, StreamRecordsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataParser_StreamRecordsClient = grpc.BidiStreamingClient[StreamRecordsRequest, StreamRecordsResponse]
+
+func (c *dataParserClient) ListFormats(ctx context.Context, in *ListFormatsRequest, opts ...grpc.CallOption) (*ListFormatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFormatsResponse)
+	err := c.cc.Invoke(ctx, DataParser_ListFormats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataParserServer is the server API for DataParser service.
+// All implementations must embed UnimplementedDataParserServer
+// for forward compatibility.
+type DataParserServer interface {
+	Convert(context.Context, *ConvertRequest) (*ConvertResponse, error)
+	ConvertStream(grpc.BidiStreamingServer[ConvertStreamRequest, ConvertStreamResponse]) error
+	StreamRecords(grpc.BidiStreamingServer[StreamRecordsRequest, StreamRecordsResponse]) error
+	ListFormats(context.Context, *ListFormatsRequest) (*ListFormatsResponse, error)
+	mustEmbedUnimplementedDataParserServer()
+}
+
+// UnimplementedDataParserServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDataParserServer struct{}
+
+func (UnimplementedDataParserServer) Convert(context.Context, *ConvertRequest) (*ConvertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Convert not implemented")
+}
+func (UnimplementedDataParserServer) ConvertStream(grpc.BidiStreamingServer[ConvertStreamRequest, ConvertStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ConvertStream not implemented")
+}
+func (UnimplementedDataParserServer) StreamRecords(grpc.BidiStreamingServer[StreamRecordsRequest, StreamRecordsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRecords not implemented")
+}
+func (UnimplementedDataParserServer) ListFormats(context.Context, *ListFormatsRequest) (*ListFormatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFormats not implemented")
+}
+func (UnimplementedDataParserServer) mustEmbedUnimplementedDataParserServer() {}
+func (UnimplementedDataParserServer) testEmbeddedByValue()                    {}
+
+// UnsafeDataParserServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DataParserServer will
+// result in compilation errors.
+type UnsafeDataParserServer interface {
+	mustEmbedUnimplementedDataParserServer()
+}
+
+func RegisterDataParserServer(s grpc.ServiceRegistrar, srv DataParserServer) {
+	// If the following call pancis, it indicates UnimplementedDataParserServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DataParser_ServiceDesc, srv)
+}
+
+func _DataParser_Convert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).Convert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_Convert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).Convert(ctx, req.(*ConvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataParser_ConvertStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DataParserServer).ConvertStream(&grpc.GenericServerStream[ConvertStreamRequest, ConvertStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataParser_ConvertStreamServer = grpc.BidiStreamingServer[ConvertStreamRequest, ConvertStreamResponse]
+
+func _DataParser_StreamRecords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DataParserServer).StreamRecords(&grpc.GenericServerStream[StreamRecordsRequest, StreamRecordsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataParser_StreamRecordsServer = grpc.BidiStreamingServer[StreamRecordsRequest, StreamRecordsResponse]
+
+func _DataParser_ListFormats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFormatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataParserServer).ListFormats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataParser_ListFormats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataParserServer).ListFormats(ctx, req.(*ListFormatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DataParser_ServiceDesc is the grpc.ServiceDesc for DataParser service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DataParser_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "data.v2.DataParser",
+	HandlerType: (*DataParserServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Convert",
+			Handler:    _DataParser_Convert_Handler,
+		},
+		{
+			MethodName: "ListFormats",
+			Handler:    _DataParser_ListFormats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ConvertStream",
+			Handler:       _DataParser_ConvertStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamRecords",
+			Handler:       _DataParser_StreamRecords_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/v2/data.proto",
+}
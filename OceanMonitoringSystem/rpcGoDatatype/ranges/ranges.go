@@ -0,0 +1,58 @@
+// Package ranges loads the acceptable value ranges of each sensor type,
+// which requests select with their sensor_type option.
+package ranges
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"rpcGoDatatype/csvconverter"
+)
+
+// Rule bounds the values of one column, inclusively.
+type Rule struct {
+	Column string   `json:"column"`
+	Min    *float64 `json:"min"`
+	Max    *float64 `json:"max"`
+}
+
+// Config is the on-disk range configuration, e.g.
+//
+//	{"sensor_types": {"ctd": [{"column": "temp", "min": -2, "max": 40}]}}
+type Config struct {
+	SensorTypes map[string][]Rule `json:"sensor_types"`
+}
+
+// LoadConfig reads a JSON range configuration file and returns the rules
+// of each sensor type.
+func LoadConfig(path string) (map[string][]csvconverter.RangeRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading ranges config: %v", err)
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("error parsing ranges config: %v", err)
+	}
+	sensors := make(map[string][]csvconverter.RangeRule, len(cfg.SensorTypes))
+	for name, rules := range cfg.SensorTypes {
+		seen := make(map[string]bool, len(rules))
+		sensors[name] = make([]csvconverter.RangeRule, 0, len(rules))
+		for i, r := range rules {
+			switch {
+			case r.Column == "":
+				return nil, fmt.Errorf("sensor type %s, rule %d: missing column", name, i)
+			case r.Min == nil || r.Max == nil:
+				return nil, fmt.Errorf("sensor type %s, rule %d: needs a min and a max", name, i)
+			case *r.Min > *r.Max:
+				return nil, fmt.Errorf("sensor type %s, rule %d: min is above max", name, i)
+			case seen[r.Column]:
+				return nil, fmt.Errorf("sensor type %s: column %s has more than one rule", name, r.Column)
+			}
+			seen[r.Column] = true
+			sensors[name] = append(sensors[name], csvconverter.RangeRule{Column: r.Column, Min: *r.Min, Max: *r.Max})
+		}
+	}
+	return sensors, nil
+}
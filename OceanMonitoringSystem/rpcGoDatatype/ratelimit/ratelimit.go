@@ -0,0 +1,164 @@
+// Package ratelimit limits the call rate of each client with a token bucket.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// idleTimeout is how long an unused client bucket is kept.
+const idleTimeout = 10 * time.Minute
+
+// Limit is a sustained rate in calls per second with a burst size.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// ParseLimit parses "rate" or "rate:burst". The burst defaults to the rate
+// rounded up.
+func ParseLimit(s string) (Limit, error) {
+	r, b, hasBurst := strings.Cut(strings.TrimSpace(s), ":")
+	l := Limit{}
+	var err error
+	if l.Rate, err = strconv.ParseFloat(r, 64); err != nil || l.Rate <= 0 {
+		return Limit{}, fmt.Errorf("invalid rate %q", r)
+	}
+	l.Burst = int(l.Rate)
+	if float64(l.Burst) < l.Rate {
+		l.Burst++
+	}
+	if hasBurst {
+		if l.Burst, err = strconv.Atoi(b); err != nil || l.Burst <= 0 {
+			return Limit{}, fmt.Errorf("invalid burst %q", b)
+		}
+	}
+	return l, nil
+}
+
+// ParseOverrides parses per-client limits such as "st1=5:10,client-a=20".
+func ParseOverrides(s string) (map[string]Limit, error) {
+	limits := make(map[string]Limit)
+	for _, item := range strings.Split(s, ",") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		client, spec, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid client limit %q", item)
+		}
+		l, err := ParseLimit(spec)
+		if err != nil {
+			return nil, fmt.Errorf("client %s: %v", strings.TrimSpace(client), err)
+		}
+		limits[strings.TrimSpace(client)] = l
+	}
+	return limits, nil
+}
+
+// KeyFunc identifies the client making a call.
+type KeyFunc func(ctx context.Context) string
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter keeps one token bucket per client.
+type Limiter struct {
+	limit     Limit
+	overrides map[string]Limit
+	key       KeyFunc
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// New creates a Limiter applying limit to every client, except those
+// named in overrides.
+func New(limit Limit, overrides map[string]Limit, key KeyFunc) *Limiter {
+	return &Limiter{limit: limit, overrides: overrides, key: key, buckets: make(map[string]*bucket)}
+}
+
+// reserve takes a token for client, returning how long the client must
+// wait when none is left.
+func (l *Limiter) reserve(client string) (time.Duration, bool) {
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now.Sub(l.lastSweep) > idleTimeout {
+		for k, b := range l.buckets {
+			if now.Sub(b.lastSeen) > idleTimeout {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+	b, ok := l.buckets[client]
+	if !ok {
+		limit, ok := l.overrides[client]
+		if !ok {
+			limit = l.limit
+		}
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)}
+		l.buckets[client] = b
+	}
+	b.lastSeen = now
+	r := b.limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
+
+// Check takes a token for the calling client, failing with
+// RESOURCE_EXHAUSTED when none is left.
+func (l *Limiter) Check(ctx context.Context) error {
+	client := l.key(ctx)
+	delay, ok := l.reserve(client)
+	if ok {
+		return nil
+	}
+	retry := delay.Round(time.Millisecond)
+	grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(int((delay+time.Second-1)/time.Second))))
+	st := status.Newf(codes.ResourceExhausted, "rate limit exceeded for %s, retry in %v", client, retry)
+	if d, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)}); err == nil {
+		st = d
+	}
+	return st.Err()
+}
+
+// UnaryInterceptor rejects unary calls over the client's limit with
+// RESOURCE_EXHAUSTED.
+func (l *Limiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.Check(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor rejects streams opened over the client's limit.
+func (l *Limiter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.Check(ss.Context()); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
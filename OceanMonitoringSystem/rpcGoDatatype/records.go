@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"rpcGoDatatype/csvconverter"
+	pb "rpcGoDatatype/proto"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// recordConverter converts messages from Kafka or NATS in format from with
+// options given as ProtoJSON into JSON records. Each message is a complete
+// input, header included unless the options supply one, and takes a pool
+// worker like a Parse call.
+func (s *server) recordConverter(from, options string) (func(context.Context, []byte) ([][]byte, error), error) {
+	o := &pb.ConvertOptions{}
+	if options != "" {
+		if err := protojson.Unmarshal([]byte(options), o); err != nil {
+			return nil, fmt.Errorf("invalid options: %v", err)
+		}
+	}
+	opts := s.options(o)
+	return func(ctx context.Context, value []byte) ([][]byte, error) {
+		release, err := s.pool.Acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		var records [][]byte
+		_, err = csvconverter.ConvertRows(ctx, from, bytes.NewReader(value), opts, func(row []byte, _ int) error {
+			records = append(records, row)
+			return nil
+		})
+		return records, err
+	}, nil
+}
@@ -0,0 +1,316 @@
+// Package registration keeps the self-registration requests of stations.
+// A station registers with its instruments and receives a bootstrap token;
+// once an admin approves it, the station collects its API key with that
+// token, exactly once.
+package registration
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Registration states.
+const (
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+	StatusRejected = "rejected"
+)
+
+// MaxPending is the most registrations that may wait for a decision.
+const MaxPending = 1000
+
+// DefaultScopes are granted to newly approved stations: conversions only,
+// not jobs, usage, history, the station registry or alerting.
+var DefaultScopes = []string{"parse"}
+
+var (
+	ErrNotFound     = errors.New("registration not found")
+	ErrInvalidToken = errors.New("invalid bootstrap token")
+	ErrDecided      = errors.New("registration already decided")
+	ErrStationTaken = errors.New("station id belongs to another approved registration")
+	ErrTooMany      = fmt.Errorf("more than %d registrations are pending", MaxPending)
+)
+
+// Instrument is a sensor reported by a station during registration.
+type Instrument struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	Column string `json:"column"`
+	Unit   string `json:"unit,omitempty"`
+}
+
+// Station is a registration request and, once approved, its credentials.
+type Station struct {
+	RegistrationID string       `json:"registration_id"`
+	StationID      string       `json:"station_id"`
+	Name           string       `json:"name"`
+	Contact        string       `json:"contact,omitempty"`
+	Instruments    []Instrument `json:"instruments"`
+	Status         string       `json:"status"`
+	Reason         string       `json:"reason,omitempty"`
+	Scopes         []string     `json:"scopes,omitempty"`
+	CreatedAt      time.Time    `json:"created_at"`
+	DecidedAt      time.Time    `json:"decided_at,omitempty"`
+
+	BootstrapHash string `json:"bootstrap_hash"`
+	// APIKeyHash is set once the station of an approved registration has
+	// collected its API key. Only the hash is kept.
+	APIKeyHash string `json:"api_key_hash,omitempty"`
+}
+
+// storedStation is a Station as read from the file. Earlier versions kept
+// the API key of an approved registration there until it was collected.
+type storedStation struct {
+	Station
+	UndeliveredKey string `json:"undelivered_key,omitempty"`
+}
+
+// Store keeps registrations in memory and, when a path is set, in a JSON file.
+type Store struct {
+	path string
+
+	mu       sync.Mutex
+	stations map[string]*Station
+}
+
+// Open loads the store from path. An empty path keeps registrations in memory only.
+func Open(path string) (*Store, error) {
+	s := &Store{path: path, stations: make(map[string]*Station)}
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading registrations: %v", err)
+	}
+	var stations []*storedStation
+	if err := json.Unmarshal(data, &stations); err != nil {
+		return nil, fmt.Errorf("error parsing registrations: %v", err)
+	}
+	rewrite := false
+	for _, st := range stations {
+		if st.UndeliveredKey != "" {
+			// The key was never collected: drop it, and issue a new one
+			// when the station asks.
+			st.APIKeyHash = ""
+			rewrite = true
+		}
+		s.stations[st.RegistrationID] = &st.Station
+	}
+	if rewrite {
+		if err := s.save(); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Register records a pending registration and returns its ID together with
+// the bootstrap token the station uses to collect its credentials. It fails
+// with ErrTooMany while MaxPending registrations are pending.
+func (s *Store) Register(stationID, name, contact string, instruments []Instrument) (*Station, string, error) {
+	if stationID == "" {
+		return nil, "", fmt.Errorf("station id is required")
+	}
+	for i, inst := range instruments {
+		if inst.ID == "" || inst.Column == "" {
+			return nil, "", fmt.Errorf("instrument %d: id and column are required", i)
+		}
+	}
+
+	id, err := randomToken(8)
+	if err != nil {
+		return nil, "", err
+	}
+	token, err := randomToken(24)
+	if err != nil {
+		return nil, "", err
+	}
+
+	st := &Station{
+		RegistrationID: id,
+		StationID:      stationID,
+		Name:           name,
+		Contact:        contact,
+		Instruments:    instruments,
+		Status:         StatusPending,
+		CreatedAt:      time.Now().UTC(),
+		BootstrapHash:  hash(token),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pending := 0
+	for _, other := range s.stations {
+		if other.Status == StatusPending {
+			pending++
+		}
+	}
+	if pending >= MaxPending {
+		return nil, "", ErrTooMany
+	}
+	s.stations[id] = st
+	if err := s.save(); err != nil {
+		delete(s.stations, id)
+		return nil, "", err
+	}
+	c := *st
+	return &c, token, nil
+}
+
+// Decide approves or rejects a pending registration. Approval grants the
+// default scopes; the station collects its API key with Status. A station
+// ID can only belong to one approved registration.
+func (s *Store) Decide(registrationID string, approve bool, reason string) (*Station, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prev, ok := s.stations[registrationID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if prev.Status != StatusPending {
+		return nil, ErrDecided
+	}
+	if approve {
+		for _, other := range s.stations {
+			if other.Status == StatusApproved && other.StationID == prev.StationID {
+				return nil, ErrStationTaken
+			}
+		}
+	}
+
+	st := *prev
+	st.DecidedAt = time.Now().UTC()
+	st.Reason = reason
+	if approve {
+		st.Status = StatusApproved
+		st.Scopes = append([]string(nil), DefaultScopes...)
+	} else {
+		st.Status = StatusRejected
+	}
+
+	s.stations[registrationID] = &st
+	if err := s.save(); err != nil {
+		s.stations[registrationID] = prev
+		return nil, err
+	}
+	c := st
+	return &c, nil
+}
+
+// Status returns the registration for a station holding the bootstrap token.
+// The API key is issued on the first call after approval and returned that
+// once; it is never stored.
+func (s *Store) Status(registrationID, bootstrapToken string) (*Station, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.stations[registrationID]
+	if !ok {
+		return nil, "", ErrNotFound
+	}
+	if subtle.ConstantTimeCompare([]byte(hash(bootstrapToken)), []byte(st.BootstrapHash)) != 1 {
+		return nil, "", ErrInvalidToken
+	}
+
+	var key string
+	if st.Status == StatusApproved && st.APIKeyHash == "" {
+		var err error
+		if key, err = randomToken(32); err != nil {
+			return nil, "", err
+		}
+		st.APIKeyHash = hash(key)
+		if err := s.save(); err != nil {
+			st.APIKeyHash = ""
+			return nil, "", err
+		}
+	}
+	c := *st
+	return &c, key, nil
+}
+
+// LookupKey returns the approved station owning an API key.
+func (s *Store) LookupKey(apiKey string) (*Station, bool) {
+	h := hash(apiKey)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, st := range s.stations {
+		if st.Status == StatusApproved && subtle.ConstantTimeCompare([]byte(h), []byte(st.APIKeyHash)) == 1 {
+			c := *st
+			return &c, true
+		}
+	}
+	return nil, false
+}
+
+// LookupStation returns the approved registration of a station.
+func (s *Store) LookupStation(stationID string) (*Station, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, st := range s.stations {
+		if st.Status == StatusApproved && st.StationID == stationID {
+			c := *st
+			return &c, true
+		}
+	}
+	return nil, false
+}
+
+// List returns all registrations.
+func (s *Store) List() []Station {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := make([]Station, 0, len(s.stations))
+	for _, st := range s.stations {
+		list = append(list, *st)
+	}
+	return list
+}
+
+// save writes the store to disk. Callers must hold s.mu.
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	stations := make([]*Station, 0, len(s.stations))
+	for _, st := range s.stations {
+		stations = append(stations, st)
+	}
+	data, err := json.MarshalIndent(stations, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding registrations: %v", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("error writing registrations: %v", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("error writing registrations: %v", err)
+	}
+	return nil
+}
+
+func randomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating token: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,181 @@
+package registration
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func register(t *testing.T, s *Store, stationID string) (*Station, string) {
+	t.Helper()
+	st, token, err := s.Register(stationID, "Buoy "+stationID, "", []Instrument{{ID: "t1", Column: "temp"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st, token
+}
+
+func TestKeyIsDeliveredOnce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "registrations.json")
+	s, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, token := register(t, s, "B12")
+
+	steps := []struct {
+		name       string
+		token      string
+		decide     bool
+		wantStatus string
+		wantKey    bool
+		wantErr    error
+	}{
+		{name: "wrong token", token: "nope", wantErr: ErrInvalidToken},
+		{name: "pending", token: token, wantStatus: StatusPending},
+		{name: "approved", token: token, decide: true, wantStatus: StatusApproved, wantKey: true},
+		{name: "collected", token: token, wantStatus: StatusApproved},
+	}
+	var key string
+	for _, step := range steps {
+		if step.decide {
+			if _, err := s.Decide(st.RegistrationID, true, ""); err != nil {
+				t.Fatalf("%s: %v", step.name, err)
+			}
+		}
+		got, k, err := s.Status(st.RegistrationID, step.token)
+		if !errors.Is(err, step.wantErr) {
+			t.Fatalf("%s: got error %v, want %v", step.name, err, step.wantErr)
+		}
+		if err != nil {
+			continue
+		}
+		if got.Status != step.wantStatus {
+			t.Errorf("%s: status %s, want %s", step.name, got.Status, step.wantStatus)
+		}
+		if (k != "") != step.wantKey {
+			t.Errorf("%s: got key %q, want one: %v", step.name, k, step.wantKey)
+		}
+		if k != "" {
+			key = k
+		}
+	}
+
+	if found, ok := s.LookupKey(key); !ok || found.StationID != "B12" {
+		t.Errorf("LookupKey did not find the delivered key")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), key) {
+		t.Errorf("registrations file holds the API key")
+	}
+
+	// The key survives a restart and is not issued again.
+	s, err = Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.LookupKey(key); !ok {
+		t.Errorf("LookupKey did not find the key after reopening")
+	}
+	if _, k, err := s.Status(st.RegistrationID, token); err != nil || k != "" {
+		t.Errorf("Status after reopening returned key %q, error %v", k, err)
+	}
+}
+
+func TestDecide(t *testing.T) {
+	s, _ := Open("")
+	first, _ := register(t, s, "B12")
+	second, _ := register(t, s, "B12")
+	other, _ := register(t, s, "B13")
+
+	tests := []struct {
+		name    string
+		id      string
+		approve bool
+		wantErr error
+	}{
+		{name: "unknown", id: "missing", approve: true, wantErr: ErrNotFound},
+		{name: "approve", id: first.RegistrationID, approve: true},
+		{name: "decided", id: first.RegistrationID, approve: false, wantErr: ErrDecided},
+		{name: "station id taken", id: second.RegistrationID, approve: true, wantErr: ErrStationTaken},
+		{name: "reject same station id", id: second.RegistrationID, approve: false},
+		{name: "reject", id: other.RegistrationID, approve: false},
+	}
+	for _, tt := range tests {
+		if _, err := s.Decide(tt.id, tt.approve, ""); !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestDecideSaveFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "registrations.json")
+	s, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, token := register(t, s, "B12")
+
+	// A directory in the way of the temporary file makes saving fail.
+	if err := os.Mkdir(path+".tmp", 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Decide(st.RegistrationID, true, ""); err == nil {
+		t.Fatal("Decide succeeded without saving")
+	}
+	if got, _, _ := s.Status(st.RegistrationID, token); got.Status != StatusPending {
+		t.Errorf("status %s after a failed save, want %s", got.Status, StatusPending)
+	}
+
+	os.Remove(path + ".tmp")
+	if _, err := s.Decide(st.RegistrationID, true, ""); err != nil {
+		t.Errorf("retrying the decision: %v", err)
+	}
+}
+
+func TestLegacyUndeliveredKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "registrations.json")
+	legacy := `[{"registration_id":"r1","station_id":"B12","status":"approved",` +
+		`"bootstrap_hash":"` + hash("token") + `","api_key_hash":"` + hash("old") + `","undelivered_key":"old"}]`
+	if err := os.WriteFile(path, []byte(legacy), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	s, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(path)
+	if strings.Contains(string(data), "undelivered_key") {
+		t.Errorf("registrations file still holds the undelivered key")
+	}
+	if _, ok := s.LookupKey("old"); ok {
+		t.Errorf("the undelivered key is still accepted")
+	}
+	if _, key, err := s.Status("r1", "token"); err != nil || key == "" {
+		t.Errorf("Status returned key %q, error %v, want a new key", key, err)
+	}
+}
+
+func TestMaxPending(t *testing.T) {
+	s, _ := Open("")
+	for i := 0; i < MaxPending; i++ {
+		register(t, s, "B12")
+	}
+	if _, _, err := s.Register("B12", "", "", nil); !errors.Is(err, ErrTooMany) {
+		t.Fatalf("got error %v, want ErrTooMany", err)
+	}
+	for _, st := range s.List() {
+		if _, err := s.Decide(st.RegistrationID, false, ""); err != nil {
+			t.Fatal(err)
+		}
+		break
+	}
+	if _, _, err := s.Register("B12", "", "", nil); err != nil {
+		t.Errorf("registering after a decision: %v", err)
+	}
+}
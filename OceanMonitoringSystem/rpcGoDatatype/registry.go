@@ -0,0 +1,334 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"rpcGoDatatype/csvconverter"
+	pb "rpcGoDatatype/proto"
+	"rpcGoDatatype/registry"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// registryServer serves the StationRegistry service. Any authenticated
+// client may read the registry; changes need the admin token.
+type registryServer struct {
+	pb.UnimplementedStationRegistryServer
+	s *server
+}
+
+func registryError(err error) error {
+	switch {
+	case errors.Is(err, registry.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, registry.ErrExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, registry.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func (r registryServer) admin(ctx context.Context) error {
+	if !r.s.isAdmin(ctx) {
+		return status.Error(codes.PermissionDenied, "admin token required")
+	}
+	return nil
+}
+
+func (r registryServer) CreatePlatform(ctx context.Context, req *pb.CreatePlatformRequest) (*pb.Platform, error) {
+	if err := r.admin(ctx); err != nil {
+		return nil, err
+	}
+	p, err := platformFromProto(req.Platform)
+	if err != nil {
+		return nil, err
+	}
+	created, err := r.s.registry.Create(*p)
+	if err != nil {
+		return nil, registryError(err)
+	}
+	return platformProto(created), nil
+}
+
+func (r registryServer) GetPlatform(ctx context.Context, req *pb.GetPlatformRequest) (*pb.Platform, error) {
+	p, err := r.s.registry.Get(req.Id)
+	if err != nil {
+		return nil, registryError(err)
+	}
+	return platformProto(p), nil
+}
+
+func (r registryServer) ListPlatforms(ctx context.Context, req *pb.ListPlatformsRequest) (*pb.ListPlatformsResponse, error) {
+	resp := &pb.ListPlatformsResponse{}
+	for _, p := range r.s.registry.List(req.Type) {
+		resp.Platforms = append(resp.Platforms, platformProto(&p))
+	}
+	return resp, nil
+}
+
+// UpdatePlatform replaces a platform, including its sensors and
+// deployments.
+func (r registryServer) UpdatePlatform(ctx context.Context, req *pb.UpdatePlatformRequest) (*pb.Platform, error) {
+	if err := r.admin(ctx); err != nil {
+		return nil, err
+	}
+	p, err := platformFromProto(req.Platform)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := r.s.registry.Update(p.ID, func(old *registry.Platform) error {
+		*old = *p
+		return nil
+	})
+	if err != nil {
+		return nil, registryError(err)
+	}
+	return platformProto(updated), nil
+}
+
+func (r registryServer) DeletePlatform(ctx context.Context, req *pb.DeletePlatformRequest) (*pb.DeletePlatformResponse, error) {
+	if err := r.admin(ctx); err != nil {
+		return nil, err
+	}
+	if err := r.s.registry.Delete(req.Id); err != nil {
+		return nil, registryError(err)
+	}
+	return &pb.DeletePlatformResponse{}, nil
+}
+
+func (r registryServer) PutSensor(ctx context.Context, req *pb.PutSensorRequest) (*pb.Platform, error) {
+	if err := r.admin(ctx); err != nil {
+		return nil, err
+	}
+	sensor, err := sensorFromProto(req.Sensor)
+	if err != nil {
+		return nil, err
+	}
+	p, err := r.s.registry.PutSensor(req.PlatformId, sensor)
+	if err != nil {
+		return nil, registryError(err)
+	}
+	return platformProto(p), nil
+}
+
+func (r registryServer) DeleteSensor(ctx context.Context, req *pb.DeleteSensorRequest) (*pb.Platform, error) {
+	if err := r.admin(ctx); err != nil {
+		return nil, err
+	}
+	p, err := r.s.registry.DeleteSensor(req.PlatformId, req.SensorId)
+	if err != nil {
+		return nil, registryError(err)
+	}
+	return platformProto(p), nil
+}
+
+func (r registryServer) AddCalibration(ctx context.Context, req *pb.AddCalibrationRequest) (*pb.Platform, error) {
+	if err := r.admin(ctx); err != nil {
+		return nil, err
+	}
+	c, err := calibrationFromProto(req.Calibration)
+	if err != nil {
+		return nil, err
+	}
+	p, err := r.s.registry.AddCalibration(req.PlatformId, req.SensorId, c)
+	if err != nil {
+		return nil, registryError(err)
+	}
+	return platformProto(p), nil
+}
+
+func (r registryServer) PutDeployment(ctx context.Context, req *pb.PutDeploymentRequest) (*pb.Platform, error) {
+	if err := r.admin(ctx); err != nil {
+		return nil, err
+	}
+	d, err := deploymentFromProto(req.Deployment)
+	if err != nil {
+		return nil, err
+	}
+	p, err := r.s.registry.PutDeployment(req.PlatformId, d)
+	if err != nil {
+		return nil, registryError(err)
+	}
+	return platformProto(p), nil
+}
+
+func (r registryServer) DeleteDeployment(ctx context.Context, req *pb.DeleteDeploymentRequest) (*pb.Platform, error) {
+	if err := r.admin(ctx); err != nil {
+		return nil, err
+	}
+	p, err := r.s.registry.DeleteDeployment(req.PlatformId, req.DeploymentId)
+	if err != nil {
+		return nil, registryError(err)
+	}
+	return platformProto(p), nil
+}
+
+// stationInfo looks a station up in the registry for enrichment steps. The
+// position is that of the deployment covering at, or of the latest
+// deployment when at is zero.
+func (s *server) stationInfo(station string, at time.Time) (csvconverter.StationInfo, bool) {
+	p, err := s.registry.Get(station)
+	if err != nil {
+		return csvconverter.StationInfo{}, false
+	}
+	info := csvconverter.StationInfo{Name: p.Name, Type: p.Type, Institution: p.Institution}
+	var (
+		d  registry.Deployment
+		ok bool
+	)
+	if at.IsZero() {
+		if n := len(p.Deployments); n > 0 {
+			d, ok = p.Deployments[n-1], true
+		}
+	} else {
+		d, ok = p.Deployment(at)
+	}
+	if ok {
+		info.Located = true
+		info.Latitude, info.Longitude, info.Depth = d.Location.Latitude, d.Location.Longitude, d.Location.Depth
+	}
+	return info, true
+}
+
+// sensorCalibration looks up the calibration polynomial of a station's
+// sensor for calibration steps: the one in effect at at, or the latest when
+// at is zero.
+func (s *server) sensorCalibration(station, column string, at time.Time) ([]float64, bool) {
+	p, err := s.registry.Get(station)
+	if err != nil {
+		return nil, false
+	}
+	for _, sensor := range p.Sensors {
+		if sensor.Column != column || len(sensor.Calibrations) == 0 {
+			continue
+		}
+		c := sensor.Calibrations[len(sensor.Calibrations)-1]
+		if !at.IsZero() {
+			var ok bool
+			if c, ok = sensor.Calibration(at); !ok {
+				return nil, false
+			}
+		}
+		return c.Coefficients, len(c.Coefficients) > 0
+	}
+	return nil, false
+}
+
+// requestTime parses an RFC 3339 time of a request message. An empty
+// value is the zero time.
+func requestTime(value, field string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, badRequest(fmt.Sprintf("invalid %s %q: expected an RFC 3339 time", field, value), field)
+	}
+	return t.UTC(), nil
+}
+
+func platformFromProto(m *pb.Platform) (*registry.Platform, error) {
+	if m == nil {
+		return nil, badRequest("platform is required", "platform")
+	}
+	p := &registry.Platform{ID: m.Id, Name: m.Name, Type: m.Type, Description: m.Description, Institution: m.Institution}
+	for _, s := range m.Sensors {
+		sensor, err := sensorFromProto(s)
+		if err != nil {
+			return nil, err
+		}
+		p.Sensors = append(p.Sensors, sensor)
+	}
+	for _, d := range m.Deployments {
+		deployment, err := deploymentFromProto(d)
+		if err != nil {
+			return nil, err
+		}
+		p.Deployments = append(p.Deployments, deployment)
+	}
+	return p, nil
+}
+
+func sensorFromProto(m *pb.Sensor) (registry.Sensor, error) {
+	if m == nil {
+		return registry.Sensor{}, badRequest("sensor is required", "sensor")
+	}
+	s := registry.Sensor{ID: m.Id, Type: m.Type, Model: m.Model, SerialNumber: m.SerialNumber, Column: m.Column, Unit: m.Unit}
+	for _, c := range m.Calibrations {
+		cal, err := calibrationFromProto(c)
+		if err != nil {
+			return registry.Sensor{}, err
+		}
+		s.Calibrations = append(s.Calibrations, cal)
+	}
+	return s, nil
+}
+
+func calibrationFromProto(m *pb.Calibration) (registry.Calibration, error) {
+	if m == nil {
+		return registry.Calibration{}, badRequest("calibration is required", "calibration")
+	}
+	date, err := requestTime(m.Date, "date")
+	if err != nil {
+		return registry.Calibration{}, err
+	}
+	return registry.Calibration{Date: date, Coefficients: m.Coefficients, Certificate: m.Certificate, Notes: m.Notes}, nil
+}
+
+func deploymentFromProto(m *pb.Deployment) (registry.Deployment, error) {
+	if m == nil {
+		return registry.Deployment{}, badRequest("deployment is required", "deployment")
+	}
+	start, err := requestTime(m.Start, "start")
+	if err != nil {
+		return registry.Deployment{}, err
+	}
+	end, err := requestTime(m.End, "end")
+	if err != nil {
+		return registry.Deployment{}, err
+	}
+	d := registry.Deployment{ID: m.Id, Start: start, End: end, Notes: m.Notes}
+	if l := m.Location; l != nil {
+		d.Location = registry.Location{Latitude: l.Latitude, Longitude: l.Longitude, Depth: l.Depth}
+	}
+	return d, nil
+}
+
+func platformProto(p *registry.Platform) *pb.Platform {
+	m := &pb.Platform{
+		Id:          p.ID,
+		Name:        p.Name,
+		Type:        p.Type,
+		Description: p.Description,
+		Institution: p.Institution,
+		CreatedAt:   formatTime(p.CreatedAt),
+		UpdatedAt:   formatTime(p.UpdatedAt),
+	}
+	for _, s := range p.Sensors {
+		sensor := &pb.Sensor{Id: s.ID, Type: s.Type, Model: s.Model, SerialNumber: s.SerialNumber, Column: s.Column, Unit: s.Unit}
+		for _, c := range s.Calibrations {
+			sensor.Calibrations = append(sensor.Calibrations, &pb.Calibration{
+				Date:         formatTime(c.Date),
+				Coefficients: c.Coefficients,
+				Certificate:  c.Certificate,
+				Notes:        c.Notes,
+			})
+		}
+		m.Sensors = append(m.Sensors, sensor)
+	}
+	for _, d := range p.Deployments {
+		m.Deployments = append(m.Deployments, &pb.Deployment{
+			Id:       d.ID,
+			Start:    formatTime(d.Start),
+			End:      formatTime(d.End),
+			Location: &pb.Location{Latitude: d.Location.Latitude, Longitude: d.Location.Longitude, Depth: d.Location.Depth},
+			Notes:    d.Notes,
+		})
+	}
+	return m
+}
@@ -0,0 +1,392 @@
+// Package registry keeps the metadata of observing platforms: their
+// sensors and calibrations, and where and when they were deployed.
+package registry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	ErrNotFound = errors.New("not found")
+	ErrExists   = errors.New("already exists")
+	ErrInvalid  = errors.New("invalid platform")
+)
+
+// Calibration is one calibration of a sensor. Corrected values are
+// Coefficients[0] + Coefficients[1]*v + Coefficients[2]*v² and so on.
+type Calibration struct {
+	Date         time.Time `json:"date"`
+	Coefficients []float64 `json:"coefficients,omitempty"`
+	Certificate  string    `json:"certificate,omitempty"`
+	Notes        string    `json:"notes,omitempty"`
+}
+
+// Sensor is an instrument on a platform, reporting in Column.
+type Sensor struct {
+	ID           string        `json:"id"`
+	Type         string        `json:"type,omitempty"`
+	Model        string        `json:"model,omitempty"`
+	SerialNumber string        `json:"serial_number,omitempty"`
+	Column       string        `json:"column,omitempty"`
+	Unit         string        `json:"unit,omitempty"`
+	Calibrations []Calibration `json:"calibrations,omitempty"`
+}
+
+// Location is a position in decimal degrees, with the depth below the
+// surface in metres.
+type Location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Depth     float64 `json:"depth,omitempty"`
+}
+
+// Deployment is a period a platform spent at a location. A zero End means
+// it is still deployed.
+type Deployment struct {
+	ID       string    `json:"id"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end,omitzero"`
+	Location Location  `json:"location"`
+	Notes    string    `json:"notes,omitempty"`
+}
+
+// Platform is a station, buoy, mooring or vehicle carrying sensors.
+type Platform struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name,omitempty"`
+	Type        string       `json:"type,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Institution string       `json:"institution,omitempty"`
+	Sensors     []Sensor     `json:"sensors,omitempty"`
+	Deployments []Deployment `json:"deployments,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// Deployment returns the deployment of the platform covering t.
+func (p *Platform) Deployment(t time.Time) (Deployment, bool) {
+	for _, d := range p.Deployments {
+		if !t.Before(d.Start) && (d.End.IsZero() || t.Before(d.End)) {
+			return d, true
+		}
+	}
+	return Deployment{}, false
+}
+
+// Calibration returns the latest calibration of a sensor made at or before
+// t.
+func (s *Sensor) Calibration(t time.Time) (Calibration, bool) {
+	for i := len(s.Calibrations) - 1; i >= 0; i-- {
+		if c := s.Calibrations[i]; !c.Date.After(t) {
+			return c, true
+		}
+	}
+	return Calibration{}, false
+}
+
+// validate checks a platform and sorts its deployments and calibrations by
+// time.
+func (p *Platform) validate() error {
+	if p.ID == "" {
+		return fmt.Errorf("platform id is required")
+	}
+	seen := make(map[string]bool)
+	for i := range p.Sensors {
+		if err := p.Sensors[i].validate(); err != nil {
+			return err
+		}
+		if seen[p.Sensors[i].ID] {
+			return fmt.Errorf("sensor %s is listed twice", p.Sensors[i].ID)
+		}
+		seen[p.Sensors[i].ID] = true
+	}
+	clear(seen)
+	for _, d := range p.Deployments {
+		if err := d.validate(); err != nil {
+			return err
+		}
+		if seen[d.ID] {
+			return fmt.Errorf("deployment %s is listed twice", d.ID)
+		}
+		seen[d.ID] = true
+	}
+	sort.SliceStable(p.Deployments, func(i, j int) bool { return p.Deployments[i].Start.Before(p.Deployments[j].Start) })
+	for i := 1; i < len(p.Deployments); i++ {
+		prev, d := p.Deployments[i-1], p.Deployments[i]
+		if prev.End.IsZero() || d.Start.Before(prev.End) {
+			return fmt.Errorf("deployments %s and %s overlap", prev.ID, d.ID)
+		}
+	}
+	return nil
+}
+
+func (s *Sensor) validate() error {
+	if s.ID == "" {
+		return fmt.Errorf("sensor id is required")
+	}
+	for _, c := range s.Calibrations {
+		if c.Date.IsZero() {
+			return fmt.Errorf("sensor %s: calibration date is required", s.ID)
+		}
+		for _, f := range c.Coefficients {
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return fmt.Errorf("sensor %s: calibration coefficients must be finite", s.ID)
+			}
+		}
+	}
+	sort.SliceStable(s.Calibrations, func(i, j int) bool { return s.Calibrations[i].Date.Before(s.Calibrations[j].Date) })
+	return nil
+}
+
+func (d *Deployment) validate() error {
+	switch {
+	case d.ID == "":
+		return fmt.Errorf("deployment id is required")
+	case d.Start.IsZero():
+		return fmt.Errorf("deployment %s: start is required", d.ID)
+	case !d.End.IsZero() && !d.End.After(d.Start):
+		return fmt.Errorf("deployment %s: end must be after start", d.ID)
+	case !(math.Abs(d.Location.Latitude) <= 90 && math.Abs(d.Location.Longitude) <= 180):
+		return fmt.Errorf("deployment %s: location is out of range", d.ID)
+	}
+	return nil
+}
+
+// Store keeps platforms in memory and, when a path is set, in a JSON file.
+type Store struct {
+	path string
+
+	mu        sync.Mutex
+	platforms map[string]*Platform
+}
+
+// Open loads the store from path. An empty path keeps platforms in memory
+// only.
+func Open(path string) (*Store, error) {
+	s := &Store{path: path, platforms: make(map[string]*Platform)}
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading station registry: %v", err)
+	}
+	var platforms []*Platform
+	if err := json.Unmarshal(data, &platforms); err != nil {
+		return nil, fmt.Errorf("error parsing station registry: %v", err)
+	}
+	for _, p := range platforms {
+		s.platforms[p.ID] = p
+	}
+	return s, nil
+}
+
+// Create adds a platform.
+func (s *Store) Create(p Platform) (*Platform, error) {
+	p = clone(&p)
+	if err := p.validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.platforms[p.ID]; ok {
+		return nil, fmt.Errorf("platform %s: %w", p.ID, ErrExists)
+	}
+	p.CreatedAt = time.Now().UTC()
+	p.UpdatedAt = p.CreatedAt
+	s.platforms[p.ID] = &p
+	if err := s.save(); err != nil {
+		delete(s.platforms, p.ID)
+		return nil, err
+	}
+	c := clone(&p)
+	return &c, nil
+}
+
+// Get returns a platform.
+func (s *Store) Get(id string) (*Platform, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.platforms[id]
+	if !ok {
+		return nil, fmt.Errorf("platform %s: %w", id, ErrNotFound)
+	}
+	c := clone(p)
+	return &c, nil
+}
+
+// List returns the platforms of a type, or all platforms for an empty
+// type, ordered by ID.
+func (s *Store) List(typ string) []Platform {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := make([]Platform, 0, len(s.platforms))
+	for _, p := range s.platforms {
+		if typ == "" || p.Type == typ {
+			list = append(list, clone(p))
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list
+}
+
+// Update changes a platform with fn, which may return an error to leave
+// it unchanged.
+func (s *Store) Update(id string, fn func(*Platform) error) (*Platform, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, ok := s.platforms[id]
+	if !ok {
+		return nil, fmt.Errorf("platform %s: %w", id, ErrNotFound)
+	}
+	p := clone(old)
+	if err := fn(&p); err != nil {
+		return nil, err
+	}
+	p.ID, p.CreatedAt = old.ID, old.CreatedAt
+	if err := p.validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	p.UpdatedAt = time.Now().UTC()
+	s.platforms[id] = &p
+	if err := s.save(); err != nil {
+		s.platforms[id] = old
+		return nil, err
+	}
+	c := clone(&p)
+	return &c, nil
+}
+
+// Delete removes a platform.
+func (s *Store) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.platforms[id]
+	if !ok {
+		return fmt.Errorf("platform %s: %w", id, ErrNotFound)
+	}
+	delete(s.platforms, id)
+	if err := s.save(); err != nil {
+		s.platforms[id] = p
+		return err
+	}
+	return nil
+}
+
+// PutSensor adds a sensor to a platform or replaces the one with its ID.
+func (s *Store) PutSensor(platformID string, sensor Sensor) (*Platform, error) {
+	return s.Update(platformID, func(p *Platform) error {
+		for i := range p.Sensors {
+			if p.Sensors[i].ID == sensor.ID {
+				p.Sensors[i] = sensor
+				return nil
+			}
+		}
+		p.Sensors = append(p.Sensors, sensor)
+		return nil
+	})
+}
+
+// DeleteSensor removes a sensor from a platform.
+func (s *Store) DeleteSensor(platformID, sensorID string) (*Platform, error) {
+	return s.Update(platformID, func(p *Platform) error {
+		for i := range p.Sensors {
+			if p.Sensors[i].ID == sensorID {
+				p.Sensors = append(p.Sensors[:i], p.Sensors[i+1:]...)
+				return nil
+			}
+		}
+		return fmt.Errorf("sensor %s: %w", sensorID, ErrNotFound)
+	})
+}
+
+// AddCalibration records a calibration of a sensor.
+func (s *Store) AddCalibration(platformID, sensorID string, c Calibration) (*Platform, error) {
+	return s.Update(platformID, func(p *Platform) error {
+		for i := range p.Sensors {
+			if p.Sensors[i].ID == sensorID {
+				p.Sensors[i].Calibrations = append(p.Sensors[i].Calibrations, c)
+				return nil
+			}
+		}
+		return fmt.Errorf("sensor %s: %w", sensorID, ErrNotFound)
+	})
+}
+
+// PutDeployment adds a deployment to a platform or replaces the one with
+// its ID.
+func (s *Store) PutDeployment(platformID string, d Deployment) (*Platform, error) {
+	return s.Update(platformID, func(p *Platform) error {
+		for i := range p.Deployments {
+			if p.Deployments[i].ID == d.ID {
+				p.Deployments[i] = d
+				return nil
+			}
+		}
+		p.Deployments = append(p.Deployments, d)
+		return nil
+	})
+}
+
+// DeleteDeployment removes a deployment from a platform.
+func (s *Store) DeleteDeployment(platformID, deploymentID string) (*Platform, error) {
+	return s.Update(platformID, func(p *Platform) error {
+		for i := range p.Deployments {
+			if p.Deployments[i].ID == deploymentID {
+				p.Deployments = append(p.Deployments[:i], p.Deployments[i+1:]...)
+				return nil
+			}
+		}
+		return fmt.Errorf("deployment %s: %w", deploymentID, ErrNotFound)
+	})
+}
+
+// clone copies a platform deeply enough that the copy can be changed
+// without affecting the original.
+func clone(p *Platform) Platform {
+	c := *p
+	c.Sensors = append([]Sensor(nil), p.Sensors...)
+	for i := range c.Sensors {
+		c.Sensors[i].Calibrations = append([]Calibration(nil), c.Sensors[i].Calibrations...)
+		for j := range c.Sensors[i].Calibrations {
+			cal := &c.Sensors[i].Calibrations[j]
+			cal.Coefficients = append([]float64(nil), cal.Coefficients...)
+		}
+	}
+	c.Deployments = append([]Deployment(nil), p.Deployments...)
+	return c
+}
+
+// save writes the store to disk. Callers must hold s.mu.
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	platforms := make([]*Platform, 0, len(s.platforms))
+	for _, p := range s.platforms {
+		platforms = append(platforms, p)
+	}
+	sort.Slice(platforms, func(i, j int) bool { return platforms[i].ID < platforms[j].ID })
+	data, err := json.MarshalIndent(platforms, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding station registry: %v", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("error writing station registry: %v", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("error writing station registry: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+
+	"rpcGoDatatype/cache"
+	pb "rpcGoDatatype/proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// resultKey identifies a conversion by its formats, options and input, and
+// by the server's defaults for the options a request leaves unset.
+func resultKey(req *pb.ParseRequest, raw []byte, defaults string) (string, error) {
+	opts, err := proto.MarshalOptions{Deterministic: true}.Marshal(req.GetOptions())
+	if err != nil {
+		return "", err
+	}
+	data := raw
+	if data == nil {
+		data = []byte(req.Data)
+	}
+	return cache.Key([]byte(req.From), []byte(req.To), opts, []byte(defaults), data), nil
+}
+
+// cachedResult returns the cached response for key, if any. Cache errors
+// are logged and treated as a miss.
+func (s *server) cachedResult(ctx context.Context, key string) *pb.ParseResponse {
+	data, ok, err := s.cache.Get(ctx, key)
+	if err != nil {
+		slog.WarnContext(ctx, "result cache unavailable", "error", err)
+		return nil
+	}
+	if !ok {
+		return nil
+	}
+	resp := &pb.ParseResponse{}
+	if err := proto.Unmarshal(data, resp); err != nil {
+		slog.WarnContext(ctx, "error decoding result cache entry", "error", err)
+		return nil
+	}
+	resp.CacheHit = true
+	return resp
+}
+
+func (s *server) cacheResult(ctx context.Context, key string, resp *pb.ParseResponse) {
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		slog.WarnContext(ctx, "error encoding result cache entry", "error", err)
+		return
+	}
+	if err := s.cache.Set(ctx, key, data); err != nil {
+		slog.WarnContext(ctx, "result cache unavailable", "error", err)
+	}
+}
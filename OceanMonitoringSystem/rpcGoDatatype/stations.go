@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+
+	"rpcGoDatatype/auth"
+	"rpcGoDatatype/mtls"
+	pb "rpcGoDatatype/proto"
+	pbv2 "rpcGoDatatype/proto/v2"
+	"rpcGoDatatype/registration"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func registrationError(err error) error {
+	switch {
+	case errors.Is(err, registration.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, registration.ErrInvalidToken):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, registration.ErrDecided):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, registration.ErrStationTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, registration.ErrTooMany):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return err
+}
+
+// defaultProfile builds the conversion options a newly registered station
+// starts with: instrument columns are typed as numbers and timestamps are
+// normalized to UTC.
+func defaultProfile(st *registration.Station) *pb.ConvertOptions {
+	profile := &pb.ConvertOptions{
+		NormalizeTimestamps: true,
+		ColumnTypes:         make(map[string]string),
+	}
+	for _, inst := range st.Instruments {
+		profile.ColumnTypes[inst.Column] = "number"
+	}
+	return profile
+}
+
+// RegisterStation is open to unauthenticated clients, so its calls are
+// rate limited on their own.
+func (s *server) RegisterStation(ctx context.Context, req *pb.RegisterStationRequest) (*pb.RegisterStationResponse, error) {
+	if s.regLimiter != nil {
+		if err := s.regLimiter.Check(ctx); err != nil {
+			return nil, err
+		}
+	}
+	instruments := make([]registration.Instrument, len(req.Instruments))
+	for i, inst := range req.Instruments {
+		instruments[i] = registration.Instrument{
+			ID:     inst.Id,
+			Type:   inst.Type,
+			Column: inst.Column,
+			Unit:   inst.Unit,
+		}
+	}
+
+	st, token, err := s.stations.Register(req.StationId, req.Name, req.Contact, instruments)
+	if errors.Is(err, registration.ErrTooMany) {
+		return nil, registrationError(err)
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.RegisterStationResponse{
+		RegistrationId: st.RegistrationID,
+		BootstrapToken: token,
+		Status:         st.Status,
+	}, nil
+}
+
+func (s *server) ApproveStation(ctx context.Context, req *pb.ApproveStationRequest) (*pb.ApproveStationResponse, error) {
+	if !s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "admin token required")
+	}
+
+	st, err := s.stations.Decide(req.RegistrationId, req.Approve, req.Reason)
+	if err != nil {
+		return nil, registrationError(err)
+	}
+
+	return &pb.ApproveStationResponse{Status: st.Status}, nil
+}
+
+func (s *server) GetRegistrationStatus(ctx context.Context, req *pb.RegistrationStatusRequest) (*pb.RegistrationStatusResponse, error) {
+	st, key, err := s.stations.Status(req.RegistrationId, req.BootstrapToken)
+	if err != nil {
+		return nil, registrationError(err)
+	}
+
+	resp := &pb.RegistrationStatusResponse{
+		Status: st.Status,
+		Reason: st.Reason,
+		ApiKey: key,
+		Scopes: st.Scopes,
+	}
+	if st.Status == registration.StatusApproved {
+		resp.DefaultProfile = defaultProfile(st)
+	}
+	return resp, nil
+}
+
+// isAdmin checks the x-admin-token metadata against the configured admin token.
+func (s *server) isAdmin(ctx context.Context) bool {
+	if s.adminToken == "" {
+		return false
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, token := range md.Get("x-admin-token") {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// openMethods may be called by any client with a verified certificate and
+// without an API key, so that gateways can register before they are
+// approved.
+var openMethods = map[string]bool{
+	pb.DataParser_GetCompatibilityMatrix_FullMethodName: true,
+	pb.DataParser_RegisterStation_FullMethodName:        true,
+	pb.DataParser_ApproveStation_FullMethodName:         true,
+	pb.DataParser_GetRegistrationStatus_FullMethodName:  true,
+	pbv2.DataParser_ListFormats_FullMethodName:          true,
+	healthpb.Health_Check_FullMethodName:                true,
+	healthpb.Health_Watch_FullMethodName:                true,
+	healthpb.Health_List_FullMethodName:                 true,
+}
+
+// methodScopes are the scopes that principals need to call the methods that
+// are not open. Stations are granted registration.DefaultScopes.
+var methodScopes = map[string]string{
+	pb.DataParser_Parse_FullMethodName:           "parse",
+	pb.DataParser_Merge_FullMethodName:           "parse",
+	pb.DataParser_Split_FullMethodName:           "parse",
+	pb.DataParser_InferSchema_FullMethodName:     "parse",
+	pb.DataParser_DescribeData_FullMethodName:    "parse",
+	pb.DataParser_Validate_FullMethodName:        "parse",
+	pb.DataParser_Pipeline_FullMethodName:        "parse",
+	pb.DataParser_QualityControl_FullMethodName:  "parse",
+	pb.DataParser_DetectAnomalies_FullMethodName: "parse",
+	pb.DataParser_DetectGaps_FullMethodName:      "parse",
+	pb.DataParser_Correlate_FullMethodName:       "parse",
+	pb.DataParser_Aggregate_FullMethodName:       "parse",
+	pb.DataParser_ParseBatch_FullMethodName:      "parse",
+	pb.DataParser_ParseLive_FullMethodName:       "parse",
+	pbv2.DataParser_Convert_FullMethodName:       "parse",
+	pbv2.DataParser_ConvertStream_FullMethodName: "parse",
+	pbv2.DataParser_StreamRecords_FullMethodName: "parse",
+
+	pb.DataParser_SubmitJob_FullMethodName:    "jobs",
+	pb.DataParser_GetJobStatus_FullMethodName: "jobs",
+	pb.DataParser_GetJobResult_FullMethodName: "jobs",
+	pb.DataParser_CancelJob_FullMethodName:    "jobs",
+	pb.DataParser_ListJobs_FullMethodName:     "jobs",
+
+	pb.DataParser_GetUsage_FullMethodName:   "usage",
+	pb.DataParser_GetHistory_FullMethodName: "history",
+
+	pb.StationRegistry_CreatePlatform_FullMethodName:   "registry",
+	pb.StationRegistry_GetPlatform_FullMethodName:      "registry",
+	pb.StationRegistry_ListPlatforms_FullMethodName:    "registry",
+	pb.StationRegistry_UpdatePlatform_FullMethodName:   "registry",
+	pb.StationRegistry_DeletePlatform_FullMethodName:   "registry",
+	pb.StationRegistry_PutSensor_FullMethodName:        "registry",
+	pb.StationRegistry_DeleteSensor_FullMethodName:     "registry",
+	pb.StationRegistry_AddCalibration_FullMethodName:   "registry",
+	pb.StationRegistry_PutDeployment_FullMethodName:    "registry",
+	pb.StationRegistry_DeleteDeployment_FullMethodName: "registry",
+
+	pb.Alerting_CreateAlertRule_FullMethodName: "alerts",
+	pb.Alerting_ListAlertRules_FullMethodName:  "alerts",
+	pb.Alerting_DeleteAlertRule_FullMethodName: "alerts",
+	pb.Alerting_WatchAlerts_FullMethodName:     "alerts",
+}
+
+// authorizeGateway admits clients whose certificate common name is the
+// station ID of an approved registration.
+func (s *server) authorizeGateway(ctx context.Context, method string, id *mtls.Identity) error {
+	if id == nil {
+		return status.Error(codes.Unauthenticated, "client certificate required")
+	}
+	if openMethods[method] {
+		return nil
+	}
+	st, ok := s.stations.LookupStation(id.CommonName)
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "certificate %q does not belong to an approved station", id.CommonName)
+	}
+	p := &auth.Principal{Subject: st.StationID, Scopes: st.Scopes}
+	if scope, ok := methodScopes[method]; !ok || !p.HasScope(scope) {
+		return status.Errorf(codes.PermissionDenied, "station %q may not call %s", st.StationID, method)
+	}
+	return nil
+}
+
+// validateAPIKey authenticates approved stations by the API key they
+// collected after approval.
+func (s *server) validateAPIKey(ctx context.Context, cred auth.Credential) (*auth.Principal, error) {
+	if cred.APIKey == "" {
+		return nil, auth.ErrInvalidCredentials
+	}
+	st, ok := s.stations.LookupKey(cred.APIKey)
+	if !ok {
+		return nil, auth.ErrInvalidCredentials
+	}
+	return &auth.Principal{Subject: st.StationID, Scopes: st.Scopes}, nil
+}
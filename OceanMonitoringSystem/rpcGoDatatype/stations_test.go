@@ -0,0 +1,57 @@
+package main
+
+import (
+	"testing"
+
+	pb "rpcGoDatatype/proto"
+	pbv2 "rpcGoDatatype/proto/v2"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// services are the services registered on the gRPC server.
+var services = []*grpc.ServiceDesc{
+	&pb.DataParser_ServiceDesc,
+	&pbv2.DataParser_ServiceDesc,
+	&pb.StationRegistry_ServiceDesc,
+	&pb.Alerting_ServiceDesc,
+	&healthpb.Health_ServiceDesc,
+}
+
+// TestMethodAccess checks that every served method is either open or
+// mapped to a scope, so that none is refused to every caller once scopes
+// are required.
+func TestMethodAccess(t *testing.T) {
+	served := make(map[string]bool)
+	for _, desc := range services {
+		var names []string
+		for _, m := range desc.Methods {
+			names = append(names, m.MethodName)
+		}
+		for _, s := range desc.Streams {
+			names = append(names, s.StreamName)
+		}
+		for _, name := range names {
+			method := "/" + desc.ServiceName + "/" + name
+			served[method] = true
+			_, scoped := methodScopes[method]
+			switch {
+			case openMethods[method] && scoped:
+				t.Errorf("%s is both open and scoped", method)
+			case !openMethods[method] && !scoped:
+				t.Errorf("%s is neither open nor scoped", method)
+			}
+		}
+	}
+	for method := range methodScopes {
+		if !served[method] {
+			t.Errorf("scope for %s, which is not served", method)
+		}
+	}
+	for method := range openMethods {
+		if !served[method] {
+			t.Errorf("%s is open but not served", method)
+		}
+	}
+}
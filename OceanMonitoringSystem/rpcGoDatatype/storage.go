@@ -0,0 +1,227 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"rpcGoDatatype/fetch"
+	"rpcGoDatatype/hooks"
+	"rpcGoDatatype/influx"
+	"rpcGoDatatype/mmap"
+	"rpcGoDatatype/objectstore"
+	"rpcGoDatatype/postgres"
+	pb "rpcGoDatatype/proto"
+	"rpcGoDatatype/tracing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var contentTypes = map[string]string{
+	"csv":     "text/csv",
+	"json":    "application/json",
+	"influx":  "text/plain; charset=utf-8",
+	"arrow":   "application/vnd.apache.arrow.stream",
+	"parquet": "application/vnd.apache.parquet",
+}
+
+var tracer = otel.Tracer("rpcGoDatatype")
+
+// download reads the input referenced by a request URL, either from object
+// storage or over HTTP(S). With an object cache directory, objects are
+// downloaded there and mapped into memory. release frees the data once it
+// is converted.
+func (s *server) download(ctx context.Context, url string) (data []byte, release func(), err error) {
+	ctx, span := tracer.Start(ctx, "download")
+	defer func() {
+		span.SetAttributes(attribute.Int("download.bytes", len(data)))
+		tracing.EndSpan(span, err)
+	}()
+	if objectstore.IsURI(url) {
+		if s.objects == nil {
+			return nil, nil, status.Error(codes.FailedPrecondition, "object storage is not configured")
+		}
+		if s.objectCache == "" {
+			data, err = s.objects.Get(ctx, url, s.maxFetch)
+			return data, func() {}, err
+		}
+		path, err := s.objects.Download(ctx, url, s.objectCache, s.maxFetch)
+		if err != nil {
+			return nil, nil, err
+		}
+		return mapFile(path, url)
+	}
+	data, err = s.fetcher.Fetch(ctx, url)
+	if errors.Is(err, fetch.ErrDisabled) {
+		return nil, nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return data, func() {}, err
+}
+
+// mapFile maps the file at path, which holds the input named by name.
+func mapFile(path, name string) ([]byte, func(), error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error opening %s: %v", name, err)
+	}
+	defer f.Close()
+	return mapOpen(f)
+}
+
+// mapOpen maps an open file, which may be closed afterwards.
+func mapOpen(f *os.File) ([]byte, func(), error) {
+	region, err := mmap.Map(f)
+	if err != nil {
+		return nil, nil, err
+	}
+	return region.Bytes(), func() { region.Close() }, nil
+}
+
+// upload stores a conversion result at the request's output URL and
+// removes it from the response, which then only reports where it was
+// written.
+func (s *server) upload(ctx context.Context, req *pb.ParseRequest, resp *pb.ParseResponse) (err error) {
+	ctx, span := tracer.Start(ctx, "upload")
+	defer func() { tracing.EndSpan(span, err) }()
+	url, format := req.OutputUrl, req.To
+	data := resp.RawResult
+	if data == nil {
+		data = []byte(resp.Result)
+	}
+	span.SetAttributes(attribute.Int("upload.bytes", len(data)))
+	var sink string
+	switch {
+	case influx.IsURI(url):
+		if s.influx == nil {
+			return status.Error(codes.FailedPrecondition, "InfluxDB is not configured")
+		}
+		if !strings.EqualFold(format, "influx") {
+			return badRequest(fmt.Sprintf("InfluxDB output needs influx format, not %s", format), "to")
+		}
+		sink = "influx"
+		err = s.influx.Write(ctx, url, req.Options.GetInfluxPrecision(), data)
+	case postgres.IsURI(url):
+		if s.postgres == nil {
+			return status.Error(codes.FailedPrecondition, "PostgreSQL is not configured")
+		}
+		if !strings.EqualFold(format, "json") {
+			return badRequest(fmt.Sprintf("PostgreSQL output needs json format, not %s", format), "to")
+		}
+		sink = "postgres"
+		_, err = s.postgres.Write(ctx, url, data, postgres.WriteOptions{
+			Columns:         req.Options.GetPostgresColumns(),
+			OnConflict:      req.Options.GetPostgresOnConflict(),
+			ConflictColumns: req.Options.GetPostgresConflictColumns(),
+		})
+	case objectstore.IsURI(url):
+		sink = "objectstore"
+		err = s.putObject(ctx, url, format, data)
+	default:
+		return badRequest(fmt.Sprintf("unsupported output URL %q", url), "output_url")
+	}
+	if err != nil {
+		s.sinkFailed(sink, err)
+		return err
+	}
+	s.sinkSucceeded(sink)
+	resp.Result = ""
+	resp.RawResult = nil
+	resp.OutputUrl = url
+	return nil
+}
+
+// sinkFailureThreshold is the number of consecutive failed writes after
+// which an output sink is considered down, firing hooks.SinkCircuitOpen.
+const sinkFailureThreshold = 5
+
+// sinkFailed counts a failed write to sink.
+func (s *server) sinkFailed(sink string, err error) {
+	s.sinkMu.Lock()
+	if s.sinkFailures == nil {
+		s.sinkFailures = make(map[string]int)
+	}
+	s.sinkFailures[sink]++
+	n := s.sinkFailures[sink]
+	s.sinkMu.Unlock()
+	// Kafka writes are retried by the client; one failure stops the stage.
+	if n == sinkFailureThreshold || sink == "kafka" {
+		s.hooks.Fire(hooks.Event{
+			Condition: hooks.SinkCircuitOpen,
+			Message:   fmt.Sprintf("output sink %s is failing: %v", sink, err),
+			Details:   map[string]string{"sink": sink, "failures": strconv.Itoa(n), "error": err.Error()},
+		})
+	}
+}
+
+// sinkSucceeded resets the failures of sink.
+func (s *server) sinkSucceeded(sink string) {
+	s.sinkMu.Lock()
+	delete(s.sinkFailures, sink)
+	s.sinkMu.Unlock()
+}
+
+// putObject stores data in object storage.
+func (s *server) putObject(ctx context.Context, url, format string, data []byte) error {
+	if s.objects == nil {
+		return status.Error(codes.FailedPrecondition, "object storage is not configured")
+	}
+	contentType, ok := contentTypes[strings.ToLower(format)]
+	if !ok {
+		contentType = "application/octet-stream"
+	}
+	return s.objects.Put(ctx, url, data, contentType)
+}
+
+// readLocal reads a file below the data root. Paths are relative to the
+// root; ".." components and symbolic links cannot leave it.
+func (s *server) readLocal(path string) ([]byte, func(), error) {
+	if s.dataRoot == nil {
+		return nil, nil, status.Error(codes.FailedPrecondition, "file paths are not enabled")
+	}
+	name := filepath.Clean("/" + filepath.FromSlash(path))[1:]
+	if name == "" {
+		return nil, nil, badRequest(fmt.Sprintf("invalid path %q", path), "path")
+	}
+	f, err := s.dataRoot.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, status.Errorf(codes.NotFound, "error opening %s: %v", path, err)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("error opening %s: %v", path, err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error opening %s: %v", path, err)
+	}
+	if !info.Mode().IsRegular() {
+		return nil, nil, badRequest(fmt.Sprintf("%s is not a regular file", path), "path")
+	}
+	tooLarge := func() error {
+		msg := fmt.Sprintf("%s is larger than %d bytes", path, s.maxFetch)
+		return withDetails(codes.ResourceExhausted, msg, errorInfo(reasonLimitExceeded, nil))
+	}
+	if info.Size() > s.maxFetch {
+		return nil, nil, tooLarge()
+	}
+	// Stations write below the data root, possibly over NFS, so a file may
+	// be truncated while it is converted. It is read rather than mapped, as
+	// reading a mapped file past its new end would crash the server.
+	data, err := io.ReadAll(io.LimitReader(f, s.maxFetch+1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading %s: %v", path, err)
+	}
+	if int64(len(data)) > s.maxFetch {
+		return nil, nil, tooLarge()
+	}
+	return data, func() {}, nil
+}
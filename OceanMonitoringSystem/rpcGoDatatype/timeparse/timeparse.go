@@ -0,0 +1,220 @@
+package timeparse
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultPivot matches the two-digit year handling of time.Parse:
+// 69-99 map to 1969-1999 and 00-68 map to 2000-2068.
+const DefaultPivot = 69
+
+type layout struct {
+	format       string
+	twoDigitYear bool
+}
+
+var layouts = []layout{
+	{format: time.RFC3339Nano},
+	{format: "2006-01-02T15:04:05.999999999"},
+	{format: "2006-01-02 15:04:05.999999999Z07:00"},
+	{format: "2006-01-02 15:04:05.999999999"},
+	{format: "2006-01-02T15:04"},
+	{format: "2006-01-02 15:04"},
+	{format: "2006-01-02"},
+	{format: "2006/01/02 15:04:05"},
+	{format: "2006/01/02"},
+	{format: "01/02/2006 15:04:05"},
+	{format: "01/02/2006"},
+	{format: "2006-002T15:04:05.999999999Z07:00"},
+	{format: "2006-002T15:04:05.999999999"},
+	{format: "2006-002 15:04:05"},
+	{format: "2006-002"},
+	{format: "2006.002"},
+	{format: "2006002"},
+	{format: "2006-01-02 15:04:05,000"},
+	{format: "02/Jan/2006:15:04:05 -0700"},
+	{format: "02-Jan-2006 15:04:05"},
+	{format: time.ANSIC},
+	{format: time.UnixDate},
+	{format: time.RFC1123Z},
+	{format: time.RFC1123},
+	{format: "01/02/06 15:04:05", twoDigitYear: true},
+	{format: "01/02/06", twoDigitYear: true},
+	{format: "060102 150405", twoDigitYear: true},
+	{format: "060102", twoDigitYear: true},
+}
+
+// minDigits is the fewest digits any layout holds ("060102").
+const minDigits = 6
+
+// clockPattern matches the hh:mm[:ss] part of a timestamp.
+var clockPattern = regexp.MustCompile(`(^|[T\s])(\d{2}):(\d{2})(?::(\d{2}))?`)
+
+// Parser parses timestamps from legacy gauge archives, including leap
+// seconds, 24:00 end-of-day stamps, day-of-year dates and two-digit years.
+type Parser struct {
+	// Pivot decides the century of two-digit years: years below the pivot
+	// belong to the 2000s, the rest to the 1900s.
+	Pivot int
+	// Location is used for timestamps without an explicit offset. Defaults to UTC.
+	Location *time.Location
+}
+
+// ParseEpoch interprets a numeric string as a Unix timestamp. The unit is
+// chosen from the magnitude: seconds, milliseconds, microseconds or
+// nanoseconds.
+func ParseEpoch(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		abs := i
+		if abs < 0 {
+			abs = -abs
+		}
+		switch {
+		case abs < 1e11:
+			return time.Unix(i, 0).UTC(), nil
+		case abs < 1e14:
+			return time.UnixMilli(i).UTC(), nil
+		case abs < 1e17:
+			return time.UnixMicro(i).UTC(), nil
+		default:
+			return time.Unix(0, i).UTC(), nil
+		}
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) || math.Abs(f) >= 1e11 {
+		return time.Time{}, fmt.Errorf("invalid epoch timestamp %q", s)
+	}
+	sec, frac := math.Modf(f)
+	return time.Unix(int64(sec), int64(frac*1e9)).UTC(), nil
+}
+
+// Parse parses s with the default parser.
+func Parse(s string) (time.Time, error) {
+	return Parser{Pivot: DefaultPivot}.Parse(s)
+}
+
+// Parse parses s, trying each supported layout in turn.
+//
+// A leap second (23:59:60) is folded into the first instant of the next
+// minute, and 24:00:00 is read as midnight at the start of the next day.
+func (p Parser) Parse(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, fmt.Errorf("empty timestamp")
+	}
+
+	normalized, carry, err := normalizeClock(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !Plausible(s) {
+		return time.Time{}, fmt.Errorf("unrecognized timestamp %q", s)
+	}
+
+	loc := p.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	for _, l := range layouts {
+		t, err := time.ParseInLocation(l.format, normalized, loc)
+		if err != nil {
+			continue
+		}
+		if l.twoDigitYear {
+			if t, err = p.applyPivot(t); err != nil {
+				return time.Time{}, fmt.Errorf("%q: %v", s, err)
+			}
+		}
+		return carry.apply(t), nil
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized timestamp %q", s)
+}
+
+func (p Parser) applyPivot(t time.Time) (time.Time, error) {
+	yy := t.Year() % 100
+	year := 1900 + yy
+	if yy < p.Pivot {
+		year = 2000 + yy
+	}
+	pivoted := time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if pivoted.Day() != t.Day() {
+		return time.Time{}, fmt.Errorf("day out of range for year %d", year)
+	}
+	return pivoted, nil
+}
+
+// clockCarry is what normalizeClock takes off a clock value, to be added
+// back once it is parsed.
+type clockCarry struct {
+	days int
+	d    time.Duration
+}
+
+// apply adds the carry to t. Days are added on the calendar, so that 24:00
+// is the next midnight even when the day is shorter or longer than 24 hours
+// in t's location.
+func (c clockCarry) apply(t time.Time) time.Time {
+	return t.AddDate(0, 0, c.days).Add(c.d)
+}
+
+// normalizeClock rewrites clock values that time.Parse rejects into valid
+// ones and returns what has to be added back afterwards.
+func normalizeClock(s string) (string, clockCarry, error) {
+	m := clockPattern.FindStringSubmatchIndex(s)
+	if m == nil {
+		return s, clockCarry{}, nil
+	}
+
+	hour, minute := s[m[4]:m[5]], s[m[6]:m[7]]
+	second := ""
+	if m[8] >= 0 {
+		second = s[m[8]:m[9]]
+	}
+	rest := s[m[1]:]
+
+	switch {
+	case hour == "24":
+		if minute != "00" || (second != "" && second != "00") || strings.Trim(fractionOf(rest), "0") != "" {
+			return "", clockCarry{}, fmt.Errorf("invalid end-of-day timestamp %q", s)
+		}
+		return s[:m[4]] + "00" + s[m[5]:], clockCarry{days: 1}, nil
+	case second == "60":
+		if minute != "59" {
+			return "", clockCarry{}, fmt.Errorf("invalid leap second in %q", s)
+		}
+		return s[:m[8]] + "59" + s[m[9]:], clockCarry{d: time.Second}, nil
+	}
+	return s, clockCarry{}, nil
+}
+
+// Plausible reports whether s has enough digits to match any layout. It
+// lets callers that only want a yes or no skip Parse, whose failed layout
+// attempts each allocate an error.
+func Plausible(s string) bool {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] >= '0' && s[i] <= '9' {
+			n++
+		}
+	}
+	return n >= minDigits
+}
+
+func fractionOf(rest string) string {
+	if !strings.HasPrefix(rest, ".") && !strings.HasPrefix(rest, ",") {
+		return ""
+	}
+	end := 1
+	for end < len(rest) && rest[end] >= '0' && rest[end] <= '9' {
+		end++
+	}
+	return rest[1:end]
+}
@@ -0,0 +1,34 @@
+package timeparse
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEndOfDay(t *testing.T) {
+	lisbon, err := time.LoadLocation("Europe/Lisbon")
+	if err != nil {
+		t.Skip(err)
+	}
+	tests := []struct {
+		in   string
+		loc  *time.Location
+		want time.Time
+	}{
+		{"2024-03-30 24:00:00", time.UTC, time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)},
+		// Clocks go forward on 31 March and back on 27 October in Lisbon.
+		{"2024-03-31 24:00:00", lisbon, time.Date(2024, 4, 1, 0, 0, 0, 0, lisbon)},
+		{"2024-10-27 24:00:00", lisbon, time.Date(2024, 10, 28, 0, 0, 0, 0, lisbon)},
+		{"2016-12-31 23:59:60", time.UTC, time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := Parser{Pivot: DefaultPivot, Location: tt.loc}.Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Parse(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+// Package usage accounts requests and bytes per client and enforces
+// monthly quotas.
+package usage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrQuotaExceeded = errors.New("monthly quota exceeded")
+
+// Quota limits a client's usage per calendar month (UTC). Zero fields are
+// unlimited.
+type Quota struct {
+	Bytes    int64
+	Requests int64
+}
+
+// ParseQuotas parses per-client quotas such as
+// "group-a=1073741824:10000,group-b=0:500", given as bytes:requests.
+func ParseQuotas(s string) (map[string]Quota, error) {
+	quotas := make(map[string]Quota)
+	for _, item := range strings.Split(s, ",") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		client, spec, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid client quota %q", item)
+		}
+		b, r, _ := strings.Cut(spec, ":")
+		var q Quota
+		var err error
+		if q.Bytes, err = parseLimit(b); err != nil {
+			return nil, fmt.Errorf("client %s: invalid byte quota %q", strings.TrimSpace(client), b)
+		}
+		if q.Requests, err = parseLimit(r); err != nil {
+			return nil, fmt.Errorf("client %s: invalid request quota %q", strings.TrimSpace(client), r)
+		}
+		quotas[strings.TrimSpace(client)] = q
+	}
+	return quotas, nil
+}
+
+func parseLimit(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err == nil && n < 0 {
+		err = fmt.Errorf("negative")
+	}
+	return n, err
+}
+
+// Record is a client's usage in one month.
+type Record struct {
+	Client   string `json:"client"`
+	Period   string `json:"period"`
+	Requests int64  `json:"requests"`
+	BytesIn  int64  `json:"bytes_in"`
+	BytesOut int64  `json:"bytes_out"`
+	Rejected int64  `json:"rejected"`
+	Quota    Quota  `json:"-"`
+}
+
+func period(t time.Time) string {
+	return t.UTC().Format("2006-01")
+}
+
+// Tracker keeps the current month's usage in memory and, when a path is
+// set, in a JSON file written by Flush.
+type Tracker struct {
+	path      string
+	quota     Quota
+	overrides map[string]Quota
+
+	mu      sync.Mutex
+	records map[string]*Record
+	dirty   bool
+}
+
+// Open loads the usage stored at path. An empty path keeps usage in memory
+// only.
+func Open(path string, quota Quota, overrides map[string]Quota) (*Tracker, error) {
+	t := &Tracker{path: path, quota: quota, overrides: overrides, records: make(map[string]*Record)}
+	if path == "" {
+		return t, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading usage: %v", err)
+	}
+	var records []*Record
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("error parsing usage: %v", err)
+	}
+	for _, r := range records {
+		t.records[r.Client] = r
+	}
+	return t, nil
+}
+
+func (t *Tracker) quotaFor(client string) Quota {
+	if q, ok := t.overrides[client]; ok {
+		return q
+	}
+	return t.quota
+}
+
+// record returns the client's record for the current month. Callers must
+// hold t.mu.
+func (t *Tracker) record(client string, now time.Time) *Record {
+	p := period(now)
+	r, ok := t.records[client]
+	if !ok || r.Period != p {
+		r = &Record{Client: client, Period: p}
+		t.records[client] = r
+	}
+	return r
+}
+
+// Check returns ErrQuotaExceeded when the client has used up its quota for
+// the month, counting the call as rejected.
+func (t *Tracker) Check(client string) error {
+	q := t.quotaFor(client)
+	if q.Bytes == 0 && q.Requests == 0 {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	r := t.record(client, time.Now())
+	if q.Requests > 0 && r.Requests >= q.Requests || q.Bytes > 0 && r.BytesIn >= q.Bytes {
+		r.Rejected++
+		t.dirty = true
+		return ErrQuotaExceeded
+	}
+	return nil
+}
+
+// Add accounts a completed call.
+func (t *Tracker) Add(client string, bytesIn, bytesOut int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	r := t.record(client, time.Now())
+	r.Requests++
+	r.BytesIn += bytesIn
+	r.BytesOut += bytesOut
+	t.dirty = true
+}
+
+// Get returns the client's usage this month.
+func (t *Tracker) Get(client string) Record {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	r := Record{Client: client, Period: period(time.Now())}
+	if cur, ok := t.records[client]; ok && cur.Period == r.Period {
+		r = *cur
+	}
+	r.Quota = t.quotaFor(client)
+	return r
+}
+
+// List returns every client's usage this month, sorted by client.
+func (t *Tracker) List() []Record {
+	p := period(time.Now())
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var list []Record
+	for _, r := range t.records {
+		if r.Period == p {
+			c := *r
+			c.Quota = t.quotaFor(r.Client)
+			list = append(list, c)
+		}
+	}
+	sort.Slice(list, func(i, k int) bool { return list[i].Client < list[k].Client })
+	return list
+}
+
+// Flush writes usage to disk if it changed since the last flush.
+func (t *Tracker) Flush() error {
+	if t.path == "" {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.dirty {
+		return nil
+	}
+	records := make([]*Record, 0, len(t.records))
+	for _, r := range t.records {
+		records = append(records, r)
+	}
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding usage: %v", err)
+	}
+	tmp := t.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("error writing usage: %v", err)
+	}
+	if err := os.Rename(tmp, t.path); err != nil {
+		return fmt.Errorf("error writing usage: %v", err)
+	}
+	t.dirty = false
+	return nil
+}
+
+// Vars returns the current usage in a form suitable for expvar.
+func (t *Tracker) Vars() interface{} {
+	vars := make(map[string]Record)
+	for _, r := range t.List() {
+		vars[r.Client] = r
+	}
+	return vars
+}
@@ -0,0 +1,398 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+
+	"rpcGoDatatype/csvconverter"
+	pb "rpcGoDatatype/proto"
+	pbv2 "rpcGoDatatype/proto/v2"
+
+	rpccode "google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// streamChunkSize is the size of the output chunks ConvertStream sends.
+const streamChunkSize = 64 << 10
+
+// v2Server serves data.v2.DataParser on top of the v1 service, so both
+// versions share limits, caching, storage and accounting.
+type v2Server struct {
+	pbv2.UnimplementedDataParserServer
+	s *server
+}
+
+// v2Fields maps the v1 option fields to their place in the grouped v2
+// options: options.influx_tags is options.influx.tags.
+var v2Fields = func() map[string]string {
+	renamed := map[string]string{
+		"types.disable_inference": "disable_type_inference",
+		"template.body":           "template",
+	}
+	v1 := (&pb.ConvertOptions{}).ProtoReflect().Descriptor().Fields()
+	groups := (&pbv2.ConvertOptions{}).ProtoReflect().Descriptor().Fields()
+	m := make(map[string]string)
+	for i := 0; i < groups.Len(); i++ {
+		g := groups.Get(i)
+		fields := g.Message().Fields()
+		for j := 0; j < fields.Len(); j++ {
+			f := fields.Get(j)
+			path := string(g.Name()) + "." + string(f.Name())
+			for _, name := range []string{
+				renamed[path],
+				string(g.Name()) + "_" + string(f.Name()),
+				string(f.Name()) + "_" + string(g.Name()),
+				string(f.Name()),
+			} {
+				if name != "" && v1.ByName(protoreflect.Name(name)) != nil {
+					m["options."+name] = "options." + path
+					break
+				}
+			}
+		}
+	}
+	return m
+}()
+
+// v2Error reports an error like v1 does, with option fields named as in
+// the v2 options. Errors without an ErrorInfo get one whose reason is the
+// status code name.
+func v2Error(err error) error {
+	if err == nil {
+		return nil
+	}
+	st := status.Convert(convertError(err))
+	var details []protoadapt.MessageV1
+	hasInfo := false
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				if f, ok := v2Fields[v.Field]; ok {
+					v.Field = f
+				}
+			}
+		case *errdetails.ErrorInfo:
+			hasInfo = true
+			if f, ok := v2Fields[d.Metadata["field"]]; ok {
+				d.Metadata["field"] = f
+			}
+		}
+		if m, ok := d.(protoadapt.MessageV1); ok {
+			details = append(details, m)
+		}
+	}
+	if !hasInfo {
+		details = append(details, errorInfo(rpccode.Code(st.Code()).String(), nil))
+	}
+	return withDetails(st.Code(), st.Message(), details...)
+}
+
+// v1Options maps grouped v2 options onto the v1 options.
+func v1Options(o *pbv2.ConvertOptions) *pb.ConvertOptions {
+	if o == nil {
+		return nil
+	}
+	c, t, ts := o.GetCsv(), o.GetTypes(), o.GetTimestamps()
+	tr, v, tpl := o.GetTransform(), o.GetValidation(), o.GetTemplate()
+	in, pg, js := o.GetInflux(), o.GetPostgres(), o.GetJson()
+	opts := &pb.ConvertOptions{
+		NoHeader:                c.GetNoHeader(),
+		Headers:                 c.GetHeaders(),
+		OmitHeader:              c.GetOmitHeader(),
+		DuplicateHeaders:        c.GetDuplicateHeaders(),
+		JaggedRows:              c.GetJaggedRows(),
+		SkipLines:               c.GetSkipLines(),
+		CommentPrefix:           c.GetCommentPrefix(),
+		CaptureMetadata:         c.GetCaptureMetadata(),
+		InputEncoding:           c.GetInputEncoding(),
+		OutputEncoding:          c.GetOutputEncoding(),
+		DisableTypeInference:    t.GetDisableInference(),
+		StringColumns:           t.GetStringColumns(),
+		ColumnTypes:             t.GetColumnTypes(),
+		StrictNumbers:           t.GetStrictNumbers(),
+		NonFiniteAs:             t.GetNonFiniteAs(),
+		InferBooleans:           t.GetInferBooleans(),
+		NullValues:              t.GetNullValues(),
+		NullOutput:              t.GetNullOutput(),
+		DetectTimestamps:        ts.GetDetect(),
+		NormalizeTimestamps:     ts.GetNormalize(),
+		SourceTimezone:          ts.GetSourceTimezone(),
+		TargetTimezone:          ts.GetTargetTimezone(),
+		YearPivot:               ts.GetYearPivot(),
+		Columns:                 tr.GetColumns(),
+		Rename:                  tr.GetRename(),
+		ComputedColumns:         tr.GetComputedColumns(),
+		Filter:                  tr.GetFilter(),
+		Units:                   tr.GetUnits(),
+		SortBy:                  tr.GetSortBy(),
+		Deduplicate:             tr.GetDeduplicate(),
+		DedupKeys:               tr.GetDedupKeys(),
+		Reshape:                 tr.GetReshape(),
+		ReshapeIdColumns:        tr.GetReshapeIdColumns(),
+		ReshapeNameColumn:       tr.GetReshapeNameColumn(),
+		ReshapeValueColumn:      tr.GetReshapeValueColumn(),
+		UnpivotColumns:          tr.GetUnpivotColumns(),
+		Schema:                  v.GetSchema(),
+		SkipInvalidRows:         v.GetSkipInvalidRows(),
+		Mode:                    v.GetMode(),
+		OutOfRange:              v.GetOutOfRange(),
+		SensorType:              v.GetSensorType(),
+		Template:                tpl.GetBody(),
+		TemplateHeader:          tpl.GetHeader(),
+		TemplateFooter:          tpl.GetFooter(),
+		InfluxMeasurement:       in.GetMeasurement(),
+		InfluxTags:              in.GetTags(),
+		InfluxFields:            in.GetFields(),
+		InfluxTimeColumn:        in.GetTimeColumn(),
+		InfluxPrecision:         in.GetPrecision(),
+		PostgresColumns:         pg.GetColumns(),
+		PostgresOnConflict:      pg.GetOnConflict(),
+		PostgresConflictColumns: pg.GetConflictColumns(),
+		JsonIndent:              js.GetIndent(),
+	}
+	if js != nil {
+		opts.CompactJson = js.Compact
+	}
+	for _, r := range v.GetRanges() {
+		opts.Ranges = append(opts.Ranges, &pb.RangeRule{Column: r.Column, Min: r.Min, Max: r.Max})
+	}
+	return opts
+}
+
+// v2Metadata returns everything about a v1 response but its result.
+func v2Metadata(resp *pb.ParseResponse) *pbv2.ConversionMetadata {
+	md := &pbv2.ConversionMetadata{
+		Source:            resp.Metadata,
+		Preamble:          resp.Preamble,
+		Warnings:          resp.Warnings,
+		DuplicatesRemoved: resp.DuplicatesRemoved,
+		CacheHit:          resp.CacheHit,
+	}
+	for _, e := range resp.RowErrors {
+		md.RowErrors = append(md.RowErrors, &pbv2.RowError{Row: e.Row, Column: e.Column, Reason: e.Reason})
+	}
+	if st := resp.Stats; st != nil {
+		md.Stats = &pbv2.Stats{
+			RowsRead:    st.RowsRead,
+			RowsSkipped: st.RowsSkipped,
+			RowsWritten: st.RowsWritten,
+			Columns:     st.Columns,
+			ColumnTypes: st.ColumnTypes,
+			DurationMs:  st.DurationMs,
+		}
+	}
+	return md
+}
+
+func contentType(format string) string {
+	if t, ok := contentTypes[strings.ToLower(format)]; ok {
+		return t
+	}
+	return "application/octet-stream"
+}
+
+// Convert converts data, the document at a URL or a file below the data
+// root, like v1 Parse. The result is always bytes.
+func (v v2Server) Convert(ctx context.Context, req *pbv2.ConvertRequest) (*pbv2.ConvertResponse, error) {
+	resp, err := v.s.Parse(ctx, &pb.ParseRequest{
+		From:           req.From,
+		To:             req.To,
+		RawData:        req.GetData(),
+		Url:            req.GetUrl(),
+		Path:           req.GetPath(),
+		Options:        v1Options(req.Options),
+		OutputUrl:      req.OutputUrl,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		return nil, v2Error(err)
+	}
+	out := &pbv2.ConvertResponse{
+		Result:      resp.RawResult,
+		ContentType: contentType(req.To),
+		OutputUrl:   resp.OutputUrl,
+		Metadata:    v2Metadata(resp),
+	}
+	if out.Result == nil && resp.Result != "" {
+		out.Result = []byte(resp.Result)
+	}
+	return out, nil
+}
+
+// streamInput copies the chunks that follow a stream's header into a pipe
+// the conversion reads from.
+type streamInput struct {
+	*io.PipeReader
+	err atomic.Pointer[error]
+}
+
+func newStreamInput(recv func() ([]byte, bool, error)) *streamInput {
+	pr, pw := io.Pipe()
+	in := &streamInput{PipeReader: pr}
+	go func() {
+		for {
+			chunk, header, err := recv()
+			if err == io.EOF {
+				pw.Close()
+				return
+			}
+			if err == nil && header {
+				err = status.Error(codes.InvalidArgument, "a stream has one header")
+			}
+			if err != nil {
+				in.err.Store(&err)
+				pw.CloseWithError(err)
+				return
+			}
+			if _, err := pw.Write(chunk); err != nil {
+				return
+			}
+		}
+	}()
+	return in
+}
+
+// fail returns the error that ended the input, if any, rather than the
+// conversion error it caused.
+func (in *streamInput) fail(err error) error {
+	if p := in.err.Load(); p != nil {
+		return *p
+	}
+	return v2Error(err)
+}
+
+// chunkWriter sends its output in chunks of streamChunkSize.
+type chunkWriter struct {
+	buf  []byte
+	send func([]byte) error
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		k := min(len(p), streamChunkSize-len(c.buf))
+		c.buf = append(c.buf, p[:k]...)
+		p = p[k:]
+		if len(c.buf) == streamChunkSize {
+			if err := c.Flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (c *chunkWriter) Flush() error {
+	if len(c.buf) == 0 {
+		return nil
+	}
+	err := c.send(c.buf)
+	c.buf = make([]byte, 0, streamChunkSize)
+	return err
+}
+
+// ConvertStream converts input sent in chunks after a header and sends the
+// output back in chunks as it is written, then the conversion metadata.
+// The server's input limits apply, and the conversion takes a pool worker.
+func (v v2Server) ConvertStream(stream pbv2.DataParser_ConvertStreamServer) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "stream ended before its header")
+	}
+	if err != nil {
+		return err
+	}
+	header := first.GetHeader()
+	if header == nil {
+		return status.Error(codes.InvalidArgument, "the first message must be a header")
+	}
+	slog.InfoContext(ctx, "ConvertStream request", "from", header.From, "to", header.To)
+
+	release, err := v.s.pool.Acquire(ctx)
+	if err != nil {
+		return v2Error(err)
+	}
+	defer release()
+
+	in := newStreamInput(func() ([]byte, bool, error) {
+		msg, err := stream.Recv()
+		return msg.GetChunk(), msg.GetHeader() != nil, err
+	})
+	defer in.Close()
+	w := &chunkWriter{send: func(b []byte) error {
+		return stream.Send(&pbv2.ConvertStreamResponse{Message: &pbv2.ConvertStreamResponse_Chunk{Chunk: b}})
+	}}
+	result, err := csvconverter.ConvertStreamContext(ctx, header.From, header.To, in, w, v.s.alertOptions(ctx, v.s.options(v1Options(header.Options))))
+	if err != nil {
+		return in.fail(err)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return stream.Send(&pbv2.ConvertStreamResponse{Message: &pbv2.ConvertStreamResponse_Metadata{Metadata: v2Metadata(parseResponse(result))}})
+}
+
+// StreamRecords converts a feed sent in chunks after a header, sending
+// each row as a JSON record as soon as it is read, like v1 ParseLive. The
+// header's output format must be empty or json.
+func (v v2Server) StreamRecords(stream pbv2.DataParser_StreamRecordsServer) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "stream ended before its header")
+	}
+	if err != nil {
+		return err
+	}
+	header := first.GetHeader()
+	if header == nil {
+		return status.Error(codes.InvalidArgument, "the first message must be a header")
+	}
+	if header.To != "" && !strings.EqualFold(header.To, "json") {
+		return status.Errorf(codes.InvalidArgument, "records are JSON, not %s", header.To)
+	}
+	from := header.From
+	if from == "" {
+		from = "csv"
+	}
+	slog.InfoContext(ctx, "StreamRecords request", "from", from)
+	opts := v.s.alertOptions(ctx, v.s.options(v1Options(header.Options)))
+	opts.MaxInputBytes, opts.MaxRows = 0, 0
+
+	in := newStreamInput(func() ([]byte, bool, error) {
+		msg, err := stream.Recv()
+		return msg.GetChunk(), msg.GetHeader() != nil, err
+	})
+	defer in.Close()
+	result, err := csvconverter.ConvertRows(ctx, from, in, opts, func(row []byte, line int) error {
+		rec := &pbv2.Record{Line: int64(line), Json: row}
+		return stream.Send(&pbv2.StreamRecordsResponse{Message: &pbv2.StreamRecordsResponse_Record{Record: rec}})
+	})
+	if err != nil {
+		return in.fail(err)
+	}
+	return stream.Send(&pbv2.StreamRecordsResponse{Message: &pbv2.StreamRecordsResponse_Metadata{Metadata: v2Metadata(parseResponse(result))}})
+}
+
+// ListFormats lists the formats and the conversions between them.
+func (v v2Server) ListFormats(ctx context.Context, req *pbv2.ListFormatsRequest) (*pbv2.ListFormatsResponse, error) {
+	resp := &pbv2.ListFormatsResponse{Formats: csvconverter.Formats()}
+	for _, c := range csvconverter.CompatibilityMatrix() {
+		resp.Conversions = append(resp.Conversions, &pbv2.FormatPair{
+			From:   c.From,
+			To:     c.To,
+			Level:  c.Level,
+			Reason: c.Reason,
+		})
+	}
+	return resp, nil
+}